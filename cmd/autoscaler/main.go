@@ -8,6 +8,7 @@ import (
 	"github.com/airbusgeo/geocube/interface/autoscaler"
 	rc "github.com/airbusgeo/geocube/interface/autoscaler/k8s"
 	"github.com/airbusgeo/geocube/interface/autoscaler/qbas"
+	"github.com/airbusgeo/geocube/interface/messaging/nats"
 	"github.com/airbusgeo/geocube/interface/messaging/pgqueue"
 	"github.com/airbusgeo/geocube/interface/messaging/pubsub"
 	"github.com/airbusgeo/geocube/internal/log"
@@ -21,7 +22,8 @@ func main() {
 		argupd      = flag.Duration("update", 30*time.Second, "time between updates")
 		argproject  = flag.String("ps-project", "", "pubsub subscription project")
 		argpgqconn  = flag.String("pgq-connection", "", "pgqueue database connection")
-		argsub      = flag.String("queue", "", "pgqueue or pubsub subscription to configure the backlog for autoscaling (needs --ps-project or pgq-connection)")
+		argnatsurl  = flag.String("nats-url", "", "nats server url (jetstream)")
+		argsub      = flag.String("queue", "", "pgqueue, nats or pubsub subscription to configure the backlog for autoscaling (needs --ps-project, --pgq-connection or --nats-url)")
 		argrc       = flag.String("rc", "", "K8S replication controller")
 		argns       = flag.String("ns", "default", "K8S replication controller namespace")
 		argratio    = flag.Float64("ratio", 10.0, "job/worker ratio over which instances will be added")
@@ -59,6 +61,14 @@ func main() {
 		}
 		queue = pgqueue.NewConsumer(db, *argsub)
 		logMessage += " from pgqueue:" + *argsub
+	} else if *argnatsurl != "" {
+		nc, js, err := nats.Connect(*argnatsurl)
+		if err != nil {
+			panic(err)
+		}
+		defer nc.Close()
+		queue = nats.NewQueue(js, *argsub)
+		logMessage += " from nats:" + *argsub
 	} else if *argproject != "" {
 		if queue, err = pubsub.NewConsumer(*argproject, *argsub); err != nil {
 			panic(err)
@@ -66,7 +76,7 @@ func main() {
 		logMessage += " from pubsub:" + *argsub
 	}
 	if queue == nil {
-		panic("missing backlog configuration (e.g. queue, ps-project or pgq-connection)")
+		panic("missing backlog configuration (e.g. queue, ps-project, pgq-connection or nats-url)")
 	}
 	cfg := qbas.Config{
 		Ratio:        *argratio,
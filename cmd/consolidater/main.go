@@ -1,7 +1,6 @@
 package main
 
 import (
-	"bytes"
 	"context"
 	"flag"
 	"fmt"
@@ -12,18 +11,20 @@ import (
 
 	"github.com/airbusgeo/geocube/cmd"
 	"github.com/airbusgeo/geocube/interface/messaging"
+	"github.com/airbusgeo/geocube/interface/messaging/nats"
 	"github.com/airbusgeo/geocube/interface/messaging/pgqueue"
 	"github.com/airbusgeo/geocube/interface/messaging/pubsub"
+	"github.com/airbusgeo/geocube/interface/storage/cache"
 	"github.com/airbusgeo/geocube/internal/geocube"
 	"github.com/airbusgeo/geocube/internal/image"
 	"github.com/airbusgeo/geocube/internal/log"
-	"github.com/airbusgeo/geocube/internal/utils"
 	"go.uber.org/zap"
 )
 
 var (
-	eventPublisher messaging.Publisher
-	taskConsumer   messaging.Consumer
+	eventPublisher      messaging.Publisher
+	taskConsumer        messaging.Consumer
+	consolidationWorker *cmd.ConsolidationWorker
 )
 
 func main() {
@@ -42,6 +43,7 @@ func run(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	geocube.SetEventEncoding(consolidaterConfig.EventsEncoding)
 
 	jobStarted := time.Time{}
 	go func() {
@@ -58,6 +60,7 @@ func run(ctx context.Context) error {
 	if err := cmd.InitGDAL(ctx, consolidaterConfig.GDALConfig); err != nil {
 		return fmt.Errorf("init gdal: %w", err)
 	}
+	defer cache.CloseDefault()
 
 	// Create Messaging Service
 	var logMessaging string
@@ -69,7 +72,7 @@ func run(ctx context.Context) error {
 			}
 			if consolidaterConfig.ConsolidationsQueue != "" {
 				logMessaging += fmt.Sprintf(" pulling on pgqueue:%s", consolidaterConfig.ConsolidationsQueue)
-				consumer := pgqueue.NewConsumer(db, consolidaterConfig.ConsolidationsQueue)
+				consumer := pgqueue.NewConsumer(db, consolidaterConfig.ConsolidationsQueue, pgqueue.WithDeadLetterHandler(notifyDeadLetter))
 				defer consumer.Stop()
 				//consumer.SetProcessOption(pubsub.OnErrorRetryDelay(60 * time.Second))
 				taskConsumer = consumer
@@ -77,7 +80,29 @@ func run(ctx context.Context) error {
 
 			if consolidaterConfig.EventsQueue != "" {
 				logMessaging += fmt.Sprintf(" publishing on pgqueue:%s", consolidaterConfig.EventsQueue)
-				eventPublisher = pgqueue.NewPublisher(w, consolidaterConfig.EventsQueue)
+				eventPublisher = pgqueue.NewPublisher(db, w, consolidaterConfig.EventsQueue)
+			}
+		} else if consolidaterConfig.NatsURL != "" {
+			nc, js, err := nats.Connect(consolidaterConfig.NatsURL)
+			if err != nil {
+				return fmt.Errorf("natsMessaging: %w", err)
+			}
+			defer nc.Close()
+			if consolidaterConfig.ConsolidationsQueue != "" {
+				logMessaging += fmt.Sprintf(" pulling on nats:%s", consolidaterConfig.ConsolidationsQueue)
+				consumer, err := nats.NewConsumer(ctx, js, consolidaterConfig.ConsolidationsQueue, nats.WithRedeliveryDelay(60*time.Second))
+				if err != nil {
+					return fmt.Errorf("nats.NewConsumer: %w", err)
+				}
+				defer consumer.Stop()
+				taskConsumer = consumer
+			}
+
+			if consolidaterConfig.EventsQueue != "" {
+				logMessaging += fmt.Sprintf(" publishing on nats:%s", consolidaterConfig.EventsQueue)
+				if eventPublisher, err = nats.NewPublisher(ctx, js, consolidaterConfig.EventsQueue); err != nil {
+					return fmt.Errorf("nats.NewPublisher: %w", err)
+				}
 			}
 		} else {
 			// Connection to pubsub
@@ -113,6 +138,7 @@ func run(ctx context.Context) error {
 	}
 
 	handlerConsolidation := image.NewHandleConsolidation(image.NewCogGenerator(), image.NewMucogGenerator(), consolidaterConfig.CancelledJobsStorage, consolidaterConfig.Workers, consolidaterConfig.LocalDownloadMaxMb)
+	consolidationWorker = cmd.NewConsolidationWorker(handlerConsolidation, eventPublisher, consolidaterConfig.WorkDir, consolidaterConfig.RetryCount)
 	log.Logger(ctx).Sugar().Debugf("consolidater starts "+logMessaging+" with %d worker(s)", consolidaterConfig.Workers)
 	for {
 		err := taskConsumer.Pull(ctx, func(ctx context.Context, msg *messaging.Message) error {
@@ -120,49 +146,7 @@ func run(ctx context.Context) error {
 			defer func() {
 				jobStarted = time.Time{}
 			}()
-
-			// Retrieve consolidation event
-			evt, err := geocube.UnmarshalConsolidationEvent(bytes.NewReader(msg.Data))
-			if err != nil {
-				return fmt.Errorf("got message id %s in workdir %s : unreadable (%d bytes): %w", msg.ID, consolidaterConfig.WorkDir, len(msg.Data), err)
-			}
-			ctx = log.WithFields(ctx, zap.String("JobID", evt.JobID), zap.String("TaskID", evt.TaskID))
-
-			if msg.TryCount > consolidaterConfig.RetryCount {
-				log.Logger(ctx).Sugar().Errorf("too many tries")
-				if err := notify(ctx, evt, geocube.TaskFailed, fmt.Errorf("too many tries")); err != nil {
-					return fmt.Errorf("failed to notify consolidation event: %w", err)
-				}
-				return nil
-			}
-
-			// Start consolidation
-			var taskStatus geocube.TaskStatus
-			log.Logger(ctx).Sugar().Infof("got message id %s in workdir %s : start consolidation of %d records into the container: %s", msg.ID, consolidaterConfig.WorkDir, len(evt.Records), evt.Container.URI)
-			taskErr := handlerConsolidation.Consolidate(ctx, evt, consolidaterConfig.WorkDir)
-
-			if taskErr != nil && utils.Temporary(taskErr) && msg.TryCount < consolidaterConfig.RetryCount {
-				log.Logger(ctx).Sugar().Errorf("temporary error: %s", taskErr.Error())
-				return taskErr
-			}
-
-			switch {
-			case taskErr == nil:
-				taskStatus = geocube.TaskSuccessful
-			case taskErr == image.TaskCancelledConsolidationError:
-				taskStatus = geocube.TaskCancelled
-			case taskErr == image.NotImplementedError:
-				taskStatus = geocube.TaskIgnored
-			default:
-				log.Logger(ctx).Sugar().Errorf("failed to consolidate: %s", taskErr.Error())
-				taskStatus = geocube.TaskFailed
-			}
-
-			if err = notify(ctx, evt, taskStatus, taskErr); err != nil {
-				return fmt.Errorf("failed to notify consolidation event: %w", err)
-			}
-
-			return nil
+			return consolidationWorker.Process(ctx, msg)
 		})
 		if err != nil {
 			return fmt.Errorf("cl.process: %w", err)
@@ -183,9 +167,11 @@ func newConsolidationAppConfig() (*consolidaterConfig, error) {
 
 	// Messaging
 	flag.StringVar(&consolidaterConfig.PgqDbConnection, "pgqConnection", "", "url of the postgres database to enable pgqueue messaging system (pgqueue only)")
+	flag.StringVar(&consolidaterConfig.NatsURL, "natsURL", "", "url of the nats server to enable nats jetstream messaging system (e.g. nats://localhost:4222)")
 	flag.StringVar(&consolidaterConfig.Project, "psProject", "", "subscription project (gcp pubSub only)")
-	flag.StringVar(&consolidaterConfig.ConsolidationsQueue, "consolidationsQueue", "", "name of the messaging queue for consolidation jobs (pgqueue or pubsub subscription)")
-	flag.StringVar(&consolidaterConfig.EventsQueue, "eventsQueue", "", "name of the messaging queue for job events (pgquue or pubsub topic)")
+	flag.StringVar(&consolidaterConfig.ConsolidationsQueue, "consolidationsQueue", "", "name of the messaging queue for consolidation jobs (pgqueue, nats or pubsub subscription)")
+	flag.StringVar(&consolidaterConfig.EventsQueue, "eventsQueue", "", "name of the messaging queue for job events (pgquue, nats or pubsub topic)")
+	eventsEncoding := flag.String("eventsEncoding", "protobuf", "encoding of the task events sent by the consolidater (protobuf or gob). Both encodings are always accepted on reception. Use gob during a rolling upgrade from a version older than 1.1.0")
 
 	// GDAL
 	consolidaterConfig.GDALConfig = cmd.GDALConfigFlags()
@@ -195,6 +181,11 @@ func newConsolidationAppConfig() (*consolidaterConfig, error) {
 	if consolidaterConfig.WorkDir == "" {
 		return nil, fmt.Errorf("missing --workdir config flag")
 	}
+	consolidaterConfig.GDALConfig.CacheDir = consolidaterConfig.WorkDir
+	var err error
+	if consolidaterConfig.EventsEncoding, err = geocube.ParseEventEncoding(*eventsEncoding); err != nil {
+		return nil, fmt.Errorf("--eventsEncoding: %w", err)
+	}
 	if consolidaterConfig.CancelledJobsStorage == "" {
 		return nil, fmt.Errorf("missing --cancelledJobs storage flag")
 	}
@@ -206,6 +197,8 @@ type consolidaterConfig struct {
 	Project              string
 	EventsQueue          string
 	PgqDbConnection      string
+	NatsURL              string
+	EventsEncoding       geocube.EventEncoding
 	WorkDir              string
 	ConsolidationsQueue  string
 	CancelledJobsStorage string
@@ -216,16 +209,7 @@ type consolidaterConfig struct {
 	GDALConfig           *cmd.GDALConfig
 }
 
-func notify(ctx context.Context, evt *geocube.ConsolidationEvent, taskStatus geocube.TaskStatus, taskError error) error {
-	taskEvt := geocube.NewTaskEvent(evt.JobID, evt.TaskID, taskStatus, taskError)
-	data, err := geocube.MarshalEvent(*taskEvt)
-	if err != nil {
-		return utils.MakeTemporary(fmt.Errorf("MarshalTaskEvent: %w", err))
-	}
-
-	if err = eventPublisher.Publish(ctx, data); err != nil {
-		return utils.MakeTemporary(fmt.Errorf("PublishTaskEvent: %w", err))
-	}
-
-	return nil
+// notifyDeadLetter notifies the failure of the task whose consolidation event has been moved to the dead-letter queue
+func notifyDeadLetter(ctx context.Context, msg *messaging.Message, deadLetterErr error) {
+	consolidationWorker.NotifyDeadLetter(ctx, msg, deadLetterErr)
 }
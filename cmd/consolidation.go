@@ -0,0 +1,112 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/airbusgeo/geocube/interface/messaging"
+	"github.com/airbusgeo/geocube/internal/geocube"
+	"github.com/airbusgeo/geocube/internal/image"
+	"github.com/airbusgeo/geocube/internal/log"
+	"github.com/airbusgeo/geocube/internal/utils"
+	"go.uber.org/zap"
+)
+
+// ConsolidationWorker processes the consolidation events and publishes the task events.
+// It is used by the consolidater and by the server in all-in-one mode.
+type ConsolidationWorker struct {
+	handler        image.Handler
+	eventPublisher messaging.Publisher
+	workDir        string
+	retryCount     int
+}
+
+// NewConsolidationWorker creates a worker that consolidates in the workDir.
+// A consolidation that fails with a temporary error is retried retryCount times.
+func NewConsolidationWorker(handler image.Handler, eventPublisher messaging.Publisher, workDir string, retryCount int) *ConsolidationWorker {
+	return &ConsolidationWorker{
+		handler:        handler,
+		eventPublisher: eventPublisher,
+		workDir:        workDir,
+		retryCount:     retryCount,
+	}
+}
+
+// Process implements messaging.Callback
+func (w *ConsolidationWorker) Process(ctx context.Context, msg *messaging.Message) error {
+	// Retrieve consolidation event
+	evt, err := geocube.UnmarshalConsolidationEvent(bytes.NewReader(msg.Data))
+	if err != nil {
+		return fmt.Errorf("got message id %s in workdir %s : unreadable (%d bytes): %w", msg.ID, w.workDir, len(msg.Data), err)
+	}
+	ctx = log.WithFields(ctx, zap.String("JobID", evt.JobID), zap.String("TaskID", evt.TaskID))
+
+	if msg.TryCount > w.retryCount {
+		log.Logger(ctx).Sugar().Errorf("too many tries")
+		if err := w.notify(ctx, evt.JobID, evt.TaskID, geocube.TaskFailed, fmt.Errorf("too many tries"), image.ConsolidationOutput{}); err != nil {
+			return fmt.Errorf("failed to notify consolidation event: %w", err)
+		}
+		return nil
+	}
+
+	// Start consolidation
+	var taskStatus geocube.TaskStatus
+	log.Logger(ctx).Sugar().Infof("got message id %s in workdir %s : start consolidation of %d records into the container: %s", msg.ID, w.workDir, len(evt.Records), evt.Container.URI)
+	output, taskErr := w.handler.Consolidate(ctx, evt, w.workDir)
+
+	if taskErr != nil && utils.Temporary(taskErr) && msg.TryCount < w.retryCount {
+		log.Logger(ctx).Sugar().Errorf("temporary error: %s", taskErr.Error())
+		return taskErr
+	}
+
+	switch {
+	case taskErr == nil:
+		taskStatus = geocube.TaskSuccessful
+	case taskErr == image.TaskCancelledConsolidationError:
+		taskStatus = geocube.TaskCancelled
+	case taskErr == image.NotImplementedError:
+		taskStatus = geocube.TaskIgnored
+	default:
+		log.Logger(ctx).Sugar().Errorf("failed to consolidate: %s", taskErr.Error())
+		taskStatus = geocube.TaskFailed
+	}
+
+	if err = w.notify(ctx, evt.JobID, evt.TaskID, taskStatus, taskErr, output); err != nil {
+		return fmt.Errorf("failed to notify consolidation event: %w", err)
+	}
+
+	return nil
+}
+
+// NotifyDeadLetter notifies the failure of the task whose consolidation event has been moved to the dead-letter queue
+// If the consolidation event cannot be read, the task is identified by the attributes of the message (see geocube.ConsolidationEventAttributes).
+// It implements messaging.DeadLetterHandler
+func (w *ConsolidationWorker) NotifyDeadLetter(ctx context.Context, msg *messaging.Message, deadLetterErr error) {
+	jobID, taskID := msg.Attributes[geocube.ConsolidationJobIDAttribute], msg.Attributes[geocube.ConsolidationTaskIDAttribute]
+	if evt, err := geocube.UnmarshalConsolidationEvent(bytes.NewReader(msg.Data)); err == nil {
+		jobID, taskID = evt.JobID, evt.TaskID
+	} else if jobID == "" || taskID == "" {
+		log.Logger(ctx).Sugar().Errorf("dead letter %s: unable to notify the failure of the task: %s", msg.ID, err.Error())
+		return
+	}
+	ctx = log.WithFields(ctx, zap.String("JobID", jobID), zap.String("TaskID", taskID))
+	if err := w.notify(ctx, jobID, taskID, geocube.TaskFailed, fmt.Errorf("dead letter: %w", deadLetterErr), image.ConsolidationOutput{}); err != nil {
+		log.Logger(ctx).Sugar().Errorf("dead letter %s: %s", msg.ID, err.Error())
+	}
+}
+
+func (w *ConsolidationWorker) notify(ctx context.Context, jobID, taskID string, taskStatus geocube.TaskStatus, taskError error, output image.ConsolidationOutput) error {
+	taskEvt := geocube.NewTaskEvent(jobID, taskID, taskStatus, taskError)
+	taskEvt.Size, taskEvt.Checksum = output.Size, output.Checksums.String()
+	data, err := geocube.MarshalEvent(*taskEvt)
+	if err != nil {
+		return utils.MakeTemporary(fmt.Errorf("MarshalTaskEvent: %w", err))
+	}
+
+	if err = w.eventPublisher.Publish(ctx, data); err != nil {
+		return utils.MakeTemporary(fmt.Errorf("PublishTaskEvent: %w", err))
+	}
+
+	return nil
+}
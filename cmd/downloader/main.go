@@ -16,6 +16,7 @@ import (
 	"google.golang.org/grpc/keepalive"
 
 	"github.com/airbusgeo/geocube/cmd"
+	"github.com/airbusgeo/geocube/interface/storage/cache"
 	geogrpc "github.com/airbusgeo/geocube/internal/grpc"
 	"github.com/airbusgeo/geocube/internal/log"
 	pb "github.com/airbusgeo/geocube/internal/pb"
@@ -58,12 +59,14 @@ func run(ctx context.Context) error {
 	if err := cmd.InitGDAL(ctx, downloaderConfig.GDALConfig); err != nil {
 		return fmt.Errorf("init gdal: %w", err)
 	}
+	defer cache.CloseDefault()
 
 	// Create Geocube Service
 	svc, err := svc.New(ctx, nil, nil, nil, "", "", downloaderConfig.CubeWorkers)
 	if err != nil {
 		return fmt.Errorf("svc.new: %w", err)
 	}
+	svc.SetWorkDir(downloaderConfig.WorkDir)
 
 	grpcServer := newGrpcServer(svc, downloaderConfig.MaxConnectionAge, downloaderConfig.ChunkSizeByte)
 
@@ -115,11 +118,15 @@ func newDownloaderAppConfig() (*serverConfig, error) {
 	flag.IntVar(&serverConfig.MaxConnectionAge, "maxConnectionAge", 15*60, "grpc max age connection in seconds")
 	flag.IntVar(&serverConfig.CubeWorkers, "workers", 1, "number of workers to parallelize the processing of the slices of a cube (see also GdalMultithreading)")
 	flag.IntVar(&serverConfig.ChunkSizeByte, "chunk-size", 1024*1024, "chunk size for grpc streaming of images in bytes. If an image is bigger than chunk_size_bytes, it is divided into chunks and streamed. Grpc recommends a chunk_size of 64kbytes, but in localhost, performances are better with a bigger chunk_size, such as 1Mbytes. By default, chunk_size is limited by Grpc to 4Mbytes.")
-	flag.StringVar(&svc.PredownloadDir, "workdir", os.TempDir(), "temporary directory (for predownload)")
+	flag.StringVar(&svc.PredownloadDir, "workdir", os.TempDir(), "temporary directory (for predownload and the cube files)")
 	serverConfig.GDALConfig = cmd.GDALConfigFlags()
 
 	flag.Parse()
 
+	// The storage cache and the cube files are stored in the workdir
+	serverConfig.GDALConfig.CacheDir = svc.PredownloadDir
+	serverConfig.WorkDir = svc.PredownloadDir
+
 	if serverConfig.AppPort == "" {
 		return nil, fmt.Errorf("failed to initialize --port application flag")
 	}
@@ -133,5 +140,6 @@ type serverConfig struct {
 	MaxConnectionAge int
 	CubeWorkers      int
 	ChunkSizeByte    int
+	WorkDir          string
 	GDALConfig       *cmd.GDALConfig
 }
@@ -7,16 +7,17 @@ import (
 	"os"
 	"strconv"
 
+	geocubeStorage "github.com/airbusgeo/geocube/interface/storage"
+	"github.com/airbusgeo/geocube/interface/storage/azure"
+	"github.com/airbusgeo/geocube/interface/storage/cache"
 	"github.com/airbusgeo/geocube/interface/storage/gcs"
+	storageHttp "github.com/airbusgeo/geocube/interface/storage/http"
+	"github.com/airbusgeo/geocube/interface/storage/s3"
 	"github.com/airbusgeo/godal"
 	"github.com/airbusgeo/osio"
 
-	"github.com/aws/aws-sdk-go-v2/aws"
-	awsConfig "github.com/aws/aws-sdk-go-v2/config"
-
 	osioGcs "github.com/airbusgeo/osio/gcs"
 	osioS3 "github.com/airbusgeo/osio/s3"
-	aws3 "github.com/aws/aws-sdk-go-v2/service/s3"
 )
 
 type GDALConfig struct {
@@ -29,6 +30,12 @@ type GDALConfig struct {
 	AwsRegion       string
 	AwsEndpoint     string
 	AwsCredentials  string
+	WithAzure       bool
+	AzureAccount    string
+	AzureEndpoint   string
+	WithHTTP        bool
+	CacheSizeMb     int
+	CacheDir        string // Parent directory of the storage cache, stored in a subdirectory per process (default: os.TempDir())
 	RegisterPNG     bool
 }
 
@@ -40,6 +47,11 @@ const (
 	AWSRegion       = "aws-region"
 	AWSEndPoint     = "aws-endpoint"
 	AwsCredentials  = "aws-shared-credentials-file"
+	WithAzure       = "with-azure"
+	AzureAccount    = "azure-storage-account"
+	AzureEndpoint   = "azure-endpoint"
+	WithHTTP        = "with-http"
+	CacheSizeMb     = "storage-cache-mb"
 	StorageDebug    = "gdalStorageDebug"
 )
 
@@ -53,6 +65,11 @@ func GDALConfigFlags() *GDALConfig {
 	flag.StringVar(&gdalConfig.AwsRegion, "aws-region", "", "define aws_region for GDAL to use s3 storage (--with-s3)")
 	flag.StringVar(&gdalConfig.AwsEndpoint, "aws-endpoint", "", "define aws_endpoint for GDAL to use s3 storage (--with-s3)")
 	flag.StringVar(&gdalConfig.AwsCredentials, "aws-shared-credentials-file", "", "define aws_shared_credentials_file for GDAL to use s3 storage (--with-s3)")
+	flag.BoolVar(&gdalConfig.WithAzure, "with-azure", false, "configure GDAL to use azure blob storage (az://container/path). The access key or the connection string are read from AZURE_STORAGE_ACCESS_KEY or AZURE_STORAGE_CONNECTION_STRING env var")
+	flag.StringVar(&gdalConfig.AzureAccount, "azure-storage-account", os.Getenv("AZURE_STORAGE_ACCOUNT"), "define the azure storage account (--with-azure)")
+	flag.StringVar(&gdalConfig.AzureEndpoint, "azure-endpoint", "", "define a custom azure blob service url (e.g. Azurite emulator: http://127.0.0.1:10000/devstoreaccount1) (--with-azure)")
+	flag.BoolVar(&gdalConfig.WithHTTP, "with-http", false, "configure GDAL to read http(s) files with the read-only http storage strategy (etags are checked)")
+	flag.IntVar(&gdalConfig.CacheSizeMb, "storage-cache-mb", 0, "size of the local cache of the blocks read from the remote storages (in Mb, 0 to disable). The cache is stored in a subdirectory of the workdir, removed when the process stops")
 	flag.BoolVar(&gdalConfig.StorageDebug, "gdalStorageDebug", false, "enable storage debug to use custom gdal storage strategy")
 	return &gdalConfig
 }
@@ -85,13 +102,33 @@ func InitGDAL(ctx context.Context, gdalConfig *GDALConfig) error {
 		StreamAt(key string, off int64, n int64) (io.ReadCloser, int64, error)
 	}
 
+	// The block cache is shared by all the storages
+	var blockCache *cache.BlockCache
+	if gdalConfig.CacheSizeMb > 0 {
+		cacheDir := gdalConfig.CacheDir
+		if cacheDir == "" {
+			cacheDir = os.TempDir()
+		}
+		var err error
+		blockCache, err = cache.New(cacheDir, int64(gdalConfig.CacheSizeMb)*1024*1024, cache.DefaultBlockSize)
+		if err != nil {
+			return err
+		}
+		cache.SetDefault(blockCache)
+	}
+
 	switch {
 	case gdalConfig.WithGCS:
 		if godal.HasVSIHandler("gs://") {
 			break
 		}
 		var err error
-		if gdalConfig.StorageDebug {
+		if blockCache != nil {
+			adapter, err = newCacheStrategy(ctx, gcs.NewGsStrategy, blockCache)
+			if err != nil {
+				return err
+			}
+		} else if gdalConfig.StorageDebug {
 			adapter, err = gcs.NewGsStrategy(ctx)
 			if err != nil {
 				return err
@@ -114,39 +151,76 @@ func InitGDAL(ctx context.Context, gdalConfig *GDALConfig) error {
 		}
 
 	case gdalConfig.WithS3:
-		resolver := aws.EndpointResolverFunc(func(service, region string) (aws.Endpoint, error) {
-			return aws.Endpoint{
-				PartitionID:       "aws",
-				URL:               gdalConfig.AwsEndpoint,
-				SigningRegion:     region,
-				HostnameImmutable: true,
-			}, nil
-		})
-
-		config, err := awsConfig.LoadDefaultConfig(ctx,
-			awsConfig.WithSharedCredentialsFiles([]string{gdalConfig.AwsCredentials}),
-			awsConfig.WithRegion(gdalConfig.AwsRegion),
-			awsConfig.WithEndpointResolver(resolver),
-		)
+		s3Config := s3.Config{
+			Region:                gdalConfig.AwsRegion,
+			Endpoint:              gdalConfig.AwsEndpoint,
+			SharedCredentialsFile: gdalConfig.AwsCredentials,
+		}
+		// Storage strategies created from s3 uris share the same configuration
+		s3.SetDefaultConfig(s3Config)
+
+		var err error
+		if blockCache != nil {
+			adapter, err = newCacheStrategy(ctx, s3.NewS3Strategy, blockCache)
+			if err != nil {
+				return err
+			}
+		} else if gdalConfig.StorageDebug {
+			adapter, err = s3.NewS3Strategy(ctx)
+			if err != nil {
+				return err
+			}
+		} else {
+			s3Client, err := s3.NewClient(ctx, s3Config)
+			if err != nil {
+				return err
+			}
+			adapter, err = osioS3.Handle(ctx, osioS3.S3Client(s3Client))
+			if err != nil {
+				return err
+			}
+		}
+
+		s3Adapter, err := osio.NewAdapter(adapter,
+			osio.BlockSize(gdalConfig.BlockSize),
+			osio.NumCachedBlocks(gdalConfig.NumCachedBlocks))
 		if err != nil {
 			return err
 		}
 
-		s3Client := aws3.NewFromConfig(config)
-		osioS3Handle, err := osioS3.Handle(ctx, osioS3.S3Client(s3Client))
+		err = godal.RegisterVSIHandler("s3://", s3Adapter)
 		if err != nil {
 			return err
 		}
 
-		s3Adapter, err := osio.NewAdapter(osioS3Handle,
+	case gdalConfig.WithAzure:
+		azConfig := azure.DefaultConfig()
+		if gdalConfig.AzureAccount != "" {
+			azConfig.AccountName = gdalConfig.AzureAccount
+		}
+		if gdalConfig.AzureEndpoint != "" {
+			azConfig.Endpoint = gdalConfig.AzureEndpoint
+		}
+		// Storage strategies created from az uris share the same configuration
+		azure.SetDefaultConfig(azConfig)
+
+		var err error
+		if blockCache != nil {
+			adapter, err = newCacheStrategy(ctx, azure.NewAzStrategy, blockCache)
+		} else {
+			adapter, err = azure.NewAzStrategy(ctx)
+		}
+		if err != nil {
+			return err
+		}
+		azAdapter, err := osio.NewAdapter(adapter,
 			osio.BlockSize(gdalConfig.BlockSize),
 			osio.NumCachedBlocks(gdalConfig.NumCachedBlocks))
 		if err != nil {
 			return err
 		}
 
-		err = godal.RegisterVSIHandler("s3://", s3Adapter)
-		if err != nil {
+		if err = godal.RegisterVSIHandler("az://", azAdapter); err != nil {
 			return err
 		}
 
@@ -157,5 +231,39 @@ func InitGDAL(ctx context.Context, gdalConfig *GDALConfig) error {
 		// Else no debug > Nothing to do
 	}
 
+	// Http(s) files can be read in addition to any other storage
+	if gdalConfig.WithHTTP && !godal.HasVSIHandler("https://") {
+		var httpStrategy geocubeStorage.Strategy
+		var err error
+		if blockCache != nil {
+			httpStrategy, err = newCacheStrategy(ctx, storageHttp.NewHTTPStrategy, blockCache)
+		} else {
+			httpStrategy, err = storageHttp.NewHTTPStrategy(ctx)
+		}
+		if err != nil {
+			return err
+		}
+		for _, prefix := range []string{"http://", "https://"} {
+			httpAdapter, err := osio.NewAdapter(httpStrategy,
+				osio.BlockSize(gdalConfig.BlockSize),
+				osio.NumCachedBlocks(gdalConfig.NumCachedBlocks))
+			if err != nil {
+				return err
+			}
+			if err = godal.RegisterVSIHandler(prefix, httpAdapter); err != nil {
+				return err
+			}
+		}
+	}
+
 	return nil
 }
+
+// newCacheStrategy creates a storage strategy whose reads go through the block cache
+func newCacheStrategy(ctx context.Context, newStrategy func(context.Context) (geocubeStorage.Strategy, error), blockCache *cache.BlockCache) (geocubeStorage.Strategy, error) {
+	strategy, err := newStrategy(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return cache.NewCacheStrategy(ctx, strategy, blockCache), nil
+}
@@ -7,6 +7,7 @@ import (
 	"flag"
 	"fmt"
 	"io"
+	"math"
 	"net/http"
 	"os"
 	"os/signal"
@@ -17,9 +18,12 @@ import (
 	"github.com/airbusgeo/geocube/interface/database"
 	"github.com/airbusgeo/geocube/interface/database/pg"
 	"github.com/airbusgeo/geocube/interface/messaging"
+	"github.com/airbusgeo/geocube/interface/messaging/memqueue"
+	"github.com/airbusgeo/geocube/interface/messaging/nats"
 	"github.com/airbusgeo/geocube/interface/messaging/pgqueue"
 	"github.com/airbusgeo/geocube/interface/messaging/pubsub"
 	"github.com/airbusgeo/geocube/interface/secrets"
+	"github.com/airbusgeo/geocube/interface/storage/cache"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"go.uber.org/zap"
 	"golang.org/x/net/http2"
@@ -29,12 +33,17 @@ import (
 
 	"github.com/airbusgeo/geocube/internal/geocube"
 	geogrpc "github.com/airbusgeo/geocube/internal/grpc"
+	"github.com/airbusgeo/geocube/internal/image"
 	"github.com/airbusgeo/geocube/internal/log"
 	pb "github.com/airbusgeo/geocube/internal/pb"
+	"github.com/airbusgeo/geocube/internal/stac"
 	"github.com/airbusgeo/geocube/internal/svc"
 	"github.com/airbusgeo/geocube/internal/utils"
 )
 
+// outboxPollInterval is the interval between two polls of the outbox, to publish the messages stored by the other instances of the server
+const outboxPollInterval = 5 * time.Second
+
 func main() {
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, os.Interrupt)
@@ -93,10 +102,12 @@ func run(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	geocube.SetEventEncoding(serverConfig.EventsEncoding)
 
 	if err := cmd.InitGDAL(ctx, serverConfig.GDALConfig); err != nil {
 		return fmt.Errorf("init gdal: %w", err)
 	}
+	defer cache.CloseDefault()
 
 	// Connect to database
 	var db database.GeocubeDBBackend
@@ -106,30 +117,97 @@ func run(ctx context.Context) error {
 		if err != nil {
 			return fmt.Errorf("pg.GetConnString: %w", err)
 		}
-		if db, err = pg.New(ctx, dbConnection); err != nil {
+		pgdb, err := pg.New(ctx, dbConnection)
+		if err != nil {
 			return fmt.Errorf("pg.new: %w", err)
 		}
+		db = pgdb
+
+		// Schema migrations
+		if serverConfig.AutoMigrate || serverConfig.Migrate {
+			applied, err := pgdb.Migrate(ctx)
+			if err != nil {
+				return fmt.Errorf("pg.Migrate: %w", err)
+			}
+			for _, version := range applied {
+				log.Logger(ctx).Info("Database schema migrated to v" + version)
+			}
+		} else if err := pgdb.CheckSchemaVersion(ctx); err != nil {
+			return fmt.Errorf("pg.CheckSchemaVersion (apply the migrations with --migrate or --autoMigrate): %w", err)
+		}
+		if serverConfig.PgqDbConnection != "" {
+			if err := migratePgqueue(ctx, serverConfig); err != nil {
+				return err
+			}
+		}
+		if serverConfig.Migrate {
+			log.Logger(ctx).Info("Database schema is up to date (v" + pg.SchemaVersion() + ")")
+			return nil
+		}
 	}
 
 	// Create Messaging Service
 	var eventPublisher, consolidationPublisher messaging.Publisher
 	var eventConsumer messaging.Consumer
+	var deadLetterQueue messaging.DeadLetterQueue
+	var consolidationConsumers []messaging.Consumer
+	var consolidationWorker *cmd.ConsolidationWorker
 	{
-		if serverConfig.PgqDbConnection != "" {
+		if serverConfig.AllInOne {
+			// In-process messaging and consolidation
+			eventsQueue, consolidationsQueue := memqueue.NewQueue("events"), memqueue.NewQueue("consolidations")
+			eventPublisher = memqueue.NewPublisher(eventsQueue)
+			consumer := memqueue.NewConsumer(eventsQueue)
+			defer consumer.Stop()
+			eventConsumer = consumer
+			consolidationPublisher = memqueue.NewPublisher(consolidationsQueue)
+
+			handlerConsolidation := image.NewHandleConsolidation(image.NewCogGenerator(), image.NewMucogGenerator(), serverConfig.CancelledConsolidationStorage, serverConfig.CubeWorkers, math.MaxInt64)
+			consolidationWorker = cmd.NewConsolidationWorker(handlerConsolidation, eventPublisher, serverConfig.WorkDir, memqueue.DefaultMaxTries-1)
+			for i := 0; i < serverConfig.ConsolidationWorkers; i++ {
+				consumer := memqueue.NewConsumer(consolidationsQueue, memqueue.WithDeadLetterHandler(consolidationWorker.NotifyDeadLetter))
+				defer consumer.Stop()
+				consolidationConsumers = append(consolidationConsumers, consumer)
+			}
+		} else if serverConfig.PgqDbConnection != "" {
 			// Connection to pgqueue
 			db, w, err := pgqueue.SqlConnect(ctx, serverConfig.PgqDbConnection)
 			if err != nil {
 				return fmt.Errorf("pgqueue.Open: %w", err)
 
 			}
+			deadLetterQueue = pgqueue.NewDeadLetterQueue(db, w)
 			if serverConfig.EventsQueue != "" {
-				eventPublisher = pgqueue.NewPublisher(w, serverConfig.EventsQueue)
+				eventPublisher = pgqueue.NewPublisher(db, w, serverConfig.EventsQueue)
 				consumer := pgqueue.NewConsumer(db, serverConfig.EventsQueue)
 				defer consumer.Stop()
 				eventConsumer = consumer
 			}
 			if serverConfig.ConsolidationsQueue != "" {
-				consolidationPublisher = pgqueue.NewPublisher(w, serverConfig.ConsolidationsQueue)
+				consolidationPublisher = pgqueue.NewPublisher(db, w, serverConfig.ConsolidationsQueue)
+			}
+		} else if serverConfig.NatsURL != "" {
+			// Connection to nats
+			nc, js, err := nats.Connect(serverConfig.NatsURL)
+			if err != nil {
+				return fmt.Errorf("nats.Connect: %w", err)
+			}
+			defer nc.Close()
+			if serverConfig.EventsQueue != "" {
+				if eventPublisher, err = nats.NewPublisher(ctx, js, serverConfig.EventsQueue); err != nil {
+					return fmt.Errorf("nats.NewPublisher: %w", err)
+				}
+				consumer, err := nats.NewConsumer(ctx, js, serverConfig.EventsQueue)
+				if err != nil {
+					return fmt.Errorf("nats.NewConsumer: %w", err)
+				}
+				defer consumer.Stop()
+				eventConsumer = consumer
+			}
+			if serverConfig.ConsolidationsQueue != "" {
+				if consolidationPublisher, err = nats.NewPublisher(ctx, js, serverConfig.ConsolidationsQueue); err != nil {
+					return fmt.Errorf("nats.NewPublisher: %w", err)
+				}
 			}
 		} else if serverConfig.Project != "" {
 			// Connection to pubsub
@@ -157,6 +235,14 @@ func run(ctx context.Context) error {
 	if err != nil {
 		return fmt.Errorf("svc.new: %w", err)
 	}
+	svc.SetWorkDir(serverConfig.WorkDir)
+	if deadLetterQueue != nil {
+		svc.SetDeadLetterQueue(deadLetterQueue)
+	}
+	if serverConfig.Outbox {
+		svc.EnableOutbox()
+		go svc.RunOutboxRelay(ctx, outboxPollInterval)
+	}
 
 	eventHandler := func(ctx context.Context, m *messaging.Message) error {
 		evt, err := geocube.UnmarshalEvent(bytes.NewReader(m.Data))
@@ -171,6 +257,7 @@ func run(ctx context.Context) error {
 	log.Logger(ctx).Info("Geocube v" + geogrpc.GeocubeServerVersion)
 
 	gwmuxHandler := newGatewayHandler(ctx, svc, serverConfig.MaxConnectionAge)
+	stacHandler := stac.NewAPI(svc, "/v1/stac", "/v1/catalog/mosaic")
 
 	muxHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if isGrpcRequest(r) {
@@ -209,6 +296,22 @@ func run(ctx context.Context) error {
 			gwmuxHandler.ServeHTTP(w, r)
 			return
 		}
+		if strings.HasPrefix(r.URL.Path, "/v1/stac") {
+			w.Header().Add("Access-Control-Allow-Origin", "*")
+			if r.Method == "OPTIONS" {
+				w.Header().Add("Access-Control-Allow-Methods", "OPTIONS, GET, POST")
+				w.Header().Add("Access-Control-Allow-Headers", "Content-Type,"+utils.AuthorizationHeader+","+utils.ESRIAuthorizationHeader)
+				w.WriteHeader(200)
+				return
+			}
+			if err := authenticate([]string{userTokenKey}, []string{r.Header.Get(utils.AuthorizationHeader), r.Header.Get(utils.ESRIAuthorizationHeader)}); err != nil {
+				w.WriteHeader(401)
+				fmt.Fprint(w, err.Error())
+				return
+			}
+			stacHandler.ServeHTTP(w, r)
+			return
+		}
 		fmt.Fprintf(w, "ok")
 	})
 
@@ -238,7 +341,7 @@ func run(ctx context.Context) error {
 
 	go func() {
 		if eventConsumer != nil {
-			for {
+			for ctx.Err() == nil {
 				if err := eventConsumer.Pull(ctx, eventHandler); err != nil {
 					log.Logger(ctx).Fatal("eventConsumer.Pull", zap.Error(err))
 				}
@@ -246,12 +349,43 @@ func run(ctx context.Context) error {
 		}
 	}()
 
+	for _, consumer := range consolidationConsumers {
+		go func(consumer messaging.Consumer) {
+			for ctx.Err() == nil {
+				if err := consumer.Pull(ctx, consolidationWorker.Process); err != nil {
+					log.Logger(ctx).Fatal("consolidationConsumer.Pull", zap.Error(err))
+				}
+			}
+		}(consumer)
+	}
+
 	<-ctx.Done()
 	sctx, cncl := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cncl()
 	return srv.Shutdown(sctx)
 }
 
+// migratePgqueue applies the pending migrations of the tables of pgqueue (--migrate or --autoMigrate) or checks that they are up to date
+func migratePgqueue(ctx context.Context, serverConfig *serverConfig) error {
+	db, _, err := pgqueue.SqlConnect(ctx, serverConfig.PgqDbConnection)
+	if err != nil {
+		return fmt.Errorf("pgqueue.Open: %w", err)
+	}
+	defer db.Close()
+
+	if !serverConfig.AutoMigrate && !serverConfig.Migrate {
+		return pgqueue.CheckSchema(ctx, db)
+	}
+	applied, err := pgqueue.Migrate(ctx, db)
+	if err != nil {
+		return err
+	}
+	for _, script := range applied {
+		log.Logger(ctx).Info("Pgqueue tables migrated (" + script + ")")
+	}
+	return nil
+}
+
 func newGrpcServer(svc geogrpc.GeocubeService, asvc geogrpc.GeocubeServiceAdmin, maxConnectionAgeValue int) *grpc.Server {
 
 	opts := []grpc.ServerOption{
@@ -323,8 +457,16 @@ func newServerAppConfig() (*serverConfig, error) {
 	// Messaging
 	flag.StringVar(&serverConfig.Project, "project", "", "project name (gcp only/not required in local usage)")
 	flag.StringVar(&serverConfig.PgqDbConnection, "pgqConnection", "", "url of the postgres database to enable pgqueue messaging system (pgqueue only)")
-	flag.StringVar(&serverConfig.EventsQueue, "eventsQueue", "", "name of the pgqueue or the pubsub topic to send the asynchronous job events")
-	flag.StringVar(&serverConfig.ConsolidationsQueue, "consolidationsQueue", "", "name of the pgqueue or the pubsub topic to send the consolidation orders")
+	flag.StringVar(&serverConfig.NatsURL, "natsURL", "", "url of the nats server to enable nats jetstream messaging system (e.g. nats://localhost:4222)")
+	flag.StringVar(&serverConfig.EventsQueue, "eventsQueue", "", "name of the pgqueue, the nats stream or the pubsub topic to send the asynchronous job events")
+	flag.StringVar(&serverConfig.ConsolidationsQueue, "consolidationsQueue", "", "name of the pgqueue, the nats stream or the pubsub topic to send the consolidation orders")
+	flag.BoolVar(&serverConfig.AllInOne, "allInOne", false, "run the consolidations in the server process, using an in-memory messaging system (pending events and consolidation orders are lost when the server stops)")
+	flag.StringVar(&serverConfig.WorkDir, "workdir", os.TempDir(), "scratch work directory of the cube files (NetCDF4, Zarr), of the storage cache and of the consolidations (allInOne only)")
+	flag.IntVar(&serverConfig.ConsolidationWorkers, "consolidationWorkers", 1, "number of consolidations run in parallel (allInOne only)")
+	flag.BoolVar(&serverConfig.Migrate, "migrate", false, "apply the pending migrations of the database schema (and of the tables of pgqueue if --pgqConnection is set) and exit")
+	flag.BoolVar(&serverConfig.AutoMigrate, "autoMigrate", false, "apply the pending migrations of the database schema at startup (an empty database is initialized). Otherwise, the server refuses to start if the schema is not up to date (see --migrate)")
+	flag.BoolVar(&serverConfig.Outbox, "outbox", false, "store the job events and the consolidation orders in the database, in the same transaction as the job, before publishing them (requires the database schema v1.1.0)")
+	eventsEncoding := flag.String("eventsEncoding", "protobuf", "encoding of the job events and the consolidation orders sent by the server (protobuf or gob). Both encodings are always accepted on reception. Use gob during a rolling upgrade from a version older than 1.1.0")
 
 	// GDAL
 	serverConfig.GDALConfig = cmd.GDALConfigFlags()
@@ -332,15 +474,26 @@ func newServerAppConfig() (*serverConfig, error) {
 	flag.Parse()
 
 	serverConfig.GDALConfig.RegisterPNG = true
+	// The storage cache is stored in the workdir
+	serverConfig.GDALConfig.CacheDir = serverConfig.WorkDir
+
+	var err error
+	if serverConfig.EventsEncoding, err = geocube.ParseEventEncoding(*eventsEncoding); err != nil {
+		return nil, fmt.Errorf("--eventsEncoding: %w", err)
+	}
 
 	if serverConfig.AppPort == "" {
 		return nil, fmt.Errorf("failed to initialize --port application flag")
 	}
 
-	if serverConfig.CancelledConsolidationStorage == "" && serverConfig.IngestionStorage != "" {
+	if serverConfig.CancelledConsolidationStorage == "" && (serverConfig.IngestionStorage != "" || serverConfig.AllInOne) {
 		return nil, fmt.Errorf("missing --cancelledJobs storage flag")
 	}
 
+	if serverConfig.AllInOne && (serverConfig.PgqDbConnection != "" || serverConfig.NatsURL != "" || serverConfig.EventsQueue != "" || serverConfig.ConsolidationsQueue != "") {
+		return nil, fmt.Errorf("--allInOne uses an in-memory messaging system: --pgqConnection, --natsURL, --eventsQueue and --consolidationsQueue must not be set")
+	}
+
 	return &serverConfig, nil
 }
 
@@ -349,6 +502,14 @@ type serverConfig struct {
 	EventsQueue                   string
 	ConsolidationsQueue           string
 	PgqDbConnection               string
+	NatsURL                       string
+	EventsEncoding                geocube.EventEncoding
+	AllInOne                      bool
+	WorkDir                       string
+	ConsolidationWorkers          int
+	Outbox                        bool
+	Migrate                       bool
+	AutoMigrate                   bool
 	Local                         bool
 	TLS                           bool
 	AppPort                       string
@@ -0,0 +1,157 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/airbusgeo/geocube/internal/log"
+	pb "github.com/airbusgeo/geocube/internal/pb"
+	"github.com/airbusgeo/geocube/internal/stac"
+	"github.com/airbusgeo/geocube/internal/utils"
+)
+
+func main() {
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
+
+	if err := run(ctx); err != nil {
+		log.Logger(ctx).Fatal("run error", zap.Error(err))
+	}
+}
+
+func run(ctx context.Context) error {
+	config, err := newAppConfig()
+	if err != nil {
+		return err
+	}
+
+	// Mapping
+	data, err := os.ReadFile(config.Mapping)
+	if err != nil {
+		return fmt.Errorf("read mapping: %w", err)
+	}
+	mapping := &pb.STACMapping{}
+	if err := protojson.Unmarshal(data, mapping); err != nil {
+		return fmt.Errorf("parse mapping: %w", err)
+	}
+	if _, err := stac.NewMappingFromProtobuf(mapping); err != nil {
+		return fmt.Errorf("mapping: %w", err)
+	}
+
+	// Connection to the Geocube
+	creds := insecure.NewCredentials()
+	if config.TLS {
+		creds = credentials.NewTLS(&tls.Config{})
+	}
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(creds)}
+	if config.APIKey != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(utils.TokenAuth{Token: config.APIKey}))
+	}
+	conn, err := grpc.NewClient(config.Server, opts...)
+	if err != nil {
+		return fmt.Errorf("connect %s: %w", config.Server, err)
+	}
+	defer conn.Close()
+	client := pb.NewGeocubeClient(conn)
+
+	// Import the items by batch
+	var batch []*stac.Item
+	nbItems, nbRecords, nbDatasets := 0, 0, 0
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		items, err := json.Marshal(map[string]interface{}{"type": stac.TypeItemCollection, "features": batch})
+		if err != nil {
+			return fmt.Errorf("marshal items: %w", err)
+		}
+		resp, err := client.ImportSTAC(ctx, &pb.ImportSTACRequest{Items: items, Mapping: mapping})
+		if err != nil {
+			return fmt.Errorf("ImportSTAC: %w", err)
+		}
+		for _, item := range resp.GetItems() {
+			status := "existing"
+			if item.GetNewRecord() {
+				status = "new"
+				nbRecords++
+			}
+			fmt.Printf("%s: %s record %s, %d datasets\n", item.GetItemId(), status, item.GetRecordId(), item.GetNbDatasets())
+			nbDatasets += int(item.GetNbDatasets())
+		}
+		nbItems += len(batch)
+		batch = batch[:0]
+		return nil
+	}
+
+	for _, source := range flag.Args() {
+		// The hrefs of the assets must be absolute
+		if !strings.Contains(source, "://") {
+			if source, err = filepath.Abs(source); err != nil {
+				return err
+			}
+		}
+		if err := stac.Walk(ctx, source, func(item *stac.Item) error {
+			if batch = append(batch, item); len(batch) >= config.BatchSize {
+				return flush()
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+	if err := flush(); err != nil {
+		return err
+	}
+	log.Logger(ctx).Sugar().Infof("%d items imported: %d new records, %d datasets indexed", nbItems, nbRecords, nbDatasets)
+	return nil
+}
+
+func newAppConfig() (*appConfig, error) {
+	config := appConfig{}
+
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] SOURCE...\n", os.Args[0])
+		fmt.Fprintln(flag.CommandLine.Output(), "Import STAC Items in the Geocube. SOURCE is a STAC Item, ItemCollection, Catalog or Collection (local file or http(s) url). Catalogs and Collections are walked recursively.")
+		flag.PrintDefaults()
+	}
+	flag.StringVar(&config.Server, "server", "127.0.0.1:8080", "address of the geocube server")
+	flag.BoolVar(&config.TLS, "tls", false, "connect to the server using TLS")
+	flag.StringVar(&config.APIKey, "apikey", "", "api key to authenticate to the server")
+	flag.StringVar(&config.Mapping, "mapping", "", "json file defining the mapping of the assets (see STACMapping)")
+	flag.IntVar(&config.BatchSize, "batchSize", 100, "number of items imported in one request")
+
+	flag.Parse()
+
+	if config.Mapping == "" {
+		return nil, fmt.Errorf("missing --mapping flag")
+	}
+	if config.BatchSize <= 0 {
+		return nil, fmt.Errorf("--batchSize must be positive")
+	}
+	if flag.NArg() == 0 {
+		flag.Usage()
+		return nil, fmt.Errorf("missing SOURCE")
+	}
+	return &config, nil
+}
+
+type appConfig struct {
+	Server    string
+	TLS       bool
+	APIKey    string
+	Mapping   string
+	BatchSize int
+}
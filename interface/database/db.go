@@ -33,13 +33,15 @@ type GeocubeBackend interface {
 	DeleteRecords(ctx context.Context, ids []string) (int64, error)
 	// FindRecords fetchs all the records that match the criterias
 	// [Optional] namelike: filter by name (support "*?" and "(?i)" suffix for case insensitivity)
+	// [Optional] tags: filter by tags (see geocube.ParseTagsQuery)
 	// [Optional] fromTime, toTime: filter by datetime
 	// [Optional] jobID: filter the records whom some datasets are locked by the job
 	// [Optional] aoi: filter the records that intersects the AOI
 	// [Optional] page, limit : limits the number of results
-	// [Optional] order : order results by date
+	// [Optional] after : returns the records after the cursor (keyset pagination on datetime and id, the results are ordered)
+	// [Optional] order : order results by date and id
 	// [Optional] loadAOI : load AOI of records
-	FindRecords(ctx context.Context, namelike string, tags geocube.Metadata, fromTime, toTime time.Time, jobID string, aoi *geocube.AOI, page, limit int, order, loadAOI bool) ([]*geocube.Record, error)
+	FindRecords(ctx context.Context, namelike string, tags geocube.TagsQuery, fromTime, toTime time.Time, jobID string, aoi *geocube.AOI, page, limit int, after *geocube.Cursor, order, loadAOI bool) ([]*geocube.Record, error)
 	// AddRecordsTags add tags on list of records
 	AddRecordsTags(ctx context.Context, ids []string, tags geocube.Metadata) (int64, error)
 	// RemoveRecordsTags remove tags on list of records
@@ -93,7 +95,8 @@ type GeocubeBackend interface {
 	ReadVariableFromName(ctx context.Context, variableName string) (*geocube.Variable, error)
 	// FindVariables retrieves all the variable having a similar name (support "*?"" and "(?i)" suffix for case insensitivity)
 	// FindVariables does not retrieve ConsolidationParams
-	FindVariables(ctx context.Context, namelike string, page, limit int) ([]*geocube.Variable, error)
+	// The variables are ordered by id. [Optional] after: returns the variables after the cursor (keyset pagination on id)
+	FindVariables(ctx context.Context, namelike string, page, limit int, after *geocube.Cursor) ([]*geocube.Variable, error)
 
 	// CreateConsolidationParams creates or updates the consolidation parameters associated to the given id
 	CreateConsolidationParams(ctx context.Context, id string, consolidationParams geocube.ConsolidationParams) error
@@ -138,25 +141,28 @@ type GeocubeBackend interface {
 	DeleteDatasets(ctx context.Context, datasetsID []string) error
 	// ListActiveDatasetsID retrieves all the active datasets id from the list of records representing the given variable
 	// [Optional] recordsID: filter by list of vrecords
-	// [Optional] recordTags: filter by record's tags
+	// [Optional] recordTags: filter by record's tags (see geocube.ParseTagsQuery)
 	// [Optional] fromTime, toTime: filter by record's datetime
 	ListActiveDatasetsID(ctx context.Context, instanceID string, recordsID []string,
-		recordTags geocube.Metadata, fromTime, toTime time.Time) ([]string, error)
+		recordTags geocube.TagsQuery, fromTime, toTime time.Time) ([]string, error)
 	// FindDatasets fetches all the datasets that match the criterias
 	// [Optional] containerURIPatterns: filter by container (support "*?"" and "(?i)" suffix for case insensitivity)
 	// [Optional] lockedByJobID: filter by containers locked by job
 	// [Optional] instancesID, recordsID: filter by list of variable instances/records
-	// [Optional] recordTags: filter by record's tags
+	// [Optional] recordTags: filter by record's tags (see geocube.ParseTagsQuery)
 	// [Optional] fromTime, toTime: filter by record's datetime
 	// [Optional] geog, [refined]: filter the datasets that intersect the geographic ring, and optionally, refine with "refined" iif the dataset has the same SRID.
-	// order : by record.datetime (ascending) and record.id
+	// [Optional] after: returns the datasets of the records after the cursor (keyset pagination on (record.datetime, record.id), the results are ordered by record, whatever order)
+	// order : by record.datetime (ascending) and record.id, otherwise, the paginated results are ordered by id
 	FindDatasets(ctx context.Context, status geocube.DatasetStatus, containerURIPatterns []string, lockedByJobID string, instanceIDs, recordIDs []string,
-		recordTags geocube.Metadata, fromTime, toTime time.Time, geog *proj.GeographicRing, refined *proj.Ring, page, limit int, order bool) ([]*geocube.Dataset, error)
+		recordTags geocube.TagsQuery, fromTime, toTime time.Time, geog *proj.GeographicRing, refined *proj.Ring, page, limit int, after *geocube.Cursor, order bool) ([]*geocube.Dataset, error)
 	// GetDatasetsGeometryUnion returns the union of AOI of all the locked datasets
 	GetDatasetsGeometryUnion(ctx context.Context, lockedByJobID string) (*geom.MultiPolygon, error)
 
 	// UpdateDatasets given an instance id and records ids
 	UpdateDatasets(ctx context.Context, instanceID string, recordIds []string, dmapping geocube.DataMapping) (map[string]int64, error)
+	// UpdateDatasetsShape updates the shapes (Shape, GeogShape and GeomShape) of the datasets given their ID
+	UpdateDatasetsShape(ctx context.Context, datasets []*geocube.Dataset) error
 
 	// ComputeValidShapeFromCell compute valid shape in right crs from cell ring
 	ComputeValidShapeFromCell(ctx context.Context, datasetIDS []string, cell *grid.Cell) (*proj.Shape, error)
@@ -174,7 +180,7 @@ type GeocubeBackend interface {
 
 	// FindContainerLayouts retrieves the layouts of the containers defined by the instance and the following filters
 	// Returns list of layout names and the corresponding list of containers
-	FindContainerLayouts(ctx context.Context, instanceId string, geomAOI *geocube.AOI, recordIds []string, recordTags map[string]string, fromTime, toTime time.Time) ([]string, [][]string, error)
+	FindContainerLayouts(ctx context.Context, instanceId string, geomAOI *geocube.AOI, recordIds []string, recordTags geocube.TagsQuery, fromTime, toTime time.Time) ([]string, [][]string, error)
 	// SaveContainerLayout saves the layout that defines the container
 	SaveContainerLayout(ctx context.Context, containerURI string, layoutName string) error
 	// DeleteContainerLayout removes the layout that defines the container
@@ -199,7 +205,8 @@ type GeocubeBackend interface {
 	CreateJob(ctx context.Context, job *geocube.Job) error
 	// FindJobs retrieves the jobs but not their tasks (support "*?" and "(?i)" suffix for case insensitivity)
 	// Raise geocube.EntityNotFound
-	FindJobs(ctx context.Context, nameLike string, page, limit int) ([]*geocube.Job, error)
+	// The jobs are ordered by id. [Optional] after: returns the jobs after the cursor (keyset pagination on id)
+	FindJobs(ctx context.Context, nameLike string, page, limit int, after *geocube.Cursor) ([]*geocube.Job, error)
 	// ReadJob retrieves the job but not its tasks
 	// Raise geocube.EntityNotFound
 	ReadJob(ctx context.Context, jobID string, opts ...ReadJobOptions) (*geocube.Job, error)
@@ -240,6 +247,27 @@ type GeocubeBackend interface {
 	/******************** Consolidation *************************/
 	// ChangeDatasetsStatus changes the status of all the datasets locked by the job whom status is fromStatus to toStatus
 	ChangeDatasetsStatus(ctx context.Context, lockedByJobID string, fromStatus geocube.DatasetStatus, toStatus geocube.DatasetStatus) error
+
+	/******************** Outbox *************************/
+	// CreateOutboxMessages stores the messages to be published on the queue by the outbox relay
+	// It must be called in the transaction that persists the state the messages refer to.
+	CreateOutboxMessages(ctx context.Context, queue string, payloads [][]byte) error
+	// ReadPendingOutboxMessages retrieves the oldest messages that have not been sent yet, ordered by id
+	// Inside a transaction, the messages are locked until the end of the transaction and skipped by the concurrent transactions.
+	ReadPendingOutboxMessages(ctx context.Context, limit int) ([]*OutboxMessage, error)
+	// MarkOutboxMessagesSent marks the messages as sent
+	MarkOutboxMessagesSent(ctx context.Context, ids []int64) error
+	// DeleteSentOutboxMessages deletes the messages sent before the given time
+	// Returns the number of deleted messages
+	DeleteSentOutboxMessages(ctx context.Context, sentBefore time.Time) (int64, error)
+}
+
+// OutboxMessage is a message waiting in the outbox to be published on its queue
+type OutboxMessage struct {
+	ID          int64
+	Queue       string
+	Payload     []byte
+	CreatedTime time.Time
 }
 
 type ReadJobOptions func(o *readJobOptions)
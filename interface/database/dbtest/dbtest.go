@@ -0,0 +1,1217 @@
+// Package dbtest provides a conformance test suite for the implementations of database.GeocubeDBBackend.
+// The same suite is run against every backend, so that they all behave the same way.
+package dbtest
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/airbusgeo/geocube/interface/database"
+	"github.com/airbusgeo/geocube/internal/geocube"
+	pb "github.com/airbusgeo/geocube/internal/pb"
+	"github.com/airbusgeo/geocube/internal/utils/bitmap"
+	"github.com/airbusgeo/geocube/internal/utils/proj"
+	"github.com/google/uuid"
+	"github.com/twpayne/go-geom"
+)
+
+// Run runs the conformance test suite.
+// newDB must return a connection to an empty database.
+func Run(t *testing.T, newDB func(t *testing.T) database.GeocubeDBBackend) {
+	tests := []struct {
+		name string
+		f    func(t *testing.T, db database.GeocubeDBBackend)
+	}{
+		{"Records", testRecords},
+		{"RecordsTags", testRecordsTags},
+		{"FindRecords", testFindRecords},
+		{"Variables", testVariables},
+		{"Palettes", testPalettes},
+		{"ConsolidationParams", testConsolidationParams},
+		{"Containers", testContainers},
+		{"FindDatasets", testFindDatasets},
+		{"Jobs", testJobs},
+		{"LockDatasets", testLockDatasets},
+		{"Tasks", testTasks},
+		{"Layouts", testLayouts},
+		{"Grids", testGrids},
+		{"Outbox", testOutbox},
+		{"TransactionIsolation", testTransactionIsolation},
+		{"TransactionRollback", testTransactionRollback},
+		{"TransactionAborted", testTransactionAborted},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			test.f(t, newDB(t))
+		})
+	}
+}
+
+/******************** Helpers *************************/
+
+var ctx = context.Background()
+
+func must(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func expectError(t *testing.T, err error, code geocube.ErrorCode) {
+	t.Helper()
+	if err == nil {
+		t.Fatalf("expected error %d, got nil", code)
+	}
+	if !geocube.IsError(err, code) {
+		t.Fatalf("expected error %d, got %v", code, err)
+	}
+}
+
+func expectAnyError(t *testing.T, err error) {
+	t.Helper()
+	if err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+}
+
+func expectEqual[T comparable](t *testing.T, what string, have, want T) {
+	t.Helper()
+	if have != want {
+		t.Fatalf("%s: expected %v, got %v", what, want, have)
+	}
+}
+
+func expectIDs(t *testing.T, what string, have, want []string) {
+	t.Helper()
+	have, want = append([]string{}, have...), append([]string{}, want...)
+	sort.Strings(have)
+	sort.Strings(want)
+	if len(have) != len(want) {
+		t.Fatalf("%s: expected %v, got %v", what, want, have)
+	}
+	for i := range have {
+		if have[i] != want[i] {
+			t.Fatalf("%s: expected %v, got %v", what, want, have)
+		}
+	}
+}
+
+func expectOrderedIDs(t *testing.T, what string, have, want []string) {
+	t.Helper()
+	if len(have) != len(want) {
+		t.Fatalf("%s: expected %v, got %v", what, want, have)
+	}
+	for i := range have {
+		if have[i] != want[i] {
+			t.Fatalf("%s: expected %v, got %v", what, want, have)
+		}
+	}
+}
+
+// walkPages lists all the entities using keyset pagination and returns their ids in the order of the pages
+func walkPages[T any](t *testing.T, limit int, find func(after *geocube.Cursor) ([]T, error), cursor func(T) geocube.Cursor) []string {
+	t.Helper()
+	var ids []string
+	var after *geocube.Cursor
+	for {
+		page, err := find(after)
+		must(t, err)
+		if len(page) > limit {
+			t.Fatalf("page of %d items, limit is %d", len(page), limit)
+		}
+		for _, e := range page {
+			ids = append(ids, cursor(e).ID)
+		}
+		if len(page) < limit {
+			return ids
+		}
+		c := cursor(page[len(page)-1])
+		after = &c
+	}
+}
+
+func idCursor(id string) geocube.Cursor {
+	return geocube.Cursor{ID: id}
+}
+
+func sortedIDs(ids ...string) []string {
+	ids = append([]string{}, ids...)
+	sort.Strings(ids)
+	return ids
+}
+
+func recordIDs(records []*geocube.Record) []string {
+	ids := make([]string, len(records))
+	for i, r := range records {
+		ids[i] = r.ID
+	}
+	return ids
+}
+
+func datasetIDs(datasets []*geocube.Dataset) []string {
+	ids := make([]string, len(datasets))
+	for i, d := range datasets {
+		ids[i] = d.ID
+	}
+	return ids
+}
+
+// square returns a lon/lat square
+func square(x, y, size float64) *geom.MultiPolygon {
+	mp := geom.NewMultiPolygon(geom.XY)
+	if err := mp.Push(geom.NewPolygonFlat(geom.XY, []float64{x, y, x + size, y, x + size, y + size, x, y + size, x, y}, []int{10})); err != nil {
+		panic(err)
+	}
+	mp.SetSRID(4326)
+	return mp
+}
+
+// ring returns a lon/lat square ring
+func ring(x, y, size float64) proj.Ring {
+	return proj.NewRingFlat(4326, []float64{x, y, x + size, y, x + size, y + size, x, y + size, x, y})
+}
+
+func date(day int) time.Time {
+	return time.Date(2020, 1, day, 12, 0, 0, 0, time.UTC)
+}
+
+func createAOI(t *testing.T, db database.GeocubeBackend, mp *geom.MultiPolygon) *geocube.AOI {
+	t.Helper()
+	aoi, err := geocube.NewAOIFromMultiPolygon(*mp)
+	must(t, err)
+	must(t, db.CreateAOI(ctx, aoi))
+	return aoi
+}
+
+func newRecord(name string, day int, tags geocube.Metadata, aoiID string) *geocube.Record {
+	return &geocube.Record{ID: uuid.New().String(), Name: geocube.URN(name), Time: date(day), Tags: tags, AOI: geocube.AOI{ID: aoiID}}
+}
+
+func createRecords(t *testing.T, db database.GeocubeBackend, records ...*geocube.Record) {
+	t.Helper()
+	must(t, db.CreateRecords(ctx, records))
+}
+
+func newVariable(name string) *geocube.Variable {
+	return &geocube.Variable{
+		ID:         uuid.New().String(),
+		Name:       name,
+		Unit:       "m",
+		Bands:      []string{"R", "G"},
+		DFormat:    geocube.DataFormat{DType: bitmap.DTypeUINT8, NoData: 0, Range: geocube.Range{Min: 0, Max: 255}},
+		Resampling: geocube.ResamplingNEAR,
+	}
+}
+
+// createVariableAndInstance creates a variable with an instance and returns the instance id
+func createVariableAndInstance(t *testing.T, db database.GeocubeBackend, name string) (*geocube.Variable, *geocube.VariableInstance) {
+	t.Helper()
+	v := newVariable(name)
+	must(t, db.CreateVariable(ctx, v))
+	i, err := geocube.NewInstance("instance", map[string]string{"key": "value"})
+	must(t, err)
+	must(t, db.CreateInstance(ctx, v.ID, i))
+	return v, i
+}
+
+func createContainer(t *testing.T, db database.GeocubeBackend, uri string, managed bool) *geocube.Container {
+	t.Helper()
+	c := &geocube.Container{URI: uri, Managed: managed}
+	must(t, db.CreateContainer(ctx, c))
+	cs, err := db.ReadContainers(ctx, []string{uri})
+	must(t, err)
+	return cs[0]
+}
+
+func newDataset(recordID, instanceID, uri string, mp *geom.MultiPolygon) *geocube.Dataset {
+	return &geocube.Dataset{
+		ID:           uuid.New().String(),
+		RecordID:     recordID,
+		InstanceID:   instanceID,
+		ContainerURI: uri,
+		Bands:        []int64{1, 2},
+		DataMapping: geocube.DataMapping{
+			DataFormat: geocube.DataFormat{DType: bitmap.DTypeUINT8, NoData: 0, Range: geocube.Range{Min: 0, Max: 255}},
+			RangeExt:   geocube.Range{Min: 0, Max: 1},
+			Exponent:   1,
+		},
+		Status:    geocube.DatasetStatusACTIVE,
+		Shape:     proj.NewShape(4326, mp),
+		GeogShape: proj.GeographicShape{Shape: proj.NewShape(4326, mp)},
+		GeomShape: proj.GeometricShape{Shape: proj.NewShape(4326, mp)},
+	}
+}
+
+// fixture creates an aoi, two records, a variable with an instance, a container and one dataset per record
+type fixture struct {
+	aoi      *geocube.AOI
+	records  []*geocube.Record
+	variable *geocube.Variable
+	instance *geocube.VariableInstance
+	datasets []*geocube.Dataset
+}
+
+func newFixture(t *testing.T, db database.GeocubeBackend) fixture {
+	t.Helper()
+	f := fixture{aoi: createAOI(t, db, square(0, 0, 1))}
+	f.records = []*geocube.Record{
+		newRecord("record1", 1, geocube.Metadata{"satellite": "A"}, f.aoi.ID),
+		newRecord("record2", 2, geocube.Metadata{"satellite": "B"}, f.aoi.ID),
+	}
+	createRecords(t, db, f.records...)
+	f.variable, f.instance = createVariableAndInstance(t, db, "variable")
+	createContainer(t, db, "gs://bucket/container.tif", true)
+	f.datasets = []*geocube.Dataset{
+		newDataset(f.records[0].ID, f.instance.ID, "gs://bucket/container.tif", square(0, 0, 1)),
+		newDataset(f.records[1].ID, f.instance.ID, "gs://bucket/container.tif", square(0.5, 0.5, 1)),
+	}
+	must(t, db.CreateDatasets(ctx, f.datasets))
+	return f
+}
+
+func newJob(name string) *geocube.Job {
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	job := geocube.NewJob(uuid.New().String())
+	job.Name = name
+	job.Type = geocube.JobTypeCONSOLIDATION
+	job.State = geocube.JobStateNEW
+	job.CreationTime = now
+	job.LastUpdateTime = now
+	job.Payload = geocube.JobPayload{Layout: "layout"}
+	job.ExecutionLevel = geocube.ExecutionAsynchronous
+	return job
+}
+
+/******************** Records *************************/
+
+func testRecords(t *testing.T, db database.GeocubeDBBackend) {
+	aoi := createAOI(t, db, square(0, 0, 1))
+
+	// The same geometry cannot be inserted twice
+	aoi2, err := geocube.NewAOIFromMultiPolygon(*square(0, 0, 1))
+	must(t, err)
+	err = db.CreateAOI(ctx, aoi2)
+	expectError(t, err, geocube.EntityAlreadyExists)
+
+	readAOI, err := db.ReadAOI(ctx, aoi.ID)
+	must(t, err)
+	expectEqual(t, "aoi.NumPolygons", readAOI.Geometry.NumPolygons(), 1)
+	_, err = db.ReadAOI(ctx, uuid.New().String())
+	expectError(t, err, geocube.EntityNotFound)
+
+	r1 := newRecord("record1", 1, geocube.Metadata{"key": "value"}, aoi.ID)
+	r2 := newRecord("record2", 2, nil, aoi.ID)
+	createRecords(t, db, r1, r2)
+
+	// Duplicate record
+	err = db.CreateRecords(ctx, []*geocube.Record{newRecord("record1", 1, geocube.Metadata{"key": "value"}, aoi.ID)})
+	expectError(t, err, geocube.EntityAlreadyExists)
+
+	// Unknown AOI
+	err = db.CreateRecords(ctx, []*geocube.Record{newRecord("record3", 3, nil, uuid.New().String())})
+	expectError(t, err, geocube.EntityNotFound)
+
+	records, err := db.ReadRecords(ctx, []string{r1.ID, r2.ID, r1.ID})
+	must(t, err)
+	expectIDs(t, "ReadRecords", recordIDs(records), []string{r1.ID, r2.ID})
+	for _, r := range records {
+		if r.ID == r1.ID {
+			expectEqual(t, "record.Name", r.Name, r1.Name)
+			expectEqual(t, "record.Time", r.Time.UTC(), r1.Time)
+			expectEqual(t, "record.Tags", r.Tags["key"], "value")
+			expectEqual(t, "record.AOI", r.AOI.ID, aoi.ID)
+		}
+	}
+	_, err = db.ReadRecords(ctx, []string{uuid.New().String()})
+	expectError(t, err, geocube.EntityNotFound)
+
+	// The AOI is still used
+	n, err := db.DeletePendingAOIs(ctx)
+	must(t, err)
+	expectEqual(t, "DeletePendingAOIs", n, int64(0))
+
+	n, err = db.DeleteRecords(ctx, []string{r1.ID, r2.ID, uuid.New().String()})
+	must(t, err)
+	expectEqual(t, "DeleteRecords", n, int64(2))
+
+	n, err = db.DeletePendingAOIs(ctx)
+	must(t, err)
+	expectEqual(t, "DeletePendingAOIs", n, int64(1))
+	_, err = db.ReadAOI(ctx, aoi.ID)
+	expectError(t, err, geocube.EntityNotFound)
+}
+
+func testRecordsTags(t *testing.T, db database.GeocubeDBBackend) {
+	aoi := createAOI(t, db, square(20, 20, 1))
+	r1 := newRecord("record1", 1, geocube.Metadata{"a": "1", "b": "2"}, aoi.ID)
+	r2 := newRecord("record2", 2, geocube.Metadata{"a": "1"}, aoi.ID)
+	createRecords(t, db, r1, r2)
+
+	n, err := db.AddRecordsTags(ctx, []string{r1.ID, r2.ID}, geocube.Metadata{"c": "3", "a": "0"})
+	must(t, err)
+	expectEqual(t, "AddRecordsTags", n, int64(2))
+
+	// Every record is counted, whether it has the key or not
+	n, err = db.RemoveRecordsTags(ctx, []string{r1.ID, r2.ID}, []string{"b"})
+	must(t, err)
+	expectEqual(t, "RemoveRecordsTags", n, int64(2))
+
+	records, err := db.ReadRecords(ctx, []string{r1.ID})
+	must(t, err)
+	tags := records[0].Tags
+	expectEqual(t, "len(tags)", len(tags), 2)
+	expectEqual(t, "tags[a]", tags["a"], "0")
+	expectEqual(t, "tags[c]", tags["c"], "3")
+
+	// Records with datasets cannot be deleted
+	f := newFixture(t, db)
+	_, err = db.DeleteRecords(ctx, []string{f.records[0].ID})
+	expectError(t, err, geocube.DependencyStillExists)
+
+	n, err = db.DeletePendingRecords(ctx, []string{r1.ID, r2.ID, f.records[0].ID})
+	must(t, err)
+	expectEqual(t, "DeletePendingRecords", n, int64(2))
+}
+
+func testFindRecords(t *testing.T, db database.GeocubeDBBackend) {
+	aoi1 := createAOI(t, db, square(0, 0, 1))
+	aoi2 := createAOI(t, db, square(10, 10, 1))
+	records := []*geocube.Record{
+		newRecord("S2A_tile_1", 3, geocube.Metadata{"satellite": "S2A", "cloud": "10"}, aoi1.ID),
+		newRecord("S2B_tile_1", 1, geocube.Metadata{"satellite": "S2B", "cloud": "20"}, aoi1.ID),
+		newRecord("s2a_tile_2", 2, geocube.Metadata{"satellite": "S2A"}, aoi2.ID),
+		newRecord("S2A_TILE_22", 4, geocube.Metadata{"satellite": "S2A", "cloud": "n/a"}, aoi2.ID),
+	}
+	createRecords(t, db, records...)
+
+	tests := []struct {
+		name     string
+		namelike string
+		tags     geocube.Metadata
+		query    string
+		from, to time.Time
+		aoi      *geocube.AOI
+		want     []int
+	}{
+		{name: "all", want: []int{0, 1, 2, 3}},
+		{name: "exact", namelike: "S2A_tile_1", want: []int{0}},
+		{name: "exact case", namelike: "s2a_tile_1", want: []int{}},
+		{name: "star", namelike: "S2A*", want: []int{0, 3}},
+		{name: "question mark", namelike: "S2?_tile_1", want: []int{0, 1}},
+		{name: "underscore is not a wildcard", namelike: "S2A_tile?1", want: []int{0}},
+		{name: "insensitive", namelike: "s2a*(?i)", want: []int{0, 2, 3}},
+		{name: "insensitive exact", namelike: "s2a_tile_22(?i)", want: []int{3}},
+		{name: "tags", tags: geocube.Metadata{"satellite": "S2A"}, want: []int{0, 2, 3}},
+		{name: "several tags", tags: geocube.Metadata{"satellite": "S2A", "cloud": "10"}, want: []int{0}},
+		{name: "tag exists", tags: geocube.Metadata{"cloud": ""}, want: []int{0, 1, 3}},
+		{name: "tag pattern", tags: geocube.Metadata{"satellite": "s2?(?i)"}, want: []int{0, 1, 2, 3}},
+		{name: "query numeric", query: "cloud < 15", want: []int{0}},
+		{name: "query numeric equal", query: "cloud = 20.0", want: []int{1}},
+		{name: "query not numeric", query: "NOT cloud < 15", want: []int{1, 2, 3}},
+		{name: "query string", query: "cloud > '15'", want: []int{1, 3}},
+		{name: "query in", query: "satellite IN (S2B, S2C)", want: []int{1}},
+		{name: "query not in", query: "satellite NOT IN (S2B)", want: []int{0, 2, 3}},
+		{name: "query like", query: "satellite LIKE '*B'", want: []int{1}},
+		{name: "query ilike", query: "satellite ILIKE s2a", want: []int{0, 2, 3}},
+		{name: "query like underscore", query: "satellite LIKE 'S_A'", want: []int{}},
+		{name: "query exists", query: "cloud EXISTS AND NOT cloud = 10", want: []int{1, 3}},
+		{name: "query not exists", query: "cloud NOT EXISTS", want: []int{2}},
+		{name: "query or", query: "(cloud <= 10 OR satellite = S2B) AND satellite != S2C", want: []int{0, 1}},
+		{name: "query and tags", tags: geocube.Metadata{"satellite": "S2A"}, query: "cloud EXISTS", want: []int{0, 3}},
+		{name: "from", from: date(2), want: []int{0, 2, 3}},
+		{name: "to", to: date(2), want: []int{1, 2}},
+		{name: "from to", from: date(2), to: date(3), want: []int{0, 2}},
+		{name: "aoi", aoi: &geocube.AOI{Geometry: aoi1.Geometry}, want: []int{0, 1}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tags, err := geocube.NewTagsQuery(test.tags, test.query)
+			must(t, err)
+			found, err := db.FindRecords(ctx, test.namelike, tags, test.from, test.to, "", test.aoi, 0, 0, nil, false, false)
+			must(t, err)
+			want := make([]string, len(test.want))
+			for i, w := range test.want {
+				want[i] = records[w].ID
+			}
+			expectIDs(t, "FindRecords", recordIDs(found), want)
+		})
+	}
+
+	t.Run("order and pagination", func(t *testing.T) {
+		found, err := db.FindRecords(ctx, "", nil, time.Time{}, time.Time{}, "", nil, 1, 2, nil, true, false)
+		must(t, err)
+		expectIDs(t, "FindRecords", recordIDs(found), []string{records[0].ID, records[3].ID})
+		expectEqual(t, "first", found[0].ID, records[0].ID)
+	})
+
+	t.Run("after", func(t *testing.T) {
+		// Records with the same datetime are ordered by id
+		all := append([]*geocube.Record{
+			newRecord("tied_1", 3, geocube.Metadata{}, aoi1.ID),
+			newRecord("tied_2", 3, geocube.Metadata{}, aoi2.ID),
+		}, records...)
+		createRecords(t, db, all[:2]...)
+		sort.Slice(all, func(i, j int) bool {
+			if !all[i].Time.Equal(all[j].Time) {
+				return all[i].Time.Before(all[j].Time)
+			}
+			return all[i].ID < all[j].ID
+		})
+		found := walkPages(t, 2, func(after *geocube.Cursor) ([]*geocube.Record, error) {
+			return db.FindRecords(ctx, "", nil, time.Time{}, time.Time{}, "", nil, 0, 2, after, true, false)
+		}, (*geocube.Record).Cursor)
+		expectOrderedIDs(t, "FindRecords", found, recordIDs(all))
+
+		// Filters still apply
+		after := all[0].Cursor()
+		found2, err := db.FindRecords(ctx, "tied*", nil, time.Time{}, time.Time{}, "", nil, 0, 0, &after, false, false)
+		must(t, err)
+		expectEqual(t, "len", len(found2), 2)
+	})
+
+	t.Run("load aoi", func(t *testing.T) {
+		found, err := db.FindRecords(ctx, "S2B*", nil, time.Time{}, time.Time{}, "", nil, 0, 0, nil, false, true)
+		must(t, err)
+		expectEqual(t, "len", len(found), 1)
+		if found[0].AOI.Geometry == nil || found[0].AOI.Geometry.NumPolygons() != 1 {
+			t.Fatalf("AOI is not loaded")
+		}
+	})
+}
+
+/******************** Variables *************************/
+
+func testVariables(t *testing.T, db database.GeocubeDBBackend) {
+	v, i := createVariableAndInstance(t, db, "variable")
+
+	err := db.CreateVariable(ctx, newVariable("variable"))
+	expectError(t, err, geocube.EntityAlreadyExists)
+
+	for _, read := range []func() (*geocube.Variable, error){
+		func() (*geocube.Variable, error) { return db.ReadVariable(ctx, v.ID) },
+		func() (*geocube.Variable, error) { return db.ReadVariableFromName(ctx, v.Name) },
+		func() (*geocube.Variable, error) { return db.ReadVariableFromInstanceID(ctx, i.ID) },
+	} {
+		rv, err := read()
+		must(t, err)
+		expectEqual(t, "variable.ID", rv.ID, v.ID)
+		expectEqual(t, "variable.Unit", rv.Unit, "m")
+		expectEqual(t, "variable.DFormat", rv.DFormat, v.DFormat)
+		expectEqual(t, "len(variable.Bands)", len(rv.Bands), 2)
+		expectEqual(t, "len(variable.Instances)", len(rv.Instances), 1)
+		expectEqual(t, "instance.Metadata", rv.Instances[i.ID].Metadata["key"], "value")
+	}
+	_, err = db.ReadVariable(ctx, uuid.New().String())
+	expectError(t, err, geocube.EntityNotFound)
+	_, err = db.ReadVariableFromName(ctx, "unknown")
+	expectError(t, err, geocube.EntityNotFound)
+	_, err = db.ReadVariableFromInstanceID(ctx, uuid.New().String())
+	expectError(t, err, geocube.EntityNotFound)
+
+	// Update
+	v.Description = "description"
+	v.Resampling = geocube.ResamplingBILINEAR
+	must(t, db.UpdateVariable(ctx, v))
+	i.Metadata = geocube.Metadata{"key": "value2"}
+	must(t, db.UpdateInstance(ctx, i))
+	rv, err := db.ReadVariable(ctx, v.ID)
+	must(t, err)
+	expectEqual(t, "variable.Description", rv.Description, "description")
+	expectEqual(t, "variable.Resampling", rv.Resampling, geocube.ResamplingBILINEAR)
+	expectEqual(t, "instance.Metadata", rv.Instances[i.ID].Metadata["key"], "value2")
+
+	// Instances
+	i2, err := geocube.NewInstance("instance", nil)
+	must(t, err)
+	err = db.CreateInstance(ctx, v.ID, i2)
+	expectError(t, err, geocube.EntityAlreadyExists)
+
+	// Find
+	createVariableAndInstance(t, db, "Other_variable")
+	variables, err := db.FindVariables(ctx, "*variable", 0, 0, nil)
+	must(t, err)
+	expectEqual(t, "len(variables)", len(variables), 2)
+	variables, err = db.FindVariables(ctx, "other*(?i)", 0, 0, nil)
+	must(t, err)
+	expectEqual(t, "len(variables)", len(variables), 1)
+	variables, err = db.FindVariables(ctx, "", 1, 1, nil)
+	must(t, err)
+	expectEqual(t, "len(variables)", len(variables), 1)
+	variables, err = db.FindVariables(ctx, "", 0, 0, nil)
+	must(t, err)
+	found := walkPages(t, 1, func(after *geocube.Cursor) ([]*geocube.Variable, error) {
+		return db.FindVariables(ctx, "", 0, 1, after)
+	}, func(v *geocube.Variable) geocube.Cursor { return idCursor(v.ID) })
+	expectOrderedIDs(t, "FindVariables", found, sortedIDs(variables[0].ID, variables[1].ID))
+
+	// Pending
+	n, err := db.DeletePendingVariables(ctx)
+	must(t, err)
+	expectEqual(t, "DeletePendingVariables", n, int64(0))
+	n, err = db.DeletePendingInstances(ctx)
+	must(t, err)
+	expectEqual(t, "DeletePendingInstances", n, int64(2))
+	n, err = db.DeletePendingVariables(ctx)
+	must(t, err)
+	expectEqual(t, "DeletePendingVariables", n, int64(2))
+
+	// Instances with datasets cannot be deleted
+	f := newFixture(t, db)
+	err = db.DeleteInstance(ctx, f.instance.ID)
+	expectError(t, err, geocube.DependencyStillExists)
+	err = db.DeleteVariable(ctx, f.variable.ID)
+	expectError(t, err, geocube.DependencyStillExists)
+}
+
+func testPalettes(t *testing.T, db database.GeocubeDBBackend) {
+	newPalette := func(name string, r uint32) *geocube.Palette {
+		p, err := geocube.NewPaletteFromPb(&pb.Palette{Name: name, Colors: []*pb.ColorPoint{{Value: 0, R: r}, {Value: 1, R: 255, G: 255, B: 255, A: 255}}})
+		must(t, err)
+		return &p
+	}
+	p := newPalette("palette", 0)
+	must(t, db.CreatePalette(ctx, p))
+	// Creating the same palette twice is allowed
+	must(t, db.CreatePalette(ctx, newPalette("palette", 0)))
+	err := db.CreatePalette(ctx, newPalette("palette", 10))
+	expectError(t, err, geocube.EntityAlreadyExists)
+
+	must(t, db.UpdatePalette(ctx, newPalette("palette", 20)))
+	rp, err := db.ReadPalette(ctx, "palette")
+	must(t, err)
+	expectEqual(t, "len(points)", len(rp.Points), 2)
+	expectEqual(t, "point", rp.Points[0], newPalette("palette", 20).Points[0])
+
+	err = db.UpdatePalette(ctx, newPalette("unknown", 20))
+	expectError(t, err, geocube.EntityNotFound)
+	_, err = db.ReadPalette(ctx, "unknown")
+	expectError(t, err, geocube.EntityNotFound)
+
+	// A palette used by a variable cannot be deleted
+	v := newVariable("variable")
+	v.Palette = "palette"
+	must(t, db.CreateVariable(ctx, v))
+	err = db.DeletePalette(ctx, "palette")
+	expectError(t, err, geocube.DependencyStillExists)
+	must(t, db.DeleteVariable(ctx, v.ID))
+	must(t, db.DeletePalette(ctx, "palette"))
+	_, err = db.ReadPalette(ctx, "palette")
+	expectError(t, err, geocube.EntityNotFound)
+}
+
+func testConsolidationParams(t *testing.T, db database.GeocubeDBBackend) {
+	v, _ := createVariableAndInstance(t, db, "variable")
+	params := geocube.ConsolidationParams{
+		DFormat:        geocube.DataFormat{DType: bitmap.DTypeINT16, NoData: -1, Range: geocube.Range{Min: 0, Max: 1000}},
+		Exponent:       1,
+		Compression:    geocube.CompressionLOSSLESS,
+		CreationParams: geocube.Metadata{"COMPRESS": "ZSTD"},
+		ResamplingAlg:  geocube.ResamplingBILINEAR,
+		StorageClass:   geocube.StorageClassSTANDARD,
+	}
+	must(t, db.CreateConsolidationParams(ctx, v.ID, params))
+
+	// Upsert
+	params.Exponent = 2
+	must(t, db.CreateConsolidationParams(ctx, v.ID, params))
+	p, err := db.ReadConsolidationParams(ctx, v.ID)
+	must(t, err)
+	expectEqual(t, "Exponent", p.Exponent, 2.)
+	expectEqual(t, "DFormat", p.DFormat, params.DFormat)
+	expectEqual(t, "Compression", p.Compression, params.Compression)
+	expectEqual(t, "CreationParams", p.CreationParams["COMPRESS"], "ZSTD")
+	expectEqual(t, "ResamplingAlg", p.ResamplingAlg, params.ResamplingAlg)
+
+	_, err = db.ReadConsolidationParams(ctx, uuid.New().String())
+	expectError(t, err, geocube.EntityNotFound)
+
+	// Params that belong neither to a variable nor to a job are pending
+	must(t, db.CreateConsolidationParams(ctx, uuid.New().String(), params))
+	n, err := db.DeletePendingConsolidationParams(ctx)
+	must(t, err)
+	expectEqual(t, "DeletePendingConsolidationParams", n, int64(1))
+
+	must(t, db.DeleteConsolidationParams(ctx, v.ID))
+	_, err = db.ReadConsolidationParams(ctx, v.ID)
+	expectError(t, err, geocube.EntityNotFound)
+}
+
+/******************** Containers *************************/
+
+func testContainers(t *testing.T, db database.GeocubeDBBackend) {
+	f := newFixture(t, db)
+
+	err := db.CreateContainer(ctx, &geocube.Container{URI: "gs://bucket/container.tif"})
+	expectError(t, err, geocube.EntityAlreadyExists)
+
+	containers, err := db.ReadContainers(ctx, []string{"gs://bucket/container.tif"})
+	must(t, err)
+	expectEqual(t, "len(containers)", len(containers), 1)
+	c := containers[0]
+	expectEqual(t, "Managed", c.Managed, true)
+	expectIDs(t, "datasets", datasetIDs(c.Datasets), datasetIDs(f.datasets))
+	for _, d := range c.Datasets {
+		if d.ID == f.datasets[0].ID {
+			expectEqual(t, "RecordID", d.RecordID, f.records[0].ID)
+			expectEqual(t, "InstanceID", d.InstanceID, f.instance.ID)
+			expectEqual(t, "DataMapping", d.DataMapping, f.datasets[0].DataMapping)
+			expectEqual(t, "len(Bands)", len(d.Bands), 2)
+			expectEqual(t, "Shape.SRID", d.Shape.SRID(), 4326)
+		}
+	}
+	_, err = db.ReadContainers(ctx, []string{"gs://bucket/unknown.tif"})
+	expectError(t, err, geocube.EntityNotFound)
+
+	// Datasets with unknown keys
+	err = db.CreateDatasets(ctx, []*geocube.Dataset{newDataset(uuid.New().String(), f.instance.ID, c.URI, square(0, 0, 1))})
+	expectError(t, err, geocube.EntityNotFound)
+	err = db.CreateDatasets(ctx, []*geocube.Dataset{newDataset(f.records[0].ID, uuid.New().String(), c.URI, square(0, 0, 1))})
+	expectError(t, err, geocube.EntityNotFound)
+	err = db.CreateDatasets(ctx, []*geocube.Dataset{newDataset(f.records[0].ID, f.instance.ID, "gs://bucket/unknown.tif", square(0, 0, 1))})
+	expectError(t, err, geocube.EntityNotFound)
+
+	// UpdateDatasets
+	dmapping := f.datasets[0].DataMapping
+	dmapping.RangeExt = geocube.Range{Min: -1, Max: 1}
+	dmapping.DType = bitmap.DTypeFLOAT32
+	results, err := db.UpdateDatasets(ctx, f.instance.ID, []string{f.records[0].ID}, dmapping)
+	must(t, err)
+	expectEqual(t, "len(results)", len(results), 1)
+	for _, n := range results {
+		expectEqual(t, "n", n, int64(1))
+	}
+	containers, err = db.ReadContainers(ctx, []string{c.URI})
+	must(t, err)
+	for _, d := range containers[0].Datasets {
+		if d.ID == f.datasets[0].ID {
+			expectEqual(t, "RangeExt", d.DataMapping.RangeExt, dmapping.RangeExt)
+			expectEqual(t, "DType", d.DataMapping.DType, bitmap.DTypeUINT8)
+		}
+	}
+
+	// UpdateDatasetsShape
+	updated := *f.datasets[0]
+	shape := square(0.25, 0.25, 0.5)
+	updated.Shape = proj.NewShape(4326, shape)
+	updated.GeogShape = proj.GeographicShape{Shape: proj.NewShape(4326, shape)}
+	updated.GeomShape = proj.GeometricShape{Shape: proj.NewShape(4326, shape)}
+	must(t, db.UpdateDatasetsShape(ctx, []*geocube.Dataset{&updated}))
+	containers, err = db.ReadContainers(ctx, []string{c.URI})
+	must(t, err)
+	for _, d := range containers[0].Datasets {
+		if d.ID == f.datasets[0].ID {
+			expectEqual(t, "Shape.Area", d.Shape.Area(), 0.25)
+		}
+	}
+	updated.ID = uuid.New().String()
+	err = db.UpdateDatasetsShape(ctx, []*geocube.Dataset{&updated})
+	expectError(t, err, geocube.EntityNotFound)
+
+	// A container with datasets cannot be deleted
+	err = db.DeleteContainer(ctx, c)
+	expectError(t, err, geocube.DependencyStillExists)
+	n, err := db.DeletePendingContainers(ctx)
+	must(t, err)
+	expectEqual(t, "DeletePendingContainers", n, int64(0))
+
+	must(t, db.DeleteDatasets(ctx, datasetIDs(f.datasets)))
+	must(t, db.DeleteContainer(ctx, c))
+	_, err = db.ReadContainers(ctx, []string{c.URI})
+	expectError(t, err, geocube.EntityNotFound)
+
+	// Only the unmanaged containers without datasets are pending
+	createContainer(t, db, "gs://bucket/managed.tif", true)
+	unmanaged := createContainer(t, db, "gs://bucket/unmanaged.tif", false)
+	n, err = db.DeletePendingContainers(ctx)
+	must(t, err)
+	expectEqual(t, "DeletePendingContainers", n, int64(1))
+	_, err = db.ReadContainers(ctx, []string{unmanaged.URI})
+	expectError(t, err, geocube.EntityNotFound)
+}
+
+func testFindDatasets(t *testing.T, db database.GeocubeDBBackend) {
+	f := newFixture(t, db)
+	_, i2 := createVariableAndInstance(t, db, "variable2")
+	createContainer(t, db, "gs://bucket/other.tif", true)
+	d3 := newDataset(f.records[0].ID, i2.ID, "gs://bucket/other.tif", square(10, 10, 1))
+	d3.Status = geocube.DatasetStatusTODELETE
+	must(t, db.CreateDatasets(ctx, []*geocube.Dataset{d3}))
+	d1, d2 := f.datasets[0].ID, f.datasets[1].ID
+
+	tests := []struct {
+		name      string
+		status    geocube.DatasetStatus
+		patterns  []string
+		instances []string
+		records   []string
+		tags      geocube.Metadata
+		query     string
+		from, to  time.Time
+		geog      *proj.GeographicRing
+		want      []string
+	}{
+		{name: "active", want: []string{d1, d2}},
+		{name: "todelete", status: geocube.DatasetStatusTODELETE, want: []string{d3.ID}},
+		{name: "container", patterns: []string{"gs://bucket/container.tif"}, want: []string{d1, d2}},
+		{name: "container pattern", patterns: []string{"*OTHER*(?i)"}, status: geocube.DatasetStatusTODELETE, want: []string{d3.ID}},
+		{name: "instance", instances: []string{f.instance.ID}, want: []string{d1, d2}},
+		{name: "record", records: []string{f.records[1].ID}, want: []string{d2}},
+		{name: "tags", tags: geocube.Metadata{"satellite": "A"}, want: []string{d1}},
+		{name: "query", query: "satellite IN (B, C) OR satellite NOT EXISTS", want: []string{d2}},
+		{name: "time", from: date(2), want: []string{d2}},
+		{name: "geog", geog: &proj.GeographicRing{Ring: ring(0.1, 0.1, 0.2)}, want: []string{d1}},
+		{name: "geog none", geog: &proj.GeographicRing{Ring: ring(5, 5, 1)}, want: []string{}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tags, err := geocube.NewTagsQuery(test.tags, test.query)
+			must(t, err)
+			found, err := db.FindDatasets(ctx, test.status, test.patterns, "", test.instances, test.records, tags, test.from, test.to, test.geog, nil, 0, 0, nil, false)
+			must(t, err)
+			expectIDs(t, "FindDatasets", datasetIDs(found), test.want)
+		})
+	}
+
+	t.Run("order", func(t *testing.T) {
+		found, err := db.FindDatasets(ctx, geocube.DatasetStatusACTIVE, nil, "", nil, nil, nil, time.Time{}, time.Time{}, nil, nil, 1, 1, nil, true)
+		must(t, err)
+		expectIDs(t, "FindDatasets", datasetIDs(found), []string{d2})
+	})
+
+	t.Run("after", func(t *testing.T) {
+		// The cursor is the record of the dataset
+		found := walkPages(t, 1, func(after *geocube.Cursor) ([]*geocube.Dataset, error) {
+			return db.FindDatasets(ctx, geocube.DatasetStatusACTIVE, nil, "", nil, nil, nil, time.Time{}, time.Time{}, nil, nil, 0, 1, after, true)
+		}, func(d *geocube.Dataset) geocube.Cursor {
+			for _, r := range f.records {
+				if r.ID == d.RecordID {
+					return geocube.Cursor{Time: r.Time, ID: r.ID}
+				}
+			}
+			t.Fatalf("unexpected record %s", d.RecordID)
+			return geocube.Cursor{}
+		})
+		expectOrderedIDs(t, "FindDatasets", found, recordIDs(f.records))
+	})
+
+	t.Run("refined", func(t *testing.T) {
+		r := ring(1.2, 1.2, 0.1)
+		found, err := db.FindDatasets(ctx, geocube.DatasetStatusACTIVE, nil, "", nil, nil, nil, time.Time{}, time.Time{}, &proj.GeographicRing{Ring: ring(0, 0, 2)}, &r, 0, 0, nil, false)
+		must(t, err)
+		expectIDs(t, "FindDatasets", datasetIDs(found), []string{d2})
+	})
+
+	t.Run("ListActiveDatasetsID", func(t *testing.T) {
+		ids, err := db.ListActiveDatasetsID(ctx, f.instance.ID, nil, nil, time.Time{}, time.Time{})
+		must(t, err)
+		expectIDs(t, "ListActiveDatasetsID", ids, []string{d1, d2})
+		ids, err = db.ListActiveDatasetsID(ctx, f.instance.ID, []string{f.records[0].ID}, nil, time.Time{}, time.Time{})
+		must(t, err)
+		expectIDs(t, "ListActiveDatasetsID", ids, []string{d1})
+		ids, err = db.ListActiveDatasetsID(ctx, f.instance.ID, nil, geocube.TagCompare{Key: "satellite", Operator: "=", Value: "B"}, time.Time{}, time.Time{})
+		must(t, err)
+		expectIDs(t, "ListActiveDatasetsID", ids, []string{d2})
+		ids, err = db.ListActiveDatasetsID(ctx, i2.ID, nil, nil, time.Time{}, time.Time{})
+		must(t, err)
+		expectIDs(t, "ListActiveDatasetsID", ids, []string{})
+	})
+}
+
+/******************** Jobs *************************/
+
+func testJobs(t *testing.T, db database.GeocubeDBBackend) {
+	job := newJob("job")
+	must(t, db.CreateJob(ctx, job))
+	err := db.CreateJob(ctx, newJob("job"))
+	expectError(t, err, geocube.EntityAlreadyExists)
+
+	rj, err := db.ReadJob(ctx, job.ID)
+	must(t, err)
+	expectEqual(t, "Name", rj.Name, "job")
+	expectEqual(t, "State", rj.State, geocube.JobStateNEW)
+	expectEqual(t, "Payload", rj.Payload, job.Payload)
+	expectEqual(t, "ExecutionLevel", rj.ExecutionLevel, geocube.ExecutionAsynchronous)
+	_, err = db.ReadJob(ctx, uuid.New().String())
+	expectError(t, err, geocube.EntityNotFound)
+
+	// Logs
+	var logs geocube.JobLogs
+	for i := 0; i < 5; i++ {
+		logs = append(logs, geocube.JobLog{Severity: geocube.INFO, Msg: string(rune('a' + i)), Status: "NEW", Date: date(1 + i)})
+	}
+	must(t, db.PersistLogs(ctx, job.ID, logs))
+	rj, err = db.ReadJob(ctx, job.ID, database.LogLimit(0, 2))
+	must(t, err)
+	expectEqual(t, "LogsCount", rj.LogsCount, 5)
+	expectEqual(t, "len(Logs)", len(rj.Logs), 2)
+	expectEqual(t, "Logs[0]", rj.Logs[0].Msg, "d")
+	expectEqual(t, "Logs[1]", rj.Logs[1].Msg, "e")
+	rj, err = db.ReadJob(ctx, job.ID, database.LogLimit(2, 2))
+	must(t, err)
+	expectEqual(t, "len(Logs)", len(rj.Logs), 1)
+	expectEqual(t, "Logs[0]", rj.Logs[0].Msg, "a")
+	err = db.PersistLogs(ctx, uuid.New().String(), logs)
+	expectAnyError(t, err)
+
+	// Optimistic concurrency control
+	rj.Clean(false)
+	stale := *rj
+	rj.State = geocube.JobStateCREATED
+	rj.LastUpdateTime = rj.LastUpdateTime.Add(time.Second)
+	must(t, db.UpdateJob(ctx, rj))
+	stale.State = geocube.JobStateABORTED
+	err = db.UpdateJob(ctx, &stale)
+	expectError(t, err, geocube.EntityNotFound)
+	rj, err = db.ReadJob(ctx, job.ID)
+	must(t, err)
+	expectEqual(t, "State", rj.State, geocube.JobStateCREATED)
+
+	// Find & List
+	must(t, db.CreateJob(ctx, newJob("Other_Job")))
+	jobs, err := db.FindJobs(ctx, "*job(?i)", 0, 0, nil)
+	must(t, err)
+	expectEqual(t, "len(jobs)", len(jobs), 2)
+	jobs, err = db.FindJobs(ctx, "*job", 0, 0, nil)
+	must(t, err)
+	expectEqual(t, "len(jobs)", len(jobs), 1)
+	expectEqual(t, "len(jobs[0].Logs)", len(jobs[0].Logs), 5)
+	jobs, err = db.FindJobs(ctx, "Other_Job", 0, 0, nil)
+	must(t, err)
+	expectEqual(t, "len(jobs)", len(jobs), 1)
+	expectEqual(t, "len(jobs[0].Logs)", len(jobs[0].Logs), 0)
+	found := walkPages(t, 1, func(after *geocube.Cursor) ([]*geocube.Job, error) {
+		return db.FindJobs(ctx, "", 0, 1, after)
+	}, func(j *geocube.Job) geocube.Cursor { return idCursor(j.ID) })
+	expectOrderedIDs(t, "FindJobs", found, sortedIDs(job.ID, jobs[0].ID))
+	ids, err := db.ListJobsID(ctx, "", []geocube.JobState{geocube.JobStateCREATED})
+	must(t, err)
+	expectIDs(t, "ListJobsID", ids, []string{job.ID})
+	ids, err = db.ListJobsID(ctx, "Other*", []geocube.JobState{geocube.JobStateNEW, geocube.JobStateCREATED})
+	must(t, err)
+	expectEqual(t, "len(ids)", len(ids), 1)
+
+	must(t, db.DeleteJob(ctx, job.ID))
+	_, err = db.ReadJob(ctx, job.ID)
+	expectError(t, err, geocube.EntityNotFound)
+}
+
+func testLockDatasets(t *testing.T, db database.GeocubeDBBackend) {
+	f := newFixture(t, db)
+	job := newJob("job")
+	must(t, db.CreateJob(ctx, job))
+	d1, d2 := f.datasets[0].ID, f.datasets[1].ID
+
+	must(t, db.LockDatasets(ctx, job.ID, []string{d1, d2}, int(geocube.LockFlagINIT)))
+	err := db.LockDatasets(ctx, job.ID, []string{d1}, int(geocube.LockFlagTODELETE))
+	expectError(t, err, geocube.EntityAlreadyExists)
+
+	found, err := db.FindDatasets(ctx, geocube.DatasetStatusACTIVE, nil, job.ID, nil, nil, nil, time.Time{}, time.Time{}, nil, nil, 0, 0, nil, false)
+	must(t, err)
+	expectIDs(t, "FindDatasets", datasetIDs(found), []string{d1, d2})
+
+	records, err := db.FindRecords(ctx, "", nil, time.Time{}, time.Time{}, job.ID, nil, 0, 0, nil, true, false)
+	must(t, err)
+	expectIDs(t, "FindRecords", recordIDs(records), recordIDs(f.records))
+
+	union, err := db.GetDatasetsGeometryUnion(ctx, job.ID)
+	must(t, err)
+	expectEqual(t, "NumPolygons", union.NumPolygons(), 1)
+
+	// Locked datasets cannot be deleted
+	err = db.DeleteDatasets(ctx, []string{d1})
+	expectAnyError(t, err)
+
+	must(t, db.ChangeDatasetsStatus(ctx, job.ID, geocube.DatasetStatusACTIVE, geocube.DatasetStatusTODELETE))
+	found, err = db.FindDatasets(ctx, geocube.DatasetStatusTODELETE, nil, "", nil, nil, nil, time.Time{}, time.Time{}, nil, nil, 0, 0, nil, false)
+	must(t, err)
+	expectIDs(t, "FindDatasets", datasetIDs(found), []string{d1, d2})
+
+	must(t, db.ReleaseDatasets(ctx, job.ID, int(geocube.LockFlagTODELETE)))
+	found, err = db.FindDatasets(ctx, geocube.DatasetStatusTODELETE, nil, job.ID, nil, nil, nil, time.Time{}, time.Time{}, nil, nil, 0, 0, nil, false)
+	must(t, err)
+	expectEqual(t, "len(found)", len(found), 2)
+
+	must(t, db.ReleaseDatasets(ctx, job.ID, int(geocube.LockFlagINIT)))
+	found, err = db.FindDatasets(ctx, geocube.DatasetStatusTODELETE, nil, job.ID, nil, nil, nil, time.Time{}, time.Time{}, nil, nil, 0, 0, nil, false)
+	must(t, err)
+	expectEqual(t, "len(found)", len(found), 0)
+
+	union, err = db.GetDatasetsGeometryUnion(ctx, job.ID)
+	must(t, err)
+	expectEqual(t, "NumPolygons", union.NumPolygons(), 0)
+
+	must(t, db.DeleteDatasets(ctx, []string{d1}))
+}
+
+func testTasks(t *testing.T, db database.GeocubeDBBackend) {
+	job := newJob("job")
+	must(t, db.CreateJob(ctx, job))
+	tasks := []*geocube.Task{
+		{ID: uuid.New().String(), State: geocube.TaskStatePENDING, Payload: []byte("payload1")},
+		{ID: uuid.New().String(), State: geocube.TaskStatePENDING, Payload: []byte("payload2")},
+	}
+	must(t, db.CreateTasks(ctx, job.ID, tasks))
+	err := db.CreateTasks(ctx, uuid.New().String(), []*geocube.Task{{ID: uuid.New().String(), State: geocube.TaskStatePENDING}})
+	expectAnyError(t, err)
+
+	tasks[0].State = geocube.TaskStateDONE
+	tasks[0].Checksum = "checksum"
+	must(t, db.UpdateTask(ctx, tasks[0]))
+
+	read, err := db.ReadTasks(ctx, job.ID, nil)
+	must(t, err)
+	expectEqual(t, "len(tasks)", len(read), 2)
+	read, err = db.ReadTasks(ctx, job.ID, []geocube.TaskState{geocube.TaskStateDONE})
+	must(t, err)
+	expectEqual(t, "len(tasks)", len(read), 1)
+	expectEqual(t, "ID", read[0].ID, tasks[0].ID)
+	expectEqual(t, "Checksum", read[0].Checksum, "checksum")
+	expectEqual(t, "Payload", string(read[0].Payload), "payload1")
+
+	rj, err := db.ReadJobWithTask(ctx, job.ID, tasks[1].ID)
+	must(t, err)
+	expectEqual(t, "len(tasks)", len(rj.Tasks), 1)
+	expectEqual(t, "ID", rj.Tasks[0].ID, tasks[1].ID)
+
+	// A job with tasks cannot be deleted
+	err = db.DeleteJob(ctx, job.ID)
+	expectError(t, err, geocube.DependencyStillExists)
+
+	for _, task := range tasks {
+		must(t, db.DeleteTask(ctx, task.ID))
+	}
+	must(t, db.DeleteJob(ctx, job.ID))
+}
+
+/******************** Layouts & Grids *************************/
+
+func testLayouts(t *testing.T, db database.GeocubeDBBackend) {
+	layout := &geocube.Layout{
+		Name:               "layout",
+		GridFlags:          []string{"+proj=utm", "+zone=31"},
+		GridParameters:     geocube.Metadata{"grid": "singlecell", "crs": "4326"},
+		BlockXSize:         256,
+		BlockYSize:         256,
+		MaxRecords:         100,
+		OverviewsMinSize:   -1,
+		InterlacingPattern: "B>T>R;Z=0",
+	}
+	must(t, db.CreateLayout(ctx, layout))
+	err := db.CreateLayout(ctx, layout)
+	expectError(t, err, geocube.EntityAlreadyExists)
+
+	rl, err := db.ReadLayout(ctx, "layout")
+	must(t, err)
+	expectEqual(t, "len(GridFlags)", len(rl.GridFlags), 2)
+	expectEqual(t, "GridFlags[1]", rl.GridFlags[1], "+zone=31")
+	expectEqual(t, "GridParameters", rl.GridParameters["grid"], "singlecell")
+	expectEqual(t, "BlockXSize", rl.BlockXSize, 256)
+	expectEqual(t, "MaxRecords", rl.MaxRecords, 100)
+	expectEqual(t, "InterlacingPattern", rl.InterlacingPattern, "B>T>R;Z=0")
+	_, err = db.ReadLayout(ctx, "unknown")
+	expectError(t, err, geocube.EntityNotFound)
+
+	layouts, err := db.FindLayouts(ctx, "LAY*(?i)")
+	must(t, err)
+	expectEqual(t, "len(layouts)", len(layouts), 1)
+	expectEqual(t, "len(GridFlags)", len(layouts[0].GridFlags), 2)
+	layouts, err = db.FindLayouts(ctx, "LAY*")
+	must(t, err)
+	expectEqual(t, "len(layouts)", len(layouts), 0)
+
+	// Container layouts
+	f := newFixture(t, db)
+	uri := f.datasets[0].ContainerURI
+	must(t, db.SaveContainerLayout(ctx, uri, "layout"))
+	err = db.SaveContainerLayout(ctx, uri, "layout")
+	expectError(t, err, geocube.EntityAlreadyExists)
+	err = db.SaveContainerLayout(ctx, "gs://bucket/unknown.tif", "layout")
+	expectAnyError(t, err)
+
+	names, containers, err := db.FindContainerLayouts(ctx, f.instance.ID, nil, nil, nil, time.Time{}, time.Time{})
+	must(t, err)
+	expectIDs(t, "layouts", names, []string{"layout"})
+	expectIDs(t, "containers", containers[0], []string{uri})
+	names, _, err = db.FindContainerLayouts(ctx, f.instance.ID, nil, nil, geocube.TagIn{Key: "satellite", Values: []string{"C"}}, time.Time{}, time.Time{})
+	must(t, err)
+	expectEqual(t, "len(layouts)", len(names), 0)
+
+	err = db.DeleteLayout(ctx, "layout")
+	expectError(t, err, geocube.DependencyStillExists)
+	must(t, db.DeleteContainerLayout(ctx, uri))
+	must(t, db.DeleteLayout(ctx, "layout"))
+	_, err = db.ReadLayout(ctx, "layout")
+	expectError(t, err, geocube.EntityNotFound)
+}
+
+func testGrids(t *testing.T, db database.GeocubeDBBackend) {
+	grid := &geocube.Grid{
+		Name:        "grid",
+		Description: "description",
+		Cells: []geocube.Cell{
+			{ID: "cell1", CRS: "EPSG:4326", SRID: 4326, Coordinates: proj.GeographicRing{Ring: ring(0, 0, 1)}},
+			{ID: "cell2", CRS: "EPSG:4326", SRID: 4326, Coordinates: proj.GeographicRing{Ring: ring(1, 0, 1)}},
+			{ID: "cell3", CRS: "EPSG:4326", SRID: 4326, Coordinates: proj.GeographicRing{Ring: ring(10, 10, 1)}},
+		},
+	}
+	must(t, db.CreateGrid(ctx, grid))
+	err := db.CreateGrid(ctx, grid)
+	expectError(t, err, geocube.EntityAlreadyExists)
+
+	rg, err := db.ReadGrid(ctx, "grid")
+	must(t, err)
+	expectEqual(t, "Description", rg.Description, "description")
+	_, err = db.ReadGrid(ctx, "unknown")
+	expectError(t, err, geocube.EntityNotFound)
+
+	grids, err := db.FindGrids(ctx, "GR?D(?i)")
+	must(t, err)
+	expectEqual(t, "len(grids)", len(grids), 1)
+	grids, err = db.FindGrids(ctx, "other")
+	must(t, err)
+	expectEqual(t, "len(grids)", len(grids), 0)
+
+	aoi, err := geocube.NewAOIFromMultiPolygon(*square(0.5, 0.25, 1))
+	must(t, err)
+	cells, intersections, err := db.FindCells(ctx, "grid", aoi)
+	must(t, err)
+	ids := make([]string, len(cells))
+	for i, c := range cells {
+		ids[i] = c.ID
+		expectEqual(t, "CRS", c.CRS, "EPSG:4326")
+	}
+	expectIDs(t, "FindCells", ids, []string{"cell1", "cell2"})
+	expectEqual(t, "len(intersections)", len(intersections), 2)
+	for _, i := range intersections {
+		expectEqual(t, "NumPolygons", i.NumPolygons(), 1)
+	}
+
+	must(t, db.DeleteGrid(ctx, "grid"))
+	_, err = db.ReadGrid(ctx, "grid")
+	expectError(t, err, geocube.EntityNotFound)
+	// The name can be reused
+	must(t, db.CreateGrid(ctx, grid))
+}
+
+/******************** Outbox *************************/
+
+func testOutbox(t *testing.T, db database.GeocubeDBBackend) {
+	must(t, db.CreateOutboxMessages(ctx, "events", [][]byte{[]byte("1"), []byte("2"), []byte("3")}))
+
+	messages, err := db.ReadPendingOutboxMessages(ctx, 2)
+	must(t, err)
+	expectEqual(t, "len(messages)", len(messages), 2)
+	expectEqual(t, "Queue", messages[0].Queue, "events")
+	expectEqual(t, "Payload", string(messages[0].Payload), "1")
+	if messages[0].ID >= messages[1].ID {
+		t.Fatalf("messages are not sorted by id")
+	}
+
+	// Messages read in a transaction are locked until the end of the transaction
+	tx, err := db.StartTransaction(ctx)
+	must(t, err)
+	locked, err := tx.ReadPendingOutboxMessages(ctx, 2)
+	must(t, err)
+	expectEqual(t, "len(locked)", len(locked), 2)
+	tx2, err := db.StartTransaction(ctx)
+	must(t, err)
+	others, err := tx2.ReadPendingOutboxMessages(ctx, 10)
+	must(t, err)
+	expectEqual(t, "len(others)", len(others), 1)
+	expectEqual(t, "Payload", string(others[0].Payload), "3")
+	must(t, tx2.Rollback())
+	must(t, tx.MarkOutboxMessagesSent(ctx, []int64{locked[0].ID, locked[1].ID}))
+	must(t, tx.Commit())
+
+	messages, err = db.ReadPendingOutboxMessages(ctx, 10)
+	must(t, err)
+	expectEqual(t, "len(messages)", len(messages), 1)
+
+	n, err := db.DeleteSentOutboxMessages(ctx, time.Now().Add(-time.Hour))
+	must(t, err)
+	expectEqual(t, "DeleteSentOutboxMessages", n, int64(0))
+	n, err = db.DeleteSentOutboxMessages(ctx, time.Now().Add(time.Hour))
+	must(t, err)
+	expectEqual(t, "DeleteSentOutboxMessages", n, int64(2))
+}
+
+/******************** Transactions *************************/
+
+func testTransactionIsolation(t *testing.T, db database.GeocubeDBBackend) {
+	tx, err := db.StartTransaction(ctx)
+	must(t, err)
+	defer tx.Rollback()
+
+	aoi := createAOI(t, tx, square(0, 0, 1))
+	createRecords(t, tx, newRecord("record", 1, nil, aoi.ID))
+
+	// Uncommitted changes are only visible inside the transaction
+	records, err := tx.FindRecords(ctx, "", nil, time.Time{}, time.Time{}, "", nil, 0, 0, nil, false, false)
+	must(t, err)
+	expectEqual(t, "len(records) in tx", len(records), 1)
+	records, err = db.FindRecords(ctx, "", nil, time.Time{}, time.Time{}, "", nil, 0, 0, nil, false, false)
+	must(t, err)
+	expectEqual(t, "len(records) outside tx", len(records), 0)
+
+	must(t, tx.Commit())
+	records, err = db.FindRecords(ctx, "", nil, time.Time{}, time.Time{}, "", nil, 0, 0, nil, false, false)
+	must(t, err)
+	expectEqual(t, "len(records) after commit", len(records), 1)
+
+	// A committed transaction cannot be used anymore
+	err = tx.Commit()
+	expectAnyError(t, err)
+	_, err = tx.FindRecords(ctx, "", nil, time.Time{}, time.Time{}, "", nil, 0, 0, nil, false, false)
+	expectAnyError(t, err)
+}
+
+func testTransactionRollback(t *testing.T, db database.GeocubeDBBackend) {
+	v := newVariable("variable")
+	must(t, db.CreateVariable(ctx, v))
+
+	tx, err := db.StartTransaction(ctx)
+	must(t, err)
+	must(t, tx.DeleteVariable(ctx, v.ID))
+	createVariableAndInstance(t, tx, "variable2")
+	_, err = tx.ReadVariable(ctx, v.ID)
+	expectError(t, err, geocube.EntityNotFound)
+	must(t, tx.Rollback())
+
+	_, err = db.ReadVariable(ctx, v.ID)
+	must(t, err)
+	_, err = db.ReadVariableFromName(ctx, "variable2")
+	expectError(t, err, geocube.EntityNotFound)
+}
+
+func testTransactionAborted(t *testing.T, db database.GeocubeDBBackend) {
+	tx, err := db.StartTransaction(ctx)
+	must(t, err)
+	defer tx.Rollback()
+
+	createVariableAndInstance(t, tx, "variable")
+	err = tx.CreateVariable(ctx, newVariable("variable"))
+	expectError(t, err, geocube.EntityAlreadyExists)
+
+	// After an error, the transaction is aborted and cannot be committed
+	err = tx.Commit()
+	expectAnyError(t, err)
+
+	_, err = db.ReadVariableFromName(ctx, "variable")
+	expectError(t, err, geocube.EntityNotFound)
+
+	// An update that does not affect any row does not abort the transaction
+	tx, err = db.StartTransaction(ctx)
+	must(t, err)
+	defer tx.Rollback()
+	v := newVariable("variable")
+	err = tx.UpdateVariable(ctx, v)
+	expectError(t, err, geocube.EntityNotFound)
+	must(t, tx.CreateVariable(ctx, v))
+	must(t, tx.Commit())
+	_, err = db.ReadVariableFromName(ctx, "variable")
+	must(t, err)
+}
@@ -0,0 +1,398 @@
+package memdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/airbusgeo/geocube/interface/database"
+	"github.com/airbusgeo/geocube/internal/geocube"
+	"github.com/airbusgeo/geocube/internal/utils"
+)
+
+// errTxAborted is returned by the operations of a transaction after a failure, like postgres does
+var errTxAborted = errors.New("current transaction is aborted, commands ignored until end of transaction block")
+
+// BackendDB implements GeocubeDBBackend
+type BackendDB struct {
+	Backend
+}
+
+// BackendTx implements GeocubeTxBackend
+type BackendTx struct {
+	Backend
+}
+
+// Backend implements GeocubeBackend
+// Outside a transaction, each operation is atomic and immediately visible.
+type Backend struct {
+	db *store
+	tx *transaction // nil outside a transaction
+}
+
+// store is the committed state of the database
+// A committed state is never modified: a write creates a new state (copy-on-write)
+type store struct {
+	mutex       sync.RWMutex
+	state       *state
+	version     int64                  // Incremented at each write
+	outboxLocks map[int64]*transaction // Outbox messages locked by a transaction
+}
+
+// transaction works on a private copy of the state, created on the first write.
+// At commit, if another write has been committed in the meantime, the operations of the transaction
+// are replayed on the current state, so that the conflicts (e.g. job updated concurrently) are detected.
+type transaction struct {
+	mutex   sync.Mutex
+	state   *state
+	owned   bool  // The state is a private copy
+	version int64 // Version of the store when the state has been copied
+	ops     []func(*state) error
+	aborted bool
+	done    bool
+}
+
+// New returns an empty in-memory database
+func New() *BackendDB {
+	return &BackendDB{Backend{db: &store{state: newState(), outboxLocks: map[int64]*transaction{}}}}
+}
+
+// StartTransaction implements GeocubeDBBackend
+func (bdb BackendDB) StartTransaction(ctx context.Context) (database.GeocubeTxBackend, error) {
+	bdb.db.mutex.RLock()
+	defer bdb.db.mutex.RUnlock()
+	return BackendTx{Backend{db: bdb.db, tx: &transaction{state: bdb.db.state, version: bdb.db.version}}}, nil
+}
+
+// Commit implements GeocubeTxBackend
+// Returns a temporary error if the transaction conflicts with a transaction committed in the meantime
+func (btx BackendTx) Commit() error {
+	tx := btx.tx
+	tx.mutex.Lock()
+	defer tx.mutex.Unlock()
+	if tx.done {
+		return sql.ErrTxDone
+	}
+	tx.done = true
+
+	db := btx.db
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+	db.unlockOutbox(tx)
+
+	if tx.aborted {
+		return fmt.Errorf("commit: %w", errTxAborted)
+	}
+	if len(tx.ops) == 0 {
+		return nil
+	}
+
+	s := tx.state
+	if db.version != tx.version {
+		s = db.state.clone()
+		for _, op := range tx.ops {
+			if err := op(s); err != nil {
+				return utils.MakeTemporary(fmt.Errorf("commit: conflict with a concurrent transaction: %w", err))
+			}
+		}
+	}
+	db.state = s
+	db.version++
+	return nil
+}
+
+// Rollback implements GeocubeTxBackend (idempotent)
+func (btx BackendTx) Rollback() error {
+	tx := btx.tx
+	tx.mutex.Lock()
+	defer tx.mutex.Unlock()
+	if tx.done {
+		return nil
+	}
+	tx.done = true
+
+	btx.db.mutex.Lock()
+	defer btx.db.mutex.Unlock()
+	btx.db.unlockOutbox(tx)
+	return nil
+}
+
+func (tx *transaction) check() error {
+	if tx.done {
+		return sql.ErrTxDone
+	}
+	if tx.aborted {
+		return errTxAborted
+	}
+	return nil
+}
+
+// unaffected wraps the error of an operation that fails without modifying the state.
+// Like a pg statement that affects no rows, it does not abort the transaction.
+type unaffected struct{ error }
+
+// read executes f on the state visible by the backend. f must not modify the state.
+func (b Backend) read(f func(s *state) error) error {
+	if tx := b.tx; tx != nil {
+		tx.mutex.Lock()
+		defer tx.mutex.Unlock()
+		if err := tx.check(); err != nil {
+			return err
+		}
+		return f(tx.state)
+	}
+	b.db.mutex.RLock()
+	s := b.db.state
+	b.db.mutex.RUnlock()
+	return f(s)
+}
+
+// write executes op atomically: if op fails, the state is left unchanged (outside a transaction)
+// or the transaction is aborted.
+// As op may be replayed at commit, it must only depend on its arguments and on the state.
+func (b Backend) write(op func(s *state) error) error {
+	if tx := b.tx; tx != nil {
+		tx.mutex.Lock()
+		defer tx.mutex.Unlock()
+		if err := tx.check(); err != nil {
+			return err
+		}
+		if !tx.owned {
+			tx.state, tx.owned = tx.state.clone(), true
+		}
+		if err := op(tx.state); err != nil {
+			var u unaffected
+			if errors.As(err, &u) {
+				return u.error
+			}
+			tx.aborted = true
+			return err
+		}
+		tx.ops = append(tx.ops, op)
+		return nil
+	}
+
+	b.db.mutex.Lock()
+	defer b.db.mutex.Unlock()
+	s := b.db.state.clone()
+	if err := op(s); err != nil {
+		var u unaffected
+		if errors.As(err, &u) {
+			return u.error
+		}
+		return err
+	}
+	b.db.state = s
+	b.db.version++
+	return nil
+}
+
+// table is a set of rows indexed by a primary key, preserving the insertion order
+// A table belongs to a single state: it is copied when the state is cloned.
+type table[K comparable, V any] struct {
+	keys []K
+	rows map[K]V
+}
+
+func newTable[K comparable, V any]() table[K, V] {
+	return table[K, V]{rows: map[K]V{}}
+}
+
+func (t table[K, V]) clone() table[K, V] {
+	c := table[K, V]{keys: make([]K, len(t.keys)), rows: make(map[K]V, len(t.rows))}
+	copy(c.keys, t.keys)
+	for k, v := range t.rows {
+		c.rows[k] = v
+	}
+	return c
+}
+
+func (t *table[K, V]) get(k K) (V, bool) {
+	v, ok := t.rows[k]
+	return v, ok
+}
+
+func (t *table[K, V]) has(k K) bool {
+	_, ok := t.rows[k]
+	return ok
+}
+
+// insert returns false if the key already exists
+func (t *table[K, V]) insert(k K, v V) bool {
+	if _, ok := t.rows[k]; ok {
+		return false
+	}
+	t.keys = append(t.keys, k)
+	t.rows[k] = v
+	return true
+}
+
+// set updates an existing row
+func (t *table[K, V]) set(k K, v V) {
+	t.rows[k] = v
+}
+
+// values returns the rows in the insertion order
+func (t *table[K, V]) values() []V {
+	values := make([]V, 0, len(t.keys))
+	for _, k := range t.keys {
+		values = append(values, t.rows[k])
+	}
+	return values
+}
+
+// update replaces the rows for which f returns true. Returns the number of updated rows.
+func (t *table[K, V]) update(f func(v V) (V, bool)) int64 {
+	var n int64
+	for _, k := range t.keys {
+		if v, ok := f(t.rows[k]); ok {
+			t.rows[k] = v
+			n++
+		}
+	}
+	return n
+}
+
+// deleteWhere deletes the rows for which f returns true. Returns the number of deleted rows.
+func (t *table[K, V]) deleteWhere(f func(v V) bool) int64 {
+	keys := t.keys[:0:0]
+	for _, k := range t.keys {
+		if f(t.rows[k]) {
+			delete(t.rows, k)
+		} else {
+			keys = append(keys, k)
+		}
+	}
+	n := int64(len(t.keys) - len(keys))
+	t.keys = keys
+	return n
+}
+
+// delete returns false if the key does not exist
+func (t *table[K, V]) delete(k K) bool {
+	if _, ok := t.rows[k]; !ok {
+		return false
+	}
+	delete(t.rows, k)
+	for i := range t.keys {
+		if t.keys[i] == k {
+			t.keys = append(t.keys[:i], t.keys[i+1:]...)
+			break
+		}
+	}
+	return true
+}
+
+// paginate returns the page of the rows, like limitOffsetClause in pg
+func paginate[V any](rows []V, page, limit int) []V {
+	if limit == 0 {
+		return rows
+	}
+	offset := page * limit
+	if offset >= len(rows) {
+		return rows[:0]
+	}
+	if offset+limit < len(rows) {
+		return rows[offset : offset+limit]
+	}
+	return rows[offset:]
+}
+
+// likeMatcher returns a function that tests whether a value matches the pattern, following the rules of pg.parseLike:
+// "*" and "?" are wildcards and the "(?i)" suffix makes the comparison case-insensitive.
+// Without wildcard nor suffix, the value must be equal to the pattern.
+func likeMatcher(pattern string) func(string) bool {
+	expr := "(?s)^"
+	if strings.HasSuffix(pattern, "(?i)") {
+		pattern = strings.TrimSuffix(pattern, "(?i)")
+		expr = "(?is)^"
+	} else if !strings.ContainsAny(pattern, "*?") {
+		return func(s string) bool { return s == pattern }
+	}
+	for _, r := range pattern {
+		switch r {
+		case '*', '%':
+			expr += ".*"
+		case '?':
+			expr += "."
+		default:
+			expr += regexp.QuoteMeta(string(r))
+		}
+	}
+	return regexp.MustCompile(expr + "$").MatchString
+}
+
+// likesMatcher returns a function that tests whether a value matches one of the patterns (see likeMatcher)
+func likesMatcher(patterns []string) func(string) bool {
+	matchers := make([]func(string) bool, len(patterns))
+	for i, pattern := range patterns {
+		matchers[i] = likeMatcher(pattern)
+	}
+	return func(s string) bool {
+		for _, match := range matchers {
+			if match(s) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// pgTime returns the time as stored in a TIMESTAMP WITHOUT TIME ZONE column: wall clock and microsecond precision
+func pgTime(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC).Round(time.Microsecond)
+}
+
+// timeFilter returns a function that tests whether a time is in [fromTime, toTime] (zero means unbounded)
+func timeFilter(fromTime, toTime time.Time) func(time.Time) bool {
+	fromTime, toTime = pgTime(fromTime), pgTime(toTime)
+	return func(t time.Time) bool {
+		return (fromTime.IsZero() || !t.Before(fromTime)) && (toTime.IsZero() || !t.After(toTime))
+	}
+}
+
+// tagsFilter returns a function that tests whether the tags match the query (see geocube.TagsQuery)
+func tagsFilter(query geocube.TagsQuery) func(geocube.Metadata) bool {
+	if query == nil {
+		return func(geocube.Metadata) bool { return true }
+	}
+	return query.Match
+}
+
+// copyMetadata returns a copy of m (never nil, like an hstore column)
+func copyMetadata(m geocube.Metadata) geocube.Metadata {
+	c := make(geocube.Metadata, len(m))
+	for k, v := range m {
+		c[k] = v
+	}
+	return c
+}
+
+func metadataEqual(m1, m2 geocube.Metadata) bool {
+	if len(m1) != len(m2) {
+		return false
+	}
+	for k, v := range m1 {
+		if v2, ok := m2[k]; !ok || v != v2 {
+			return false
+		}
+	}
+	return true
+}
+
+// stringSet returns a function that tests whether a value is in the list
+func stringSet(values []string) func(string) bool {
+	set := make(map[string]struct{}, len(values))
+	for _, v := range values {
+		set[v] = struct{}{}
+	}
+	return func(s string) bool {
+		_, ok := set[s]
+		return ok
+	}
+}
@@ -0,0 +1,497 @@
+package memdb
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/airbusgeo/geocube/internal/geocube"
+	"github.com/airbusgeo/geocube/internal/utils/grid"
+	"github.com/airbusgeo/geocube/internal/utils/proj"
+	"github.com/airbusgeo/godal"
+	"github.com/twpayne/go-geom"
+)
+
+func (c containerRow) toContainer() *geocube.Container {
+	return &geocube.Container{ID: c.id, URI: c.uri, Managed: c.managed, StorageClass: c.storageClass, Checksum: c.checksum}
+}
+
+func (d datasetRow) toDataset() *geocube.Dataset {
+	return &geocube.Dataset{
+		ID:              d.id,
+		RecordID:        d.recordID,
+		InstanceID:      d.instanceID,
+		ContainerURI:    d.containerURI,
+		ContainerSubDir: d.subdir,
+		Bands:           append([]int64(nil), d.bands...),
+		DataMapping:     d.dmapping,
+		Status:          d.status,
+		Shape:           shape(d.shape),
+		GeogShape:       proj.GeographicShape{Shape: shape(d.geog)},
+		GeomShape:       proj.GeometricShape{Shape: shape(d.geom)},
+		Overviews:       d.overviews,
+	}
+}
+
+// containerHasDatasets returns true if the container is referenced by a dataset
+func (s *state) containerHasDatasets(uri string) bool {
+	for _, d := range s.datasets.rows {
+		if d.containerURI == uri {
+			return true
+		}
+	}
+	return false
+}
+
+// deleteContainer deletes the container and its layout (on delete cascade)
+func (s *state) deleteContainer(uri string) {
+	s.containers.delete(uri)
+	s.containerLayouts.delete(uri)
+}
+
+// ReadContainers implements GeocubeBackend
+func (b Backend) ReadContainers(ctx context.Context, containersURI []string) (containers []*geocube.Container, err error) {
+	if len(containersURI) == 0 {
+		return nil, nil
+	}
+
+	err = b.read(func(s *state) error {
+		idx := map[string]*geocube.Container{}
+		for _, uri := range containersURI {
+			if _, ok := idx[uri]; ok {
+				continue
+			}
+			c, ok := s.containers.get(uri)
+			if !ok {
+				return geocube.NewEntityNotFound("Container", "uri", uri, "")
+			}
+			idx[uri] = c.toContainer()
+			containers = append(containers, idx[uri])
+		}
+
+		// Fetch datasets
+		datasets, err := s.findDatasets(nil, containersURI, "", nil, nil, nil, time.Time{}, time.Time{}, nil, nil, false)
+		if err != nil {
+			return err
+		}
+		for _, d := range datasets {
+			if c, ok := idx[d.ContainerURI]; ok {
+				c.Datasets = append(c.Datasets, d)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return containers, nil
+}
+
+// CreateContainer implements GeocubeBackend
+func (b Backend) CreateContainer(ctx context.Context, container *geocube.Container) error {
+	row := containerRow{uri: container.URI, managed: container.Managed, storageClass: container.StorageClass, checksum: container.Checksum}
+	return b.write(func(s *state) error {
+		if s.containers.has(row.uri) {
+			return geocube.NewEntityAlreadyExists("Container", "uri", row.uri, "")
+		}
+		s.lastContainerID++
+		row.id = s.lastContainerID
+		s.containers.insert(row.uri, row)
+		return nil
+	})
+}
+
+// UpdateContainer implements GeocubeBackend
+func (b Backend) UpdateContainer(ctx context.Context, container *geocube.Container) error {
+	return nil
+}
+
+// DeleteContainer implements GeocubeBackend
+func (b Backend) DeleteContainer(ctx context.Context, container *geocube.Container) error {
+	id := container.ID
+	return b.write(func(s *state) error {
+		for _, c := range s.containers.values() {
+			if c.id != id {
+				continue
+			}
+			if s.containerHasDatasets(c.uri) {
+				return geocube.NewDependencyStillExists("containers", "datasets", "uri", c.uri, "")
+			}
+			s.deleteContainer(c.uri)
+		}
+		return nil
+	})
+}
+
+// DeletePendingContainers implements GeocubeBackend
+func (b Backend) DeletePendingContainers(ctx context.Context) (n int64, err error) {
+	err = b.write(func(s *state) error {
+		n = 0
+		for _, c := range s.containers.values() {
+			if !c.managed && !s.containerHasDatasets(c.uri) {
+				s.deleteContainer(c.uri)
+				n++
+			}
+		}
+		return nil
+	})
+	return n, err
+}
+
+// CreateDatasets implements GeocubeBackend
+func (b Backend) CreateDatasets(ctx context.Context, datasets []*geocube.Dataset) error {
+	if len(datasets) == 0 {
+		return nil
+	}
+
+	rows := make([]datasetRow, len(datasets))
+	for i, d := range datasets {
+		geog, err := wrapLongitudes(&d.GeogShape.MultiPolygon, 4326)
+		if err != nil {
+			return fmt.Errorf("CreateDatasets.%w", err)
+		}
+		geometry, err := wrapLongitudes(&d.GeomShape.MultiPolygon, 4326)
+		if err != nil {
+			return fmt.Errorf("CreateDatasets.%w", err)
+		}
+		rows[i] = datasetRow{
+			id:           d.ID,
+			recordID:     d.RecordID,
+			instanceID:   d.InstanceID,
+			containerURI: d.ContainerURI,
+			geog:         geog,
+			geom:         geometry,
+			shape:        d.Shape.Clone(),
+			subdir:       d.ContainerSubDir,
+			bands:        append([]int64(nil), d.Bands...),
+			status:       d.Status,
+			dmapping:     d.DataMapping,
+			overviews:    d.Overviews,
+		}
+	}
+
+	return b.write(func(s *state) error {
+		for _, d := range rows {
+			if !s.datasets.insert(d.id, d) {
+				return fmt.Errorf("CreateDatasets: duplicate key value violates unique constraint: Key (id)=(%s) already exists", d.id)
+			}
+		}
+		for _, d := range rows {
+			switch {
+			case !s.records.has(d.recordID):
+				return geocube.NewEntityNotFound("Dataset", "record_id", d.recordID, "")
+			case !s.instances.has(d.instanceID):
+				return geocube.NewEntityNotFound("Dataset", "instance_id", d.instanceID, "")
+			case !s.containers.has(d.containerURI):
+				return geocube.NewEntityNotFound("Dataset", "container_uri", d.containerURI, "")
+			}
+		}
+		return nil
+	})
+}
+
+// DeleteDatasets implements GeocubeBackend
+func (b Backend) DeleteDatasets(ctx context.Context, datasetsID []string) error {
+	inIDs := stringSet(datasetsID)
+	return b.write(func(s *state) error {
+		for _, l := range s.lockedDatasets.rows {
+			if inIDs(l.datasetID) {
+				return fmt.Errorf("DeleteDatasets: update or delete on table \"datasets\" violates foreign key constraint: Key (id)=(%s) is still referenced from table \"locked_datasets\"", l.datasetID)
+			}
+		}
+		s.datasets.deleteWhere(func(d datasetRow) bool { return inIDs(d.id) })
+		return nil
+	})
+}
+
+// FindDatasets implements GeocubeBackend
+func (b Backend) FindDatasets(ctx context.Context, status geocube.DatasetStatus, containerURIPatterns []string, lockedByJobID string, instancesID, recordsID []string,
+	recordTags geocube.TagsQuery, fromTime, toTime time.Time, geog *proj.GeographicRing, refined *proj.Ring, page, limit int, after *geocube.Cursor, order bool) (datasets []*geocube.Dataset, err error) {
+	// The cursor is a record cursor: the datasets are ordered by record
+	order = order || after != nil
+	err = b.read(func(s *state) error {
+		datasets, err = s.findDatasets([]geocube.DatasetStatus{status}, containerURIPatterns, lockedByJobID, instancesID, recordsID, recordTags, fromTime, toTime, geog, refined, order)
+		if after != nil {
+			datasets = slices.DeleteFunc(datasets, func(d *geocube.Dataset) bool {
+				r, _ := s.records.get(d.RecordID)
+				return !after.Time.Before(r.datetime) && (!after.Time.Equal(r.datetime) || r.id <= after.ID)
+			})
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !order && limit != 0 {
+		sort.Slice(datasets, func(i, j int) bool { return datasets[i].ID < datasets[j].ID })
+	}
+	return paginate(datasets, page, limit), nil
+}
+
+// findDatasets is identical to FindDatasets but it can take a list of datasetStatus (and it is not paginated)
+func (s *state) findDatasets(status []geocube.DatasetStatus, containerURIPatterns []string, lockedByJobID string, instancesID, recordsID []string,
+	recordTags geocube.TagsQuery, fromTime, toTime time.Time, geog *proj.GeographicRing, refined *proj.Ring, order bool) ([]*geocube.Dataset, error) {
+	matchContainer := likesMatcher(containerURIPatterns)
+	inInstances, inRecords := stringSet(instancesID), stringSet(recordsID)
+	inTimeRange := timeFilter(fromTime, toTime)
+	matchTags := tagsFilter(recordTags)
+
+	var geogPolygon *geom.MultiPolygon
+	var refinedPolygon *geom.Polygon
+	if geog != nil {
+		var err error
+		mp := geom.NewMultiPolygon(geom.XY)
+		if err := mp.Push(polygonFromRing(&geog.LinearRing)); err != nil {
+			return nil, fmt.Errorf("findDatasets.%w", err)
+		}
+		if geogPolygon, err = wrapLongitudes(mp, 4326); err != nil {
+			return nil, fmt.Errorf("findDatasets.%w", err)
+		}
+		if refined != nil {
+			refinedPolygon = polygonFromRing(&refined.LinearRing)
+		}
+	}
+
+	type result struct {
+		dataset *geocube.Dataset
+		record  recordRow
+	}
+	var results []result
+	for _, d := range s.datasets.values() {
+		if len(status) > 0 && !slices.Contains(status, d.status) {
+			continue
+		}
+		if lockedByJobID != "" && d.lockedByJobID != lockedByJobID {
+			continue
+		}
+		if len(containerURIPatterns) > 0 && !matchContainer(d.containerURI) {
+			continue
+		}
+		if (len(instancesID) > 0 && !inInstances(d.instanceID)) || (len(recordsID) > 0 && !inRecords(d.recordID)) {
+			continue
+		}
+		r, _ := s.records.get(d.recordID)
+		if !inTimeRange(r.datetime) || !matchTags(r.tags) {
+			continue
+		}
+		if geogPolygon != nil {
+			ok, err := intersects(d.geog, geogPolygon)
+			if err != nil {
+				return nil, fmt.Errorf("findDatasets.%w", err)
+			}
+			if ok && refinedPolygon != nil && d.shape.SRID() == refined.SRID() {
+				if ok, err = relateInterior(d.shape, refinedPolygon); err != nil {
+					return nil, fmt.Errorf("findDatasets.%w", err)
+				}
+			}
+			if !ok {
+				continue
+			}
+		}
+		results = append(results, result{dataset: d.toDataset(), record: r})
+	}
+
+	if order {
+		sort.SliceStable(results, func(i, j int) bool {
+			ri, rj := results[i].record, results[j].record
+			if !ri.datetime.Equal(rj.datetime) {
+				return ri.datetime.Before(rj.datetime)
+			}
+			return ri.id < rj.id
+		})
+	}
+
+	var datasets []*geocube.Dataset
+	for _, r := range results {
+		datasets = append(datasets, r.dataset)
+	}
+	return datasets, nil
+}
+
+// ListActiveDatasetsID implements GeocubeBackend
+func (b Backend) ListActiveDatasetsID(ctx context.Context, instanceID string, recordsID []string, recordTags geocube.TagsQuery, fromTime, toTime time.Time) (ids []string, err error) {
+	err = b.read(func(s *state) error {
+		datasets, err := s.findDatasets([]geocube.DatasetStatus{geocube.DatasetStatusACTIVE}, nil, "", []string{instanceID}, recordsID, recordTags, fromTime, toTime, nil, nil, false)
+		for _, d := range datasets {
+			ids = append(ids, d.ID)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// GetDatasetsGeometryUnion implements GeocubeBackend
+func (b Backend) GetDatasetsGeometryUnion(ctx context.Context, lockedByJobID string) (mp *geom.MultiPolygon, err error) {
+	err = b.read(func(s *state) error {
+		var geometries []*geom.MultiPolygon
+		for _, d := range s.datasets.values() {
+			if d.lockedByJobID == lockedByJobID {
+				geometries = append(geometries, d.geom)
+			}
+		}
+		if len(geometries) == 0 {
+			mp = &geom.MultiPolygon{}
+			return nil
+		}
+		// As pg, the SRID is lost
+		mp, err = union(geometries, 0)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("GetDatasetsGeometryUnion.%w", err)
+	}
+	return mp, nil
+}
+
+// ComputeValidShapeFromCell implements GeocubeBackend
+func (b Backend) ComputeValidShapeFromCell(ctx context.Context, datasetIDS []string, cell *grid.Cell) (*proj.Shape, error) {
+	srid := proj.Srid(cell.CRS)
+
+	inIDs := stringSet(datasetIDS)
+	var shapes []*geom.MultiPolygon
+	err := b.read(func(s *state) error {
+		for _, d := range s.datasets.values() {
+			if inIDs(d.id) {
+				shapes = append(shapes, d.shape)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Reproject the shapes in the crs of the cell
+	for i, shape := range shapes {
+		if shape.SRID() != srid {
+			if shapes[i], err = transform(shape, cell.CRS); err != nil {
+				return nil, fmt.Errorf("ComputeValidShapeFromCell.%w", err)
+			}
+		}
+	}
+
+	u, err := union(shapes, srid)
+	if err != nil {
+		return nil, fmt.Errorf("ComputeValidShapeFromCell.%w", err)
+	}
+	computeShape, err := intersection(u, polygonFromRing(&cell.Ring.LinearRing), srid)
+	if err != nil {
+		return nil, fmt.Errorf("ComputeValidShapeFromCell.%w", err)
+	}
+	if computeShape.NumPolygons() == 0 {
+		return nil, geocube.NewEntityNotFound("", "", "", "empty intersection with %v", cell.Ring.Coords())
+	}
+	shape := proj.NewShape(srid, computeShape)
+	return &shape, nil
+}
+
+// transform reprojects the multipolygon from its SRID to the crs
+func transform(mp *geom.MultiPolygon, crs *godal.SpatialRef) (*geom.MultiPolygon, error) {
+	srcCRS, err := proj.CRSFromEPSG(mp.SRID())
+	if err != nil {
+		return nil, fmt.Errorf("transform.%w", err)
+	}
+	tr, err := godal.NewTransform(srcCRS, crs)
+	if err != nil {
+		return nil, fmt.Errorf("transform: %w", err)
+	}
+	defer tr.Close()
+
+	x, y := proj.FlatCoordToXY(mp.FlatCoords())
+	if err := tr.TransformEx(x, y, make([]float64, len(x)), nil); err != nil {
+		return nil, fmt.Errorf("transform: %w", err)
+	}
+	return geom.NewMultiPolygonFlat(geom.XY, proj.XYToFlatCoord(x, y), mp.Endss()), nil
+}
+
+// pgFloat formats the float as pg does when a double precision is converted to text
+func pgFloat(f float64) string {
+	switch {
+	case math.IsInf(f, 1):
+		return "Infinity"
+	case math.IsInf(f, -1):
+		return "-Infinity"
+	case math.IsNaN(f):
+		return "NaN"
+	}
+	s := strconv.FormatFloat(f, 'e', -1, 64)
+	mantissa, exp, _ := strings.Cut(s, "e")
+	e, _ := strconv.Atoi(exp)
+	if e < -4 || e >= 15 {
+		return fmt.Sprintf("%se%s%02d", mantissa, exp[:1], int(math.Abs(float64(e))))
+	}
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// describeDataMapping returns the description of the data mapping of a dataset (see pg.UpdateDatasets)
+func describeDataMapping(dm geocube.DataMapping) string {
+	return "(" + dm.DType.String() + ", " + pgFloat(dm.Range.Min) + ", " + pgFloat(dm.Range.Max) + ", no_data=" + pgFloat(dm.NoData) +
+		") currently maps to (" + pgFloat(dm.RangeExt.Min) + ", " + pgFloat(dm.RangeExt.Max) + ") with exponent=" + pgFloat(dm.Exponent)
+}
+
+// UpdateDatasets implements GeocubeBackend
+func (b Backend) UpdateDatasets(ctx context.Context, instanceID string, recordIds []string, dmapping geocube.DataMapping) (results map[string]int64, err error) {
+	inRecords := stringSet(recordIds)
+	err = b.write(func(s *state) error {
+		// Get impact of the update
+		results = map[string]int64{}
+		s.datasets.update(func(d datasetRow) (datasetRow, bool) {
+			if d.instanceID != instanceID || !inRecords(d.recordID) {
+				return d, false
+			}
+			results[describeDataMapping(d.dmapping)]++
+
+			// Update (the data type is not updated)
+			d.dmapping = geocube.DataMapping{
+				DataFormat: geocube.DataFormat{DType: d.dmapping.DType, NoData: dmapping.NoData, Range: dmapping.Range},
+				RangeExt:   dmapping.RangeExt,
+				Exponent:   dmapping.Exponent,
+			}
+			return d, true
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// UpdateDatasetsShape implements GeocubeBackend
+func (b Backend) UpdateDatasetsShape(ctx context.Context, datasets []*geocube.Dataset) error {
+	type shapes struct {
+		geog, geom, shape *geom.MultiPolygon
+	}
+	rows := make(map[string]shapes, len(datasets))
+	for _, d := range datasets {
+		geog, err := wrapLongitudes(&d.GeogShape.MultiPolygon, 4326)
+		if err != nil {
+			return fmt.Errorf("UpdateDatasetsShape.%w", err)
+		}
+		geometry, err := wrapLongitudes(&d.GeomShape.MultiPolygon, 4326)
+		if err != nil {
+			return fmt.Errorf("UpdateDatasetsShape.%w", err)
+		}
+		rows[d.ID] = shapes{geog: geog, geom: geometry, shape: d.Shape.Clone()}
+	}
+
+	return b.write(func(s *state) error {
+		for id, r := range rows {
+			d, ok := s.datasets.get(id)
+			if !ok {
+				return geocube.NewEntityNotFound("Dataset", "id", id, "")
+			}
+			d.geog, d.geom, d.shape = r.geog, r.geom, r.shape
+			s.datasets.set(id, d)
+		}
+		return nil
+	})
+}
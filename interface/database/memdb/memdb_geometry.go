@@ -0,0 +1,161 @@
+package memdb
+
+import (
+	"fmt"
+	"math"
+
+	sfgeom "github.com/peterstace/simplefeatures/geom"
+	"github.com/twpayne/go-geom"
+	"github.com/twpayne/go-geom/encoding/wkb"
+)
+
+// The spatial operations are computed in planar coordinates, using simplefeatures in place of PostGIS.
+// Contrary to PostGIS, the geographies are not handled on the sphere: the geodesic lines are approximated by
+// lon/lat segments, that is accurate enough for small geometries.
+
+// toSF converts a go-geom geometry to a simplefeatures geometry
+func toSF(g geom.T) (sfgeom.Geometry, error) {
+	if g == nil || g.Empty() {
+		return sfgeom.Geometry{}, nil
+	}
+	b, err := wkb.Marshal(g, wkb.NDR)
+	if err != nil {
+		return sfgeom.Geometry{}, fmt.Errorf("toSF.%w", err)
+	}
+	sg, err := sfgeom.UnmarshalWKB(b, sfgeom.NoValidate{})
+	if err != nil {
+		return sfgeom.Geometry{}, fmt.Errorf("toSF.%w", err)
+	}
+	return sg, nil
+}
+
+// fromSF extracts the polygons of a simplefeatures geometry (like ST_CollectionExtract(g, 3))
+func fromSF(g sfgeom.Geometry, srid int) (*geom.MultiPolygon, error) {
+	mp := geom.NewMultiPolygon(geom.XY)
+	for _, p := range g.Dump() {
+		if p.Type() != sfgeom.TypePolygon || p.IsEmpty() {
+			continue
+		}
+		gp, err := wkb.Unmarshal(p.AsBinary())
+		if err != nil {
+			return nil, fmt.Errorf("fromSF.%w", err)
+		}
+		if err := mp.Push(gp.(*geom.Polygon)); err != nil {
+			return nil, fmt.Errorf("fromSF.%w", err)
+		}
+	}
+	mp.SetSRID(srid)
+	return mp, nil
+}
+
+// intersects returns true if the geometries share at least one point (like ST_Intersects)
+func intersects(g1, g2 geom.T) (bool, error) {
+	sg1, err := toSF(g1)
+	if err != nil {
+		return false, fmt.Errorf("intersects.%w", err)
+	}
+	sg2, err := toSF(g2)
+	if err != nil {
+		return false, fmt.Errorf("intersects.%w", err)
+	}
+	return sfgeom.Intersects(sg1, sg2), nil
+}
+
+// relateInterior returns true if the interiors of the geometries intersect (like ST_Relate(g1, g2, 'T********'))
+func relateInterior(g1, g2 geom.T) (bool, error) {
+	sg1, err := toSF(g1)
+	if err != nil {
+		return false, fmt.Errorf("relateInterior.%w", err)
+	}
+	sg2, err := toSF(g2)
+	if err != nil {
+		return false, fmt.Errorf("relateInterior.%w", err)
+	}
+	matrix, err := sfgeom.Relate(sg1, sg2)
+	if err != nil {
+		return false, fmt.Errorf("relateInterior.%w", err)
+	}
+	return sfgeom.RelateMatches(matrix, "T********")
+}
+
+// intersection returns the polygonal part of the intersection of the geometries
+func intersection(g1, g2 geom.T, srid int) (*geom.MultiPolygon, error) {
+	sg1, err := toSF(g1)
+	if err != nil {
+		return nil, fmt.Errorf("intersection.%w", err)
+	}
+	sg2, err := toSF(g2)
+	if err != nil {
+		return nil, fmt.Errorf("intersection.%w", err)
+	}
+	g, err := sfgeom.Intersection(sg1, sg2)
+	if err != nil {
+		return nil, fmt.Errorf("intersection.%w", err)
+	}
+	return fromSF(g, srid)
+}
+
+// union returns the union of the multipolygons
+func union(mps []*geom.MultiPolygon, srid int) (*geom.MultiPolygon, error) {
+	sgs := make([]sfgeom.Geometry, 0, len(mps))
+	for _, mp := range mps {
+		sg, err := toSF(mp)
+		if err != nil {
+			return nil, fmt.Errorf("union.%w", err)
+		}
+		sgs = append(sgs, sg)
+	}
+	g, err := sfgeom.UnionMany(sgs)
+	if err != nil {
+		return nil, fmt.Errorf("union.%w", err)
+	}
+	return fromSF(g, srid)
+}
+
+// validPolygon returns an error if the ring is not a valid polygon (like ST_IsValid)
+func validPolygon(ring *geom.LinearRing) error {
+	p := geom.NewPolygonFlat(ring.Layout(), ring.FlatCoords(), []int{len(ring.FlatCoords())})
+	b, err := wkb.Marshal(p, wkb.NDR)
+	if err != nil {
+		return err
+	}
+	_, err = sfgeom.UnmarshalWKB(b)
+	return err
+}
+
+// polygonFromRing returns the polygon defined by the ring
+func polygonFromRing(ring *geom.LinearRing) *geom.Polygon {
+	p := geom.NewPolygonFlat(ring.Layout(), ring.FlatCoords(), []int{len(ring.FlatCoords())})
+	p.SetSRID(ring.SRID())
+	return p
+}
+
+// wrapLongitudes translates the parts of the geometry that are out of [-180, 180] (see pg.splitGeom)
+func wrapLongitudes(mp *geom.MultiPolygon, srid int) (*geom.MultiPolygon, error) {
+	bounds := mp.Bounds()
+	if mp.Empty() || (bounds.Min(0) >= -180 && bounds.Max(0) <= 180) {
+		c := mp.Clone()
+		c.SetSRID(srid)
+		return c, nil
+	}
+
+	sg, err := toSF(mp)
+	if err != nil {
+		return nil, fmt.Errorf("wrapLongitudes.%w", err)
+	}
+	var parts []sfgeom.Geometry
+	for i := 360 * math.Floor((bounds.Min(0)+180)/360); i-180 < bounds.Max(0); i += 360 {
+		hemisphere := sfgeom.NewEnvelope(sfgeom.XY{X: i - 180, Y: -90}, sfgeom.XY{X: i + 180, Y: 90}).AsGeometry()
+		part, err := sfgeom.Intersection(sg, hemisphere)
+		if err != nil {
+			return nil, fmt.Errorf("wrapLongitudes.%w", err)
+		}
+		translation := i
+		parts = append(parts, part.TransformXY(func(xy sfgeom.XY) sfgeom.XY { return sfgeom.XY{X: xy.X - translation, Y: xy.Y} }))
+	}
+	var polygons []sfgeom.Geometry
+	for _, part := range parts {
+		polygons = append(polygons, part.Dump()...)
+	}
+	return fromSF(sfgeom.NewGeometryCollection(polygons).AsGeometry(), srid)
+}
@@ -0,0 +1,115 @@
+package memdb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/airbusgeo/geocube/internal/geocube"
+	"github.com/airbusgeo/geocube/internal/utils/proj"
+	"github.com/twpayne/go-geom"
+)
+
+// CreateGrid implements GeocubeBackend
+func (b Backend) CreateGrid(ctx context.Context, grid *geocube.Grid) error {
+	row := geocube.Grid{Name: grid.Name, Description: grid.Description}
+	cells := make([]geocube.Cell, len(grid.Cells))
+	for i, cell := range grid.Cells {
+		if err := validPolygon(&cell.Coordinates.LinearRing); err != nil {
+			return geocube.NewValidationError("cell %s: %v", cell.ID, err)
+		}
+		cells[i] = geocube.Cell{ID: cell.ID, CRS: cell.CRS, SRID: cell.SRID,
+			Coordinates: proj.GeographicRing{Ring: proj.NewRingFlat(4326, cell.Coordinates.FlatCoords())}}
+	}
+
+	return b.write(func(s *state) error {
+		if !s.grids.insert(row.Name, row) {
+			return geocube.NewEntityAlreadyExists("Grid", "name", row.Name, "")
+		}
+		for _, cell := range cells {
+			if !s.cells.insert(cellKey{grid: row.Name, id: cell.ID}, cell) {
+				return geocube.NewEntityAlreadyExists("Cell", "id for gridName", cell.ID+", "+row.Name, "")
+			}
+		}
+		return nil
+	})
+}
+
+// DeleteGrid implements GeocubeBackend
+func (b Backend) DeleteGrid(ctx context.Context, gridName string) error {
+	return b.write(func(s *state) error {
+		for _, k := range append([]cellKey(nil), s.cells.keys...) {
+			if k.grid == gridName {
+				s.cells.delete(k)
+			}
+		}
+		s.grids.delete(gridName)
+		return nil
+	})
+}
+
+// ReadGrid implements GeocubeBackend
+func (b Backend) ReadGrid(ctx context.Context, name string) (grid *geocube.Grid, err error) {
+	err = b.read(func(s *state) error {
+		g, ok := s.grids.get(name)
+		if !ok {
+			return geocube.NewEntityNotFound("Grid", "name", name, "")
+		}
+		grid = &geocube.Grid{Name: g.Name, Description: g.Description}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return grid, nil
+}
+
+// FindGrids implements GeocubeBackend
+func (b Backend) FindGrids(ctx context.Context, nameLike string) (grids []*geocube.Grid, err error) {
+	matchName := func(string) bool { return true }
+	if nameLike != "" {
+		matchName = likeMatcher(nameLike)
+	}
+
+	grids = []*geocube.Grid{}
+	err = b.read(func(s *state) error {
+		for _, g := range s.grids.values() {
+			if matchName(g.Name) {
+				grids = append(grids, &geocube.Grid{Name: g.Name, Description: g.Description})
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return grids, nil
+}
+
+// FindCells implements GeocubeBackend
+// Returns the cells and the intersection with the AOI
+// Contrary to pg, the intersection is computed in lon/lat, whatever the CRS of the cell.
+func (b Backend) FindCells(ctx context.Context, gridName string, aoi *geocube.AOI) (cells []geocube.Cell, intersections []geom.MultiPolygon, err error) {
+	err = b.read(func(s *state) error {
+		for _, k := range s.cells.keys {
+			if k.grid != gridName {
+				continue
+			}
+			cell := s.cells.rows[k]
+			i, err := intersection(aoi.Geometry.MultiPolygon, polygonFromRing(&cell.Coordinates.LinearRing), 4326)
+			if err != nil {
+				return fmt.Errorf("FindCells.%w", err)
+			}
+			if i.NumPolygons() == 0 {
+				continue
+			}
+			cells = append(cells, geocube.Cell{ID: cell.ID, CRS: cell.CRS, SRID: cell.SRID,
+				Coordinates: proj.GeographicRing{Ring: proj.NewRingFlat(4326, cell.Coordinates.FlatCoords())}})
+			intersections = append(intersections, *i)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return cells, intersections, nil
+}
@@ -0,0 +1,412 @@
+package memdb
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/airbusgeo/geocube/interface/database"
+	"github.com/airbusgeo/geocube/internal/geocube"
+)
+
+func (j jobRow) toJob() *geocube.Job {
+	job := geocube.NewJob(j.id)
+	job.Name = j.name
+	job.Type = j.jobType
+	job.CreationTime = j.creationTime
+	job.LastUpdateTime = j.lastUpdateTime
+	job.State = j.state
+	job.ActiveTasks = j.activeTasks
+	job.FailedTasks = j.failedTasks
+	job.Payload = j.payload
+	job.ExecutionLevel = j.executionLevel
+	job.Waiting = j.waiting
+	return job
+}
+
+func (t taskRow) toTask() *geocube.Task {
+	return &geocube.Task{ID: t.id, State: t.state, Payload: append([]byte(nil), t.payload...), Checksum: t.checksum}
+}
+
+// readJobLogs returns the logs of the job, the most recent first, and the total number of logs
+func (s *state) readJobLogs(jobID string, offset, limit int) (geocube.JobLogs, int) {
+	var logs []jobLogRow
+	for _, l := range s.jobLogs.values() {
+		if l.jobID == jobID {
+			logs = append(logs, l)
+		}
+	}
+	count := len(logs)
+
+	// The logs are sorted by time (descending), then by insertion order (descending)
+	for i, j := 0, len(logs)-1; i < j; i, j = i+1, j-1 {
+		logs[i], logs[j] = logs[j], logs[i]
+	}
+	sort.SliceStable(logs, func(i, j int) bool { return logs[i].log.Date.After(logs[j].log.Date) })
+
+	jobLogs := geocube.JobLogs{}
+	for i := offset; i < len(logs) && i < offset+limit; i++ {
+		jobLogs = append(jobLogs, logs[i].log)
+	}
+	return jobLogs, count
+}
+
+// FindJobs implements GeocubeBackend
+func (b Backend) FindJobs(ctx context.Context, nameLike string, page, limit int, after *geocube.Cursor) (jobs []*geocube.Job, err error) {
+	matchName := func(string) bool { return true }
+	if nameLike != "" {
+		matchName = likeMatcher(nameLike)
+	}
+
+	jobs = []*geocube.Job{}
+	err = b.read(func(s *state) error {
+		for _, j := range s.jobs.values() {
+			if matchName(j.name) && (after == nil || j.id > after.ID) {
+				job := j.toJob()
+				job.Logs, _ = s.readJobLogs(j.id, 0, 10)
+				job.LogsCount = -1
+				reverse(job.Logs)
+				jobs = append(jobs, job)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(jobs, func(i, j int) bool { return jobs[i].ID < jobs[j].ID })
+	return paginate(jobs, page, limit), nil
+}
+
+// ReadJob implements GeocubeBackend
+func (b Backend) ReadJob(ctx context.Context, jobID string, opts ...database.ReadJobOptions) (job *geocube.Job, err error) {
+	readJobOpts := database.Apply(opts...)
+
+	err = b.read(func(s *state) error {
+		j, ok := s.jobs.get(jobID)
+		if !ok {
+			return geocube.NewEntityNotFound("Job", "id", jobID, "")
+		}
+		job = j.toJob()
+		if readJobOpts.Limit != 0 {
+			job.Logs, job.LogsCount = s.readJobLogs(jobID, readJobOpts.Page*readJobOpts.Limit, readJobOpts.Limit)
+			reverse(job.Logs)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+func reverse(log geocube.JobLogs) {
+	for i, j := 0, len(log)-1; i < j; i, j = i+1, j-1 {
+		log[i], log[j] = log[j], log[i]
+	}
+}
+
+// ReadJobWithTask implements GeocubeBackend
+func (b Backend) ReadJobWithTask(ctx context.Context, jobID string, taskID string) (job *geocube.Job, err error) {
+	err = b.read(func(s *state) error {
+		j, ok := s.jobs.get(jobID)
+		t, ok2 := s.tasks.get(taskID)
+		if !ok || !ok2 || t.jobID != jobID {
+			return geocube.NewEntityNotFound("Job/Task", "id/id", jobID+"/"+taskID, "")
+		}
+		job = j.toJob()
+		job.Tasks = []*geocube.Task{t.toTask()}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+// CreateJob implements GeocubeBackend
+func (b Backend) CreateJob(ctx context.Context, job *geocube.Job) error {
+	row := jobRow{
+		id:             job.ID,
+		name:           job.Name,
+		jobType:        job.Type,
+		creationTime:   pgTime(job.CreationTime),
+		lastUpdateTime: pgTime(job.LastUpdateTime),
+		payload:        job.Payload,
+		state:          job.State,
+		activeTasks:    job.ActiveTasks,
+		failedTasks:    job.FailedTasks,
+		executionLevel: job.ExecutionLevel,
+	}
+	return b.write(func(s *state) error {
+		for _, j := range s.jobs.rows {
+			if j.name == row.name {
+				return geocube.NewEntityAlreadyExists("Job", "name", row.name, "")
+			}
+		}
+		if !s.jobs.insert(row.id, row) {
+			return geocube.NewEntityAlreadyExists("Job", "name", row.name, "")
+		}
+		return nil
+	})
+}
+
+// DeleteJob implements GeocubeBackend
+// The logs and the locks of the job are deleted in cascade
+func (b Backend) DeleteJob(ctx context.Context, jobID string) error {
+	return b.write(func(s *state) error {
+		for _, t := range s.tasks.rows {
+			if t.jobID == jobID {
+				return geocube.NewDependencyStillExists("jobs", "tasks", "id", jobID, "")
+			}
+		}
+		if s.jobs.delete(jobID) {
+			s.jobLogs.deleteWhere(func(l jobLogRow) bool { return l.jobID == jobID })
+			s.lockedDatasets.deleteWhere(func(l lockedDatasetRow) bool { return l.jobID == jobID })
+		}
+		return nil
+	})
+}
+
+// UpdateJob implements GeocubeBackend
+// The job is updated only if it has not been updated since it was read (Optimistic Concurrency Control)
+func (b Backend) UpdateJob(ctx context.Context, job *geocube.Job) error {
+	id, occTime := job.ID, job.OCCTime()
+	lastUpdateTime, jobState, activeTasks, failedTasks, waiting := pgTime(job.LastUpdateTime), job.State, job.ActiveTasks, job.FailedTasks, job.Waiting
+	return b.write(func(s *state) error {
+		j, ok := s.jobs.get(id)
+		if !ok || !j.lastUpdateTime.Equal(pgTime(occTime)) {
+			return unaffected{geocube.NewEntityNotFound("Job", "id/timestamp", id+"/"+occTime.String(), "")}
+		}
+		j.lastUpdateTime, j.state, j.activeTasks, j.failedTasks, j.waiting = lastUpdateTime, jobState, activeTasks, failedTasks, waiting
+		s.jobs.set(id, j)
+		return nil
+	})
+}
+
+// PersistLogs implements GeocubeBackend
+func (b Backend) PersistLogs(ctx context.Context, jobID string, logs geocube.JobLogs) error {
+	if len(logs) == 0 {
+		return nil
+	}
+	rows := make([]jobLogRow, len(logs))
+	for i, log := range logs {
+		log.Date = pgTime(log.Date)
+		rows[i] = jobLogRow{jobID: jobID, log: log}
+	}
+
+	return b.write(func(s *state) error {
+		if !s.jobs.has(jobID) {
+			return fmt.Errorf("PersistLogs: %w", foreignKeyViolation("job_logs", "job_id", jobID, "jobs"))
+		}
+		for _, row := range rows {
+			s.lastJobLogID++
+			s.jobLogs.insert(s.lastJobLogID, row)
+		}
+		return nil
+	})
+}
+
+// ListJobsID implements GeocubeBackend
+func (b Backend) ListJobsID(ctx context.Context, nameLike string, states []geocube.JobState) (ids []string, err error) {
+	matchName := func(string) bool { return true }
+	if nameLike != "" {
+		matchName = likeMatcher(nameLike)
+	}
+
+	err = b.read(func(s *state) error {
+		for _, j := range s.jobs.values() {
+			if matchName(j.name) && slices.Contains(states, j.state) {
+				ids = append(ids, j.id)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// LockDatasets implements GeocubeBackend
+func (b Backend) LockDatasets(ctx context.Context, lockedByJobID string, datasetsID []string, flag int) error {
+	datasetsID = append([]string(nil), datasetsID...)
+	return b.write(func(s *state) error {
+		for _, datasetID := range datasetsID {
+			if !s.lockedDatasets.insert(datasetID, lockedDatasetRow{datasetID: datasetID, jobID: lockedByJobID, flag: flag}) {
+				return geocube.NewEntityAlreadyExists("dataset", "id", datasetID, "Dataset is already locked: "+datasetID)
+			}
+		}
+		for _, datasetID := range datasetsID {
+			if !s.datasets.has(datasetID) {
+				return fmt.Errorf("LockDatasets: %w", foreignKeyViolation("locked_datasets", "dataset_id", datasetID, "datasets"))
+			}
+		}
+		if len(datasetsID) > 0 && !s.jobs.has(lockedByJobID) {
+			return fmt.Errorf("LockDatasets: %w", foreignKeyViolation("locked_datasets", "job_id", lockedByJobID, "jobs"))
+		}
+
+		// Update datasets table
+		inIDs := stringSet(datasetsID)
+		s.datasets.update(func(d datasetRow) (datasetRow, bool) {
+			if !inIDs(d.id) {
+				return d, false
+			}
+			d.lockedByJobID = lockedByJobID
+			return d, true
+		})
+		return nil
+	})
+}
+
+// ReleaseDatasets implements GeocubeBackend
+func (b Backend) ReleaseDatasets(ctx context.Context, lockedByJobID string, flag int) error {
+	return b.write(func(s *state) error {
+		s.datasets.update(func(d datasetRow) (datasetRow, bool) {
+			if l, ok := s.lockedDatasets.get(d.id); d.lockedByJobID != lockedByJobID || !ok || l.flag != flag {
+				return d, false
+			}
+			d.lockedByJobID = ""
+			return d, true
+		})
+		s.lockedDatasets.deleteWhere(func(l lockedDatasetRow) bool { return l.jobID == lockedByJobID && l.flag == flag })
+		return nil
+	})
+}
+
+// CreateTasks implements GeocubeBackend
+func (b Backend) CreateTasks(ctx context.Context, jobID string, tasks []*geocube.Task) error {
+	rows := make([]taskRow, len(tasks))
+	for i, task := range tasks {
+		rows[i] = taskRow{id: task.ID, state: task.State, payload: append([]byte(nil), task.Payload...), jobID: jobID}
+	}
+
+	return b.write(func(s *state) error {
+		for _, t := range rows {
+			if !s.tasks.insert(t.id, t) {
+				return geocube.NewEntityAlreadyExists("Task", "id", t.id, "")
+			}
+		}
+		if len(rows) > 0 && !s.jobs.has(jobID) {
+			return fmt.Errorf("CreateTasks: %w", foreignKeyViolation("tasks", "job_id", jobID, "jobs"))
+		}
+		return nil
+	})
+}
+
+// ReadTasks implements GeocubeBackend
+func (b Backend) ReadTasks(ctx context.Context, jobID string, states []geocube.TaskState) (tasks []*geocube.Task, err error) {
+	err = b.read(func(s *state) error {
+		for _, t := range s.tasks.values() {
+			if t.jobID == jobID && (states == nil || slices.Contains(states, t.state)) {
+				tasks = append(tasks, t.toTask())
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return tasks, nil
+}
+
+// UpdateTask implements GeocubeBackend
+func (b Backend) UpdateTask(ctx context.Context, task *geocube.Task) error {
+	id, taskState, checksum := task.ID, task.State, task.Checksum
+	return b.write(func(s *state) error {
+		t, ok := s.tasks.get(id)
+		if !ok {
+			return unaffected{geocube.NewEntityNotFound("Task", "id", id, "")}
+		}
+		t.state, t.checksum = taskState, checksum
+		s.tasks.set(id, t)
+		return nil
+	})
+}
+
+// DeleteTask implements GeocubeBackend
+func (b Backend) DeleteTask(ctx context.Context, taskID string) error {
+	return b.write(func(s *state) error {
+		s.tasks.delete(taskID)
+		return nil
+	})
+}
+
+// ChangeDatasetsStatus implements GeocubeBackend
+func (b Backend) ChangeDatasetsStatus(ctx context.Context, lockedByJobID string, fromStatus geocube.DatasetStatus, toStatus geocube.DatasetStatus) error {
+	return b.write(func(s *state) error {
+		s.datasets.update(func(d datasetRow) (datasetRow, bool) {
+			if d.lockedByJobID != lockedByJobID || d.status != fromStatus {
+				return d, false
+			}
+			d.status = toStatus
+			return d, true
+		})
+		return nil
+	})
+}
+
+// CreateConsolidationParams implements GeocubeBackend
+func (b Backend) CreateConsolidationParams(ctx context.Context, id string, cp geocube.ConsolidationParams) error {
+	row := geocube.ConsolidationParams{
+		DFormat:        cp.DFormat,
+		Exponent:       cp.Exponent,
+		Compression:    cp.Compression,
+		CreationParams: copyMetadata(cp.CreationParams),
+		ResamplingAlg:  cp.ResamplingAlg,
+		StorageClass:   cp.StorageClass,
+	}
+	return b.write(func(s *state) error {
+		if !s.consolidationParams.insert(id, row) {
+			s.consolidationParams.set(id, row)
+		}
+		return nil
+	})
+}
+
+// ReadConsolidationParams implements GeocubeBackend
+func (b Backend) ReadConsolidationParams(ctx context.Context, id string) (cp *geocube.ConsolidationParams, err error) {
+	err = b.read(func(s *state) error {
+		row, ok := s.consolidationParams.get(id)
+		if !ok {
+			return geocube.NewEntityNotFound("ConsolidationParams", "id", id, "")
+		}
+		cp = &geocube.ConsolidationParams{
+			DFormat:        row.DFormat,
+			Exponent:       row.Exponent,
+			Compression:    row.Compression,
+			CreationParams: copyMetadata(row.CreationParams),
+			ResamplingAlg:  row.ResamplingAlg,
+			StorageClass:   row.StorageClass,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return cp, nil
+}
+
+// DeleteConsolidationParams implements GeocubeBackend
+func (b Backend) DeleteConsolidationParams(ctx context.Context, id string) error {
+	return b.write(func(s *state) error {
+		s.consolidationParams.delete(id)
+		return nil
+	})
+}
+
+// DeletePendingConsolidationParams implements GeocubeBackend
+func (b Backend) DeletePendingConsolidationParams(ctx context.Context) (n int64, err error) {
+	err = b.write(func(s *state) error {
+		n = 0
+		for _, id := range append([]string(nil), s.consolidationParams.keys...) {
+			if !s.variables.has(id) && !s.jobs.has(id) {
+				s.consolidationParams.delete(id)
+				n++
+			}
+		}
+		return nil
+	})
+	return n, err
+}
@@ -0,0 +1,162 @@
+package memdb
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/airbusgeo/geocube/internal/geocube"
+)
+
+// copyLayout returns a copy of the persistent fields of the layout
+func copyLayout(l *geocube.Layout) *geocube.Layout {
+	return &geocube.Layout{
+		Name:               l.Name,
+		GridFlags:          slices.Clone(l.GridFlags),
+		GridParameters:     copyMetadata(l.GridParameters),
+		BlockXSize:         l.BlockXSize,
+		BlockYSize:         l.BlockYSize,
+		MaxRecords:         l.MaxRecords,
+		OverviewsMinSize:   l.OverviewsMinSize,
+		InterlacingPattern: l.InterlacingPattern,
+	}
+}
+
+// CreateLayout implements GeocubeBackend
+func (b Backend) CreateLayout(ctx context.Context, layout *geocube.Layout) error {
+	row := *copyLayout(layout)
+	return b.write(func(s *state) error {
+		if !s.layouts.insert(row.Name, row) {
+			return geocube.NewEntityAlreadyExists("Layout", "name", row.Name, "")
+		}
+		return nil
+	})
+}
+
+// DeleteLayout implements GeocubeBackend
+func (b Backend) DeleteLayout(ctx context.Context, name string) error {
+	return b.write(func(s *state) error {
+		for _, l := range s.containerLayouts.rows {
+			if l == name {
+				return geocube.NewDependencyStillExists("layouts", "container_layouts", "name", name, "")
+			}
+		}
+		s.layouts.delete(name)
+		return nil
+	})
+}
+
+// ReadLayout implements GeocubeBackend
+func (b Backend) ReadLayout(ctx context.Context, name string) (layout *geocube.Layout, err error) {
+	err = b.read(func(s *state) error {
+		l, ok := s.layouts.get(name)
+		if !ok {
+			return geocube.NewEntityNotFound("Layout", "name", name, "")
+		}
+		layout = copyLayout(&l)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return layout, nil
+}
+
+// FindLayouts implements GeocubeBackend
+func (b Backend) FindLayouts(ctx context.Context, nameLike string) (layouts []*geocube.Layout, err error) {
+	matchName := func(string) bool { return true }
+	if nameLike != "" {
+		matchName = likeMatcher(nameLike)
+	}
+
+	layouts = []*geocube.Layout{}
+	err = b.read(func(s *state) error {
+		for _, l := range s.layouts.values() {
+			if matchName(l.Name) {
+				layouts = append(layouts, copyLayout(&l))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return layouts, nil
+}
+
+// FindContainerLayouts implements GeocubeBackend
+func (b Backend) FindContainerLayouts(ctx context.Context, instanceId string, geomAOI *geocube.AOI, recordIds []string, recordTags geocube.TagsQuery, fromTime, toTime time.Time) (layouts []string, containers [][]string, err error) {
+	inRecords := stringSet(recordIds)
+	inTimeRange := timeFilter(fromTime, toTime)
+	matchTags := tagsFilter(recordTags)
+
+	err = b.read(func(s *state) error {
+		containersByLayout := map[string][]string{}
+		done := map[string]struct{}{}
+		for _, d := range s.datasets.values() {
+			layout, ok := s.containerLayouts.get(d.containerURI)
+			if !ok || d.instanceID != instanceId || (len(recordIds) > 0 && !inRecords(d.recordID)) {
+				continue
+			}
+			if _, ok := done[d.containerURI]; ok {
+				continue
+			}
+			r, _ := s.records.get(d.recordID)
+			if !inTimeRange(r.datetime) || !matchTags(r.tags) {
+				continue
+			}
+			if geomAOI != nil {
+				a, _ := s.aois.get(r.aoiID)
+				ok, err := intersects(a.geometry, geomAOI.Geometry.MultiPolygon)
+				if err != nil {
+					return fmt.Errorf("FindContainerLayouts.%w", err)
+				}
+				if !ok {
+					continue
+				}
+			}
+			done[d.containerURI] = struct{}{}
+			if _, ok := containersByLayout[layout]; !ok {
+				layouts = append(layouts, layout)
+			}
+			containersByLayout[layout] = append(containersByLayout[layout], d.containerURI)
+		}
+
+		sort.Strings(layouts)
+		for _, layout := range layouts {
+			containers = append(containers, containersByLayout[layout])
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return layouts, containers, nil
+}
+
+// SaveContainerLayout implements GeocubeBackend
+func (b Backend) SaveContainerLayout(ctx context.Context, containerURI string, layoutName string) error {
+	return b.write(func(s *state) error {
+		if s.containerLayouts.has(containerURI) {
+			return geocube.NewEntityAlreadyExists("ContainerURI", "container_uri", containerURI, "")
+		}
+		if !s.containers.has(containerURI) {
+			return fmt.Errorf("SaveContainerLayout: %w", foreignKeyViolation("container_layouts", "container_uri", containerURI, "containers"))
+		}
+		if !s.layouts.has(layoutName) {
+			return fmt.Errorf("SaveContainerLayout: %w", foreignKeyViolation("container_layouts", "layout_name", layoutName, "layouts"))
+		}
+		s.containerLayouts.insert(containerURI, layoutName)
+		return nil
+	})
+}
+
+// DeleteContainerLayout implements GeocubeBackend
+func (b Backend) DeleteContainerLayout(ctx context.Context, containerURI string) error {
+	return b.write(func(s *state) error {
+		s.containerLayouts.delete(containerURI)
+		return nil
+	})
+}
@@ -0,0 +1,101 @@
+package memdb
+
+import (
+	"context"
+	"time"
+
+	"github.com/airbusgeo/geocube/interface/database"
+)
+
+// CreateOutboxMessages implements GeocubeBackend
+func (b Backend) CreateOutboxMessages(ctx context.Context, queue string, payloads [][]byte) error {
+	if len(payloads) == 0 {
+		return nil
+	}
+	createdTime := pgTime(time.Now().UTC())
+	rows := make([]outboxRow, len(payloads))
+	for i, payload := range payloads {
+		rows[i] = outboxRow{createdTime: createdTime, queue: queue, payload: append([]byte(nil), payload...)}
+	}
+
+	return b.write(func(s *state) error {
+		for _, row := range rows {
+			s.lastOutboxID++
+			row.id = s.lastOutboxID
+			s.outbox.insert(row.id, row)
+		}
+		return nil
+	})
+}
+
+// ReadPendingOutboxMessages implements GeocubeBackend
+// Inside a transaction, the messages are locked until the end of the transaction (like FOR UPDATE SKIP LOCKED)
+func (b Backend) ReadPendingOutboxMessages(ctx context.Context, limit int) (messages []*database.OutboxMessage, err error) {
+	err = b.read(func(s *state) error {
+		b.db.mutex.Lock()
+		defer b.db.mutex.Unlock()
+		for _, m := range s.outbox.values() {
+			if len(messages) == limit {
+				break
+			}
+			if !m.sentTime.IsZero() {
+				continue
+			}
+			if tx, ok := b.db.outboxLocks[m.id]; ok && tx != b.tx {
+				continue
+			}
+			if b.tx != nil {
+				b.db.outboxLocks[m.id] = b.tx
+			}
+			messages = append(messages, &database.OutboxMessage{ID: m.id, Queue: m.queue, Payload: append([]byte(nil), m.payload...), CreatedTime: m.createdTime})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return messages, nil
+}
+
+// unlockOutbox releases the outbox messages locked by the transaction
+// The store must be locked
+func (db *store) unlockOutbox(tx *transaction) {
+	for id, t := range db.outboxLocks {
+		if t == tx {
+			delete(db.outboxLocks, id)
+		}
+	}
+}
+
+// MarkOutboxMessagesSent implements GeocubeBackend
+func (b Backend) MarkOutboxMessagesSent(ctx context.Context, ids []int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	sentTime := pgTime(time.Now().UTC())
+	inIDs := map[int64]struct{}{}
+	for _, id := range ids {
+		inIDs[id] = struct{}{}
+	}
+
+	return b.write(func(s *state) error {
+		s.outbox.update(func(m outboxRow) (outboxRow, bool) {
+			if _, ok := inIDs[m.id]; !ok {
+				return m, false
+			}
+			m.sentTime = sentTime
+			return m, true
+		})
+		return nil
+	})
+}
+
+// DeleteSentOutboxMessages implements GeocubeBackend
+func (b Backend) DeleteSentOutboxMessages(ctx context.Context, sentBefore time.Time) (n int64, err error) {
+	sentBefore = pgTime(sentBefore.UTC())
+	err = b.write(func(s *state) error {
+		n = s.outbox.deleteWhere(func(m outboxRow) bool { return !m.sentTime.IsZero() && m.sentTime.Before(sentBefore) })
+		return nil
+	})
+	return n, err
+}
@@ -0,0 +1,283 @@
+package memdb
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/airbusgeo/geocube/internal/geocube"
+	"github.com/twpayne/go-geom/encoding/wkb"
+)
+
+func newRecordRow(r *geocube.Record) recordRow {
+	return recordRow{id: r.ID, name: r.Name, datetime: pgTime(r.Time), tags: copyMetadata(r.Tags), aoiID: r.AOI.ID}
+}
+
+func (r recordRow) toRecord(aoi *aoiRow) *geocube.Record {
+	record := geocube.Record{ID: r.id, Name: r.name, Time: r.datetime, Tags: copyMetadata(r.tags), AOI: geocube.AOI{ID: r.aoiID}}
+	if aoi != nil {
+		record.AOI.Geometry = &wkb.MultiPolygon{MultiPolygon: aoi.geometry.Clone()}
+	}
+	return &record
+}
+
+// recordHasDatasets returns true if the record is referenced by a dataset
+func (s *state) recordHasDatasets(recordID string) bool {
+	for _, d := range s.datasets.rows {
+		if d.recordID == recordID {
+			return true
+		}
+	}
+	return false
+}
+
+// CreateRecords implements GeocubeBackend
+func (b Backend) CreateRecords(ctx context.Context, records []*geocube.Record) error {
+	rows := make([]recordRow, len(records))
+	for i, r := range records {
+		rows[i] = newRecordRow(r)
+	}
+
+	return b.write(func(s *state) error {
+		for _, r := range rows {
+			if !s.aois.has(r.aoiID) {
+				return geocube.NewEntityNotFound("AOI", "id", r.aoiID, "")
+			}
+			if s.records.has(r.id) {
+				return geocube.NewEntityAlreadyExists("Record", "", r.id, "")
+			}
+			for _, r2 := range s.records.rows {
+				if r2.name == r.name && r2.datetime.Equal(r.datetime) && metadataEqual(r2.tags, r.tags) {
+					return geocube.NewEntityAlreadyExists("Record", "", fmt.Sprintf("%s, %v, %s", r.name, r.tags, r.datetime), "")
+				}
+			}
+			s.records.insert(r.id, r)
+		}
+		return nil
+	})
+}
+
+// FindRecords implements GeocubeBackend
+func (b Backend) FindRecords(ctx context.Context, namelike string, tags geocube.TagsQuery, fromTime, toTime time.Time, jobID string, aoi *geocube.AOI, page, limit int, after *geocube.Cursor, order, loadAOI bool) (records []*geocube.Record, err error) {
+	if aoi != nil && (aoi.Geometry == nil || aoi.Geometry.NumPolygons() == 0) {
+		aoi = nil
+	}
+	matchName := func(string) bool { return true }
+	if namelike != "" {
+		matchName = likeMatcher(namelike)
+	}
+	inTimeRange := timeFilter(fromTime, toTime)
+	matchTags := tagsFilter(tags)
+
+	err = b.read(func(s *state) error {
+		// A record is returned once per dataset locked by the job
+		var lockedDatasets map[string]int
+		if jobID != "" {
+			lockedDatasets = map[string]int{}
+			for _, d := range s.datasets.rows {
+				if d.lockedByJobID == jobID {
+					lockedDatasets[d.recordID]++
+				}
+			}
+		}
+
+		for _, r := range s.records.values() {
+			if !matchName(string(r.name)) || !inTimeRange(r.datetime) || !matchTags(r.tags) {
+				continue
+			}
+			if after != nil && !after.Time.Before(r.datetime) && (!after.Time.Equal(r.datetime) || r.id <= after.ID) {
+				continue
+			}
+			n := 1
+			if lockedDatasets != nil {
+				n = lockedDatasets[r.id]
+			}
+			if n == 0 {
+				continue
+			}
+			a, _ := s.aois.get(r.aoiID)
+			if aoi != nil {
+				ok, err := intersects(a.geometry, aoi.Geometry.MultiPolygon)
+				if err != nil {
+					return fmt.Errorf("FindRecords.%w", err)
+				}
+				if !ok {
+					continue
+				}
+			}
+			for i := 0; i < n; i++ {
+				if loadAOI {
+					records = append(records, r.toRecord(&a))
+				} else {
+					records = append(records, r.toRecord(nil))
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if order || after != nil {
+		sort.SliceStable(records, func(i, j int) bool {
+			if !records[i].Time.Equal(records[j].Time) {
+				return records[i].Time.Before(records[j].Time)
+			}
+			return records[i].ID < records[j].ID
+		})
+	}
+
+	return paginate(records, page, limit), nil
+}
+
+// DeleteRecords implements GeocubeBackend
+func (b Backend) DeleteRecords(ctx context.Context, ids []string) (n int64, err error) {
+	inIDs := stringSet(ids)
+	err = b.write(func(s *state) error {
+		for _, r := range s.records.values() {
+			if inIDs(r.id) && s.recordHasDatasets(r.id) {
+				return geocube.NewDependencyStillExists("records", "datasets", "id", r.id, "")
+			}
+		}
+		n = s.records.deleteWhere(func(r recordRow) bool { return inIDs(r.id) })
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+// DeletePendingRecords implements GeocubeBackend
+func (b Backend) DeletePendingRecords(ctx context.Context, ids []string) (n int64, err error) {
+	inIDs := stringSet(ids)
+	err = b.write(func(s *state) error {
+		n = s.records.deleteWhere(func(r recordRow) bool {
+			return (len(ids) == 0 || inIDs(r.id)) && !s.recordHasDatasets(r.id)
+		})
+		return nil
+	})
+	return n, err
+}
+
+// ReadRecords implements GeocubeBackend
+func (b Backend) ReadRecords(ctx context.Context, ids []string) (records []*geocube.Record, err error) {
+	err = b.read(func(s *state) error {
+		done := map[string]struct{}{}
+		for _, id := range ids {
+			if _, ok := done[id]; ok {
+				continue
+			}
+			done[id] = struct{}{}
+			r, ok := s.records.get(id)
+			if !ok {
+				return geocube.NewEntityNotFound("Record", "id", id, "")
+			}
+			records = append(records, r.toRecord(nil))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+// CreateAOI implements GeocubeBackend
+func (b Backend) CreateAOI(ctx context.Context, aoi *geocube.AOI) error {
+	hash, err := aoi.HashGeometry()
+	if err != nil {
+		return fmt.Errorf("CreateAOI.%w", err)
+	}
+	row := aoiRow{id: aoi.ID, hash: hash, geometry: aoi.Geometry.Clone()}
+	row.geometry.SetSRID(4326)
+
+	return b.write(func(s *state) error {
+		for _, a := range s.aois.rows {
+			if a.hash == row.hash {
+				return unaffected{geocube.NewEntityAlreadyExists("AOI", "id", a.id, "")}
+			}
+		}
+		if !s.aois.insert(row.id, row) {
+			return geocube.NewEntityAlreadyExists("AOI", "id", row.id, "")
+		}
+		return nil
+	})
+}
+
+// ReadAOI implements GeocubeBackend
+func (b Backend) ReadAOI(ctx context.Context, aoiID string) (aoi *geocube.AOI, err error) {
+	err = b.read(func(s *state) error {
+		a, ok := s.aois.get(aoiID)
+		if !ok {
+			return geocube.NewEntityNotFound("AOI", "id", aoiID, "")
+		}
+		aoi = &geocube.AOI{ID: a.id, Geometry: &wkb.MultiPolygon{MultiPolygon: a.geometry.Clone()}}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return aoi, nil
+}
+
+// DeletePendingAOIs implements GeocubeBackend
+func (b Backend) DeletePendingAOIs(ctx context.Context) (n int64, err error) {
+	err = b.write(func(s *state) error {
+		used := map[string]struct{}{}
+		for _, r := range s.records.rows {
+			used[r.aoiID] = struct{}{}
+		}
+		n = s.aois.deleteWhere(func(a aoiRow) bool {
+			_, ok := used[a.id]
+			return !ok
+		})
+		return nil
+	})
+	return n, err
+}
+
+// AddRecordsTags implements GeocubeBackend
+func (b Backend) AddRecordsTags(ctx context.Context, ids []string, tags geocube.Metadata) (n int64, err error) {
+	inIDs := stringSet(ids)
+	tags = copyMetadata(tags)
+	err = b.write(func(s *state) error {
+		n = s.records.update(func(r recordRow) (recordRow, bool) {
+			if !inIDs(r.id) {
+				return r, false
+			}
+			newTags := copyMetadata(r.tags)
+			for k, v := range tags {
+				newTags[k] = v
+			}
+			r.tags = newTags
+			return r, true
+		})
+		return nil
+	})
+	return n, err
+}
+
+// RemoveRecordsTags implements GeocubeBackend
+// As pg, the number of updated rows is counted for each key
+func (b Backend) RemoveRecordsTags(ctx context.Context, ids []string, tagsKey []string) (n int64, err error) {
+	inIDs := stringSet(ids)
+	tagsKey = append([]string(nil), tagsKey...)
+	err = b.write(func(s *state) error {
+		n = 0
+		for _, key := range tagsKey {
+			n += s.records.update(func(r recordRow) (recordRow, bool) {
+				if !inIDs(r.id) {
+					return r, false
+				}
+				r.tags = copyMetadata(r.tags)
+				delete(r.tags, key)
+				return r, true
+			})
+		}
+		return nil
+	})
+	return n, err
+}
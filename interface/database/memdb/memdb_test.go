@@ -0,0 +1,13 @@
+package memdb_test
+
+import (
+	"testing"
+
+	"github.com/airbusgeo/geocube/interface/database"
+	"github.com/airbusgeo/geocube/interface/database/dbtest"
+	"github.com/airbusgeo/geocube/interface/database/memdb"
+)
+
+func TestConformance(t *testing.T) {
+	dbtest.Run(t, func(t *testing.T) database.GeocubeDBBackend { return memdb.New() })
+}
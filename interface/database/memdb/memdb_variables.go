@@ -0,0 +1,331 @@
+package memdb
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/airbusgeo/geocube/internal/geocube"
+)
+
+func newVariableRow(v *geocube.Variable) variableRow {
+	return variableRow{
+		id:          v.ID,
+		name:        v.Name,
+		unit:        v.Unit,
+		description: v.Description,
+		bands:       append([]string(nil), v.Bands...),
+		dformat:     v.DFormat,
+		palette:     v.Palette,
+		resampling:  v.Resampling,
+	}
+}
+
+func (v variableRow) toVariable() *geocube.Variable {
+	return &geocube.Variable{
+		ID:          v.id,
+		Name:        v.name,
+		Unit:        v.unit,
+		Description: v.description,
+		Bands:       append([]string(nil), v.bands...),
+		DFormat:     v.dformat,
+		Palette:     v.palette,
+		Resampling:  v.resampling,
+	}
+}
+
+func (vi instanceRow) toInstance() *geocube.VariableInstance {
+	return &geocube.VariableInstance{ID: vi.id, Name: vi.name, Metadata: copyMetadata(vi.metadata)}
+}
+
+// foreignKeyViolation returns the error raised by pg when a row references a row that does not exist
+func foreignKeyViolation(table, key, value, refTable string) error {
+	return fmt.Errorf("insert or update on table \"%s\" violates foreign key constraint: Key (%s)=(%s) is not present in table \"%s\"", table, key, value, refTable)
+}
+
+// checkPalette returns an error if the palette does not exist
+func (s *state) checkPalette(name string) error {
+	if name != "" && !s.palettes.has(name) {
+		return foreignKeyViolation("variable_definitions", "palette", name, "palette")
+	}
+	return nil
+}
+
+// instancesOf returns the instances of the variable
+func (s *state) instancesOf(variableID string) map[string]*geocube.VariableInstance {
+	instances := map[string]*geocube.VariableInstance{}
+	for _, vi := range s.instances.rows {
+		if vi.definitionID == variableID {
+			instances[vi.id] = vi.toInstance()
+		}
+	}
+	return instances
+}
+
+// instanceHasDatasets returns true if the instance is referenced by a dataset
+func (s *state) instanceHasDatasets(instanceID string) bool {
+	for _, d := range s.datasets.rows {
+		if d.instanceID == instanceID {
+			return true
+		}
+	}
+	return false
+}
+
+// ReadVariable implements GeocubeBackend
+func (b Backend) ReadVariable(ctx context.Context, variableID string) (*geocube.Variable, error) {
+	return b.readVariable(ctx, "id", variableID)
+}
+
+// ReadVariableFromName implements GeocubeBackend
+func (b Backend) ReadVariableFromName(ctx context.Context, variableName string) (*geocube.Variable, error) {
+	return b.readVariable(ctx, "name", variableName)
+}
+
+func (b Backend) readVariable(ctx context.Context, field, id string) (variable *geocube.Variable, err error) {
+	err = b.read(func(s *state) error {
+		for _, v := range s.variables.rows {
+			if (field == "id" && v.id == id) || (field == "name" && v.name == id) {
+				variable = v.toVariable()
+				variable.Instances = s.instancesOf(v.id)
+				return nil
+			}
+		}
+		return geocube.NewEntityNotFound("Variable", field, id, "")
+	})
+	if err != nil {
+		return nil, err
+	}
+	return variable, nil
+}
+
+// ReadVariableFromInstanceID implements GeocubeBackend
+func (b Backend) ReadVariableFromInstanceID(ctx context.Context, instanceID string) (variable *geocube.Variable, err error) {
+	err = b.read(func(s *state) error {
+		vi, ok := s.instances.get(instanceID)
+		if !ok {
+			return geocube.NewEntityNotFound("Variable Instance", "id", instanceID, "")
+		}
+		v, ok := s.variables.get(vi.definitionID)
+		if !ok {
+			return geocube.NewEntityNotFound("Variable Instance", "id", instanceID, "")
+		}
+		variable = v.toVariable()
+		variable.Instances = map[string]*geocube.VariableInstance{vi.id: vi.toInstance()}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return variable, nil
+}
+
+// FindVariables implements GeocubeBackend
+func (b Backend) FindVariables(ctx context.Context, namelike string, page, limit int, after *geocube.Cursor) (variables []*geocube.Variable, err error) {
+	matchName := func(string) bool { return true }
+	if namelike != "" {
+		matchName = likeMatcher(namelike)
+	}
+
+	err = b.read(func(s *state) error {
+		for _, v := range s.variables.values() {
+			if matchName(v.name) && (after == nil || v.id > after.ID) {
+				variable := v.toVariable()
+				variable.Instances = s.instancesOf(v.id)
+				variables = append(variables, variable)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(variables, func(i, j int) bool { return variables[i].ID < variables[j].ID })
+	return paginate(variables, page, limit), nil
+}
+
+// CreateVariable implements GeocubeBackend
+func (b Backend) CreateVariable(ctx context.Context, variable *geocube.Variable) error {
+	row := newVariableRow(variable)
+	return b.write(func(s *state) error {
+		for _, v := range s.variables.rows {
+			if v.name == row.name {
+				return geocube.NewEntityAlreadyExists("Variable", "name", row.name, "")
+			}
+		}
+		if err := s.checkPalette(row.palette); err != nil {
+			return fmt.Errorf("CreateVariable: %w", err)
+		}
+		if !s.variables.insert(row.id, row) {
+			return geocube.NewEntityAlreadyExists("Variable", "name", row.name, "")
+		}
+		return nil
+	})
+}
+
+// UpdateVariable implements GeocubeBackend
+func (b Backend) UpdateVariable(ctx context.Context, variable *geocube.Variable) error {
+	update := newVariableRow(variable)
+	return b.write(func(s *state) error {
+		v, ok := s.variables.get(update.id)
+		if !ok {
+			return unaffected{geocube.NewEntityNotFound("Variable", "id", update.id, "")}
+		}
+		for _, v2 := range s.variables.rows {
+			if v2.name == update.name && v2.id != update.id {
+				return geocube.NewEntityAlreadyExists("Variable", "name", update.name, "")
+			}
+		}
+		if err := s.checkPalette(update.palette); err != nil {
+			return fmt.Errorf("UpdateVariable: %w", err)
+		}
+		v.name, v.unit, v.description, v.palette, v.resampling = update.name, update.unit, update.description, update.palette, update.resampling
+		s.variables.set(v.id, v)
+		return nil
+	})
+}
+
+// DeleteVariable implements GeocubeBackend
+func (b Backend) DeleteVariable(ctx context.Context, variableID string) error {
+	return b.write(func(s *state) error {
+		for _, vi := range s.instances.rows {
+			if vi.definitionID == variableID {
+				return geocube.NewDependencyStillExists("variable_definitions", "variable_instances", "id", variableID, "")
+			}
+		}
+		s.variables.delete(variableID)
+		return nil
+	})
+}
+
+// DeletePendingVariables implements GeocubeBackend
+func (b Backend) DeletePendingVariables(ctx context.Context) (n int64, err error) {
+	err = b.write(func(s *state) error {
+		used := map[string]struct{}{}
+		for _, vi := range s.instances.rows {
+			used[vi.definitionID] = struct{}{}
+		}
+		n = s.variables.deleteWhere(func(v variableRow) bool {
+			_, ok := used[v.id]
+			return !ok
+		})
+		return nil
+	})
+	return n, err
+}
+
+// CreateInstance implements GeocubeBackend
+func (b Backend) CreateInstance(ctx context.Context, variableID string, instance *geocube.VariableInstance) error {
+	row := instanceRow{id: instance.ID, name: instance.Name, metadata: copyMetadata(instance.Metadata), definitionID: variableID}
+	return b.write(func(s *state) error {
+		for _, vi := range s.instances.rows {
+			if vi.name == row.name && vi.definitionID == row.definitionID {
+				return geocube.NewEntityAlreadyExists("Instance", "name", row.name, "")
+			}
+		}
+		if !s.variables.has(row.definitionID) {
+			return fmt.Errorf("CreateInstance: %w", foreignKeyViolation("variable_instances", "definition_id", row.definitionID, "variable_definitions"))
+		}
+		if !s.instances.insert(row.id, row) {
+			return geocube.NewEntityAlreadyExists("Instance", "name", row.name, "")
+		}
+		return nil
+	})
+}
+
+// UpdateInstance implements GeocubeBackend
+func (b Backend) UpdateInstance(ctx context.Context, instance *geocube.VariableInstance) error {
+	id, name, metadata := instance.ID, instance.Name, copyMetadata(instance.Metadata)
+	return b.write(func(s *state) error {
+		vi, ok := s.instances.get(id)
+		if !ok {
+			return unaffected{geocube.NewEntityNotFound("Instance", "id", id, "")}
+		}
+		for _, vi2 := range s.instances.rows {
+			if vi2.name == name && vi2.definitionID == vi.definitionID && vi2.id != id {
+				return geocube.NewEntityAlreadyExists("Instance", "name", name, "")
+			}
+		}
+		vi.name, vi.metadata = name, metadata
+		s.instances.set(id, vi)
+		return nil
+	})
+}
+
+// DeleteInstance implements GeocubeBackend
+func (b Backend) DeleteInstance(ctx context.Context, instanceID string) error {
+	return b.write(func(s *state) error {
+		if s.instanceHasDatasets(instanceID) {
+			return geocube.NewDependencyStillExists("variable_instances", "datasets", "id", instanceID, "")
+		}
+		s.instances.delete(instanceID)
+		return nil
+	})
+}
+
+// DeletePendingInstances implements GeocubeBackend
+func (b Backend) DeletePendingInstances(ctx context.Context) (n int64, err error) {
+	err = b.write(func(s *state) error {
+		n = s.instances.deleteWhere(func(vi instanceRow) bool { return !s.instanceHasDatasets(vi.id) })
+		return nil
+	})
+	return n, err
+}
+
+// CreatePalette implements GeocubeBackend
+// As pg, creating a palette that already exists with the same points is not an error
+func (b Backend) CreatePalette(ctx context.Context, palette *geocube.Palette) error {
+	row := geocube.Palette{Name: palette.Name, Points: slices.Clone(palette.Points)}
+	return b.write(func(s *state) error {
+		if p, ok := s.palettes.get(row.Name); ok {
+			if !slices.Equal(p.Points, row.Points) {
+				return unaffected{geocube.NewEntityAlreadyExists("Palette", "name", row.Name, "")}
+			}
+			return nil
+		}
+		s.palettes.insert(row.Name, row)
+		return nil
+	})
+}
+
+// ReadPalette implements GeocubeBackend
+func (b Backend) ReadPalette(ctx context.Context, name string) (palette *geocube.Palette, err error) {
+	err = b.read(func(s *state) error {
+		p, ok := s.palettes.get(name)
+		if !ok {
+			return geocube.NewEntityNotFound("Palette", "name", name, "")
+		}
+		palette = &geocube.Palette{Name: p.Name, Points: slices.Clone(p.Points)}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return palette, nil
+}
+
+// UpdatePalette implements GeocubeBackend
+func (b Backend) UpdatePalette(ctx context.Context, palette *geocube.Palette) error {
+	row := geocube.Palette{Name: palette.Name, Points: slices.Clone(palette.Points)}
+	return b.write(func(s *state) error {
+		if !s.palettes.has(row.Name) {
+			return unaffected{geocube.NewEntityNotFound("Palette", "name", row.Name, "")}
+		}
+		s.palettes.set(row.Name, row)
+		return nil
+	})
+}
+
+// DeletePalette implements GeocubeBackend
+func (b Backend) DeletePalette(ctx context.Context, name string) error {
+	return b.write(func(s *state) error {
+		for _, v := range s.variables.rows {
+			if v.palette == name {
+				return geocube.NewDependencyStillExists("palette", "variable_definitions", "name", name, "")
+			}
+		}
+		s.palettes.delete(name)
+		return nil
+	})
+}
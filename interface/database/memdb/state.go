@@ -0,0 +1,189 @@
+package memdb
+
+import (
+	"time"
+
+	"github.com/airbusgeo/geocube/internal/geocube"
+	"github.com/airbusgeo/geocube/internal/utils/proj"
+	"github.com/twpayne/go-geom"
+)
+
+// state contains the tables of the database (see interface/database/pg/create.sql)
+// The rows are never modified in place: they are replaced, so that the tables can be cloned cheaply.
+type state struct {
+	aois                table[string, aoiRow]
+	records             table[string, recordRow]
+	palettes            table[string, geocube.Palette]
+	variables           table[string, variableRow]
+	instances           table[string, instanceRow]
+	consolidationParams table[string, geocube.ConsolidationParams]
+	containers          table[string, containerRow]
+	datasets            table[string, datasetRow]
+	layouts             table[string, geocube.Layout]
+	containerLayouts    table[string, string]
+	jobs                table[string, jobRow]
+	jobLogs             table[int64, jobLogRow]
+	lockedDatasets      table[string, lockedDatasetRow]
+	tasks               table[string, taskRow]
+	grids               table[string, geocube.Grid]
+	cells               table[cellKey, geocube.Cell]
+	outbox              table[int64, outboxRow]
+
+	// Sequences
+	lastContainerID int
+	lastJobLogID    int64
+	lastOutboxID    int64
+}
+
+type aoiRow struct {
+	id       string
+	hash     string
+	geometry *geom.MultiPolygon
+}
+
+type recordRow struct {
+	id       string
+	name     geocube.URN
+	datetime time.Time
+	tags     geocube.Metadata
+	aoiID    string
+}
+
+type variableRow struct {
+	id          string
+	name        string
+	unit        string
+	description string
+	bands       []string
+	dformat     geocube.DataFormat
+	palette     string
+	resampling  geocube.Resampling
+}
+
+type instanceRow struct {
+	id           string
+	name         string
+	metadata     geocube.Metadata
+	definitionID string
+}
+
+type containerRow struct {
+	id           int
+	uri          string
+	managed      bool
+	storageClass geocube.StorageClass
+	checksum     string
+}
+
+type datasetRow struct {
+	id            string
+	recordID      string
+	instanceID    string
+	containerURI  string
+	lockedByJobID string
+	geog          *geom.MultiPolygon
+	geom          *geom.MultiPolygon
+	shape         *geom.MultiPolygon
+	subdir        string
+	bands         []int64
+	status        geocube.DatasetStatus
+	dmapping      geocube.DataMapping
+	overviews     bool
+}
+
+type jobRow struct {
+	id             string
+	name           string
+	jobType        geocube.JobType
+	creationTime   time.Time
+	lastUpdateTime time.Time
+	payload        geocube.JobPayload
+	state          geocube.JobState
+	activeTasks    int
+	failedTasks    int
+	executionLevel geocube.ExecutionLevel
+	waiting        bool
+}
+
+type jobLogRow struct {
+	jobID string
+	log   geocube.JobLog
+}
+
+type lockedDatasetRow struct {
+	datasetID string
+	jobID     string
+	flag      int
+}
+
+type taskRow struct {
+	id       string
+	state    geocube.TaskState
+	payload  []byte
+	checksum string
+	jobID    string
+}
+
+type cellKey struct {
+	grid, id string
+}
+
+type outboxRow struct {
+	id          int64
+	createdTime time.Time
+	queue       string
+	payload     []byte
+	sentTime    time.Time
+}
+
+func newState() *state {
+	return &state{
+		aois:                newTable[string, aoiRow](),
+		records:             newTable[string, recordRow](),
+		palettes:            newTable[string, geocube.Palette](),
+		variables:           newTable[string, variableRow](),
+		instances:           newTable[string, instanceRow](),
+		consolidationParams: newTable[string, geocube.ConsolidationParams](),
+		containers:          newTable[string, containerRow](),
+		datasets:            newTable[string, datasetRow](),
+		layouts:             newTable[string, geocube.Layout](),
+		containerLayouts:    newTable[string, string](),
+		jobs:                newTable[string, jobRow](),
+		jobLogs:             newTable[int64, jobLogRow](),
+		lockedDatasets:      newTable[string, lockedDatasetRow](),
+		tasks:               newTable[string, taskRow](),
+		grids:               newTable[string, geocube.Grid](),
+		cells:               newTable[cellKey, geocube.Cell](),
+		outbox:              newTable[int64, outboxRow](),
+	}
+}
+
+func (s *state) clone() *state {
+	return &state{
+		aois:                s.aois.clone(),
+		records:             s.records.clone(),
+		palettes:            s.palettes.clone(),
+		variables:           s.variables.clone(),
+		instances:           s.instances.clone(),
+		consolidationParams: s.consolidationParams.clone(),
+		containers:          s.containers.clone(),
+		datasets:            s.datasets.clone(),
+		layouts:             s.layouts.clone(),
+		containerLayouts:    s.containerLayouts.clone(),
+		jobs:                s.jobs.clone(),
+		jobLogs:             s.jobLogs.clone(),
+		lockedDatasets:      s.lockedDatasets.clone(),
+		tasks:               s.tasks.clone(),
+		grids:               s.grids.clone(),
+		cells:               s.cells.clone(),
+		outbox:              s.outbox.clone(),
+		lastContainerID:     s.lastContainerID,
+		lastJobLogID:        s.lastJobLogID,
+		lastOutboxID:        s.lastOutboxID,
+	}
+}
+
+// shape returns a copy of the multipolygon as a proj.Shape
+func shape(mp *geom.MultiPolygon) proj.Shape {
+	return proj.NewShape(mp.SRID(), mp)
+}
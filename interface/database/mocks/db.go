@@ -52,19 +52,19 @@ func (_m *GeocubeBackend) DeleteRecords(ctx context.Context, ids []string) (int6
 	panic("implement me")
 }
 
-func (_m *GeocubeBackend) FindRecords(ctx context.Context, namelike string, tags geocube.Metadata, fromTime, toTime time.Time, jobID string, aoi *geocube.AOI, page, limit int, order, loadAOI bool) ([]*geocube.Record, error) {
-	ret := _m.Called(ctx, namelike, tags, fromTime, toTime, jobID, aoi, page, limit, order, loadAOI)
+func (_m *GeocubeBackend) FindRecords(ctx context.Context, namelike string, tags geocube.TagsQuery, fromTime, toTime time.Time, jobID string, aoi *geocube.AOI, page, limit int, after *geocube.Cursor, order, loadAOI bool) ([]*geocube.Record, error) {
+	ret := _m.Called(ctx, namelike, tags, fromTime, toTime, jobID, aoi, page, limit, after, order, loadAOI)
 
 	var r0 []*geocube.Record
-	if rf, ok := ret.Get(0).(func(context.Context, string, geocube.Metadata, time.Time, time.Time, string, *geocube.AOI, int, int, bool, bool) []*geocube.Record); ok {
-		r0 = rf(ctx, namelike, tags, fromTime, toTime, jobID, aoi, page, limit, order, loadAOI)
+	if rf, ok := ret.Get(0).(func(context.Context, string, geocube.TagsQuery, time.Time, time.Time, string, *geocube.AOI, int, int, *geocube.Cursor, bool, bool) []*geocube.Record); ok {
+		r0 = rf(ctx, namelike, tags, fromTime, toTime, jobID, aoi, page, limit, after, order, loadAOI)
 	} else {
 		r0 = ret.Get(0).([]*geocube.Record)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string, geocube.Metadata, time.Time, time.Time, string, *geocube.AOI, int, int, bool, bool) error); ok {
-		r1 = rf(ctx, namelike, tags, fromTime, toTime, jobID, aoi, page, limit, order, loadAOI)
+	if rf, ok := ret.Get(1).(func(context.Context, string, geocube.TagsQuery, time.Time, time.Time, string, *geocube.AOI, int, int, *geocube.Cursor, bool, bool) error); ok {
+		r1 = rf(ctx, namelike, tags, fromTime, toTime, jobID, aoi, page, limit, after, order, loadAOI)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -160,7 +160,7 @@ func (_m *GeocubeBackend) ReadVariableFromName(ctx context.Context, variableName
 	panic("implement me")
 }
 
-func (_m *GeocubeBackend) FindVariables(ctx context.Context, namelike string, page, limit int) ([]*geocube.Variable, error) {
+func (_m *GeocubeBackend) FindVariables(ctx context.Context, namelike string, page, limit int, after *geocube.Cursor) ([]*geocube.Variable, error) {
 	panic("implement me")
 }
 
@@ -233,18 +233,18 @@ func (_m *GeocubeBackend) DeleteDatasets(ctx context.Context, datasetsID []strin
 	panic("implement me")
 }
 
-func (_m *GeocubeBackend) ListActiveDatasetsID(ctx context.Context, instanceID string, recordsID []string, recordTags geocube.Metadata, fromTime, toTime time.Time) ([]string, error) {
+func (_m *GeocubeBackend) ListActiveDatasetsID(ctx context.Context, instanceID string, recordsID []string, recordTags geocube.TagsQuery, fromTime, toTime time.Time) ([]string, error) {
 	ret := _m.Called(ctx, instanceID, recordsID, recordTags, fromTime, toTime)
 
 	var r0 []string
-	if rf, ok := ret.Get(0).(func(context.Context, string, []string, geocube.Metadata, time.Time, time.Time) []string); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string, geocube.TagsQuery, time.Time, time.Time) []string); ok {
 		r0 = rf(ctx, instanceID, recordsID, recordTags, fromTime, toTime)
 	} else {
 		r0 = ret.Get(0).([]string)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string, []string, geocube.Metadata, time.Time, time.Time) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string, geocube.TagsQuery, time.Time, time.Time) error); ok {
 		r1 = rf(ctx, instanceID, recordsID, recordTags, fromTime, toTime)
 	} else {
 		r1 = ret.Error(1)
@@ -253,19 +253,19 @@ func (_m *GeocubeBackend) ListActiveDatasetsID(ctx context.Context, instanceID s
 	return r0, r1
 }
 
-func (_m *GeocubeBackend) FindDatasets(ctx context.Context, status geocube.DatasetStatus, containerURIs []string, lockedByJobID string, instanceIDs, recordIDs []string, recordTags geocube.Metadata, fromTime, toTime time.Time, geog *proj.GeographicRing, refined *proj.Ring, page, limit int, order bool) ([]*geocube.Dataset, error) {
-	ret := _m.Called(ctx, status, containerURIs, lockedByJobID, instanceIDs, recordIDs, recordTags, fromTime, toTime, geog, refined, page, limit, order)
+func (_m *GeocubeBackend) FindDatasets(ctx context.Context, status geocube.DatasetStatus, containerURIs []string, lockedByJobID string, instanceIDs, recordIDs []string, recordTags geocube.TagsQuery, fromTime, toTime time.Time, geog *proj.GeographicRing, refined *proj.Ring, page, limit int, after *geocube.Cursor, order bool) ([]*geocube.Dataset, error) {
+	ret := _m.Called(ctx, status, containerURIs, lockedByJobID, instanceIDs, recordIDs, recordTags, fromTime, toTime, geog, refined, page, limit, after, order)
 
 	var r0 []*geocube.Dataset
-	if rf, ok := ret.Get(0).(func(context.Context, geocube.DatasetStatus, []string, string, []string, []string, geocube.Metadata, time.Time, time.Time, *proj.GeographicRing, *proj.Ring, int, int, bool) []*geocube.Dataset); ok {
-		r0 = rf(ctx, status, containerURIs, lockedByJobID, instanceIDs, recordIDs, recordTags, fromTime, toTime, geog, refined, page, limit, order)
+	if rf, ok := ret.Get(0).(func(context.Context, geocube.DatasetStatus, []string, string, []string, []string, geocube.TagsQuery, time.Time, time.Time, *proj.GeographicRing, *proj.Ring, int, int, *geocube.Cursor, bool) []*geocube.Dataset); ok {
+		r0 = rf(ctx, status, containerURIs, lockedByJobID, instanceIDs, recordIDs, recordTags, fromTime, toTime, geog, refined, page, limit, after, order)
 	} else {
 		r0 = ret.Get(0).([]*geocube.Dataset)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, geocube.DatasetStatus, []string, string, []string, []string, geocube.Metadata, time.Time, time.Time, *proj.GeographicRing, *proj.Ring, int, int, bool) error); ok {
-		r1 = rf(ctx, status, containerURIs, lockedByJobID, instanceIDs, recordIDs, recordTags, fromTime, toTime, geog, refined, page, limit, order)
+	if rf, ok := ret.Get(1).(func(context.Context, geocube.DatasetStatus, []string, string, []string, []string, geocube.TagsQuery, time.Time, time.Time, *proj.GeographicRing, *proj.Ring, int, int, *geocube.Cursor, bool) error); ok {
+		r1 = rf(ctx, status, containerURIs, lockedByJobID, instanceIDs, recordIDs, recordTags, fromTime, toTime, geog, refined, page, limit, after, order)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -297,6 +297,10 @@ func (_m *GeocubeBackend) UpdateDatasets(ctx context.Context, instanceID string,
 	panic("implement me")
 }
 
+func (_m *GeocubeBackend) UpdateDatasetsShape(ctx context.Context, datasets []*geocube.Dataset) error {
+	panic("implement me")
+}
+
 func (_m *GeocubeBackend) CreateLayout(ctx context.Context, layout *geocube.Layout) error {
 	panic("implement me")
 }
@@ -329,7 +333,7 @@ func (_m *GeocubeBackend) FindLayouts(ctx context.Context, nameLike string) ([]*
 	panic("implement me")
 }
 
-func (_m *GeocubeBackend) FindContainerLayouts(ctx context.Context, instanceId string, geomAOI *geocube.AOI, recordIds []string, recordTags map[string]string, fromTime, toTime time.Time) ([]string, [][]string, error) {
+func (_m *GeocubeBackend) FindContainerLayouts(ctx context.Context, instanceId string, geomAOI *geocube.AOI, recordIds []string, recordTags geocube.TagsQuery, fromTime, toTime time.Time) ([]string, [][]string, error) {
 	panic("implement me")
 }
 
@@ -391,7 +395,7 @@ func (_m *GeocubeBackend) CreateJob(ctx context.Context, job *geocube.Job) error
 	return r0
 }
 
-func (_m *GeocubeBackend) FindJobs(ctx context.Context, nameLike string, page, limit int) ([]*geocube.Job, error) {
+func (_m *GeocubeBackend) FindJobs(ctx context.Context, nameLike string, page, limit int, after *geocube.Cursor) ([]*geocube.Job, error) {
 	panic("implement me")
 }
 
@@ -560,6 +564,22 @@ func (_m *GeocubeBackend) ChangeDatasetsStatus(ctx context.Context, lockedByJobI
 	panic("implement me")
 }
 
+func (_m *GeocubeBackend) CreateOutboxMessages(ctx context.Context, queue string, payloads [][]byte) error {
+	panic("implement me")
+}
+
+func (_m *GeocubeBackend) ReadPendingOutboxMessages(ctx context.Context, limit int) ([]*database.OutboxMessage, error) {
+	panic("implement me")
+}
+
+func (_m *GeocubeBackend) MarkOutboxMessagesSent(ctx context.Context, ids []int64) error {
+	panic("implement me")
+}
+
+func (_m *GeocubeBackend) DeleteSentOutboxMessages(ctx context.Context, sentBefore time.Time) (int64, error) {
+	panic("implement me")
+}
+
 type GeocubeTxBackend struct {
 	mock.Mock
 	GeocubeBackend
@@ -670,19 +690,19 @@ func (_m *GeocubeTxBackend) LockDatasets(ctx context.Context, jobID string, data
 	return r0
 }
 
-func (_m *GeocubeTxBackend) FindRecords(ctx context.Context, namelike string, tags geocube.Metadata, fromTime, toTime time.Time, jobID string, aoi *geocube.AOI, page, limit int, order, loadAOI bool) ([]*geocube.Record, error) {
-	ret := _m.Called(ctx, namelike, tags, fromTime, toTime, jobID, aoi, page, limit, order, loadAOI)
+func (_m *GeocubeTxBackend) FindRecords(ctx context.Context, namelike string, tags geocube.TagsQuery, fromTime, toTime time.Time, jobID string, aoi *geocube.AOI, page, limit int, after *geocube.Cursor, order, loadAOI bool) ([]*geocube.Record, error) {
+	ret := _m.Called(ctx, namelike, tags, fromTime, toTime, jobID, aoi, page, limit, after, order, loadAOI)
 
 	var r0 []*geocube.Record
-	if rf, ok := ret.Get(0).(func(context.Context, string, geocube.Metadata, time.Time, time.Time, string, *geocube.AOI, int, int, bool, bool) []*geocube.Record); ok {
-		r0 = rf(ctx, namelike, tags, fromTime, toTime, jobID, aoi, page, limit, order, loadAOI)
+	if rf, ok := ret.Get(0).(func(context.Context, string, geocube.TagsQuery, time.Time, time.Time, string, *geocube.AOI, int, int, *geocube.Cursor, bool, bool) []*geocube.Record); ok {
+		r0 = rf(ctx, namelike, tags, fromTime, toTime, jobID, aoi, page, limit, after, order, loadAOI)
 	} else {
 		r0 = ret.Get(0).([]*geocube.Record)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string, geocube.Metadata, time.Time, time.Time, string, *geocube.AOI, int, int, bool, bool) error); ok {
-		r1 = rf(ctx, namelike, tags, fromTime, toTime, jobID, aoi, page, limit, order, loadAOI)
+	if rf, ok := ret.Get(1).(func(context.Context, string, geocube.TagsQuery, time.Time, time.Time, string, *geocube.AOI, int, int, *geocube.Cursor, bool, bool) error); ok {
+		r1 = rf(ctx, namelike, tags, fromTime, toTime, jobID, aoi, page, limit, after, order, loadAOI)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -730,19 +750,19 @@ func (_m *GeocubeTxBackend) ReadLayout(ctx context.Context, layoutID string) (*g
 	return r0, r1
 }
 
-func (_m *GeocubeTxBackend) FindDatasets(ctx context.Context, status geocube.DatasetStatus, containerURIs []string, lockedByJobID string, instanceIDs, recordIDs []string, recordTags geocube.Metadata, fromTime, toTime time.Time, geog *proj.GeographicRing, refined *proj.Ring, page, limit int, order bool) ([]*geocube.Dataset, error) {
-	ret := _m.Called(ctx, status, containerURIs, lockedByJobID, instanceIDs, recordIDs, recordTags, fromTime, toTime, geog, refined, page, limit, order)
+func (_m *GeocubeTxBackend) FindDatasets(ctx context.Context, status geocube.DatasetStatus, containerURIs []string, lockedByJobID string, instanceIDs, recordIDs []string, recordTags geocube.TagsQuery, fromTime, toTime time.Time, geog *proj.GeographicRing, refined *proj.Ring, page, limit int, after *geocube.Cursor, order bool) ([]*geocube.Dataset, error) {
+	ret := _m.Called(ctx, status, containerURIs, lockedByJobID, instanceIDs, recordIDs, recordTags, fromTime, toTime, geog, refined, page, limit, after, order)
 
 	var r0 []*geocube.Dataset
-	if rf, ok := ret.Get(0).(func(context.Context, geocube.DatasetStatus, []string, string, []string, []string, geocube.Metadata, time.Time, time.Time, *proj.GeographicRing, *proj.Ring, int, int, bool) []*geocube.Dataset); ok {
-		r0 = rf(ctx, status, containerURIs, lockedByJobID, instanceIDs, recordIDs, recordTags, fromTime, toTime, geog, refined, page, limit, order)
+	if rf, ok := ret.Get(0).(func(context.Context, geocube.DatasetStatus, []string, string, []string, []string, geocube.TagsQuery, time.Time, time.Time, *proj.GeographicRing, *proj.Ring, int, int, *geocube.Cursor, bool) []*geocube.Dataset); ok {
+		r0 = rf(ctx, status, containerURIs, lockedByJobID, instanceIDs, recordIDs, recordTags, fromTime, toTime, geog, refined, page, limit, after, order)
 	} else {
 		r0 = ret.Get(0).([]*geocube.Dataset)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, geocube.DatasetStatus, []string, string, []string, []string, geocube.Metadata, time.Time, time.Time, *proj.GeographicRing, *proj.Ring, int, int, bool) error); ok {
-		r1 = rf(ctx, status, containerURIs, lockedByJobID, instanceIDs, recordIDs, recordTags, fromTime, toTime, geog, refined, page, limit, order)
+	if rf, ok := ret.Get(1).(func(context.Context, geocube.DatasetStatus, []string, string, []string, []string, geocube.TagsQuery, time.Time, time.Time, *proj.GeographicRing, *proj.Ring, int, int, *geocube.Cursor, bool) error); ok {
+		r1 = rf(ctx, status, containerURIs, lockedByJobID, instanceIDs, recordIDs, recordTags, fromTime, toTime, geog, refined, page, limit, after, order)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -801,3 +821,49 @@ func (_m *GeocubeTxBackend) PersistLogs(ctx context.Context, jobID string, logs
 
 	return r1
 }
+
+func (_m *GeocubeTxBackend) CreateOutboxMessages(ctx context.Context, queue string, payloads [][]byte) error {
+	ret := _m.Called(ctx, queue, payloads)
+
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, [][]byte) error); ok {
+		r1 = rf(ctx, queue, payloads)
+	} else {
+		r1 = ret.Error(0)
+	}
+
+	return r1
+}
+
+func (_m *GeocubeTxBackend) ReadPendingOutboxMessages(ctx context.Context, limit int) ([]*database.OutboxMessage, error) {
+	ret := _m.Called(ctx, limit)
+
+	var r0 []*database.OutboxMessage
+	if rf, ok := ret.Get(0).(func(context.Context, int) []*database.OutboxMessage); ok {
+		r0 = rf(ctx, limit)
+	} else {
+		r0 = ret.Get(0).([]*database.OutboxMessage)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+func (_m *GeocubeTxBackend) MarkOutboxMessagesSent(ctx context.Context, ids []int64) error {
+	ret := _m.Called(ctx, ids)
+
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) error); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		r1 = ret.Error(0)
+	}
+
+	return r1
+}
@@ -76,6 +76,7 @@ CREATE TABLE geocube.containers (
 	uri TEXT NOT NULL,
 	managed BOOLEAN NOT NULL,
 	storage_class geocube.storage_class,
+	checksum TEXT NOT NULL DEFAULT '',
 	PRIMARY KEY (uri)
 );
 CREATE INDEX idx_containers_id ON geocube.containers (id);
@@ -179,6 +180,7 @@ CREATE TABLE geocube.tasks (
 	id UUID NOT NULL,
 	state geocube.task_state NOT NULL,
 	payload bytea NOT NULL,
+	checksum TEXT NOT NULL DEFAULT '',
 	job_id UUID NOT NULL,
 	PRIMARY KEY (id),
 	FOREIGN KEY(job_id) REFERENCES geocube.jobs (id) MATCH FULL ON DELETE NO ACTION ON UPDATE NO ACTION
@@ -212,6 +214,24 @@ CREATE TABLE geocube.container_layouts (
  	FOREIGN KEY(layout_name) REFERENCES geocube.layouts (name) MATCH FULL ON DELETE NO ACTION ON UPDATE NO ACTION
 );
 
+CREATE TABLE geocube.outbox (
+	id BIGSERIAL NOT NULL,
+	created_ts TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT (now() at time zone 'utc'),
+	queue TEXT NOT NULL,
+	payload bytea NOT NULL,
+	sent_ts TIMESTAMP WITHOUT TIME ZONE,
+	PRIMARY KEY (id)
+);
+CREATE INDEX idx_outbox_pending ON geocube.outbox (id) WHERE sent_ts IS NULL;
+
+CREATE TABLE geocube.schema_migrations (
+	version TEXT NOT NULL,
+	applied_ts TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT (now() at time zone 'utc'),
+	PRIMARY KEY (version)
+);
+-- versions of all the update_X.X.X.sql scripts
+INSERT INTO geocube.schema_migrations (version) VALUES ('0.3.0'), ('0.6.0'), ('1.1.0');
+
 
 -- CREATE ROLE apiserver WITH LOGIN;
 -- GRANT USAGE ON SCHEMA geocube TO apiserver;
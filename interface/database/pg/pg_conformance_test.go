@@ -0,0 +1,30 @@
+package pg
+
+import (
+	"context"
+	"testing"
+
+	"github.com/airbusgeo/geocube/interface/database"
+	"github.com/airbusgeo/geocube/interface/database/dbtest"
+)
+
+// TestConformance runs the conformance test suite against the database GEOCUBE_TEST_DB_CONNECTION (see newTestDB),
+// migrated to the last version of the schema.
+// Warning: the content of the database is deleted.
+func TestConformance(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	if _, err := db.Migrate(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	dbtest.Run(t, func(t *testing.T) database.GeocubeDBBackend {
+		if _, err := db.ExecContext(ctx, "TRUNCATE geocube.aoi, geocube.records, geocube.palette, geocube.variable_definitions,"+
+			" geocube.variable_instances, geocube.containers, geocube.datasets, geocube.layouts, geocube.jobs, geocube.job_logs,"+
+			" geocube.consolidation_params, geocube.locked_datasets, geocube.tasks, geocube.grids, geocube.cells,"+
+			" geocube.container_layouts, geocube.outbox CASCADE"); err != nil {
+			t.Fatal(err)
+		}
+		return db
+	})
+}
@@ -25,7 +25,7 @@ func (b Backend) ReadContainers(ctx context.Context, containersURI []string) (co
 	}
 
 	// Get Containers
-	rows, err := b.pg.QueryContext(ctx, "SELECT id, uri, managed, storage_class FROM geocube.containers WHERE uri = ANY($1)", pq.Array(containersURI))
+	rows, err := b.pg.QueryContext(ctx, "SELECT id, uri, managed, storage_class, checksum FROM geocube.containers WHERE uri = ANY($1)", pq.Array(containersURI))
 	if err != nil {
 		return nil, pqErrorFormat("ReadContainers: %w", err)
 	}
@@ -41,7 +41,7 @@ func (b Backend) ReadContainers(ctx context.Context, containersURI []string) (co
 	containers = make([]*geocube.Container, len(idx))
 	for rows.Next() {
 		c := geocube.Container{}
-		if err := rows.Scan(&c.ID, &c.URI, &c.Managed, &c.StorageClass); err != nil {
+		if err := rows.Scan(&c.ID, &c.URI, &c.Managed, &c.StorageClass, &c.Checksum); err != nil {
 			return nil, pqErrorFormat("ReadContainers.scan: %w", err)
 		}
 		containers[idx[c.URI]] = &c
@@ -55,7 +55,7 @@ func (b Backend) ReadContainers(ctx context.Context, containersURI []string) (co
 	}
 
 	// Fetch datasets
-	datasets, err := b.findDatasets(ctx, nil, containersURI, "", nil, nil, geocube.Metadata{}, time.Time{}, time.Time{}, nil, nil, 0, 0, false)
+	datasets, err := b.findDatasets(ctx, nil, containersURI, "", nil, nil, nil, time.Time{}, time.Time{}, nil, nil, 0, 0, nil, false)
 	if err != nil {
 		return nil, err
 	}
@@ -71,9 +71,9 @@ func (b Backend) ReadContainers(ctx context.Context, containersURI []string) (co
 // CreateContainer implements GeocubeBackend
 func (b Backend) CreateContainer(ctx context.Context, container *geocube.Container) error {
 	_, err := b.pg.ExecContext(ctx,
-		"INSERT INTO geocube.containers (uri, managed, storage_class)"+
-			" VALUES ($1, $2, $3)",
-		container.URI, container.Managed, container.StorageClass)
+		"INSERT INTO geocube.containers (uri, managed, storage_class, checksum)"+
+			" VALUES ($1, $2, $3, $4)",
+		container.URI, container.Managed, container.StorageClass, container.Checksum)
 
 	switch pqErrorCode(err) {
 	case noError:
@@ -242,18 +242,21 @@ func (b Backend) DeleteDatasets(ctx context.Context, datasetsID []string) error
 
 // FindDatasets implements GeocubeBackend
 func (b Backend) FindDatasets(ctx context.Context, status geocube.DatasetStatus, containerURIPatterns []string, lockedByJobID string, instancesID, recordsID []string,
-	recordTags geocube.Metadata, fromTime, toTime time.Time, geog *proj.GeographicRing, refined *proj.Ring, page, limit int, order bool) (datasets []*geocube.Dataset, err error) {
-	return b.findDatasets(ctx, []geocube.DatasetStatus{status}, containerURIPatterns, lockedByJobID, instancesID, recordsID, recordTags, fromTime, toTime, geog, refined, page, limit, order)
+	recordTags geocube.TagsQuery, fromTime, toTime time.Time, geog *proj.GeographicRing, refined *proj.Ring, page, limit int, after *geocube.Cursor, order bool) (datasets []*geocube.Dataset, err error) {
+	return b.findDatasets(ctx, []geocube.DatasetStatus{status}, containerURIPatterns, lockedByJobID, instancesID, recordsID, recordTags, fromTime, toTime, geog, refined, page, limit, after, order)
 }
 
 // findDatasets is identical to FindDatasets but it can take a list of datasetStatus
 func (b Backend) findDatasets(ctx context.Context, status []geocube.DatasetStatus, containerURIPatterns []string, lockedByJobID string, instancesID, recordsID []string,
-	recordTags geocube.Metadata, fromTime, toTime time.Time, geog *proj.GeographicRing, refined *proj.Ring, page, limit int, order bool) (datasets []*geocube.Dataset, err error) {
+	recordTags geocube.TagsQuery, fromTime, toTime time.Time, geog *proj.GeographicRing, refined *proj.Ring, page, limit int, after *geocube.Cursor, order bool) (datasets []*geocube.Dataset, err error) {
 	// Create the selectClause
 	query := "SELECT d.id, d.record_id, d.instance_id, d.container_uri, d.geog, d.geom, d.shape, d.subdir, d.bands, d.status, " +
 		"d.dtype, d.no_data, d.min_value, d.max_value, d.real_min_value, d.real_max_value, d.exponent, d.overviews FROM geocube.datasets d"
 
-	if order || !fromTime.IsZero() || !toTime.IsZero() || len(recordTags) > 0 {
+	// The cursor is a record cursor: the datasets are ordered by record
+	order = order || after != nil
+
+	if order || !fromTime.IsZero() || !toTime.IsZero() || recordTags != nil {
 		query += " JOIN geocube.records r ON d.record_id = r.id"
 	}
 
@@ -310,12 +313,18 @@ func (b Backend) findDatasets(ctx context.Context, status []geocube.DatasetStatu
 		}
 	}
 
+	if after != nil {
+		wc.append("(r.datetime, r.id) > ($%d, $%d)", after.Time, after.ID)
+	}
+
 	// Append the whereClause to the query
 	query += wc.WhereClause()
 
 	// Append the order
 	if order {
 		query += " ORDER BY r.datetime, r.id"
+	} else if limit != 0 {
+		query += " ORDER BY d.id"
 	}
 
 	// Append the limitOffsetClause to the query
@@ -349,13 +358,13 @@ func (b Backend) findDatasets(ctx context.Context, status []geocube.DatasetStatu
 
 // ListActiveDatasetsID implements GeocubeBackend
 // ListActiveDatasetsID retrieves all the datasets from the list of records representing the given variable
-func (b Backend) ListActiveDatasetsID(ctx context.Context, instanceID string, recordsID []string, recordTags geocube.Metadata, fromTime, toTime time.Time) ([]string, error) {
+func (b Backend) ListActiveDatasetsID(ctx context.Context, instanceID string, recordsID []string, recordTags geocube.TagsQuery, fromTime, toTime time.Time) ([]string, error) {
 
 	// Create the selectClause
 	query := "SELECT d.id FROM geocube.datasets d"
 
 	// Append the Join clause if necessary
-	if !fromTime.IsZero() || !toTime.IsZero() || len(recordTags) > 0 {
+	if !fromTime.IsZero() || !toTime.IsZero() || recordTags != nil {
 		query += " JOIN geocube.records r ON d.record_id = r.id"
 	}
 
@@ -471,3 +480,29 @@ func (b Backend) UpdateDatasets(ctx context.Context, instanceID string, recordId
 
 	return results, nil
 }
+
+// UpdateDatasetsShape implements GeocubeBackend
+func (b Backend) UpdateDatasetsShape(ctx context.Context, datasets []*geocube.Dataset) error {
+	for _, dataset := range datasets {
+		geometry, err := b.splitGeom(ctx, &dataset.GeomShape, false)
+		if err != nil {
+			return pqErrorFormat("UpdateDatasetsShape.%w", err)
+		}
+		geography, err := b.splitGeom(ctx, &dataset.GeogShape, true)
+		if err != nil {
+			return pqErrorFormat("UpdateDatasetsShape.%w", err)
+		}
+		res, err := b.pg.ExecContext(ctx,
+			"UPDATE geocube.datasets SET geog = $1, geom = $2, shape = $3 WHERE id = $4", geography, geometry, &dataset.Shape, dataset.ID)
+
+		switch pqErrorCode(err) {
+		case noError:
+			if n, err := res.RowsAffected(); err != nil || n == 0 {
+				return geocube.NewEntityNotFound("Dataset", "id", dataset.ID, "")
+			}
+		default:
+			return pqErrorFormat("UpdateDatasetsShape.exec: %w", err)
+		}
+	}
+	return nil
+}
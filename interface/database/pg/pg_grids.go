@@ -78,7 +78,7 @@ func (b Backend) ReadGrid(ctx context.Context, name string) (*geocube.Grid, erro
 	case noError:
 		return &grid, nil
 
-	case noDataFound:
+	case noDataFound, noData:
 		return nil, geocube.NewEntityNotFound("Grid", "name", name, "")
 	default:
 		return nil, pqErrorFormat("ReadGrid: %w", err)
@@ -13,7 +13,7 @@ import (
 
 const (
 	logsSubtable = `
-		(SELECT jobs.*, json_agg(json_build_object('time', to_char((time::timestamp), 'YYYY-MM-DD"T"HH24:MI:SS.US"Z"'), 'severity', severity,'status', status, 'message', message)) AS logs
+		(SELECT jobs.*, COALESCE(json_agg(json_build_object('time', to_char((time::timestamp), 'YYYY-MM-DD"T"HH24:MI:SS.US"Z"'), 'severity', severity,'status', status, 'message', message)) FILTER (WHERE sub.id IS NOT NULL), '[]') AS logs
 		FROM geocube.jobs
 		LEFT JOIN LATERAL (
 			SELECT * FROM geocube.job_logs
@@ -32,17 +32,20 @@ func reverse(log geocube.JobLogs) {
 }
 
 // FindJobs implements GeocubeBackend
-func (b Backend) FindJobs(ctx context.Context, nameLike string, page, limit int) ([]*geocube.Job, error) {
+func (b Backend) FindJobs(ctx context.Context, nameLike string, page, limit int, after *geocube.Cursor) ([]*geocube.Job, error) {
 	wc := joinClause{}
 	if nameLike != "" {
 		nameLike, operator := parseLike(nameLike)
 		wc.append(" name "+operator+" $%d", nameLike)
 	}
+	if after != nil {
+		wc.append(" id > $%d", after.ID)
+	}
 
 	rows, err := b.pg.QueryContext(ctx,
 		"SELECT id, name, type, creation_ts, last_update_ts, state, active_tasks, failed_tasks, payload, execution_level, waiting, logs"+
 			" FROM "+fmt.Sprintf(logsSubtable, 0, 10)+
-			wc.WhereClause()+limitOffsetClause(page, limit), wc.Parameters...)
+			wc.WhereClause()+" ORDER BY id"+limitOffsetClause(page, limit), wc.Parameters...)
 
 	if err != nil {
 		return nil, pqErrorFormat("FindJobs: %w", err)
@@ -102,9 +105,9 @@ func (b Backend) ReadJobWithTask(ctx context.Context, jobID string, taskID strin
 
 	var t geocube.Task
 	err := b.pg.QueryRowContext(ctx,
-		"SELECT j.name, j.type, j.creation_ts, j.last_update_ts, j.state, j.active_tasks, j.failed_tasks, j.payload, j.execution_level, j.waiting, t.id, t.state "+
+		"SELECT j.name, j.type, j.creation_ts, j.last_update_ts, j.state, j.active_tasks, j.failed_tasks, j.payload, j.execution_level, j.waiting, t.id, t.state, t.payload, t.checksum "+
 			"FROM geocube.jobs j JOIN geocube.tasks t ON j.id = t.job_id WHERE j.id = $1 AND t.id = $2", jobID, taskID).
-		Scan(&j.Name, &j.Type, &j.CreationTime, &j.LastUpdateTime, &j.State, &j.ActiveTasks, &j.FailedTasks, &j.Payload, &j.ExecutionLevel, &j.Waiting, &t.ID, &t.State)
+		Scan(&j.Name, &j.Type, &j.CreationTime, &j.LastUpdateTime, &j.State, &j.ActiveTasks, &j.FailedTasks, &j.Payload, &j.ExecutionLevel, &j.Waiting, &t.ID, &t.State, &t.Payload, &t.Checksum)
 
 	switch {
 	case err == sql.ErrNoRows:
@@ -312,13 +315,13 @@ func (b Backend) ReadTasks(ctx context.Context, jobID string, states []geocube.T
 	var rows *sql.Rows
 
 	if states == nil {
-		rows, err = b.pg.QueryContext(ctx, "SELECT id, state, payload FROM geocube.tasks WHERE job_id=$1", jobID)
+		rows, err = b.pg.QueryContext(ctx, "SELECT id, state, payload, checksum FROM geocube.tasks WHERE job_id=$1", jobID)
 	} else {
 		strStates := make([]string, len(states))
 		for i, s := range states {
 			strStates[i] = s.String()
 		}
-		rows, err = b.pg.QueryContext(ctx, "SELECT id, state, payload FROM geocube.tasks WHERE job_id=$1 and state=ANY($2)", jobID, pq.Array(strStates))
+		rows, err = b.pg.QueryContext(ctx, "SELECT id, state, payload, checksum FROM geocube.tasks WHERE job_id=$1 and state=ANY($2)", jobID, pq.Array(strStates))
 	}
 
 	if err != nil {
@@ -332,7 +335,7 @@ func (b Backend) ReadTasks(ctx context.Context, jobID string, states []geocube.T
 
 	for rows.Next() {
 		var task geocube.Task
-		err := rows.Scan(&task.ID, &task.State, &task.Payload)
+		err := rows.Scan(&task.ID, &task.State, &task.Payload, &task.Checksum)
 		if err != nil {
 			return nil, pqErrorFormat("ReadTasks.Scan: %w", err)
 		}
@@ -344,7 +347,7 @@ func (b Backend) ReadTasks(ctx context.Context, jobID string, states []geocube.T
 // UpdateTask implements GeocubeBackend
 func (b Backend) UpdateTask(ctx context.Context, task *geocube.Task) error {
 	res, err := b.pg.ExecContext(ctx,
-		"UPDATE geocube.tasks SET state = $1 WHERE id = $2", task.State, task.ID)
+		"UPDATE geocube.tasks SET state = $1, checksum = $2 WHERE id = $3", task.State, task.Checksum, task.ID)
 
 	switch pqErrorCode(err) {
 	case noError:
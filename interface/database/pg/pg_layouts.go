@@ -41,7 +41,7 @@ func (b Backend) ReadLayout(ctx context.Context, name string) (*geocube.Layout,
 	err := b.pg.QueryRowContext(ctx,
 		"SELECT grid_flags, grid_parameters, block_x_size, block_y_size, max_records, overviews_min_size, interlacing_pattern "+
 			"FROM geocube.layouts WHERE name = $1", name).
-		Scan(pq.Array(l.GridFlags), &l.GridParameters, &l.BlockXSize, &l.BlockYSize, &l.MaxRecords, &l.OverviewsMinSize, &l.InterlacingPattern)
+		Scan(pq.Array(&l.GridFlags), &l.GridParameters, &l.BlockXSize, &l.BlockYSize, &l.MaxRecords, &l.OverviewsMinSize, &l.InterlacingPattern)
 
 	switch {
 	case err == sql.ErrNoRows:
@@ -73,7 +73,7 @@ func (b Backend) FindLayouts(ctx context.Context, nameLike string) ([]*geocube.L
 	layouts := []*geocube.Layout{}
 	for rows.Next() {
 		var l geocube.Layout
-		if err := rows.Scan(&l.Name, pq.Array(l.GridFlags), &l.GridParameters, &l.BlockXSize, &l.BlockYSize, &l.MaxRecords, &l.OverviewsMinSize, &l.InterlacingPattern); err != nil {
+		if err := rows.Scan(&l.Name, pq.Array(&l.GridFlags), &l.GridParameters, &l.BlockXSize, &l.BlockYSize, &l.MaxRecords, &l.OverviewsMinSize, &l.InterlacingPattern); err != nil {
 			return nil, fmt.Errorf("FindLayouts: %w", err)
 		}
 		layouts = append(layouts, &l)
@@ -82,11 +82,11 @@ func (b Backend) FindLayouts(ctx context.Context, nameLike string) ([]*geocube.L
 }
 
 // FindContainerLayouts implements GeocubeBackend
-func (b Backend) FindContainerLayouts(ctx context.Context, instanceId string, geomAOI *geocube.AOI, recordIds []string, recordTags map[string]string, fromTime, toTime time.Time) ([]string, [][]string, error) {
+func (b Backend) FindContainerLayouts(ctx context.Context, instanceId string, geomAOI *geocube.AOI, recordIds []string, recordTags geocube.TagsQuery, fromTime, toTime time.Time) ([]string, [][]string, error) {
 	// Create the selectClause
 	query := "SELECT DISTINCT cl.layout_name, cl.container_uri FROM geocube.container_layouts cl JOIN geocube.datasets d ON d.container_uri = cl.container_uri"
 
-	if geomAOI != nil || !fromTime.IsZero() || !toTime.IsZero() || len(recordTags) > 0 {
+	if geomAOI != nil || !fromTime.IsZero() || !toTime.IsZero() || recordTags != nil {
 		query += " JOIN geocube.records r ON d.record_id = r.id"
 	}
 
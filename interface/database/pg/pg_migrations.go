@@ -0,0 +1,245 @@
+package pg
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+//go:embed create.sql update_*.sql
+var scripts embed.FS
+
+type migration struct {
+	version string
+	script  string
+}
+
+// migrations are the updates of the schema, sorted by version.
+// create.sql creates the schema of the last version and records all the versions in schema_migrations.
+var migrations = []migration{
+	{version: "0.3.0", script: "update_0.3.0.sql"},
+	{version: "0.6.0", script: "update_0.6.0.sql"},
+	{version: "1.1.0", script: "update_1.1.0.sql"},
+}
+
+// migrationsLockID is the key of the advisory lock taken during the migrations ("geocube")
+const migrationsLockID = 0x67656f63756265
+
+const createSchemaMigrations = "CREATE TABLE IF NOT EXISTS geocube.schema_migrations (" +
+	" version TEXT NOT NULL," +
+	" applied_ts TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT (now() at time zone 'utc')," +
+	" PRIMARY KEY (version))"
+
+// ErrSchemaTooRecent is returned when the schema of the database is newer than the schema supported by the binary
+var ErrSchemaTooRecent = errors.New("the schema of the database is newer than the schema supported by this version of the geocube")
+
+// SchemaVersion returns the version of the schema supported by the binary
+func SchemaVersion() string {
+	return migrations[len(migrations)-1].version
+}
+
+// Migrate applies the pending migrations of the schema and returns the versions that have been applied.
+// An empty database is initialized with create.sql.
+// The migrations are applied under an advisory lock, so that several instances can be started concurrently.
+// Each migration is applied in its own transaction (ALTER TYPE ... ADD VALUE requires PostgreSQL 12 or later).
+// Returns ErrSchemaTooRecent if the schema is newer than SchemaVersion()
+func (bdb BackendDB) Migrate(ctx context.Context) (applied []string, err error) {
+	conn, err := bdb.Conn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Migrate.Conn: %w", err)
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", migrationsLockID); err != nil {
+		return nil, pqErrorFormat("Migrate.lock: %w", err)
+	}
+	defer func() {
+		if _, e := conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", migrationsLockID); e != nil && err == nil {
+			err = pqErrorFormat("Migrate.unlock: %w", e)
+		}
+	}()
+
+	version, legacy, err := schemaVersion(ctx, conn)
+	if err != nil {
+		return nil, fmt.Errorf("Migrate.%w", err)
+	}
+	if legacy {
+		if err := recordLegacyVersion(ctx, conn, version); err != nil {
+			return nil, fmt.Errorf("Migrate.%w", err)
+		}
+	}
+
+	if version == "" {
+		// Empty database
+		if err := execInTx(ctx, conn, "create.sql"); err != nil {
+			return nil, fmt.Errorf("Migrate: %w", err)
+		}
+		return []string{SchemaVersion()}, nil
+	}
+
+	if compareVersions(version, SchemaVersion()) > 0 {
+		return nil, fmt.Errorf("Migrate: %w (%s > %s)", ErrSchemaTooRecent, version, SchemaVersion())
+	}
+
+	for _, m := range migrations {
+		if compareVersions(m.version, version) <= 0 {
+			continue
+		}
+		if err := execInTx(ctx, conn, m.script, m.version); err != nil {
+			return applied, fmt.Errorf("Migrate[%s]: %w", m.version, err)
+		}
+		applied = append(applied, m.version)
+	}
+	return applied, nil
+}
+
+// CheckSchemaVersion returns an error if the schema of the database is not the version supported by the binary
+func (bdb BackendDB) CheckSchemaVersion(ctx context.Context) error {
+	conn, err := bdb.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("CheckSchemaVersion.Conn: %w", err)
+	}
+	defer conn.Close()
+
+	version, _, err := schemaVersion(ctx, conn)
+	if err != nil {
+		return fmt.Errorf("CheckSchemaVersion.%w", err)
+	}
+	switch c := compareVersions(version, SchemaVersion()); {
+	case c > 0:
+		return fmt.Errorf("CheckSchemaVersion: %w (%s > %s)", ErrSchemaTooRecent, version, SchemaVersion())
+	case c < 0:
+		return fmt.Errorf("CheckSchemaVersion: the schema of the database must be migrated (%s < %s)", version, SchemaVersion())
+	}
+	return nil
+}
+
+// schemaVersion returns the last version of the schema applied to the database or "" if the database is empty.
+// legacy is true if the schema has been created before the schema_migrations table: its version is guessed.
+func schemaVersion(ctx context.Context, conn *sql.Conn) (version string, legacy bool, err error) {
+	var hasSchema, hasMigrations bool
+	if err := conn.QueryRowContext(ctx, "SELECT EXISTS (SELECT NULL FROM information_schema.schemata WHERE schema_name = 'geocube'),"+
+		" to_regclass('geocube.schema_migrations') IS NOT NULL").Scan(&hasSchema, &hasMigrations); err != nil {
+		return "", false, pqErrorFormat("schemaVersion: %w", err)
+	}
+	if !hasSchema {
+		return "", false, nil
+	}
+	if !hasMigrations {
+		version, err := legacyVersion(ctx, conn)
+		return version, true, err
+	}
+
+	rows, err := conn.QueryContext(ctx, "SELECT version FROM geocube.schema_migrations")
+	if err != nil {
+		return "", false, pqErrorFormat("schemaVersion: %w", err)
+	}
+	defer rows.Close()
+	version = "0.0.0"
+	for rows.Next() {
+		var v string
+		if err := rows.Scan(&v); err != nil {
+			return "", false, fmt.Errorf("schemaVersion: %w", err)
+		}
+		if compareVersions(v, version) > 0 {
+			version = v
+		}
+	}
+	return version, false, rows.Err()
+}
+
+// legacyVersion guesses the version of a schema created before the schema_migrations table, from the last change of each migration
+func legacyVersion(ctx context.Context, conn *sql.Conn) (string, error) {
+	var v110, v060, v030 bool
+	if err := conn.QueryRowContext(ctx, "SELECT to_regclass('geocube.outbox') IS NOT NULL, to_regclass('geocube.job_logs') IS NOT NULL,"+
+		" EXISTS (SELECT NULL FROM information_schema.columns WHERE table_schema = 'geocube' AND table_name = 'jobs' AND column_name = 'execution_level')").
+		Scan(&v110, &v060, &v030); err != nil {
+		return "", pqErrorFormat("legacyVersion: %w", err)
+	}
+	switch {
+	case v110:
+		return "1.1.0", nil
+	case v060:
+		return "0.6.0", nil
+	case v030:
+		return "0.3.0", nil
+	}
+	return "0.0.0", nil
+}
+
+// recordLegacyVersion creates the schema_migrations table and records the migrations up to version
+func recordLegacyVersion(ctx context.Context, conn *sql.Conn, version string) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("recordLegacyVersion.BeginTx: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, createSchemaMigrations); err != nil {
+		return pqErrorFormat("recordLegacyVersion.create: %w", err)
+	}
+	for _, m := range migrations {
+		if compareVersions(m.version, version) > 0 {
+			break
+		}
+		if _, err := tx.ExecContext(ctx, "INSERT INTO geocube.schema_migrations (version) VALUES ($1)", m.version); err != nil {
+			return pqErrorFormat("recordLegacyVersion.insert: %w", err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return pqErrorFormat("recordLegacyVersion.commit: %w", err)
+	}
+	return nil
+}
+
+// execInTx executes the script in a transaction and records the versions in schema_migrations
+func execInTx(ctx context.Context, conn *sql.Conn, script string, versions ...string) error {
+	query, err := scripts.ReadFile(script)
+	if err != nil {
+		return fmt.Errorf("execInTx: %w", err)
+	}
+
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("execInTx.BeginTx: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, string(query)); err != nil {
+		return pqErrorFormat("execInTx["+script+"]: %w", err)
+	}
+	for _, version := range versions {
+		if _, err := tx.ExecContext(ctx, "INSERT INTO geocube.schema_migrations (version) VALUES ($1)", version); err != nil {
+			return pqErrorFormat("execInTx.insert: %w", err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return pqErrorFormat("execInTx.commit: %w", err)
+	}
+	return nil
+}
+
+// compareVersions compares two versions "x.y.z" and returns -1, 0 or 1
+func compareVersions(v1, v2 string) int {
+	s1, s2 := strings.Split(v1, "."), strings.Split(v2, ".")
+	for i := 0; i < len(s1) || i < len(s2); i++ {
+		var n1, n2 int
+		if i < len(s1) {
+			n1, _ = strconv.Atoi(s1[i])
+		}
+		if i < len(s2) {
+			n2, _ = strconv.Atoi(s2[i])
+		}
+		switch {
+		case n1 < n2:
+			return -1
+		case n1 > n2:
+			return 1
+		}
+	}
+	return 0
+}
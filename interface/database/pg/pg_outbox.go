@@ -0,0 +1,69 @@
+package pg
+
+import (
+	"context"
+	"time"
+
+	"github.com/airbusgeo/geocube/interface/database"
+	"github.com/lib/pq"
+)
+
+// CreateOutboxMessages implements GeocubeBackend
+func (b Backend) CreateOutboxMessages(ctx context.Context, queue string, payloads [][]byte) error {
+	if len(payloads) == 0 {
+		return nil
+	}
+	data := make([][]interface{}, len(payloads))
+	for i, payload := range payloads {
+		data[i] = []interface{}{queue, payload}
+	}
+
+	if err := b.bulkInsert(ctx, "geocube", "outbox", []string{"queue", "payload"}, data); err != nil {
+		return pqErrorFormat("CreateOutboxMessages: %w", err)
+	}
+	return nil
+}
+
+// ReadPendingOutboxMessages implements GeocubeBackend
+func (b Backend) ReadPendingOutboxMessages(ctx context.Context, limit int) (messages []*database.OutboxMessage, err error) {
+	rows, err := b.pg.QueryContext(ctx,
+		"SELECT id, queue, payload, created_ts FROM geocube.outbox WHERE sent_ts IS NULL ORDER BY id LIMIT $1 FOR UPDATE SKIP LOCKED", limit)
+	if err != nil {
+		return nil, pqErrorFormat("ReadPendingOutboxMessages.Query: %w", err)
+	}
+	defer func() {
+		if e := rows.Close(); e != nil && err == nil {
+			err = e
+		}
+	}()
+
+	for rows.Next() {
+		var m database.OutboxMessage
+		if err := rows.Scan(&m.ID, &m.Queue, &m.Payload, &m.CreatedTime); err != nil {
+			return nil, pqErrorFormat("ReadPendingOutboxMessages.Scan: %w", err)
+		}
+		messages = append(messages, &m)
+	}
+	return messages, nil
+}
+
+// MarkOutboxMessagesSent implements GeocubeBackend
+func (b Backend) MarkOutboxMessagesSent(ctx context.Context, ids []int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	if _, err := b.pg.ExecContext(ctx,
+		"UPDATE geocube.outbox SET sent_ts = (now() at time zone 'utc') WHERE id = ANY($1)", pq.Array(ids)); err != nil {
+		return pqErrorFormat("MarkOutboxMessagesSent: %w", err)
+	}
+	return nil
+}
+
+// DeleteSentOutboxMessages implements GeocubeBackend
+func (b Backend) DeleteSentOutboxMessages(ctx context.Context, sentBefore time.Time) (int64, error) {
+	res, err := b.pg.ExecContext(ctx, "DELETE FROM geocube.outbox WHERE sent_ts < $1", sentBefore.UTC())
+	if err != nil {
+		return 0, pqErrorFormat("DeleteSentOutboxMessages: %w", err)
+	}
+	return res.RowsAffected()
+}
@@ -63,21 +63,75 @@ func appendTimeFilters(wc *joinClause, fromTime, toTime time.Time) {
 	}
 }
 
-func appendTagsFilters(wc *joinClause, tags geocube.Metadata) {
-	if len(tags) != 0 {
-		for k, v := range tags {
-			if v == "" {
-				wc.append("r.tags ? $%d", k)
-			} else {
-				v, operator := parseLike(v)
-				wc.append("r.tags -> $%d "+operator+" $%d", k, v)
-			}
+// appendTagsFilters appends the filters on the tags of the records (r.tags)
+func appendTagsFilters(wc *joinClause, tags geocube.TagsQuery) {
+	if tags == nil {
+		return
+	}
+	tc := tagsClause{offset: len(wc.Parameters)}
+	clause := tc.sql(tags)
+	wc.appendWithoutPlacement(clause, tc.parameters...)
+}
+
+// tagsClause translates a geocube.TagsQuery to a SQL condition on r.tags
+// A condition on a missing tag is false (and not NULL), so that NOT is consistent with geocube.TagsQuery.Match
+type tagsClause struct {
+	offset     int
+	parameters []interface{}
+}
+
+// param appends a parameter and returns its placeholder
+func (tc *tagsClause) param(p interface{}) string {
+	tc.parameters = append(tc.parameters, p)
+	return fmt.Sprintf("$%d", tc.offset+len(tc.parameters))
+}
+
+func (tc *tagsClause) sql(q geocube.TagsQuery) string {
+	switch q := q.(type) {
+	case geocube.TagsAnd:
+		return tc.join(q, " AND ", "TRUE")
+	case geocube.TagsOr:
+		return tc.join(q, " OR ", "FALSE")
+	case geocube.TagsNot:
+		return "NOT " + tc.sql(q.Query)
+	case geocube.TagExists:
+		return "r.tags ? " + tc.param(q.Key)
+	case geocube.TagCompare:
+		tag := "(r.tags -> " + tc.param(q.Key) + ")"
+		if q.Numeric {
+			return "COALESCE(CASE WHEN " + tag + " ~ " + tc.param(geocube.NumericTagPattern) + " THEN " + tag + "::numeric " + q.Operator + " " + tc.param(q.Value) + "::numeric END, FALSE)"
+		}
+		return "COALESCE(" + tag + " COLLATE \"C\" " + q.Operator + " " + tc.param(q.Value) + ", FALSE)"
+	case geocube.TagIn:
+		return "COALESCE((r.tags -> " + tc.param(q.Key) + ") = ANY(" + tc.param(pq.Array(q.Values)) + "::text[]), FALSE)"
+	case geocube.TagLike:
+		operator := "LIKE"
+		if q.CaseInsensitive {
+			operator = "ILIKE"
 		}
+		return "COALESCE((r.tags -> " + tc.param(q.Key) + ") " + operator + " " + tc.param(globToLike(q.Pattern)) + ", FALSE)"
 	}
+	panic(fmt.Sprintf("tagsClause: unsupported query %T", q))
+}
+
+func (tc *tagsClause) join(queries []geocube.TagsQuery, sep, empty string) string {
+	if len(queries) == 0 {
+		return empty
+	}
+	clauses := make([]string, len(queries))
+	for i, q := range queries {
+		clauses[i] = tc.sql(q)
+	}
+	return "(" + strings.Join(clauses, sep) + ")"
+}
+
+// globToLike converts a pattern where * and ? stand for any sequence of characters and any character to a LIKE pattern
+func globToLike(pattern string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`, "*", "%", "?", "_").Replace(pattern)
 }
 
 // FindRecords implements GeocubeBackend
-func (b Backend) FindRecords(ctx context.Context, namelike string, tags geocube.Metadata, fromTime, toTime time.Time, jobID string, aoi *geocube.AOI, page, limit int, order, loadAOI bool) (records []*geocube.Record, err error) {
+func (b Backend) FindRecords(ctx context.Context, namelike string, tags geocube.TagsQuery, fromTime, toTime time.Time, jobID string, aoi *geocube.AOI, page, limit int, after *geocube.Cursor, order, loadAOI bool) (records []*geocube.Record, err error) {
 	// Create the selectClause
 	query := "SELECT r.id, r.name, r.datetime, r.tags, r.aoi_id"
 	if loadAOI {
@@ -115,12 +169,17 @@ func (b Backend) FindRecords(ctx context.Context, namelike string, tags geocube.
 
 	appendTagsFilters(&wc, tags)
 
+	if after != nil {
+		wc.append("(r.datetime, r.id) > ($%d, $%d)", after.Time, after.ID)
+		order = true
+	}
+
 	// Append the whereClause to the query
 	query += wc.WhereClause()
 
 	// Append the order
 	if order {
-		query += " ORDER BY r.datetime"
+		query += " ORDER BY r.datetime, r.id"
 	}
 
 	// Append the limitOffsetClause
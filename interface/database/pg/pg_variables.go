@@ -125,7 +125,7 @@ func (b Backend) readInstancesFromVariableID(ctx context.Context, variableID str
 }
 
 // FindVariables implements GeocubeBackend
-func (b Backend) FindVariables(ctx context.Context, namelike string, page, limit int) ([]*geocube.Variable, error) {
+func (b Backend) FindVariables(ctx context.Context, namelike string, page, limit int, after *geocube.Cursor) ([]*geocube.Variable, error) {
 	// Create the selectClause
 	query := sqlSelectVariable + " FROM geocube.variable_definitions v"
 
@@ -135,9 +135,12 @@ func (b Backend) FindVariables(ctx context.Context, namelike string, page, limit
 		namelike, operator := parseLike(namelike)
 		wc.append("v.name "+operator+" $%d", namelike)
 	}
+	if after != nil {
+		wc.append("v.id > $%d", after.ID)
+	}
 
-	// Append the whereClause to the query
-	query += wc.WhereClause()
+	// Append the whereClause and the order to the query
+	query += wc.WhereClause() + " ORDER BY v.id"
 
 	// Append the limitOffsetClause to the query
 	query += limitOffsetClause(page, limit)
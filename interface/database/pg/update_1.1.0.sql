@@ -1,7 +1,20 @@
 -- add index on geocube.records on datetime
-CREATE INDEX idx_records_datetime ON geocube.records (datetime);
+CREATE INDEX IF NOT EXISTS idx_records_datetime ON geocube.records (datetime);
 -- add jpeg support
-ALTER TYPE geocube.compression ADD VALUE 'CUSTOM';
-ALTER TABLE geocube.consolidation_params ADD COLUMN creation_params hstore NOT NULL default ''::hstore;
+ALTER TYPE geocube.compression ADD VALUE IF NOT EXISTS 'CUSTOM';
+ALTER TABLE geocube.consolidation_params ADD COLUMN IF NOT EXISTS creation_params hstore NOT NULL default ''::hstore;
 -- add index on geocube.datasets on shape
-CREATE INDEX idx_datasets_shape ON geocube.datasets USING GIST (shape);
\ No newline at end of file
+CREATE INDEX IF NOT EXISTS idx_datasets_shape ON geocube.datasets USING GIST (shape);
+-- add checksums of the consolidated containers
+ALTER TABLE geocube.containers ADD COLUMN IF NOT EXISTS checksum TEXT NOT NULL DEFAULT '';
+ALTER TABLE geocube.tasks ADD COLUMN IF NOT EXISTS checksum TEXT NOT NULL DEFAULT '';
+-- add the outbox of the job events and the consolidation orders
+CREATE TABLE IF NOT EXISTS geocube.outbox (
+	id BIGSERIAL NOT NULL,
+	created_ts TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT (now() at time zone 'utc'),
+	queue TEXT NOT NULL,
+	payload bytea NOT NULL,
+	sent_ts TIMESTAMP WITHOUT TIME ZONE,
+	PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_outbox_pending ON geocube.outbox (id) WHERE sent_ts IS NULL;
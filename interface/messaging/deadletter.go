@@ -0,0 +1,35 @@
+package messaging
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrDeadLetterNotFound is returned when a dead letter does not exist
+var ErrDeadLetterNotFound = errors.New("dead letter not found")
+
+// DeadLetter is a message that has not been processed by the consumer (fatal error or too many tries)
+type DeadLetter struct {
+	ID       int64
+	Queue    string
+	Message  Message // Message.Data is not loaded when listing the dead letters
+	Error    string  // Last error returned by the consumer
+	FailedAt time.Time
+}
+
+// DeadLetterQueue stores the dead letters, so that they can be inspected, requeued or purged
+type DeadLetterQueue interface {
+	// ListDeadLetters of the queue (all the queues if empty), ordered by id, without the data of the messages
+	ListDeadLetters(ctx context.Context, queue string, page, limit int) ([]*DeadLetter, error)
+	// ReadDeadLetter with the data of the message
+	// Returns ErrDeadLetterNotFound
+	ReadDeadLetter(ctx context.Context, id int64) (*DeadLetter, error)
+	// RequeueDeadLetters publishes the messages again in their original queue and removes the dead letters
+	RequeueDeadLetters(ctx context.Context, ids []int64) (int64, error)
+	// PurgeDeadLetters removes the dead letters given their ids or all the dead letters of the queue
+	PurgeDeadLetters(ctx context.Context, queue string, ids []int64) (int64, error)
+}
+
+// DeadLetterHandler is called by a consumer when a message is moved to the dead-letter queue
+type DeadLetterHandler func(ctx context.Context, m *Message, err error)
@@ -0,0 +1,252 @@
+package memqueue
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/airbusgeo/geocube/interface/messaging"
+	"github.com/airbusgeo/geocube/internal/log"
+	"github.com/airbusgeo/geocube/internal/utils"
+)
+
+const (
+	// DefaultMaxTries is the default maximum number of tries of a message
+	DefaultMaxTries = 5
+	// DefaultRetryDelay is the default delay before a message that failed with a temporary error is delivered again
+	DefaultRetryDelay = 10 * time.Second
+)
+
+// Queue is an in-process and unbounded queue of messages.
+// The messages are lost when the process exits.
+// A queue is shared by its publishers and its consumers, each message being delivered to only one consumer.
+type Queue struct {
+	name    string
+	mutex   sync.Mutex
+	pending []*messaging.Message
+	ready   chan struct{} // Signaled when a message is pushed in the pending list
+	backlog int64         // Number of messages pending, delayed or being processed
+	lastID  uint64
+}
+
+// NewQueue returns an empty in-memory queue
+func NewQueue(name string) *Queue {
+	return &Queue{
+		name:  name,
+		ready: make(chan struct{}, 1),
+	}
+}
+
+// Name of the queue
+func (q *Queue) Name() string {
+	return q.name
+}
+
+// Backlog implements interface.autoscaler.qbas.Queue
+func (q *Queue) Backlog(ctx context.Context) (int64, error) {
+	return atomic.LoadInt64(&q.backlog), nil
+}
+
+// push appends the message to the pending list after the delay
+func (q *Queue) push(m *messaging.Message, delay time.Duration) {
+	if delay > 0 {
+		time.AfterFunc(delay, func() { q.push(m, 0) })
+		return
+	}
+	q.mutex.Lock()
+	q.pending = append(q.pending, m)
+	q.mutex.Unlock()
+	select {
+	case q.ready <- struct{}{}:
+	default:
+	}
+}
+
+// pop returns the first pending message or nil
+func (q *Queue) pop() *messaging.Message {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	if len(q.pending) == 0 {
+		return nil
+	}
+	m := q.pending[0]
+	q.pending[0] = nil
+	q.pending = q.pending[1:]
+	if len(q.pending) > 0 {
+		// Wake up another consumer
+		select {
+		case q.ready <- struct{}{}:
+		default:
+		}
+	}
+	return m
+}
+
+// done removes a message from the backlog
+func (q *Queue) done() {
+	atomic.AddInt64(&q.backlog, -1)
+}
+
+type PublisherOption func(o *Publisher)
+
+// WithDelay delays the delivery of the published messages
+func WithDelay(d time.Duration) PublisherOption {
+	return func(p *Publisher) {
+		p.delay = d
+	}
+}
+
+// Publisher implements messaging.Publisher
+type Publisher struct {
+	queue *Queue
+	delay time.Duration
+}
+
+// NewPublisher returns a publisher on the in-memory queue
+func NewPublisher(q *Queue, opts ...PublisherOption) *Publisher {
+	p := &Publisher{queue: q}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+// Publish implements messaging.Publisher
+func (p *Publisher) Publish(ctx context.Context, data ...[]byte) error {
+	return p.PublishWithAttributes(ctx, nil, data...)
+}
+
+// PublishWithAttributes implements messaging.AttributesPublisher
+func (p *Publisher) PublishWithAttributes(ctx context.Context, attributes []map[string]string, data ...[]byte) error {
+	now := time.Now()
+	for i, d := range data {
+		attrs := map[string]string{}
+		if attributes != nil {
+			for k, v := range attributes[i] {
+				attrs[k] = v
+			}
+		}
+		atomic.AddInt64(&p.queue.backlog, 1)
+		p.queue.push(&messaging.Message{
+			ID:          strconv.FormatUint(atomic.AddUint64(&p.queue.lastID, 1), 10),
+			Data:        d,
+			Attributes:  attrs,
+			PublishTime: now,
+		}, p.delay)
+	}
+	return nil
+}
+
+type ConsumerOption func(o *Consumer)
+
+// WithMaxTries sets the maximum number of tries of a message that fails with a temporary error
+func WithMaxTries(maxTries int) ConsumerOption {
+	return func(c *Consumer) {
+		c.maxTries = maxTries
+	}
+}
+
+// WithRetryDelay sets the delay before a message that failed with a temporary error is delivered again
+func WithRetryDelay(d time.Duration) ConsumerOption {
+	return func(c *Consumer) {
+		c.retryDelay = d
+	}
+}
+
+// WithDeadLetterHandler sets a handler called when a message is dropped (fatal error or too many tries)
+func WithDeadLetterHandler(h messaging.DeadLetterHandler) ConsumerOption {
+	return func(c *Consumer) {
+		c.onDeadLetter = h
+	}
+}
+
+// Consumer implements messaging.Consumer and interface.autoscaler.qbas.Queue
+type Consumer struct {
+	queue        *Queue
+	stopChan     chan bool
+	run          bool
+	maxTries     int
+	retryDelay   time.Duration
+	onDeadLetter messaging.DeadLetterHandler
+}
+
+// NewConsumer returns a consumer of the in-memory queue.
+// Several consumers can pull the same queue concurrently.
+func NewConsumer(q *Queue, opts ...ConsumerOption) *Consumer {
+	c := &Consumer{
+		queue:      q,
+		stopChan:   make(chan bool),
+		maxTries:   DefaultMaxTries,
+		retryDelay: DefaultRetryDelay,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Pull implements messaging.Consumer
+// It waits for the next message and returns after its processing, or returns nil if the consumer is stopped or the context is done
+func (c *Consumer) Pull(ctx context.Context, cb messaging.Callback) error {
+	c.run = true
+	defer func() {
+		c.run = false
+	}()
+
+	for {
+		if m := c.queue.pop(); m != nil {
+			c.process(ctx, m, cb)
+			return nil
+		}
+		select {
+		case <-c.stopChan:
+			return nil
+		case <-ctx.Done():
+			return nil
+		case <-c.queue.ready:
+		}
+	}
+}
+
+func (c *Consumer) Stop() {
+	if c.run {
+		c.stopChan <- true
+	}
+}
+
+// Backlog implements interface.autoscaler.qbas.Queue
+func (c *Consumer) Backlog(ctx context.Context) (int64, error) {
+	return c.queue.Backlog(ctx)
+}
+
+// process calls the callback and reschedules the message if it fails with a temporary error
+func (c *Consumer) process(ctx context.Context, m *messaging.Message, cb messaging.Callback) {
+	m.TryCount++
+	err := c.call(ctx, m, cb)
+	switch {
+	case err == nil:
+		c.queue.done()
+	case utils.Temporary(err) && m.TryCount < c.maxTries:
+		log.Logger(ctx).Sugar().Warnf("%s: temporary error (retry in %v): %s", c.queue.name, c.retryDelay, err.Error())
+		c.queue.push(m, c.retryDelay)
+	default:
+		c.queue.done()
+		log.Logger(ctx).Sugar().Errorf("%s: message %s dropped after %d tries: %s", c.queue.name, m.ID, m.TryCount, err.Error())
+		if c.onDeadLetter != nil {
+			c.onDeadLetter(ctx, m, err)
+		}
+	}
+}
+
+// call the callback, recovering from a panic
+func (c *Consumer) call(ctx context.Context, m *messaging.Message, cb messaging.Callback) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return cb(ctx, m)
+}
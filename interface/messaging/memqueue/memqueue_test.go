@@ -0,0 +1,152 @@
+package memqueue
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/airbusgeo/geocube/interface/messaging"
+	"github.com/airbusgeo/geocube/internal/utils"
+)
+
+func TestPublishPull(t *testing.T) {
+	ctx := context.Background()
+	q := NewQueue("events")
+	p := NewPublisher(q)
+	c := NewConsumer(q)
+
+	if err := p.Publish(ctx, []byte("msg1"), []byte("msg2")); err != nil {
+		t.Fatalf("Publish: %v", err)
+	}
+	if n, _ := c.Backlog(ctx); n != 2 {
+		t.Errorf("Backlog: expecting 2, found %d", n)
+	}
+
+	for _, expected := range []string{"msg1", "msg2"} {
+		if err := c.Pull(ctx, func(ctx context.Context, m *messaging.Message) error {
+			if string(m.Data) != expected || m.TryCount != 1 || m.PublishTime.IsZero() {
+				t.Errorf("Pull: unexpected message %s (try %d)", m.Data, m.TryCount)
+			}
+			return nil
+		}); err != nil {
+			t.Fatalf("Pull: %v", err)
+		}
+	}
+	if n, _ := c.Backlog(ctx); n != 0 {
+		t.Errorf("Backlog: expecting 0, found %d", n)
+	}
+}
+
+func TestPublishWithAttributes(t *testing.T) {
+	ctx := context.Background()
+	q := NewQueue("consolidations")
+	p := NewPublisher(q)
+	c := NewConsumer(q)
+
+	if err := messaging.PublishWithAttributes(ctx, p, []map[string]string{{"job_id": "job"}, nil}, []byte("msg1"), []byte("msg2")); err != nil {
+		t.Fatalf("PublishWithAttributes: %v", err)
+	}
+	for _, expected := range []map[string]string{{"job_id": "job"}, {}} {
+		if err := c.Pull(ctx, func(ctx context.Context, m *messaging.Message) error {
+			if len(m.Attributes) != len(expected) || m.Attributes["job_id"] != expected["job_id"] {
+				t.Errorf("Pull: unexpected attributes %v of the message %s", m.Attributes, m.Data)
+			}
+			return nil
+		}); err != nil {
+			t.Fatalf("Pull: %v", err)
+		}
+	}
+}
+
+func TestRetry(t *testing.T) {
+	ctx := context.Background()
+	q := NewQueue("consolidations")
+	var deadLetters []string
+	c := NewConsumer(q, WithMaxTries(3), WithRetryDelay(10*time.Millisecond), WithDeadLetterHandler(func(ctx context.Context, m *messaging.Message, err error) {
+		deadLetters = append(deadLetters, string(m.Data))
+	}))
+	NewPublisher(q).Publish(ctx, []byte("temporary"), []byte("fatal"))
+
+	// The temporary error is retried until the max number of tries is reached,
+	// the fatal error is not retried
+	tries := map[string][]int{}
+	for i := 0; i < 4; i++ {
+		if err := c.Pull(ctx, func(ctx context.Context, m *messaging.Message) error {
+			tries[string(m.Data)] = append(tries[string(m.Data)], m.TryCount)
+			if string(m.Data) == "fatal" {
+				panic("fatal")
+			}
+			return utils.MakeTemporary(errors.New("temporary"))
+		}); err != nil {
+			t.Fatalf("Pull: %v", err)
+		}
+	}
+	if len(tries["temporary"]) != 3 || tries["temporary"][2] != 3 || len(tries["fatal"]) != 1 {
+		t.Errorf("Pull: unexpected tries %v", tries)
+	}
+	if len(deadLetters) != 2 {
+		t.Errorf("DeadLetters: expecting 2, found %v", deadLetters)
+	}
+	if n, _ := c.Backlog(ctx); n != 0 {
+		t.Errorf("Backlog: expecting 0, found %d", n)
+	}
+}
+
+func TestDelay(t *testing.T) {
+	ctx := context.Background()
+	q := NewQueue("delayed")
+	c := NewConsumer(q)
+	start := time.Now()
+	NewPublisher(q, WithDelay(50*time.Millisecond)).Publish(ctx, []byte("delayed"))
+	if n, _ := c.Backlog(ctx); n != 1 {
+		t.Errorf("Backlog: expecting 1, found %d", n)
+	}
+	c.Pull(ctx, func(ctx context.Context, m *messaging.Message) error { return nil })
+	if time.Since(start) < 50*time.Millisecond {
+		t.Errorf("Pull: message delivered before the delay")
+	}
+}
+
+func TestConcurrentConsumers(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	q := NewQueue("concurrent")
+	p := NewPublisher(q)
+
+	var mutex sync.Mutex
+	received := map[string]int{}
+	wg := sync.WaitGroup{}
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c := NewConsumer(q)
+			for ctx.Err() == nil {
+				c.Pull(ctx, func(ctx context.Context, m *messaging.Message) error {
+					mutex.Lock()
+					received[m.ID]++
+					mutex.Unlock()
+					return nil
+				})
+			}
+		}()
+	}
+	for i := 0; i < 100; i++ {
+		p.Publish(ctx, []byte("msg"))
+	}
+	for n := int64(1); n != 0; n, _ = q.Backlog(ctx) {
+		time.Sleep(time.Millisecond)
+	}
+	cancel()
+	wg.Wait()
+
+	if len(received) != 100 {
+		t.Errorf("expecting 100 messages, found %d", len(received))
+	}
+	for id, n := range received {
+		if n != 1 {
+			t.Errorf("message %s received %d times", id, n)
+		}
+	}
+}
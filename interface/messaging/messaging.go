@@ -13,6 +13,22 @@ type Publisher interface {
 	Publish(ctx context.Context, data ...[]byte) error
 }
 
+// AttributesPublisher is a Publisher that can attach attributes to the messages.
+// The attributes are delivered in Message.Attributes and kept in the dead-letter queue.
+type AttributesPublisher interface {
+	Publisher
+	// PublishWithAttributes publishes the messages data[i] with the attributes attributes[i] (attributes is nil or as long as data)
+	PublishWithAttributes(ctx context.Context, attributes []map[string]string, data ...[]byte) error
+}
+
+// PublishWithAttributes publishes the messages with their attributes if the publisher supports them, otherwise without attributes.
+func PublishWithAttributes(ctx context.Context, p Publisher, attributes []map[string]string, data ...[]byte) error {
+	if ap, ok := p.(AttributesPublisher); ok {
+		return ap.PublishWithAttributes(ctx, attributes, data...)
+	}
+	return p.Publish(ctx, data...)
+}
+
 type Message struct {
 	ID          string
 	Data        []byte
@@ -0,0 +1,215 @@
+package nats
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/airbusgeo/geocube/interface/messaging"
+	"github.com/airbusgeo/geocube/internal/log"
+	"github.com/airbusgeo/geocube/internal/utils"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+const (
+	// DefaultMaxDeliveries is the default maximum number of deliveries of a message
+	DefaultMaxDeliveries = 5
+	// DefaultRedeliveryDelay is the default delay before the redelivery of a message that failed with a temporary error
+	DefaultRedeliveryDelay = time.Minute
+	// DefaultAckWait is the default duration after which a message is redelivered if it is not acknowledged.
+	// It is extended during the processing of the message.
+	DefaultAckWait = time.Minute
+)
+
+type ConsumerOption func(o *Consumer)
+
+// WithMaxDeliveries sets the maximum number of deliveries of a message (including the deliveries that were not acknowledged)
+func WithMaxDeliveries(maxDeliveries int) ConsumerOption {
+	return func(c *Consumer) {
+		c.maxDeliveries = maxDeliveries
+	}
+}
+
+// WithRedeliveryDelay sets the delay before the redelivery of a message that failed with a temporary error
+func WithRedeliveryDelay(d time.Duration) ConsumerOption {
+	return func(c *Consumer) {
+		c.redeliveryDelay = d
+	}
+}
+
+// WithAckWait sets the duration after which a message is redelivered if the consumer does not extend it (e.g. crash)
+func WithAckWait(d time.Duration) ConsumerOption {
+	return func(c *Consumer) {
+		c.ackWait = d
+	}
+}
+
+// Consumer implements messaging.Consumer and interface.autoscaler.qbas.Queue
+// All the consumers of a queue share the same durable consumer (named after the queue),
+// so that each message is delivered to only one of them.
+type Consumer struct {
+	consumer        jetstream.Consumer
+	stopChan        chan bool
+	run             bool
+	maxDeliveries   int
+	redeliveryDelay time.Duration
+	ackWait         time.Duration
+	fetchMaxWait    time.Duration
+}
+
+// NewConsumer returns a NATS JetStream consumer, creating or updating the stream and the durable consumer of the queue
+func NewConsumer(ctx context.Context, js jetstream.JetStream, queue string, opts ...ConsumerOption) (*Consumer, error) {
+	c := &Consumer{
+		stopChan:        make(chan bool),
+		maxDeliveries:   DefaultMaxDeliveries,
+		redeliveryDelay: DefaultRedeliveryDelay,
+		ackWait:         DefaultAckWait,
+		fetchMaxWait:    10 * time.Second,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	stream, err := ensureStream(ctx, js, queue)
+	if err != nil {
+		return nil, fmt.Errorf("nats.NewConsumer: %w", err)
+	}
+	if c.consumer, err = stream.CreateOrUpdateConsumer(ctx, jetstream.ConsumerConfig{
+		Durable:    queue,
+		AckPolicy:  jetstream.AckExplicitPolicy,
+		AckWait:    c.ackWait,
+		MaxDeliver: c.maxDeliveries,
+	}); err != nil {
+		return nil, fmt.Errorf("nats.NewConsumer: %w", err)
+	}
+	return c, nil
+}
+
+// Pull implements messaging.Consumer
+func (c *Consumer) Pull(ctx context.Context, cb messaging.Callback) error {
+	c.run = true
+	defer func() {
+		c.run = false
+	}()
+
+	for {
+		select {
+		case <-c.stopChan:
+			return nil
+		default:
+			msg, err := c.consumer.Next(jetstream.FetchMaxWait(c.fetchMaxWait))
+			if errors.Is(err, nats.ErrTimeout) {
+				continue
+			}
+			if err != nil {
+				return fmt.Errorf("nats.Next: %w", err)
+			}
+			return c.process(ctx, msg, cb)
+		}
+	}
+}
+
+func (c *Consumer) Stop() {
+	if c.run {
+		c.stopChan <- true
+	}
+}
+
+// process calls the callback and acknowledges the message (ack, nak with delay or term)
+func (c *Consumer) process(ctx context.Context, msg jetstream.Msg, cb messaging.Callback) error {
+	meta, err := msg.Metadata()
+	if err != nil {
+		return fmt.Errorf("nats.Metadata: %w", err)
+	}
+	m := messaging.Message{
+		ID:          strconv.FormatUint(meta.Sequence.Stream, 10),
+		Data:        msg.Data(),
+		Attributes:  map[string]string{},
+		PublishTime: meta.Timestamp,
+		TryCount:    int(meta.NumDelivered),
+	}
+	for k := range msg.Headers() {
+		m.Attributes[k] = msg.Headers().Get(k)
+	}
+
+	stopKeepAlive := c.keepAlive(ctx, msg)
+	err = cb(ctx, &m)
+	stopKeepAlive()
+
+	switch {
+	case err == nil:
+		err = msg.DoubleAck(ctx)
+	case !utils.Temporary(err):
+		// Fatal error : termination
+		log.Logger(ctx).Error("Fatal error: " + err.Error())
+		err = msg.Term()
+	case m.TryCount >= c.maxDeliveries:
+		log.Logger(ctx).Error("Temporary error (too many deliveries): " + err.Error())
+		err = msg.Term()
+	default:
+		// Temporary error : retry
+		log.Logger(ctx).Warn("Temporary error: " + err.Error())
+		err = msg.NakWithDelay(c.redeliveryDelay)
+	}
+	if err != nil {
+		return fmt.Errorf("nats.Ack: %w", err)
+	}
+	return nil
+}
+
+// keepAlive extends the ack deadline of the message until the returned function is called
+func (c *Consumer) keepAlive(ctx context.Context, msg jetstream.Msg) func() {
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(c.ackWait / 2)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				if err := msg.InProgress(); err != nil {
+					log.Logger(ctx).Warn("nats: failed to extend the ack deadline: " + err.Error())
+				}
+			}
+		}
+	}()
+	return func() { close(done) }
+}
+
+// Backlog implements interface.autoscaler.qbas.Queue
+func (c *Consumer) Backlog(ctx context.Context) (int64, error) {
+	return backlog(ctx, c.consumer)
+}
+
+// Queue implements interface.autoscaler.qbas.Queue without creating nor updating the durable consumer
+type Queue struct {
+	js    jetstream.JetStream
+	queue string
+}
+
+// NewQueue returns a NATS JetStream Queue
+func NewQueue(js jetstream.JetStream, queue string) *Queue {
+	return &Queue{js: js, queue: queue}
+}
+
+// Backlog implements interface.autoscaler.qbas.Queue
+func (q *Queue) Backlog(ctx context.Context) (int64, error) {
+	consumer, err := q.js.Consumer(ctx, q.queue, q.queue)
+	if err != nil {
+		return -1, fmt.Errorf("Backlog: %w", err)
+	}
+	return backlog(ctx, consumer)
+}
+
+// backlog returns the number of messages pending or being processed
+func backlog(ctx context.Context, consumer jetstream.Consumer) (int64, error) {
+	info, err := consumer.Info(ctx)
+	if err != nil {
+		return -1, fmt.Errorf("Backlog: could not get consumer info: %w", err)
+	}
+	return int64(info.NumPending) + int64(info.NumAckPending), nil
+}
@@ -0,0 +1,143 @@
+package nats
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/airbusgeo/geocube/interface/messaging"
+	"github.com/airbusgeo/geocube/internal/utils"
+	"github.com/nats-io/nats-server/v2/server"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+func runServer(t *testing.T) jetstream.JetStream {
+	t.Helper()
+	s, err := server.NewServer(&server.Options{
+		Host:      "127.0.0.1",
+		Port:      -1,
+		JetStream: true,
+		StoreDir:  t.TempDir(),
+		NoLog:     true,
+		NoSigs:    true,
+	})
+	if err != nil {
+		t.Fatalf("NewServer: %v", err)
+	}
+	go s.Start()
+	if !s.ReadyForConnections(10 * time.Second) {
+		t.Fatal("nats server not ready")
+	}
+	t.Cleanup(s.Shutdown)
+
+	nc, js, err := Connect(s.ClientURL())
+	if err != nil {
+		t.Fatalf("Connect: %v", err)
+	}
+	t.Cleanup(nc.Close)
+	return js
+}
+
+func TestPublishPull(t *testing.T) {
+	ctx := context.Background()
+	js := runServer(t)
+
+	p, err := NewPublisher(ctx, js, "events")
+	if err != nil {
+		t.Fatalf("NewPublisher: %v", err)
+	}
+	c, err := NewConsumer(ctx, js, "events")
+	if err != nil {
+		t.Fatalf("NewConsumer: %v", err)
+	}
+	if err := p.Publish(ctx, []byte("msg1"), []byte("msg2")); err != nil {
+		t.Fatalf("Publish: %v", err)
+	}
+	if n, err := c.Backlog(ctx); err != nil || n != 2 {
+		t.Errorf("Backlog: expecting 2, found %d, %v", n, err)
+	}
+	if n, err := NewQueue(js, "events").Backlog(ctx); err != nil || n != 2 {
+		t.Errorf("Queue.Backlog: expecting 2, found %d, %v", n, err)
+	}
+
+	for _, expected := range []string{"msg1", "msg2"} {
+		if err := c.Pull(ctx, func(ctx context.Context, m *messaging.Message) error {
+			if string(m.Data) != expected || m.TryCount != 1 || m.PublishTime.IsZero() {
+				t.Errorf("Pull: unexpected message %s (try %d)", m.Data, m.TryCount)
+			}
+			return nil
+		}); err != nil {
+			t.Fatalf("Pull: %v", err)
+		}
+	}
+	if n, err := c.Backlog(ctx); err != nil || n != 0 {
+		t.Errorf("Backlog: expecting 0, found %d, %v", n, err)
+	}
+}
+
+func TestPublishWithAttributes(t *testing.T) {
+	ctx := context.Background()
+	js := runServer(t)
+
+	p, err := NewPublisher(ctx, js, "consolidations")
+	if err != nil {
+		t.Fatalf("NewPublisher: %v", err)
+	}
+	c, err := NewConsumer(ctx, js, "consolidations")
+	if err != nil {
+		t.Fatalf("NewConsumer: %v", err)
+	}
+	if err := p.PublishWithAttributes(ctx, []map[string]string{{"job_id": "job", "task_id": "task"}}, []byte("msg")); err != nil {
+		t.Fatalf("PublishWithAttributes: %v", err)
+	}
+	if err := c.Pull(ctx, func(ctx context.Context, m *messaging.Message) error {
+		if m.Attributes["job_id"] != "job" || m.Attributes["task_id"] != "task" {
+			t.Errorf("Pull: unexpected attributes %v", m.Attributes)
+		}
+		return nil
+	}); err != nil {
+		t.Fatalf("Pull: %v", err)
+	}
+}
+
+func TestRedelivery(t *testing.T) {
+	ctx := context.Background()
+	js := runServer(t)
+
+	p, _ := NewPublisher(ctx, js, "consolidations")
+	c, err := NewConsumer(ctx, js, "consolidations", WithMaxDeliveries(3), WithRedeliveryDelay(10*time.Millisecond))
+	if err != nil {
+		t.Fatalf("NewConsumer: %v", err)
+	}
+	p.Publish(ctx, []byte("temporary"), []byte("fatal"))
+
+	// The temporary error is retried until the max number of deliveries is reached,
+	// the fatal error is not retried
+	tries := map[string][]int{}
+	for i := 0; i < 4; i++ {
+		if err := c.Pull(ctx, func(ctx context.Context, m *messaging.Message) error {
+			tries[string(m.Data)] = append(tries[string(m.Data)], m.TryCount)
+			if string(m.Data) == "fatal" {
+				return errors.New("fatal")
+			}
+			return utils.MakeTemporary(errors.New("temporary"))
+		}); err != nil {
+			t.Fatalf("Pull: %v", err)
+		}
+	}
+	if len(tries["temporary"]) != 3 || tries["temporary"][2] != 3 || len(tries["fatal"]) != 1 {
+		t.Errorf("Pull: unexpected tries %v", tries)
+	}
+	// Term is asynchronous
+	var n int64
+	for i := 0; i < 10; i++ {
+		if n, err = c.Backlog(ctx); err != nil || n == 0 {
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	if err != nil || n != 0 {
+		t.Errorf("Backlog: expecting 0, found %d, %v", n, err)
+	}
+}
@@ -0,0 +1,134 @@
+package nats
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/airbusgeo/geocube/internal/utils"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// Connect connects to the NATS server and returns a JetStream context.
+// A connection can be shared by several publishers and consumers
+func Connect(url string) (*nats.Conn, jetstream.JetStream, error) {
+	nc, err := nats.Connect(url, nats.MaxReconnects(-1))
+	if err != nil {
+		return nil, nil, fmt.Errorf("nats.Connect: %w", err)
+	}
+	js, err := jetstream.New(nc)
+	if err != nil {
+		nc.Close()
+		return nil, nil, fmt.Errorf("nats.Connect: %w", err)
+	}
+	return nc, js, nil
+}
+
+// ensureStream creates the work-queue stream of the queue if it does not exist.
+// The stream has the name of the queue and stores the messages published on the subject of the same name
+func ensureStream(ctx context.Context, js jetstream.JetStream, queue string) (jetstream.Stream, error) {
+	stream, err := js.Stream(ctx, queue)
+	if errors.Is(err, jetstream.ErrStreamNotFound) {
+		stream, err = js.CreateStream(ctx, jetstream.StreamConfig{
+			Name:      queue,
+			Subjects:  []string{queue},
+			Retention: jetstream.WorkQueuePolicy,
+		})
+	}
+	if err != nil {
+		return nil, fmt.Errorf("stream %s: %w", queue, err)
+	}
+	return stream, nil
+}
+
+type PublisherOption func(o *Publisher)
+
+func WithMaxRetries(maxRetries int) PublisherOption {
+	return func(p *Publisher) {
+		p.maxRetries = maxRetries
+	}
+}
+
+// Publisher implements messaging.Publisher
+type Publisher struct {
+	js         jetstream.JetStream
+	subject    string
+	maxRetries int
+}
+
+// NewPublisher returns a NATS JetStream publisher, creating the stream of the queue if needed
+func NewPublisher(ctx context.Context, js jetstream.JetStream, queue string, opts ...PublisherOption) (*Publisher, error) {
+	if _, err := ensureStream(ctx, js, queue); err != nil {
+		return nil, fmt.Errorf("nats.NewPublisher: %w", err)
+	}
+	p := &Publisher{
+		js:      js,
+		subject: queue,
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p, nil
+}
+
+// Publish implements messaging.Publisher
+func (p *Publisher) Publish(ctx context.Context, data ...[]byte) error {
+	return p.publish(ctx, 0, nil, data...)
+}
+
+// PublishWithAttributes implements messaging.AttributesPublisher
+// The attributes are sent in the headers of the messages.
+func (p *Publisher) PublishWithAttributes(ctx context.Context, attributes []map[string]string, data ...[]byte) error {
+	return p.publish(ctx, 0, attributes, data...)
+}
+
+// publish with retry
+func (p *Publisher) publish(ctx context.Context, retry int, attributes []map[string]string, data ...[]byte) error {
+	var futures []jetstream.PubAckFuture
+	for i, d := range data {
+		msg := nats.NewMsg(p.subject)
+		msg.Data = d
+		if attributes != nil {
+			for k, v := range attributes[i] {
+				msg.Header.Set(k, v)
+			}
+		}
+		f, err := p.js.PublishMsgAsync(msg)
+		if err != nil {
+			return fmt.Errorf("nats.Publish: %w", err)
+		}
+		futures = append(futures, f)
+	}
+
+	ndata := [][]byte{}
+	var nattributes []map[string]string
+	for i, f := range futures {
+		select {
+		case <-f.Ok():
+		case err := <-f.Err():
+			if !retriable(err) || retry >= p.maxRetries {
+				return fmt.Errorf("nats.Publish: %w", err)
+			}
+			ndata = append(ndata, data[i])
+			if attributes != nil {
+				nattributes = append(nattributes, attributes[i])
+			}
+		case <-ctx.Done():
+			return fmt.Errorf("nats.Publish: %w", ctx.Err())
+		}
+	}
+
+	if len(ndata) > 0 {
+		time.Sleep(time.Second * time.Duration(math.Exp2(float64(retry))))
+		return p.publish(ctx, retry+1, nattributes, ndata...)
+	}
+	return nil
+}
+
+// retriable returns true if the publication can be retried (e.g. timeout or stream temporarily unavailable)
+func retriable(err error) bool {
+	return utils.Retriable(err) || errors.Is(err, nats.ErrNoResponders)
+}
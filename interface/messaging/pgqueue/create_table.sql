@@ -10,7 +10,12 @@ CREATE TABLE pgq_jobs (
   run_after TIMESTAMP WITH TIME ZONE NOT NULL,
   retry_waits TEXT[] NOT NULL,
   ran_at TIMESTAMP WITH TIME ZONE,
-  error TEXT
+  error TEXT,
+  -- Geocube: number of deliveries and lease of the job being processed
+  try_count INTEGER NOT NULL DEFAULT 0,
+  leased_until TIMESTAMP WITH TIME ZONE,
+  -- Geocube: attributes of the message
+  attributes JSONB NOT NULL DEFAULT '{}'
 );
 
 -- Add an index for fast fetching of jobs by queue_name, sorted by run_after.  But only
@@ -19,6 +24,29 @@ CREATE INDEX idx_pgq_jobs_fetch
 	ON pgq_jobs (queue_name, run_after)
 	WHERE ran_at IS NULL;
 
-GRANT SELECT, INSERT, UPDATE, DELETE ON TABLE pgq_jobs TO apiserver;
+-- Geocube: jobs that failed with a fatal error or too many times
+CREATE TABLE pgq_dead_letters (
+  id SERIAL PRIMARY KEY,
+  created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+  failed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+  queue_name TEXT NOT NULL,
+  data BYTEA NOT NULL,
+  attributes JSONB NOT NULL DEFAULT '{}',
+  error TEXT NOT NULL,
+  try_count INTEGER NOT NULL
+);
+
+CREATE INDEX idx_pgq_dead_letters_queue
+	ON pgq_dead_letters (queue_name, id);
+
+-- Geocube: the privileges are granted to the role apiserver, if it exists
+DO $$
+BEGIN
+  IF EXISTS (SELECT NULL FROM pg_roles WHERE rolname = 'apiserver') THEN
+    GRANT SELECT, INSERT, UPDATE, DELETE ON TABLE pgq_jobs TO apiserver;
+    GRANT SELECT, INSERT, UPDATE, DELETE ON TABLE pgq_dead_letters TO apiserver;
+  END IF;
+END
+$$;
 
 COMMIT;
\ No newline at end of file
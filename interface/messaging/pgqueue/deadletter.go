@@ -0,0 +1,154 @@
+package pgqueue
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/airbusgeo/geocube/interface/messaging"
+	"github.com/btubbs/pgq"
+	"github.com/lib/pq"
+)
+
+// DeadLetterQueue implements messaging.DeadLetterQueue using the pgq_dead_letters table
+type DeadLetterQueue struct {
+	db     *sql.DB
+	worker *pgq.Worker
+}
+
+var _ messaging.DeadLetterQueue = &DeadLetterQueue{}
+
+// NewDeadLetterQueue returns a pg dead-letter queue.
+// The worker is used to requeue the messages with their attributes (with the default retry policy of pgq)
+func NewDeadLetterQueue(db *sql.DB, w *pgq.Worker) *DeadLetterQueue {
+	return &DeadLetterQueue{db: db, worker: w}
+}
+
+// ListDeadLetters implements messaging.DeadLetterQueue
+func (q *DeadLetterQueue) ListDeadLetters(ctx context.Context, queue string, page, limit int) ([]*messaging.DeadLetter, error) {
+	query := "SELECT id, created_at, failed_at, queue_name, attributes, error, try_count FROM pgq_dead_letters"
+	args := []interface{}{}
+	if queue != "" {
+		query += " WHERE queue_name = $1"
+		args = append(args, queue)
+	}
+	query += " ORDER BY id"
+	if limit > 0 {
+		query += fmt.Sprintf(" LIMIT %d OFFSET %d", limit, page*limit)
+	}
+
+	rows, err := q.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("ListDeadLetters: %w", err)
+	}
+	defer rows.Close()
+
+	deadLetters := []*messaging.DeadLetter{}
+	for rows.Next() {
+		dl, err := scanDeadLetter(rows, false)
+		if err != nil {
+			return nil, fmt.Errorf("ListDeadLetters: %w", err)
+		}
+		deadLetters = append(deadLetters, dl)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("ListDeadLetters: %w", err)
+	}
+	return deadLetters, nil
+}
+
+// ReadDeadLetter implements messaging.DeadLetterQueue
+func (q *DeadLetterQueue) ReadDeadLetter(ctx context.Context, id int64) (*messaging.DeadLetter, error) {
+	dl, err := scanDeadLetter(q.db.QueryRowContext(ctx,
+		"SELECT id, created_at, failed_at, queue_name, attributes, error, try_count, data FROM pgq_dead_letters WHERE id = $1", id), true)
+	switch err {
+	case nil:
+		return dl, nil
+	case sql.ErrNoRows:
+		return nil, fmt.Errorf("ReadDeadLetter(%d): %w", id, messaging.ErrDeadLetterNotFound)
+	default:
+		return nil, fmt.Errorf("ReadDeadLetter: %w", err)
+	}
+}
+
+// RequeueDeadLetters implements messaging.DeadLetterQueue
+func (q *DeadLetterQueue) RequeueDeadLetters(ctx context.Context, ids []int64) (int64, error) {
+	tx, err := q.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("RequeueDeadLetters: %w", err)
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, "DELETE FROM pgq_dead_letters WHERE id = ANY($1) RETURNING queue_name, data, attributes", pq.Array(ids))
+	if err != nil {
+		return 0, fmt.Errorf("RequeueDeadLetters: %w", err)
+	}
+	type message struct {
+		queue      string
+		data       []byte
+		attributes []byte
+	}
+	var messages []message
+	for rows.Next() {
+		m := message{}
+		if err := rows.Scan(&m.queue, &m.data, &m.attributes); err != nil {
+			rows.Close()
+			return 0, fmt.Errorf("RequeueDeadLetters: %w", err)
+		}
+		messages = append(messages, m)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, fmt.Errorf("RequeueDeadLetters: %w", err)
+	}
+
+	for _, m := range messages {
+		var attributes map[string]string
+		if err := json.Unmarshal(m.attributes, &attributes); err != nil {
+			return 0, fmt.Errorf("RequeueDeadLetters: failed to unmarshal attributes: %w", err)
+		}
+		if err := enqueueJobInTx(ctx, q.worker, tx, m.queue, m.data, attributes); err != nil {
+			return 0, fmt.Errorf("RequeueDeadLetters: %w", err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("RequeueDeadLetters: %w", err)
+	}
+	return int64(len(messages)), nil
+}
+
+// PurgeDeadLetters implements messaging.DeadLetterQueue
+func (q *DeadLetterQueue) PurgeDeadLetters(ctx context.Context, queue string, ids []int64) (int64, error) {
+	var res sql.Result
+	var err error
+	if len(ids) > 0 {
+		res, err = q.db.ExecContext(ctx, "DELETE FROM pgq_dead_letters WHERE id = ANY($1) AND ($2 = '' OR queue_name = $2)", pq.Array(ids), queue)
+	} else {
+		res, err = q.db.ExecContext(ctx, "DELETE FROM pgq_dead_letters WHERE queue_name = $1", queue)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("PurgeDeadLetters: %w", err)
+	}
+	return res.RowsAffected()
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanDeadLetter(row scanner, withData bool) (*messaging.DeadLetter, error) {
+	dl := messaging.DeadLetter{}
+	var attributes []byte
+	dest := []interface{}{&dl.ID, &dl.Message.PublishTime, &dl.FailedAt, &dl.Queue, &attributes, &dl.Error, &dl.Message.TryCount}
+	if withData {
+		dest = append(dest, &dl.Message.Data)
+	}
+	if err := row.Scan(dest...); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(attributes, &dl.Message.Attributes); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal attributes: %w", err)
+	}
+	return &dl, nil
+}
@@ -0,0 +1,115 @@
+package pgqueue
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"errors"
+	"fmt"
+)
+
+//go:embed create_table.sql update_1.1.0.sql
+var scripts embed.FS
+
+// migrationsLockID is the key of the advisory lock taken during the migrations ("pgqueue")
+const migrationsLockID = 0x7067717565756565
+
+// ErrSchemaNotUpToDate is returned by CheckSchema if the tables of pgqueue must be migrated
+var ErrSchemaNotUpToDate = errors.New("the tables of pgqueue must be migrated (see --migrate)")
+
+// Migrate creates the tables of pgqueue (create_table.sql) or updates them to the last version (update_*.sql)
+// and returns the scripts that have been applied.
+// pgqueue does not record the version of its tables: it is guessed from the tables and the columns.
+// The migrations are applied under an advisory lock, so that several instances can be started concurrently.
+func Migrate(ctx context.Context, db *sql.DB) (applied []string, err error) {
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("pgqueue.Migrate.Conn: %w", err)
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", migrationsLockID); err != nil {
+		return nil, fmt.Errorf("pgqueue.Migrate.lock: %w", err)
+	}
+	defer func() {
+		if _, e := conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", migrationsLockID); e != nil && err == nil {
+			err = fmt.Errorf("pgqueue.Migrate.unlock: %w", e)
+		}
+	}()
+
+	hasJobs, upToDate, err := schemaState(ctx, conn)
+	if err != nil {
+		return nil, fmt.Errorf("pgqueue.Migrate.%w", err)
+	}
+	var script string
+	switch {
+	case !hasJobs:
+		// create_table.sql has its own transaction
+		script = "create_table.sql"
+	case !upToDate:
+		script = "update_1.1.0.sql"
+	default:
+		return nil, nil
+	}
+	if err := execScript(ctx, conn, script); err != nil {
+		return nil, fmt.Errorf("pgqueue.Migrate: %w", err)
+	}
+	return []string{script}, nil
+}
+
+// CheckSchema returns ErrSchemaNotUpToDate if the tables of pgqueue do not exist or are not up to date
+func CheckSchema(ctx context.Context, db *sql.DB) error {
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("pgqueue.CheckSchema.Conn: %w", err)
+	}
+	defer conn.Close()
+
+	hasJobs, upToDate, err := schemaState(ctx, conn)
+	if err != nil {
+		return fmt.Errorf("pgqueue.CheckSchema.%w", err)
+	}
+	if !hasJobs || !upToDate {
+		return fmt.Errorf("pgqueue.CheckSchema: %w", ErrSchemaNotUpToDate)
+	}
+	return nil
+}
+
+// schemaState returns whether the table pgq_jobs exists and whether the tables are up to date
+// (the last update adds the table pgq_dead_letters and the column pgq_jobs.attributes)
+func schemaState(ctx context.Context, conn *sql.Conn) (hasJobs, upToDate bool, err error) {
+	var hasDeadLetters, hasAttributes bool
+	if err := conn.QueryRowContext(ctx, `SELECT to_regclass('pgq_jobs') IS NOT NULL, to_regclass('pgq_dead_letters') IS NOT NULL,
+		EXISTS (SELECT NULL FROM pg_attribute WHERE attrelid = to_regclass('pgq_jobs') AND attname = 'attributes' AND NOT attisdropped)`).
+		Scan(&hasJobs, &hasDeadLetters, &hasAttributes); err != nil {
+		return false, false, fmt.Errorf("schemaState: %w", err)
+	}
+	return hasJobs, hasDeadLetters && hasAttributes, nil
+}
+
+// execScript executes the script in a transaction (unless the script has its own transaction)
+func execScript(ctx context.Context, conn *sql.Conn, script string) error {
+	query, err := scripts.ReadFile(script)
+	if err != nil {
+		return fmt.Errorf("execScript: %w", err)
+	}
+	if script == "create_table.sql" {
+		if _, err := conn.ExecContext(ctx, string(query)); err != nil {
+			return fmt.Errorf("execScript[%s]: %w", script, err)
+		}
+		return nil
+	}
+
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("execScript.BeginTx: %w", err)
+	}
+	defer tx.Rollback()
+	if _, err := tx.ExecContext(ctx, string(query)); err != nil {
+		return fmt.Errorf("execScript[%s]: %w", script, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("execScript.commit: %w", err)
+	}
+	return nil
+}
@@ -3,9 +3,12 @@ package pgqueue
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"math"
 	"os"
+	"strconv"
+	"sync"
 	"time"
 
 	"github.com/airbusgeo/geocube/interface/messaging"
@@ -31,8 +34,30 @@ func WithJobRetryWaits(waits []time.Duration) PublisherOption {
 	}
 }
 
-// Publisher implements messaging.Publisher
+// WithMaxTries sets the maximum number of tries of a job, including the tries that did not end (e.g. crash of the consumer)
+func WithMaxTries(maxTries int) ConsumerOption {
+	return func(c *Consumer) {
+		c.maxTries = maxTries
+	}
+}
+
+// WithLeaseDuration sets the duration after which a job is considered as abandoned if the consumer does not extend its lease
+func WithLeaseDuration(d time.Duration) ConsumerOption {
+	return func(c *Consumer) {
+		c.leaseDuration = d
+	}
+}
+
+// WithDeadLetterHandler sets a handler called when a job is moved to the dead-letter table
+func WithDeadLetterHandler(h messaging.DeadLetterHandler) ConsumerOption {
+	return func(c *Consumer) {
+		c.onDeadLetter = h
+	}
+}
+
+// Publisher implements messaging.Publisher and messaging.AttributesPublisher
 type Publisher struct {
+	db            *sql.DB
 	worker        *pgq.Worker
 	queueName     string
 	maxRetries    int
@@ -40,13 +65,26 @@ type Publisher struct {
 }
 
 // Consumer implements messaging.Consumer and interface.autoscaler.qbas.Queue
+// The jobs are leased during their processing, so that a job is not lost if the consumer crashes.
+// A job that returns a fatal error, a temporary error with no retry left or that is tried too many times
+// is moved to the dead-letter table (see DeadLetterQueue)
 type Consumer struct {
-	db        *sql.DB
-	worker    *pgq.Worker
-	queueName string
-	run       bool
+	db            *sql.DB
+	queueName     string
+	stopChan      chan bool
+	run           bool
+	maxTries      int
+	leaseDuration time.Duration
+	onDeadLetter  messaging.DeadLetterHandler
 }
 
+const (
+	// DefaultMaxTries is the default maximum number of tries of a job (including the crashes of the consumer)
+	DefaultMaxTries = 10
+	// DefaultLeaseDuration is the default duration of the lease of a job. The lease is extended during the processing.
+	DefaultLeaseDuration = time.Minute
+)
+
 func SetDefaultLogger() pgq.WorkerOption {
 	logger := logrus.New()
 	logger.SetFormatter(&logrus.JSONFormatter{})
@@ -69,9 +107,10 @@ func SqlConnect(ctx context.Context, dbConnection string) (*sql.DB, *pgq.Worker,
 }
 
 // NewPublisher returns a pg queue publisher.
-// A publisher can share its worker with another instance
-func NewPublisher(w *pgq.Worker, queueName string, opts ...PublisherOption) *Publisher {
+// A publisher can share its database and its worker with another instance
+func NewPublisher(db *sql.DB, w *pgq.Worker, queueName string, opts ...PublisherOption) *Publisher {
 	p := &Publisher{
+		db:            db,
 		queueName:     queueName,
 		worker:        w,
 		maxRetries:    0,
@@ -88,12 +127,18 @@ func (p *Publisher) Publish(ctx context.Context, data ...[]byte) error {
 	return p.publish(ctx, 0, data...)
 }
 
-// publish with retry
-func (p *Publisher) publish(ctx context.Context, retry int, data ...[]byte) error {
+// jobOptions returns the options of the published jobs
+func (p *Publisher) jobOptions() []pgq.JobOption {
 	var opts []pgq.JobOption
 	if len(p.jobRetryWaits) > 0 {
 		opts = append(opts, pgq.RetryWaits(p.jobRetryWaits))
 	}
+	return opts
+}
+
+// publish with retry
+func (p *Publisher) publish(ctx context.Context, retry int, data ...[]byte) error {
+	opts := p.jobOptions()
 	retryIds := []int{}
 	for _, d := range data {
 		jobID, err := p.worker.EnqueueJob(p.queueName, d, opts...)
@@ -117,13 +162,66 @@ func (p *Publisher) publish(ctx context.Context, retry int, data ...[]byte) erro
 	return nil
 }
 
+// PublishWithAttributes implements messaging.AttributesPublisher
+// The messages are published in a single transaction.
+func (p *Publisher) PublishWithAttributes(ctx context.Context, attributes []map[string]string, data ...[]byte) error {
+	return p.publishWithAttributes(ctx, 0, attributes, data...)
+}
+
+// publishWithAttributes with retry
+func (p *Publisher) publishWithAttributes(ctx context.Context, retry int, attributes []map[string]string, data ...[]byte) error {
+	err := func() error {
+		tx, err := p.db.BeginTx(ctx, nil)
+		if err != nil {
+			return err
+		}
+		defer tx.Rollback()
+		for i, d := range data {
+			var attrs map[string]string
+			if attributes != nil {
+				attrs = attributes[i]
+			}
+			if err := enqueueJobInTx(ctx, p.worker, tx, p.queueName, d, attrs, p.jobOptions()...); err != nil {
+				return err
+			}
+		}
+		return tx.Commit()
+	}()
+	if err != nil {
+		if !utils.Retriable(err) || retry >= p.maxRetries {
+			return fmt.Errorf("pgQueue.PublishWithAttributes: %w", err)
+		}
+		time.Sleep(time.Second * time.Duration(math.Exp2(float64(retry))))
+		return p.publishWithAttributes(ctx, retry+1, attributes, data...)
+	}
+	return nil
+}
+
+// enqueueJobInTx enqueues a job with the attributes of its message in the transaction
+func enqueueJobInTx(ctx context.Context, w *pgq.Worker, tx *sql.Tx, queueName string, data []byte, attributes map[string]string, opts ...pgq.JobOption) error {
+	jobID, err := w.EnqueueJobInTx(tx, queueName, data, opts...)
+	if err != nil || len(attributes) == 0 {
+		return err
+	}
+	attrs, err := json.Marshal(attributes)
+	if err != nil {
+		return fmt.Errorf("could not marshal attributes: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, "UPDATE pgq_jobs SET attributes = $1 WHERE id = $2", attrs, jobID); err != nil {
+		return fmt.Errorf("could not set the attributes of the job: %w", err)
+	}
+	return nil
+}
+
 // NewConsumer returns a pg queue consumer.
 // A consumer cannot share the worker with another instance
 func NewConsumer(db *sql.DB, queueName string, opts ...ConsumerOption) *Consumer {
 	c := &Consumer{
-		db:        db,
-		queueName: queueName,
-		worker:    pgq.NewWorker(db, SetDefaultLogger()),
+		db:            db,
+		queueName:     queueName,
+		stopChan:      make(chan bool),
+		maxTries:      DefaultMaxTries,
+		leaseDuration: DefaultLeaseDuration,
 	}
 	for _, opt := range opts {
 		opt(c)
@@ -133,11 +231,6 @@ func NewConsumer(db *sql.DB, queueName string, opts ...ConsumerOption) *Consumer
 
 // Pull implements Consumer
 func (c *Consumer) Pull(ctx context.Context, cb messaging.Callback) error {
-	cbwc := CallbackWithContext{
-		ctx: ctx,
-		cb:  cb,
-	}
-	c.worker.RegisterQueue(c.queueName, cbwc.handler)
 	c.run = true
 	defer func() {
 		c.run = false
@@ -145,10 +238,10 @@ func (c *Consumer) Pull(ctx context.Context, cb messaging.Callback) error {
 
 	for {
 		select {
-		case <-c.worker.StopChan:
+		case <-c.stopChan:
 			return nil
 		default:
-			if attemptedJob, err := c.worker.PerformNextJob(); err != nil {
+			if attemptedJob, err := c.performNextJob(ctx, cb); err != nil {
 				return errorx.Decorate(err, "exiting job runner")
 			} else if attemptedJob {
 				return nil
@@ -161,31 +254,159 @@ func (c *Consumer) Pull(ctx context.Context, cb messaging.Callback) error {
 
 func (c *Consumer) Stop() {
 	if c.run {
-		c.worker.StopChan <- true
+		c.stopChan <- true
 	}
 }
 
-type CallbackWithContext struct {
-	ctx context.Context
-	cb  messaging.Callback
+type job struct {
+	id         int64
+	createdAt  time.Time
+	data       []byte
+	retryWaits pgq.Durations
+	tryCount   int
+	lastError  sql.NullString
+	attributes []byte
 }
 
-func (c *CallbackWithContext) handler(data []byte) error {
-	if err := c.cb(c.ctx, &messaging.Message{
-		ID:          "",
-		Data:        data,
+// performNextJob leases the next job of the queue and processes it.
+// It returns true if a job has been attempted and an error only if there is a problem with the database.
+func (c *Consumer) performNextJob(ctx context.Context, cb messaging.Callback) (bool, error) {
+	j, err := c.leaseNextJob(ctx)
+	if err != nil || j == nil {
+		return false, err
+	}
+	msg := &messaging.Message{
+		ID:          strconv.FormatInt(j.id, 10),
+		Data:        j.data,
 		Attributes:  map[string]string{},
-		PublishTime: time.Time{},
-		TryCount:    -1,
-	}); err != nil {
-		if utils.Temporary(err) {
-			// Temporary error : retry
-			log.Logger(c.ctx).Warn("Temporary error: " + err.Error())
-			return err
+		PublishTime: j.createdAt,
+		TryCount:    j.tryCount,
+	}
+	if err := json.Unmarshal(j.attributes, &msg.Attributes); err != nil {
+		log.Logger(ctx).Sugar().Warnf("pgqueue: unable to read the attributes of the job %d: %v", j.id, err)
+	}
+
+	if j.tryCount > c.maxTries {
+		// The lease of the previous tries has expired: the consumer has probably crashed
+		err := fmt.Errorf("too many tries (%d): the consumer may have crashed (last error: %s)", c.maxTries, j.lastError.String)
+		log.Logger(ctx).Error("Fatal error: " + err.Error())
+		return true, c.deadLetter(ctx, j, msg, err)
+	}
+
+	stopHeartbeat := c.heartbeat(ctx, j.id, j.tryCount)
+	jobErr := c.process(ctx, cb, msg)
+	stopHeartbeat()
+
+	switch {
+	case jobErr == nil:
+		if _, err := c.db.ExecContext(ctx, "DELETE FROM pgq_jobs WHERE id = $1", j.id); err != nil {
+			return true, fmt.Errorf("could not delete job: %w", err)
+		}
+	case utils.Temporary(jobErr) && len(j.retryWaits) > 0:
+		// Temporary error : retry
+		log.Logger(ctx).Warn("Temporary error: " + jobErr.Error())
+		if _, err := c.db.ExecContext(ctx, "UPDATE pgq_jobs SET run_after = $1, retry_waits = $2, leased_until = NULL, error = $3 WHERE id = $4",
+			time.Now().Add(j.retryWaits[0]), j.retryWaits[1:], jobErr.Error(), j.id); err != nil {
+			return true, fmt.Errorf("could not reschedule job: %w", err)
+		}
+	default:
+		// Fatal error or no retry left : dead letter
+		log.Logger(ctx).Error("Fatal error: " + jobErr.Error())
+		return true, c.deadLetter(ctx, j, msg, jobErr)
+	}
+	return true, nil
+}
+
+// leaseNextJob increments the try count of the next job and leases it (returns nil if there is no job)
+func (c *Consumer) leaseNextJob(ctx context.Context) (*job, error) {
+	now := time.Now()
+	j := job{}
+	err := c.db.QueryRowContext(ctx, `
+		UPDATE pgq_jobs SET try_count = try_count + 1, leased_until = $3
+		WHERE id = (
+			SELECT id FROM pgq_jobs
+			WHERE
+				queue_name = $1
+				AND run_after < $2
+				AND ran_at IS NULL
+				AND (leased_until IS NULL OR leased_until < $2)
+			ORDER BY run_after
+			LIMIT 1
+			FOR UPDATE SKIP LOCKED)
+		RETURNING id, created_at, data, retry_waits, try_count, error, attributes`, c.queueName, now, now.Add(c.leaseDuration)).
+		Scan(&j.id, &j.createdAt, &j.data, &j.retryWaits, &j.tryCount, &j.lastError, &j.attributes)
+	switch err {
+	case nil:
+		return &j, nil
+	case sql.ErrNoRows:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("could not get next job: %w", err)
+	}
+}
+
+// heartbeat extends the lease of the job until the returned function is called.
+// The returned function waits for the end of the heartbeat, so that the lease is not extended after the job is rescheduled or finished.
+// The lease is only extended if the job is still leased by this try.
+func (c *Consumer) heartbeat(ctx context.Context, id int64, tryCount int) func() {
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(c.leaseDuration / 3)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				if _, err := c.db.ExecContext(ctx, "UPDATE pgq_jobs SET leased_until = $1 WHERE id = $2 AND leased_until IS NOT NULL AND try_count = $3",
+					time.Now().Add(c.leaseDuration), id, tryCount); err != nil {
+					log.Logger(ctx).Warn("pgqueue: failed to extend the lease of the job: " + err.Error())
+				}
+			}
+		}
+	}()
+	return func() {
+		close(done)
+		wg.Wait()
+	}
+}
+
+// process calls the callback, turning a panic into an error
+func (c *Consumer) process(ctx context.Context, cb messaging.Callback, msg *messaging.Message) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic in job handler: %v", r)
 		}
-		// Fatal error : acknowledgement
-		log.Logger(c.ctx).Error("Fatal error: " + err.Error())
-		return nil
+	}()
+	return cb(ctx, msg)
+}
+
+// deadLetter moves the job to the dead-letter table and calls the DeadLetterHandler
+func (c *Consumer) deadLetter(ctx context.Context, j *job, msg *messaging.Message, jobErr error) error {
+	attributes, err := json.Marshal(msg.Attributes)
+	if err != nil {
+		return fmt.Errorf("could not marshal attributes: %w", err)
+	}
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if _, err := tx.ExecContext(ctx, `INSERT INTO pgq_dead_letters (created_at, queue_name, data, attributes, error, try_count) VALUES ($1, $2, $3, $4, $5, $6)`,
+		j.createdAt, c.queueName, j.data, attributes, jobErr.Error(), j.tryCount); err != nil {
+		return fmt.Errorf("could not insert dead letter: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM pgq_jobs WHERE id = $1", j.id); err != nil {
+		return fmt.Errorf("could not delete job: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("could not commit dead letter: %w", err)
+	}
+	if c.onDeadLetter != nil {
+		c.onDeadLetter(ctx, msg, jobErr)
 	}
 	return nil
 }
@@ -0,0 +1,24 @@
+-- add the try count, the lease of the jobs being processed and the attributes of the messages
+ALTER TABLE pgq_jobs ADD COLUMN IF NOT EXISTS try_count INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE pgq_jobs ADD COLUMN IF NOT EXISTS leased_until TIMESTAMP WITH TIME ZONE;
+ALTER TABLE pgq_jobs ADD COLUMN IF NOT EXISTS attributes JSONB NOT NULL DEFAULT '{}';
+-- add the dead-letter table
+CREATE TABLE IF NOT EXISTS pgq_dead_letters (
+  id SERIAL PRIMARY KEY,
+  created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+  failed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+  queue_name TEXT NOT NULL,
+  data BYTEA NOT NULL,
+  attributes JSONB NOT NULL DEFAULT '{}',
+  error TEXT NOT NULL,
+  try_count INTEGER NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_pgq_dead_letters_queue ON pgq_dead_letters (queue_name, id);
+-- grant the privileges to the role apiserver, if it exists
+DO $$
+BEGIN
+  IF EXISTS (SELECT NULL FROM pg_roles WHERE rolname = 'apiserver') THEN
+    GRANT SELECT, INSERT, UPDATE, DELETE ON TABLE pgq_dead_letters TO apiserver;
+  END IF;
+END
+$$;
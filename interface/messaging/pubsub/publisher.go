@@ -45,29 +45,37 @@ func NewPublisher(ctx context.Context, projectID, topic string, opts ...Publishe
 
 // Publish implements messaging.Publisher
 func (p *Publisher) Publish(ctx context.Context, data ...[]byte) error {
-	return p.publish(ctx, 0, data...)
+	return p.publish(ctx, 0, nil, data...)
 }
 
-// Publish implements messaging.Publisher
-func (p *Publisher) publish(ctx context.Context, retry int, data ...[]byte) error {
+// PublishWithAttributes implements messaging.AttributesPublisher
+func (p *Publisher) PublishWithAttributes(ctx context.Context, attributes []map[string]string, data ...[]byte) error {
+	return p.publish(ctx, 0, attributes, data...)
+}
+
+// publish with retry
+func (p *Publisher) publish(ctx context.Context, retry int, attributes []map[string]string, data ...[]byte) error {
 	retryIds := []int{}
 
 	for i := 0; i < len(data); {
+		first := i
 		var results []*pubsub.PublishResult
 		for limit := pubsub.DefaultPublishSettings.BufferedByteLimit / 2; i < len(data) && limit > 0; i++ {
 			// len(data[i]) is assumed to be smaller than half the BufferedByteLimit.
 			// It's dirty, but it's a way to control the size of the buffered messages without knowing their exact size ((which is bigger than len(data[i]))
 			limit -= len(data[i])
-			results = append(results, p.topic.Publish(ctx, &pubsub.Message{
-				Data: data[i],
-			}))
+			msg := &pubsub.Message{Data: data[i]}
+			if attributes != nil {
+				msg.Attributes = attributes[i]
+			}
+			results = append(results, p.topic.Publish(ctx, msg))
 		}
 
-		for i, r := range results {
+		for j, r := range results {
 			// Block until the result is returned and a server-generated ID is returned for the published message.
 			if _, err := r.Get(ctx); err != nil {
 				if utils.Retriable(err) && retry < p.maxRetries {
-					retryIds = append(retryIds, i)
+					retryIds = append(retryIds, first+j)
 				} else {
 					return fmt.Errorf("Publish: %w", err)
 				}
@@ -77,11 +85,15 @@ func (p *Publisher) publish(ctx context.Context, retry int, data ...[]byte) erro
 
 	if len(retryIds) > 0 {
 		ndata := [][]byte{}
+		var nattributes []map[string]string
 		for _, i := range retryIds {
 			ndata = append(ndata, data[i])
+			if attributes != nil {
+				nattributes = append(nattributes, attributes[i])
+			}
 		}
 		time.Sleep(time.Second * time.Duration(math.Exp2(float64(retry))))
-		return p.publish(ctx, retry+1, ndata...)
+		return p.publish(ctx, retry+1, nattributes, ndata...)
 	}
 
 	return nil
@@ -0,0 +1,474 @@
+package azure
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/blob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/bloberror"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/container"
+
+	geocubeStorage "github.com/airbusgeo/geocube/interface/storage"
+	"github.com/airbusgeo/geocube/internal/utils"
+)
+
+// uploadBlockSize is the size of the blocks staged by UploadFile and Upload
+const uploadBlockSize = 16 * 1024 * 1024
+
+type azStrategy struct {
+	azClient *azblob.Client
+	ctx      context.Context
+}
+
+// Config of the Azure Blob Storage client
+type Config struct {
+	ConnectionString string // If defined, the other fields are ignored
+	AccountName      string
+	AccountKey       string // If empty, the blobs are anonymously accessed
+	Endpoint         string // Blob service url (e.g. Azurite emulator: http://127.0.0.1:10000/devstoreaccount1). Default: https://<AccountName>.blob.core.windows.net/
+}
+
+// defaultConfig is loaded from the environment variables also used by GDAL
+var defaultConfig = Config{
+	ConnectionString: os.Getenv("AZURE_STORAGE_CONNECTION_STRING"),
+	AccountName:      os.Getenv("AZURE_STORAGE_ACCOUNT"),
+	AccountKey:       os.Getenv("AZURE_STORAGE_ACCESS_KEY"),
+}
+
+// SetDefaultConfig defines the configuration used by NewAzStrategy
+func SetDefaultConfig(config Config) {
+	defaultConfig = config
+}
+
+// DefaultConfig returns the configuration used by NewAzStrategy
+func DefaultConfig() Config {
+	return defaultConfig
+}
+
+var retriableSuffixErrors = []string{
+	"connection reset by peer",
+	"cannot assign requested address",
+	"broken pipe",
+	"EOF", // Unexpected EOF is a temporary error
+}
+
+func AzError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if bloberror.HasCode(err, bloberror.BlobNotFound) {
+		return geocubeStorage.ErrFileNotFound
+	}
+	var respErr *azcore.ResponseError
+	if errors.As(err, &respErr) {
+		if respErr.StatusCode == 429 || (respErr.StatusCode >= 500 && respErr.StatusCode < 600) {
+			return utils.MakeTemporary(err)
+		}
+	}
+	if utils.Temporary(err) {
+		return err
+	}
+	for _, e := range retriableSuffixErrors {
+		if strings.HasSuffix(err.Error(), e) {
+			return utils.MakeTemporary(err)
+		}
+	}
+	return err
+}
+
+// NewClient creates an azblob client using the configuration
+func NewClient(config Config) (*azblob.Client, error) {
+	if config.ConnectionString != "" {
+		return azblob.NewClientFromConnectionString(config.ConnectionString, nil)
+	}
+	serviceURL := config.Endpoint
+	if serviceURL == "" {
+		if config.AccountName == "" {
+			return nil, fmt.Errorf("missing azure storage account or endpoint")
+		}
+		serviceURL = fmt.Sprintf("https://%s.blob.core.windows.net/", config.AccountName)
+	}
+	if config.AccountKey == "" {
+		return azblob.NewClientWithNoCredential(serviceURL, nil)
+	}
+	cred, err := azblob.NewSharedKeyCredential(config.AccountName, config.AccountKey)
+	if err != nil {
+		return nil, err
+	}
+	return azblob.NewClientWithSharedKeyCredential(serviceURL, cred, nil)
+}
+
+// NewAzStrategy creates an Azure Blob Storage strategy using the default configuration (see SetDefaultConfig)
+func NewAzStrategy(ctx context.Context) (geocubeStorage.Strategy, error) {
+	return NewAzStrategyWithConfig(ctx, defaultConfig)
+}
+
+// NewAzStrategyWithConfig creates an Azure Blob Storage strategy using a custom configuration
+func NewAzStrategyWithConfig(ctx context.Context, config Config) (geocubeStorage.Strategy, error) {
+	azClient, err := NewClient(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create azure blob Client : %w", AzError(err))
+	}
+
+	return azStrategy{
+		azClient: azClient,
+		ctx:      ctx,
+	}, nil
+}
+
+func (s azStrategy) Download(ctx context.Context, uri string, options ...geocubeStorage.Option) ([]byte, error) {
+	container, blobName, err := s.decodeURI(ctx, uri)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode URI %s : %w", uri, err)
+	}
+
+	buf := &bytes.Buffer{}
+	if err := s.downloadBlobTo(ctx, container, blobName, buf, options...); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (s azStrategy) DownloadToFile(ctx context.Context, source, destination string, options ...geocubeStorage.Option) error {
+	container, blobName, err := s.decodeURI(ctx, source)
+	if err != nil {
+		return fmt.Errorf("failed to decode URI %s : %w", source, err)
+	}
+
+	if _, err := os.Stat(filepath.Dir(destination)); os.IsNotExist(err) {
+		if err := os.MkdirAll(filepath.Dir(destination), os.ModePerm); err != nil {
+			return err
+		}
+	}
+
+	writer, err := os.Create(destination)
+	if err != nil {
+		return fmt.Errorf("failed to create destination file")
+	}
+
+	if err = s.downloadBlobTo(ctx, container, blobName, writer, options...); err != nil {
+		writer.Close()
+		return fmt.Errorf("failed to download object to destination: %w", err)
+	}
+
+	if err = writer.Close(); err != nil {
+		return fmt.Errorf("DownloadToFile: failed to close writer: %w", err)
+	}
+
+	return nil
+}
+
+func (s azStrategy) Upload(ctx context.Context, uri string, data []byte, options ...geocubeStorage.Option) error {
+	container, blobName, err := s.decodeURI(ctx, uri)
+	if err != nil {
+		return fmt.Errorf("failed to decode URI %s : %w", uri, err)
+	}
+
+	op := geocubeStorage.Apply(options...)
+	d := op.Delay
+	for try := 0; try < op.MaxTries; try++ {
+		if try > 0 {
+			time.Sleep(d)
+			d *= 2
+		}
+		_, err = s.azClient.UploadBuffer(ctx, container, blobName, data, &azblob.UploadBufferOptions{
+			AccessTier:  accessTier(op.StorageClass),
+			BlockSize:   uploadBlockSize,
+			Concurrency: uint16(op.Concurrency),
+		})
+		err = AzError(err)
+		if err == nil {
+			return nil
+		}
+		if !utils.Retriable(err) {
+			return fmt.Errorf("az.upload: %w", err)
+		}
+	}
+	return fmt.Errorf("failed after %d retries: %w", op.MaxTries, err)
+}
+
+// UploadFile stages the content of data by blocks of uploadBlockSize and commits them
+func (s azStrategy) UploadFile(ctx context.Context, uri string, data io.ReadCloser, options ...geocubeStorage.Option) error {
+	container, blobName, err := s.decodeURI(ctx, uri)
+	if err != nil {
+		return fmt.Errorf("failed to decode URI %s : %w", uri, err)
+	}
+
+	op := geocubeStorage.Apply(options...)
+	_, err = s.azClient.UploadStream(ctx, container, blobName, data, &azblob.UploadStreamOptions{
+		BlockSize:   uploadBlockSize,
+		Concurrency: op.Concurrency,
+		AccessTier:  accessTier(op.StorageClass),
+	})
+	if err != nil {
+		return fmt.Errorf("UploadFile: %w", AzError(err))
+	}
+
+	return nil
+}
+
+func (s azStrategy) Delete(ctx context.Context, uri string, options ...geocubeStorage.Option) error {
+	container, blobName, err := s.decodeURI(ctx, uri)
+	if err != nil {
+		return fmt.Errorf("failed to decode URI %s : %w", uri, err)
+	}
+
+	op := geocubeStorage.Apply(options...)
+	d := op.Delay
+	for try := 0; try < op.MaxTries; try++ {
+		if try > 0 {
+			time.Sleep(d)
+			d *= 2
+		}
+		_, err = s.azClient.DeleteBlob(ctx, container, blobName, nil)
+		err = AzError(err)
+		if err == nil {
+			return nil
+		}
+		if op.IgnoreNotFound && errors.Is(err, geocubeStorage.ErrFileNotFound) {
+			return nil
+		}
+		if !utils.Retriable(err) {
+			return fmt.Errorf("az.deleteBlob[%s/%s]: %w", container, blobName, err)
+		}
+	}
+	return fmt.Errorf("failed after %d retries: %w", op.MaxTries, err)
+}
+
+func (s azStrategy) BulkDelete(ctx context.Context, uris []string, options ...geocubeStorage.Option) error {
+	return geocubeStorage.ParallelDelete(ctx, s.Delete, uris, options...)
+}
+
+// List returns the blobs whose name starts with prefix (az://container/prefix)
+// As the continuation token of Azure is opaque, StartAfter is applied by the client.
+func (s azStrategy) List(ctx context.Context, prefix string, options ...geocubeStorage.Option) (geocubeStorage.ObjectIterator, error) {
+	container, blobName := parse(prefix)
+	if container == "" {
+		return nil, fmt.Errorf("failed to decode URI %s : missing container", prefix)
+	}
+
+	opts := geocubeStorage.Apply(options...)
+	var startAfter string
+	if opts.StartAfter != "" {
+		_, startAfter = parse(opts.StartAfter)
+	}
+
+	return &objectIterator{
+		ctx:        ctx,
+		container:  container,
+		startAfter: startAfter,
+		pager: s.azClient.NewListBlobsFlatPager(container, &azblob.ListBlobsFlatOptions{
+			Prefix:     &blobName,
+			MaxResults: to.Ptr(int32(opts.PageSize)),
+		}),
+	}, nil
+}
+
+type objectIterator struct {
+	ctx        context.Context
+	container  string
+	startAfter string
+	pager      *runtime.Pager[azblob.ListBlobsFlatResponse]
+	page       []*container.BlobItem
+}
+
+func (it *objectIterator) Next() (geocubeStorage.ObjectAttrs, error) {
+	for {
+		for len(it.page) == 0 {
+			if !it.pager.More() {
+				return geocubeStorage.ObjectAttrs{}, geocubeStorage.ErrIteratorDone
+			}
+			page, err := it.pager.NextPage(it.ctx)
+			if err != nil {
+				return geocubeStorage.ObjectAttrs{}, fmt.Errorf("az.list[%s]: %w", it.container, AzError(err))
+			}
+			it.page = page.Segment.BlobItems
+		}
+		item := it.page[0]
+		it.page = it.page[1:]
+		if item.Name == nil || *item.Name <= it.startAfter {
+			continue
+		}
+
+		o := geocubeStorage.ObjectAttrs{
+			URI:          "az://" + it.container + "/" + *item.Name,
+			StorageClass: string(blob.AccessTierHot),
+		}
+		if props := item.Properties; props != nil {
+			if props.AccessTier != nil {
+				o.StorageClass = string(*props.AccessTier)
+			}
+			if props.ContentLength != nil {
+				o.Size = *props.ContentLength
+			}
+			if props.LastModified != nil {
+				o.ModTime = *props.LastModified
+			}
+		}
+		return o, nil
+	}
+}
+
+func (s azStrategy) Exist(ctx context.Context, uri string) (bool, error) {
+	container, blobName, err := s.decodeURI(ctx, uri)
+	if err != nil {
+		return false, fmt.Errorf("failed to decode URI %s : %w", uri, err)
+	}
+
+	if _, err = s.blobClient(container, blobName).GetProperties(ctx, nil); err != nil {
+		err = AzError(err)
+		if errors.Is(err, geocubeStorage.ErrFileNotFound) {
+			return false, geocubeStorage.ErrFileNotFound
+		}
+		return false, fmt.Errorf("failed to check if file exist on storage: %w", err)
+	}
+
+	return true, nil
+}
+
+func (s azStrategy) GetAttrs(ctx context.Context, uri string) (geocubeStorage.Attrs, error) {
+	container, blobName, err := s.decodeURI(ctx, uri)
+	if err != nil {
+		return geocubeStorage.Attrs{}, fmt.Errorf("failed to decode URI %s : %w", uri, err)
+	}
+
+	props, err := s.blobClient(container, blobName).GetProperties(ctx, nil)
+	if err = AzError(err); errors.Is(err, geocubeStorage.ErrFileNotFound) {
+		return geocubeStorage.Attrs{}, geocubeStorage.ErrFileNotFound
+	} else if err != nil {
+		return geocubeStorage.Attrs{}, fmt.Errorf("failed to get file attributes from Azure : %w", err)
+	}
+
+	// The access tier is not always returned (e.g. when it is inherited from the account)
+	tier := string(blob.AccessTierHot)
+	if props.AccessTier != nil {
+		tier = *props.AccessTier
+	}
+
+	attrs := geocubeStorage.Attrs{StorageClass: tier}
+	if props.ETag != nil {
+		attrs.Version = string(*props.ETag)
+	}
+	if props.ContentType != nil {
+		attrs.ContentType = *props.ContentType
+	}
+	if props.ContentLength != nil {
+		attrs.Size = *props.ContentLength
+	}
+	// Content-MD5 is computed by Azure for the blobs uploaded at once (not for the blobs uploaded block by block)
+	attrs.MD5 = hex.EncodeToString(props.ContentMD5)
+	return attrs, nil
+}
+
+func (s azStrategy) StreamAt(key string, off int64, n int64) (io.ReadCloser, int64, error) {
+	container, blobName, err := Parse(key)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	r, err := s.blobClient(container, blobName).DownloadStream(s.ctx, &blob.DownloadStreamOptions{
+		Range: blob.HTTPRange{Offset: off, Count: n},
+	})
+	if err != nil {
+		if off > 0 && bloberror.HasCode(err, bloberror.InvalidRange) {
+			return nil, 0, io.EOF
+		}
+		if bloberror.HasCode(err, bloberror.BlobNotFound, bloberror.ContainerNotFound) {
+			return nil, -1, syscall.ENOENT
+		}
+		return nil, 0, fmt.Errorf("new reader for az://%s/%s: %w", container, blobName, AzError(err))
+	}
+
+	var size int64
+	if r.ContentRange != nil {
+		size, _ = totalSize(*r.ContentRange)
+	} else if r.ContentLength != nil {
+		size = *r.ContentLength
+	}
+	return r.Body, size, nil
+}
+
+func (s *azStrategy) decodeURI(_ context.Context, uri string) (string, string, error) {
+	container, blobName, err := Parse(uri)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to parse URI : %s : %w", uri, err)
+	}
+
+	return container, blobName, nil
+}
+
+func (s azStrategy) blobClient(container, blobName string) *blob.Client {
+	return s.azClient.ServiceClient().NewContainerClient(container).NewBlobClient(blobName)
+}
+
+func (s azStrategy) downloadBlobTo(ctx context.Context, container, blobName string, w io.Writer, opts ...geocubeStorage.Option) error {
+	op := geocubeStorage.Apply(opts...)
+	d := op.Delay
+	var err error
+	curOffset := op.Offset
+	bytesRemaining := op.Length
+	for try := 0; try < op.MaxTries; try++ {
+		if try > 0 {
+			time.Sleep(d)
+			d *= 2
+		}
+		httpRange := blob.HTTPRange{Offset: curOffset}
+		if bytesRemaining > 0 {
+			httpRange.Count = bytesRemaining
+		}
+		var r azblob.DownloadStreamResponse
+		r, err = s.azClient.DownloadStream(ctx, container, blobName, &azblob.DownloadStreamOptions{Range: httpRange})
+		if err != nil {
+			err = AzError(err)
+			if utils.Retriable(err) {
+				continue
+			} else {
+				return fmt.Errorf("az.download.newreader: %w", err)
+			}
+		}
+
+		var n int64
+		n, err = io.Copy(w, r.Body)
+		r.Body.Close()
+		if err == nil {
+			return nil
+		}
+		err = AzError(err)
+		if !utils.Retriable(err) {
+			return fmt.Errorf("az.download.copy: %w", err)
+		}
+
+		curOffset += n
+		if bytesRemaining > 0 {
+			bytesRemaining -= n
+		}
+	}
+	return fmt.Errorf("failed after %d retries: %w", op.MaxTries, err)
+}
+
+// accessTier converts the storage class option to an azure access tier (Hot, Cool, Cold, Archive)
+func accessTier(storageClass string) *blob.AccessTier {
+	if storageClass == "" {
+		return nil
+	}
+	for _, tier := range blob.PossibleAccessTierValues() {
+		if strings.EqualFold(string(tier), storageClass) {
+			return &tier
+		}
+	}
+	tier := blob.AccessTier(storageClass)
+	return &tier
+}
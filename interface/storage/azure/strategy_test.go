@@ -0,0 +1,320 @@
+package azure
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	geocubeStorage "github.com/airbusgeo/geocube/interface/storage"
+)
+
+// fakeAzurite is a minimal Azure Blob Storage emulator implementing the requests used by the strategy
+type fakeAzurite struct {
+	mu     sync.Mutex
+	blobs  map[string][]byte
+	tiers  map[string]string
+	blocks map[string][]byte
+}
+
+var blockListRegex = regexp.MustCompile(`<(?:Latest|Uncommitted|Committed)>([^<]+)</`)
+
+func newFakeAzurite() *fakeAzurite {
+	return &fakeAzurite{blobs: map[string][]byte{}, tiers: map[string]string{}, blocks: map[string][]byte{}}
+}
+
+func (f *fakeAzurite) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	// Path: /account/container/blob
+	key := strings.SplitN(strings.TrimPrefix(r.URL.Path, "/"), "/", 2)[1]
+	q := r.URL.Query()
+	errorCode := func(status int, code string) {
+		w.Header().Set("x-ms-error-code", code)
+		w.WriteHeader(status)
+		if r.Method != http.MethodHead {
+			fmt.Fprintf(w, `<?xml version="1.0" encoding="utf-8"?><Error><Code>%s</Code><Message>%s</Message></Error>`, code, code)
+		}
+	}
+	switch {
+	case r.Method == http.MethodGet && q.Get("comp") == "list":
+		f.list(w, key, q.Get("prefix"), q.Get("marker"), q.Get("maxresults"))
+	case r.Method == http.MethodPut && q.Get("comp") == "block":
+		f.blocks[key+"/"+q.Get("blockid")], _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusCreated)
+	case r.Method == http.MethodPut && q.Get("comp") == "blocklist":
+		body, _ := io.ReadAll(r.Body)
+		var data []byte
+		for _, m := range blockListRegex.FindAllStringSubmatch(string(body), -1) {
+			data = append(data, f.blocks[key+"/"+m[1]]...)
+		}
+		f.blobs[key] = data
+		f.tiers[key] = r.Header.Get("x-ms-access-tier")
+		w.WriteHeader(http.StatusCreated)
+	case r.Method == http.MethodPut:
+		f.blobs[key], _ = io.ReadAll(r.Body)
+		f.tiers[key] = r.Header.Get("x-ms-access-tier")
+		w.WriteHeader(http.StatusCreated)
+	case r.Method == http.MethodDelete:
+		if _, ok := f.blobs[key]; !ok {
+			errorCode(http.StatusNotFound, "BlobNotFound")
+			return
+		}
+		delete(f.blobs, key)
+		w.WriteHeader(http.StatusAccepted)
+	case r.Method == http.MethodHead:
+		data, ok := f.blobs[key]
+		if !ok {
+			errorCode(http.StatusNotFound, "BlobNotFound")
+			return
+		}
+		if f.tiers[key] != "" {
+			w.Header().Set("x-ms-access-tier", f.tiers[key])
+		}
+		md5sum := md5.Sum(data)
+		w.Header().Set("Content-MD5", base64.StdEncoding.EncodeToString(md5sum[:]))
+		w.Header().Set("x-ms-blob-type", "BlockBlob")
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+	case r.Method == http.MethodGet:
+		data, ok := f.blobs[key]
+		if !ok {
+			errorCode(http.StatusNotFound, "BlobNotFound")
+			return
+		}
+		rng := r.Header.Get("x-ms-range")
+		if rng == "" {
+			rng = r.Header.Get("Range")
+		}
+		w.Header().Set("x-ms-blob-type", "BlockBlob")
+		var from, to int
+		if n, _ := fmt.Sscanf(rng, "bytes=%d-%d", &from, &to); n == 0 {
+			w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+			w.Write(data)
+			return
+		} else if n == 1 || to >= len(data) {
+			to = len(data) - 1
+		}
+		if from >= len(data) {
+			errorCode(http.StatusRequestedRangeNotSatisfiable, "InvalidRange")
+			return
+		}
+		w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", from, to, len(data)))
+		w.Header().Set("Content-Length", strconv.Itoa(to-from+1))
+		w.WriteHeader(http.StatusPartialContent)
+		w.Write(data[from : to+1])
+	default:
+		w.WriteHeader(http.StatusNotImplemented)
+	}
+}
+
+// list implements List Blobs (the marker is the last returned blob)
+func (f *fakeAzurite) list(w http.ResponseWriter, container, prefix, marker, maxResults string) {
+	max, _ := strconv.Atoi(maxResults)
+	var names []string
+	for key := range f.blobs {
+		if name := strings.TrimPrefix(key, container+"/"); name != key && strings.HasPrefix(name, prefix) && name > marker {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	nextMarker := ""
+	if max > 0 && len(names) > max {
+		names = names[:max]
+		nextMarker = names[max-1]
+	}
+	w.Header().Set("Content-Type", "application/xml")
+	fmt.Fprintf(w, `<?xml version="1.0" encoding="utf-8"?><EnumerationResults ContainerName="%s"><Blobs>`, container)
+	for _, name := range names {
+		tier := f.tiers[container+"/"+name]
+		if tier == "" {
+			tier = "Hot"
+		}
+		fmt.Fprintf(w, `<Blob><Name>%s</Name><Properties><Last-Modified>Mon, 01 Jan 2024 00:00:00 GMT</Last-Modified><Content-Length>%d</Content-Length><AccessTier>%s</AccessTier></Properties></Blob>`,
+			name, len(f.blobs[container+"/"+name]), tier)
+	}
+	fmt.Fprintf(w, `</Blobs><NextMarker>%s</NextMarker></EnumerationResults>`, nextMarker)
+}
+
+// newTestStrategy returns a strategy connected to GEOCUBE_TEST_AZURE_CONNECTION_STRING (e.g. an Azurite emulator) or to a fake emulator
+// and the container to use for the tests (GEOCUBE_TEST_AZURE_CONTAINER)
+func newTestStrategy(t *testing.T) (geocubeStorage.Strategy, string) {
+	config := Config{ConnectionString: os.Getenv("GEOCUBE_TEST_AZURE_CONNECTION_STRING")}
+	container := os.Getenv("GEOCUBE_TEST_AZURE_CONTAINER")
+	if config.ConnectionString == "" {
+		server := httptest.NewServer(newFakeAzurite())
+		t.Cleanup(server.Close)
+		config = Config{
+			AccountName: "devstoreaccount1",
+			AccountKey:  "Eby8vdM02xNOcqFlqUwJPLlmEtlCDXJ1OUzFT50uSRZ6IFsuFq2UVErCz4I6tq/K1SZFPTOtr/KBHBeksoGMGw==",
+			Endpoint:    server.URL + "/devstoreaccount1",
+		}
+		container = "container"
+	}
+	s, err := NewAzStrategyWithConfig(context.Background(), config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s, container
+}
+
+func TestParse(t *testing.T) {
+	test := func(u string, mustErr bool, expc, expb string) {
+		t.Helper()
+		c, b, err := Parse(u)
+		if mustErr {
+			if err == nil {
+				t.Error("error not raised")
+			}
+			return
+		}
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if c != expc || b != expb {
+			t.Errorf("got \"%s\", \"%s\" expected \"%s\", \"%s\"", c, b, expc, expb)
+		}
+	}
+	test("az://container/blob.tif", false, "container", "blob.tif")
+	test("az://container/path/to/blob.tif", false, "container", "path/to/blob.tif")
+	test("container/path/to/blob.tif", false, "container", "path/to/blob.tif")
+	test("az://container", true, "", "")
+	test("az://container/", true, "", "")
+}
+
+func TestUploadDownload(t *testing.T) {
+	ctx := context.Background()
+	s, container := newTestStrategy(t)
+	uri := "az://" + container + "/path/to/blob.bin"
+	data := []byte("0123456789")
+
+	if err := s.Upload(ctx, uri, data, geocubeStorage.StorageClass("cool")); err != nil {
+		t.Fatalf("Upload: %v", err)
+	}
+	if exist, err := s.Exist(ctx, uri); !exist || err != nil {
+		t.Errorf("Exist: expecting true, found %v, %v", exist, err)
+	}
+	attrs, err := s.GetAttrs(ctx, uri)
+	if err != nil {
+		t.Fatalf("GetAttrs: %v", err)
+	}
+	if attrs.Size != int64(len(data)) || attrs.StorageClass != "Cool" {
+		t.Errorf("GetAttrs: unexpected attributes %+v", attrs)
+	}
+	if expected, _, _ := geocubeStorage.ComputeChecksums(bytes.NewReader(data)); attrs.MD5 != expected.MD5 {
+		t.Errorf("GetAttrs: expecting md5 %s, found %s", expected.MD5, attrs.MD5)
+	}
+
+	got, err := s.Download(ctx, uri, geocubeStorage.Offset(2), geocubeStorage.Length(3))
+	if err != nil {
+		t.Fatalf("Download: %v", err)
+	}
+	if !bytes.Equal(got, data[2:5]) {
+		t.Errorf("Download: expecting %s, found %s", data[2:5], got)
+	}
+
+	r, size, err := s.StreamAt(uri, 4, 100)
+	if err != nil {
+		t.Fatalf("StreamAt: %v", err)
+	}
+	got, _ = io.ReadAll(r)
+	r.Close()
+	if size != int64(len(data)) || !bytes.Equal(got, data[4:]) {
+		t.Errorf("StreamAt: expecting %s (size %d), found %s (size %d)", data[4:], len(data), got, size)
+	}
+	if _, _, err := s.StreamAt(uri, 100, 10); err != io.EOF {
+		t.Errorf("StreamAt: expecting EOF, found %v", err)
+	}
+
+	if err := s.Delete(ctx, uri); err != nil {
+		t.Errorf("Delete: expecting nil error, found %v", err)
+	}
+	if _, err := s.Exist(ctx, uri); !errors.Is(err, geocubeStorage.ErrFileNotFound) {
+		t.Errorf("Exist: expecting ErrFileNotFound, found %v", err)
+	}
+	if err := s.Delete(ctx, uri); !errors.Is(err, geocubeStorage.ErrFileNotFound) {
+		t.Errorf("Delete: expecting ErrFileNotFound, found %v", err)
+	}
+	if err := s.Delete(ctx, uri, geocubeStorage.IgnoreNotFound()); err != nil {
+		t.Errorf("Delete: expecting nil error, found %v", err)
+	}
+}
+
+func TestUploadFile(t *testing.T) {
+	ctx := context.Background()
+	s, container := newTestStrategy(t)
+	uri := "az://" + container + "/blocks.bin"
+	data := make([]byte, 2*uploadBlockSize+123)
+	for i := range data {
+		data[i] = byte(i % 251)
+	}
+
+	if err := s.UploadFile(ctx, uri, io.NopCloser(bytes.NewReader(data))); err != nil {
+		t.Fatalf("UploadFile: %v", err)
+	}
+	defer s.Delete(ctx, uri, geocubeStorage.IgnoreNotFound())
+
+	dest := t.TempDir() + "/blocks.bin"
+	if err := s.DownloadToFile(ctx, uri, dest); err != nil {
+		t.Fatalf("DownloadToFile: %v", err)
+	}
+	if got, _ := os.ReadFile(dest); !bytes.Equal(got, data) {
+		t.Errorf("DownloadToFile: uploaded and downloaded data are different (%d/%d bytes)", len(got), len(data))
+	}
+}
+
+func TestList(t *testing.T) {
+	ctx := context.Background()
+	s, container := newTestStrategy(t)
+	prefix := "az://" + container + "/list/"
+	names := []string{"a.tif", "b.tif", "c/d.tif", "e.tif", "f.tif"}
+	for _, name := range names {
+		if err := s.Upload(ctx, prefix+name, []byte(name), geocubeStorage.StorageClass("Cool")); err != nil {
+			t.Fatalf("Upload: %v", err)
+		}
+	}
+
+	it, err := s.List(ctx, prefix, geocubeStorage.PageSize(2), geocubeStorage.StartAfter(prefix+"a.tif"))
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	objects, err := geocubeStorage.ListAll(it)
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(objects) != len(names)-1 {
+		t.Fatalf("List: expecting %d objects, found %d", len(names)-1, len(objects))
+	}
+	for i, o := range objects {
+		if o.URI != prefix+names[i+1] || o.Size != int64(len(names[i+1])) || o.StorageClass != "Cool" || o.ModTime.IsZero() {
+			t.Errorf("List: unexpected object %+v", o)
+		}
+	}
+
+	uris := make([]string, len(names))
+	for i, name := range names {
+		uris[i] = prefix + name
+	}
+	if err := s.BulkDelete(ctx, uris); err != nil {
+		t.Errorf("BulkDelete: expecting nil error, found %v", err)
+	}
+	if it, err = s.List(ctx, prefix); err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if _, err := it.Next(); err != geocubeStorage.ErrIteratorDone {
+		t.Errorf("List: expecting ErrIteratorDone, found %v", err)
+	}
+}
@@ -0,0 +1,47 @@
+package azure
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Parse takes in a string in the form az://container/path/to/blob or
+// container/path/to/blob or /container/path/to/blob and returns the
+// container and blob names as usable by the azblob Client
+func Parse(azUri string) (container, blob string, err error) {
+	container, blob = parse(azUri)
+	if len(container) == 0 || len(blob) == 0 {
+		err = fmt.Errorf("missing container or blob")
+	}
+	return
+}
+
+func parse(azUri string) (container, blob string) {
+	if strings.HasPrefix(azUri, "az://") {
+		azUri = strings.TrimPrefix(azUri, "az://")
+	} else {
+		azUri = strings.TrimPrefix(azUri, "/")
+	}
+	firstSlash := strings.Index(azUri, "/")
+	if firstSlash == -1 {
+		container = azUri
+		blob = ""
+	} else {
+		container = azUri[0:firstSlash]
+		blob = azUri[firstSlash+1:]
+	}
+	return
+}
+
+// totalSize parses the total size of the blob from a Content-Range header value (e.g. "bytes 0-99/1234")
+func totalSize(contentRange string) (int64, bool) {
+	idx := strings.LastIndex(contentRange, "/")
+	if idx == -1 || contentRange[idx+1:] == "*" {
+		return 0, false
+	}
+	var size int64
+	if _, err := fmt.Sscanf(contentRange[idx+1:], "%d", &size); err != nil {
+		return 0, false
+	}
+	return size, true
+}
@@ -0,0 +1,247 @@
+package cache
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"sync/atomic"
+
+	"github.com/airbusgeo/geocube/internal/log"
+)
+
+// DefaultBlockSize is the size of the blocks stored in the cache
+const DefaultBlockSize = 1024 * 1024
+
+// BlockCache is a size-bounded on-disk LRU cache of the blocks of remote files.
+// A block is identified by the uri and the version of the file and its index in the file.
+// It can safely be shared by several goroutines.
+type BlockCache struct {
+	dir       string
+	maxSize   int64
+	blockSize int64
+
+	mutex   sync.Mutex
+	size    int64
+	lru     *list.List // of *entry, most recently used first
+	entries map[string]*list.Element
+
+	hits      atomic.Int64
+	misses    atomic.Int64
+	evictions atomic.Int64
+}
+
+type entry struct {
+	name string
+	size int64
+}
+
+// Stats of the cache
+type Stats struct {
+	Hits      int64 // Number of blocks read from the cache
+	Misses    int64 // Number of blocks read from the remote storage
+	Evictions int64 // Number of blocks removed from the cache
+	Size      int64 // Current size of the cache (bytes)
+	MaxSize   int64 // Maximum size of the cache (bytes)
+}
+
+// HitRatio returns the ratio of the blocks read from the cache
+func (s Stats) HitRatio() float64 {
+	if s.Hits+s.Misses == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(s.Hits+s.Misses)
+}
+
+// New creates a block cache in a new subdirectory of dir, bounded to maxSize bytes.
+// Each cache has its own subdirectory, so that several processes can share the same dir. It is removed by Close.
+func New(dir string, maxSize, blockSize int64) (*BlockCache, error) {
+	if maxSize <= 0 || blockSize <= 0 {
+		return nil, fmt.Errorf("cache.New: maxSize and blockSize must be > 0")
+	}
+	if err := os.MkdirAll(dir, 0777); err != nil {
+		return nil, fmt.Errorf("cache.New: %w", err)
+	}
+	// As the index is only kept in memory, the blocks of a previous run or of another process cannot be used
+	dir, err := os.MkdirTemp(dir, "geocube_cache_")
+	if err != nil {
+		return nil, fmt.Errorf("cache.New: %w", err)
+	}
+	return &BlockCache{
+		dir:       dir,
+		maxSize:   maxSize,
+		blockSize: blockSize,
+		lru:       list.New(),
+		entries:   map[string]*list.Element{},
+	}, nil
+}
+
+// Dir returns the directory of the cache
+func (c *BlockCache) Dir() string {
+	return c.dir
+}
+
+// Close removes the directory of the cache. The cache must not be used afterwards.
+func (c *BlockCache) Close() error {
+	c.mutex.Lock()
+	c.size = 0
+	c.lru.Init()
+	c.entries = map[string]*list.Element{}
+	c.mutex.Unlock()
+	return os.RemoveAll(c.dir)
+}
+
+// BlockSize returns the size of the blocks
+func (c *BlockCache) BlockSize() int64 {
+	return c.blockSize
+}
+
+// Stats returns the statistics of the cache
+func (c *BlockCache) Stats() Stats {
+	c.mutex.Lock()
+	size := c.size
+	c.mutex.Unlock()
+	return Stats{
+		Hits:      c.hits.Load(),
+		Misses:    c.misses.Load(),
+		Evictions: c.evictions.Load(),
+		Size:      size,
+		MaxSize:   c.maxSize,
+	}
+}
+
+// blockName returns the name of the file storing the block
+func blockName(uri, version string, index int64) string {
+	h := sha256.Sum256([]byte(uri + "\x00" + version))
+	return hex.EncodeToString(h[:]) + "_" + strconv.FormatInt(index, 10)
+}
+
+// get returns the content of the block or false if the block is not in the cache
+func (c *BlockCache) get(uri, version string, index int64) ([]byte, bool) {
+	name := blockName(uri, version, index)
+	c.mutex.Lock()
+	elem, ok := c.entries[name]
+	if ok {
+		c.lru.MoveToFront(elem)
+	}
+	c.mutex.Unlock()
+	if !ok {
+		c.misses.Add(1)
+		return nil, false
+	}
+
+	data, err := os.ReadFile(filepath.Join(c.dir, name))
+	if err != nil {
+		// The file has been removed (by eviction or externally)
+		c.remove(name)
+		c.misses.Add(1)
+		return nil, false
+	}
+	c.hits.Add(1)
+	return data, true
+}
+
+// put adds the block to the cache, evicting the least recently used blocks if necessary
+func (c *BlockCache) put(ctx context.Context, uri, version string, index int64, data []byte) {
+	size := int64(len(data))
+	if size > c.maxSize {
+		return
+	}
+	name := blockName(uri, version, index)
+	path := filepath.Join(c.dir, name)
+
+	// Write the file atomically, so that a concurrent reader never reads a partial block
+	tmp, err := os.CreateTemp(c.dir, name+".tmp")
+	if err != nil {
+		log.Logger(ctx).Sugar().Warnf("cache.put: %v", err)
+		return
+	}
+	_, err = tmp.Write(data)
+	if e := tmp.Close(); err == nil {
+		err = e
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), path)
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		log.Logger(ctx).Sugar().Warnf("cache.put: %v", err)
+		return
+	}
+
+	c.mutex.Lock()
+	var evicted []string
+	if elem, ok := c.entries[name]; ok {
+		// Already added by a concurrent request
+		c.size -= elem.Value.(*entry).size
+		elem.Value.(*entry).size = size
+		c.lru.MoveToFront(elem)
+	} else {
+		c.entries[name] = c.lru.PushFront(&entry{name: name, size: size})
+	}
+	c.size += size
+	for c.size > c.maxSize {
+		e := c.lru.Remove(c.lru.Back()).(*entry)
+		delete(c.entries, e.name)
+		c.size -= e.size
+		evicted = append(evicted, e.name)
+	}
+	c.mutex.Unlock()
+
+	c.evictions.Add(int64(len(evicted)))
+	for _, name := range evicted {
+		if err := os.Remove(filepath.Join(c.dir, name)); err != nil && !os.IsNotExist(err) {
+			log.Logger(ctx).Sugar().Warnf("cache.evict: %v", err)
+		}
+	}
+}
+
+// remove the block from the index
+func (c *BlockCache) remove(name string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if elem, ok := c.entries[name]; ok {
+		c.lru.Remove(elem)
+		delete(c.entries, name)
+		c.size -= elem.Value.(*entry).size
+	}
+}
+
+var defaultCache *BlockCache
+
+// SetDefault defines the cache whose statistics are logged by LogStats and returned by DefaultStats
+func SetDefault(c *BlockCache) {
+	defaultCache = c
+}
+
+// CloseDefault closes the default cache (if defined)
+func CloseDefault() error {
+	if defaultCache == nil {
+		return nil
+	}
+	return defaultCache.Close()
+}
+
+// DefaultStats returns the statistics of the default cache or nil if it is not defined
+func DefaultStats() *Stats {
+	if defaultCache == nil {
+		return nil
+	}
+	s := defaultCache.Stats()
+	return &s
+}
+
+// LogStats logs the statistics of the default cache (if defined)
+func LogStats(ctx context.Context) {
+	s := DefaultStats()
+	if s == nil {
+		return
+	}
+	log.Logger(ctx).Sugar().Debugf("Storage cache: %d hits, %d misses (hit ratio: %.2f), %d evictions, %d/%d Mb",
+		s.Hits, s.Misses, s.HitRatio(), s.Evictions, s.Size/1024/1024, s.MaxSize/1024/1024)
+}
@@ -0,0 +1,205 @@
+package cache
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"syscall"
+	"testing"
+
+	geocubeStorage "github.com/airbusgeo/geocube/interface/storage"
+)
+
+// fakeStrategy serves in-memory files and counts the bytes read by StreamAt
+type fakeStrategy struct {
+	geocubeStorage.Strategy
+	mu       sync.Mutex
+	files    map[string][]byte
+	versions map[string]int
+	read     int64
+}
+
+func newFakeStrategy() *fakeStrategy {
+	return &fakeStrategy{files: map[string][]byte{}, versions: map[string]int{}}
+}
+
+func (f *fakeStrategy) set(uri string, data []byte) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.files[uri] = data
+	f.versions[uri]++
+}
+
+func (f *fakeStrategy) GetAttrs(ctx context.Context, uri string) (geocubeStorage.Attrs, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	data, ok := f.files[uri]
+	if !ok {
+		return geocubeStorage.Attrs{}, geocubeStorage.ErrFileNotFound
+	}
+	return geocubeStorage.Attrs{Size: int64(len(data)), Version: strconv.Itoa(f.versions[uri])}, nil
+}
+
+func (f *fakeStrategy) StreamAt(key string, off int64, n int64) (io.ReadCloser, int64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	data, ok := f.files[key]
+	if !ok {
+		return nil, -1, syscall.ENOENT
+	}
+	if off >= int64(len(data)) {
+		return nil, 0, io.EOF
+	}
+	end := off + n
+	if n <= 0 || end > int64(len(data)) {
+		end = int64(len(data))
+	}
+	f.read += end - off
+	return io.NopCloser(bytes.NewReader(data[off:end])), int64(len(data)), nil
+}
+
+func (f *fakeStrategy) bytesRead() int64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.read
+}
+
+func testData(size int) []byte {
+	data := make([]byte, size)
+	for i := range data {
+		data[i] = byte(i % 251)
+	}
+	return data
+}
+
+func TestStreamAt(t *testing.T) {
+	ctx := context.Background()
+	c, err := New(t.TempDir(), 100, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := newFakeStrategy()
+	data := testData(45)
+	f.set("gs://bucket/file.tif", data)
+	s := NewCacheStrategy(ctx, f, c)
+
+	test := func(off, n int64) {
+		t.Helper()
+		r, size, err := s.StreamAt("gs://bucket/file.tif", off, n)
+		if err != nil {
+			t.Fatalf("StreamAt(%d, %d): %v", off, n, err)
+		}
+		got, _ := io.ReadAll(r)
+		end := off + n
+		if end > int64(len(data)) {
+			end = int64(len(data))
+		}
+		if size != int64(len(data)) || !bytes.Equal(got, data[off:end]) {
+			t.Errorf("StreamAt(%d, %d): expecting %v (size %d), found %v (size %d)", off, n, data[off:end], len(data), got, size)
+		}
+	}
+
+	test(5, 10) // blocks 0 & 1 are fetched
+	if read := f.bytesRead(); read != 20 {
+		t.Errorf("expecting 20 bytes read, found %d", read)
+	}
+	test(12, 6) // block 1 is cached
+	if read := f.bytesRead(); read != 20 {
+		t.Errorf("expecting 20 bytes read, found %d", read)
+	}
+	test(0, 100) // blocks 2, 3 & 4 are fetched
+	if read := f.bytesRead(); read != 45 {
+		t.Errorf("expecting 45 bytes read, found %d", read)
+	}
+	if stats := c.Stats(); stats.Hits != 3 || stats.Misses != 5 || stats.Size != 45 {
+		t.Errorf("unexpected stats: %+v", stats)
+	}
+
+	if _, _, err := s.StreamAt("gs://bucket/file.tif", 100, 10); err != io.EOF {
+		t.Errorf("StreamAt: expecting EOF, found %v", err)
+	}
+	if _, size, err := s.StreamAt("gs://bucket/notfound.tif", 0, 10); err != syscall.ENOENT || size != -1 {
+		t.Errorf("StreamAt: expecting ENOENT, found %d, %v", size, err)
+	}
+
+	// Download with offset/length uses the cache
+	got, err := s.Download(ctx, "gs://bucket/file.tif", geocubeStorage.Offset(40), geocubeStorage.Length(10))
+	if err != nil || !bytes.Equal(got, data[40:]) {
+		t.Errorf("Download: expecting %v, found %v, %v", data[40:], got, err)
+	}
+	if read := f.bytesRead(); read != 45 {
+		t.Errorf("expecting 45 bytes read, found %d", read)
+	}
+}
+
+func TestEviction(t *testing.T) {
+	ctx := context.Background()
+	c, err := New(t.TempDir(), 30, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := newFakeStrategy()
+	f.set("file1", testData(20))
+	f.set("file2", testData(20))
+	s := NewCacheStrategy(ctx, f, c)
+
+	read := func(uri string) {
+		t.Helper()
+		if _, _, err := s.StreamAt(uri, 0, 20); err != nil {
+			t.Fatalf("StreamAt(%s): %v", uri, err)
+		}
+	}
+	read("file1")
+	read("file2") // the first block of file1 is evicted
+	read("file1") // only the first block of file1 is fetched
+	if stats := c.Stats(); stats.Size > 30 || stats.Evictions == 0 {
+		t.Errorf("unexpected stats: %+v", stats)
+	}
+	if b := f.bytesRead(); b != 50 {
+		t.Errorf("expecting 50 bytes read, found %d", b)
+	}
+}
+
+func TestSharedDir(t *testing.T) {
+	dir := t.TempDir()
+	c1, err := New(dir, 30, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c2, err := New(dir, 30, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c1.Dir() == c2.Dir() || filepath.Dir(c1.Dir()) != dir || filepath.Dir(c2.Dir()) != dir {
+		t.Fatalf("expecting two subdirectories of %s, found %s and %s", dir, c1.Dir(), c2.Dir())
+	}
+
+	ctx := context.Background()
+	f := newFakeStrategy()
+	f.set("file1", testData(20))
+	s := NewCacheStrategy(ctx, f, c2)
+	if _, _, err := s.StreamAt("file1", 0, 20); err != nil {
+		t.Fatal(err)
+	}
+
+	// Closing c1 must not remove the blocks of c2
+	if err := c1.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(c1.Dir()); !os.IsNotExist(err) {
+		t.Errorf("expecting %s to be removed, found %v", c1.Dir(), err)
+	}
+	if _, _, err := s.StreamAt("file1", 0, 20); err != nil {
+		t.Fatal(err)
+	}
+	if b := f.bytesRead(); b != 20 {
+		t.Errorf("expecting 20 bytes read, found %d", b)
+	}
+	if err := c2.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,191 @@
+package cache
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"syscall"
+	"time"
+
+	geocubeStorage "github.com/airbusgeo/geocube/interface/storage"
+)
+
+// versionTTL is the duration during which the version of a file is not checked again
+const versionTTL = time.Minute
+
+// cacheStrategy is a storage strategy that keeps the byte ranges read by StreamAt and Download (with Offset/Length) in a BlockCache.
+// The other methods are delegated to the underlying strategy.
+type cacheStrategy struct {
+	geocubeStorage.Strategy
+	cache    *BlockCache
+	versions *sync.Map // uri -> fileVersion
+	ctx      context.Context
+}
+
+type fileVersion struct {
+	version string
+	size    int64
+	expires time.Time
+}
+
+// NewCacheStrategy creates a strategy reading the files of the strategy through the cache.
+// The cache is shared by all the strategies created with it.
+func NewCacheStrategy(ctx context.Context, strategy geocubeStorage.Strategy, cache *BlockCache) geocubeStorage.Strategy {
+	return cacheStrategy{
+		Strategy: strategy,
+		cache:    cache,
+		versions: &sync.Map{},
+		ctx:      ctx,
+	}
+}
+
+func (s cacheStrategy) Download(ctx context.Context, uri string, options ...geocubeStorage.Option) ([]byte, error) {
+	opts := geocubeStorage.Apply(options...)
+	if opts.Length <= 0 {
+		// The whole file is not cached
+		return s.Strategy.Download(ctx, uri, options...)
+	}
+	data, _, err := s.readAt(ctx, uri, opts.Offset, opts.Length)
+	if err == io.EOF {
+		return []byte{}, nil
+	}
+	return data, err
+}
+
+func (s cacheStrategy) Upload(ctx context.Context, uri string, data []byte, options ...geocubeStorage.Option) error {
+	s.versions.Delete(uri)
+	return s.Strategy.Upload(ctx, uri, data, options...)
+}
+
+func (s cacheStrategy) UploadFile(ctx context.Context, uri string, data io.ReadCloser, options ...geocubeStorage.Option) error {
+	s.versions.Delete(uri)
+	return s.Strategy.UploadFile(ctx, uri, data, options...)
+}
+
+func (s cacheStrategy) Delete(ctx context.Context, uri string, options ...geocubeStorage.Option) error {
+	s.versions.Delete(uri)
+	return s.Strategy.Delete(ctx, uri, options...)
+}
+
+func (s cacheStrategy) BulkDelete(ctx context.Context, uris []string, options ...geocubeStorage.Option) error {
+	for _, uri := range uris {
+		s.versions.Delete(uri)
+	}
+	return s.Strategy.BulkDelete(ctx, uris, options...)
+}
+
+func (s cacheStrategy) StreamAt(key string, off int64, n int64) (io.ReadCloser, int64, error) {
+	data, size, err := s.readAt(s.ctx, key, off, n)
+	if err != nil {
+		if errors.Is(err, geocubeStorage.ErrFileNotFound) {
+			return nil, -1, syscall.ENOENT
+		}
+		return nil, 0, err
+	}
+	return io.NopCloser(bytes.NewReader(data)), size, nil
+}
+
+// fileVersion returns the version and the size of the file (cached during versionTTL)
+func (s cacheStrategy) fileVersion(ctx context.Context, uri string) (fileVersion, error) {
+	if v, ok := s.versions.Load(uri); ok && time.Now().Before(v.(fileVersion).expires) {
+		return v.(fileVersion), nil
+	}
+	attrs, err := s.Strategy.GetAttrs(ctx, uri)
+	if err != nil {
+		s.versions.Delete(uri)
+		return fileVersion{}, err
+	}
+	v := fileVersion{version: attrs.Version, size: attrs.Size, expires: time.Now().Add(versionTTL)}
+	if v.version == "" {
+		// Without version, the size is the only way to detect a modification
+		v.version = fmt.Sprintf("size:%d", attrs.Size)
+	}
+	s.versions.Store(uri, v)
+	return v, nil
+}
+
+// readAt reads the range [off, off+n) of the file (n <= 0 means until the end of the file), using the cache.
+// It returns the data, the size of the file, and io.EOF if off is after the end of the file.
+func (s cacheStrategy) readAt(ctx context.Context, uri string, off, n int64) ([]byte, int64, error) {
+	v, err := s.fileVersion(ctx, uri)
+	if err != nil {
+		return nil, 0, err
+	}
+	if off >= v.size {
+		return nil, v.size, io.EOF
+	}
+	end := v.size
+	if n > 0 && off+n < end {
+		end = off + n
+	}
+
+	bs := s.cache.BlockSize()
+	first, last := off/bs, (end-1)/bs
+	blocks := make([][]byte, last-first+1)
+	for i := first; i <= last; {
+		if blocks[i-first] != nil {
+			i++
+			continue
+		}
+		if data, ok := s.cache.get(uri, v.version, i); ok {
+			blocks[i-first] = data
+			i++
+			continue
+		}
+		// Fetch all the consecutive missing blocks at once
+		j := i + 1
+		for ; j <= last; j++ {
+			if data, ok := s.cache.get(uri, v.version, j); ok {
+				blocks[j-first] = data
+				break
+			}
+		}
+		if err := s.fetchBlocks(ctx, uri, v, i, j, blocks[i-first:j-first]); err != nil {
+			return nil, 0, err
+		}
+		i = j
+	}
+
+	data := bytes.Join(blocks, nil)
+	start := off - first*bs
+	if end-first*bs > int64(len(data)) {
+		return nil, 0, fmt.Errorf("cache.readAt[%s]: file is smaller than expected", uri)
+	}
+	return data[start : end-first*bs], v.size, nil
+}
+
+// fetchBlocks reads the blocks [from, to) from the underlying strategy and adds them to the cache
+func (s cacheStrategy) fetchBlocks(ctx context.Context, uri string, v fileVersion, from, to int64, blocks [][]byte) error {
+	bs := s.cache.BlockSize()
+	r, size, err := s.Strategy.StreamAt(uri, from*bs, (to-from)*bs)
+	if err != nil {
+		if errors.Is(err, syscall.ENOENT) {
+			s.versions.Delete(uri)
+			return geocubeStorage.ErrFileNotFound
+		}
+		return fmt.Errorf("cache.fetchBlocks[%s]: %w", uri, err)
+	}
+	defer r.Close()
+	if size != v.size {
+		// The file has been modified
+		s.versions.Delete(uri)
+		return fmt.Errorf("cache.fetchBlocks[%s]: the size of the file has changed (%d != %d)", uri, size, v.size)
+	}
+
+	for i := range blocks {
+		blockLen := bs
+		if remaining := v.size - (from+int64(i))*bs; remaining < blockLen {
+			blockLen = remaining
+		}
+		data := make([]byte, blockLen)
+		if _, err := io.ReadFull(r, data); err != nil {
+			return fmt.Errorf("cache.fetchBlocks[%s]: %w", uri, err)
+		}
+		blocks[i] = data
+		s.cache.put(ctx, uri, v.version, from+int64(i), data)
+	}
+	return nil
+}
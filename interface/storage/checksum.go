@@ -0,0 +1,92 @@
+package storage
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"strings"
+)
+
+// ErrChecksumMismatch is returned when the checksums of a file do not match the expected ones
+var ErrChecksumMismatch = errors.New("checksum mismatch")
+
+var crc32cTable = crc32.MakeTable(crc32.Castagnoli)
+
+// Checksums of a file (hex-encoded). An empty field means that the checksum is unknown.
+type Checksums struct {
+	CRC32C string // CRC32C (Castagnoli) checksum, big-endian
+	MD5    string
+}
+
+// ComputeChecksums returns the checksums and the size of the content of r
+func ComputeChecksums(r io.Reader) (Checksums, int64, error) {
+	crc := crc32.New(crc32cTable)
+	md := md5.New()
+	n, err := io.Copy(io.MultiWriter(crc, md), r)
+	if err != nil {
+		return Checksums{}, n, err
+	}
+	return Checksums{CRC32C: hex.EncodeToString(crc.Sum(nil)), MD5: hex.EncodeToString(md.Sum(nil))}, n, nil
+}
+
+// IsEmpty returns true if no checksum is known
+func (c Checksums) IsEmpty() bool {
+	return c.CRC32C == "" && c.MD5 == ""
+}
+
+// String formats the known checksums (e.g. "crc32c:1a2b3c4d,md5:0123...")
+func (c Checksums) String() string {
+	var s []string
+	if c.CRC32C != "" {
+		s = append(s, "crc32c:"+c.CRC32C)
+	}
+	if c.MD5 != "" {
+		s = append(s, "md5:"+c.MD5)
+	}
+	return strings.Join(s, ",")
+}
+
+// ParseChecksums parses the checksums formatted by Checksums.String()
+func ParseChecksums(s string) (Checksums, error) {
+	var c Checksums
+	if s == "" {
+		return c, nil
+	}
+	for _, checksum := range strings.Split(s, ",") {
+		algo, value, ok := strings.Cut(checksum, ":")
+		if !ok || value == "" {
+			return Checksums{}, fmt.Errorf("ParseChecksums: badly formatted checksum: %s", checksum)
+		}
+		switch strings.ToLower(algo) {
+		case "crc32c":
+			c.CRC32C = strings.ToLower(value)
+		case "md5":
+			c.MD5 = strings.ToLower(value)
+		default:
+			return Checksums{}, fmt.Errorf("ParseChecksums: unsupported algorithm: %s", algo)
+		}
+	}
+	return c, nil
+}
+
+// Verify compares the checksums known by both c and actual.
+// It returns false if no checksum can be compared and ErrChecksumMismatch if one of them does not match.
+func (c Checksums) Verify(actual Checksums) (bool, error) {
+	verified := false
+	for _, cs := range []struct{ algo, expected, actual string }{
+		{"crc32c", c.CRC32C, actual.CRC32C},
+		{"md5", c.MD5, actual.MD5},
+	} {
+		if cs.expected == "" || cs.actual == "" {
+			continue
+		}
+		if !strings.EqualFold(cs.expected, cs.actual) {
+			return false, fmt.Errorf("%w: %s expected %s, found %s", ErrChecksumMismatch, cs.algo, cs.expected, cs.actual)
+		}
+		verified = true
+	}
+	return verified, nil
+}
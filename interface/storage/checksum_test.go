@@ -0,0 +1,40 @@
+package storage
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestChecksums(t *testing.T) {
+	c, n, err := ComputeChecksums(strings.NewReader("123456789"))
+	if err != nil || n != 9 {
+		t.Fatalf("ComputeChecksums: %d, %v", n, err)
+	}
+	expected := Checksums{CRC32C: "e3069283", MD5: "25f9e794323b453885f5181f1b624d0b"}
+	if c != expected {
+		t.Errorf("ComputeChecksums: expecting %+v, found %+v", expected, c)
+	}
+
+	if parsed, err := ParseChecksums(c.String()); err != nil || parsed != c {
+		t.Errorf("ParseChecksums(%s): expecting %+v, found %+v, %v", c.String(), c, parsed, err)
+	}
+	if parsed, err := ParseChecksums(""); err != nil || !parsed.IsEmpty() {
+		t.Errorf("ParseChecksums: expecting empty checksums, found %+v, %v", parsed, err)
+	}
+	if _, err := ParseChecksums("sha1:abcd"); err == nil {
+		t.Errorf("ParseChecksums: error not raised")
+	}
+
+	test := func(actual Checksums, verified bool, mismatch bool) {
+		t.Helper()
+		ok, err := c.Verify(actual)
+		if ok != verified || errors.Is(err, ErrChecksumMismatch) != mismatch {
+			t.Errorf("Verify(%+v): expecting %v, mismatch=%v, found %v, %v", actual, verified, mismatch, ok, err)
+		}
+	}
+	test(Checksums{CRC32C: "E3069283"}, true, false)
+	test(Checksums{MD5: "25f9e794323b453885f5181f1b624d0b"}, true, false)
+	test(Checksums{}, false, false)
+	test(Checksums{CRC32C: "00000000", MD5: "25f9e794323b453885f5181f1b624d0b"}, false, true)
+}
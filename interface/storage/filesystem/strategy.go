@@ -6,14 +6,15 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"io/fs"
 	"net/http"
 	"os"
 	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
-	"sync/atomic"
 
 	geocubeStorage "github.com/airbusgeo/geocube/interface/storage"
-	"github.com/airbusgeo/geocube/internal/utils"
 )
 
 type fileSystemStrategy struct {
@@ -124,41 +125,76 @@ func (s fileSystemStrategy) Delete(ctx context.Context, uri string, options ...g
 }
 
 func (s fileSystemStrategy) BulkDelete(ctx context.Context, uris []string, options ...geocubeStorage.Option) error {
-	workers := 20
-	maxErrors := int64(100)
-	if len(uris) < workers {
-		workers = len(uris)
-	}
-	tasks := make(chan string)
-	wg := utils.ErrWaitGroup{}
-
-	nbErrors := atomic.Int64{}
-	for range workers {
-		wg.Go(func() error {
-			for uri := range tasks {
-				if err := s.Delete(ctx, uri, options...); err != nil {
-					if nbErrors.Add(1) < maxErrors {
-						wg.AppendError(err)
-					}
-				}
+	return geocubeStorage.ParallelDelete(ctx, s.Delete, uris, options...)
+}
+
+// List returns the files whose path starts with prefix (which can be a directory, ending with "/", or the beginning of the name of the files)
+// The whole listing is done at once, thus the PageSize option is not used.
+func (s fileSystemStrategy) List(ctx context.Context, prefix string, options ...geocubeStorage.Option) (geocubeStorage.ObjectIterator, error) {
+	opts := geocubeStorage.Apply(options...)
+	scheme := ""
+	if strings.HasPrefix(prefix, "file://") {
+		scheme = "file://"
+		prefix = strings.TrimPrefix(prefix, scheme)
+	}
+	root := prefix
+	if !strings.HasSuffix(root, "/") {
+		root = filepath.Dir(root)
+	}
+
+	var objects []geocubeStorage.ObjectAttrs
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if path == root && os.IsNotExist(err) {
+				return filepath.SkipAll
 			}
+			return err
+		}
+		if d.IsDir() {
+			// Skip the directories that cannot contain a file starting with prefix
+			if path != root && !strings.HasPrefix(path+"/", prefix) && !strings.HasPrefix(prefix, path+"/") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasPrefix(path, prefix) || scheme+path <= opts.StartAfter {
 			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return formatError(err)
+		}
+		objects = append(objects, geocubeStorage.ObjectAttrs{
+			URI:          scheme + path,
+			StorageClass: "filesystem",
+			Size:         info.Size(),
+			ModTime:      info.ModTime(),
 		})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list files: %w", err)
 	}
-	for _, uri := range uris {
-		tasks <- uri
-	}
-	close(tasks)
+	sort.Slice(objects, func(i, j int) bool { return objects[i].URI < objects[j].URI })
 
-	errs := utils.MergeErrors(true, nil, wg.Wait()...)
-	if nbErrors.Load() >= maxErrors {
-		errs = utils.MergeErrors(true, errs, utils.MakeTemporary(fmt.Errorf("[...] total: %d errors", nbErrors.Load())))
-	}
+	return &objectIterator{objects: objects}, nil
+}
 
-	return errs
+type objectIterator struct {
+	objects []geocubeStorage.ObjectAttrs
+}
+
+func (it *objectIterator) Next() (geocubeStorage.ObjectAttrs, error) {
+	if len(it.objects) == 0 {
+		return geocubeStorage.ObjectAttrs{}, geocubeStorage.ErrIteratorDone
+	}
+	o := it.objects[0]
+	it.objects = it.objects[1:]
+	return o, nil
 }
 
 func (s fileSystemStrategy) Exist(ctx context.Context, uri string) (bool, error) {
+	uri = strings.Replace(uri, "file://", "", -1)
 	if _, err := os.Stat(uri); err != nil {
 		if os.IsNotExist(err) {
 			return false, geocubeStorage.ErrFileNotFound
@@ -168,7 +204,9 @@ func (s fileSystemStrategy) Exist(ctx context.Context, uri string) (bool, error)
 	return true, nil
 }
 
+// GetAttrs returns the attributes of the file. The checksums are not computed, as it would require to read the whole file.
 func (s fileSystemStrategy) GetAttrs(ctx context.Context, uri string) (geocubeStorage.Attrs, error) {
+	uri = strings.Replace(uri, "file://", "", -1)
 	f, err := os.Open(uri)
 	if err != nil {
 		return geocubeStorage.Attrs{}, fmt.Errorf("failed to open file: %w", formatError(err))
@@ -201,6 +239,7 @@ func (s fileSystemStrategy) GetAttrs(ctx context.Context, uri string) (geocubeSt
 		ContentType:  contentType,
 		StorageClass: "filesystem",
 		Size:         fi.Size(),
+		Version:      strconv.FormatInt(fi.ModTime().UnixNano(), 10),
 	}, nil
 }
 
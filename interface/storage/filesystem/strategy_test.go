@@ -3,6 +3,8 @@ package filesystem
 import (
 	"context"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	geocubeStorage "github.com/airbusgeo/geocube/interface/storage"
@@ -66,3 +68,44 @@ func TestBulkDelete(t *testing.T) {
 	}
 
 }
+
+func TestList(t *testing.T) {
+	ctx := context.Background()
+	dname := t.TempDir()
+	for _, f := range []string{"a/1.tif", "a/2.tif", "a/sub/3.tif", "ab/4.tif", "b/5.tif"} {
+		if err := os.MkdirAll(filepath.Dir(filepath.Join(dname, f)), os.ModePerm); err != nil {
+			panic(err)
+		}
+		if err := os.WriteFile(filepath.Join(dname, f), []byte(f), 0644); err != nil {
+			panic(err)
+		}
+	}
+	s := fileSystemStrategy{}
+
+	test := func(prefix string, expected []string, options ...geocubeStorage.Option) {
+		t.Helper()
+		it, err := s.List(ctx, prefix, options...)
+		if err != nil {
+			t.Fatalf("List(%s): %v", prefix, err)
+		}
+		objects, err := geocubeStorage.ListAll(it)
+		if err != nil {
+			t.Fatalf("List(%s): %v", prefix, err)
+		}
+		var uris []string
+		for _, o := range objects {
+			uris = append(uris, strings.TrimPrefix(strings.TrimPrefix(o.URI, "file://"), dname+"/"))
+			if o.Size <= 0 || o.ModTime.IsZero() {
+				t.Errorf("List(%s): unexpected attributes %+v", prefix, o)
+			}
+		}
+		if strings.Join(uris, ",") != strings.Join(expected, ",") {
+			t.Errorf("List(%s): expecting %v, found %v", prefix, expected, uris)
+		}
+	}
+	test(dname+"/", []string{"a/1.tif", "a/2.tif", "a/sub/3.tif", "ab/4.tif", "b/5.tif"})
+	test(dname+"/a/", []string{"a/1.tif", "a/2.tif", "a/sub/3.tif"})
+	test(dname+"/a", []string{"a/1.tif", "a/2.tif", "a/sub/3.tif", "ab/4.tif"})
+	test("file://"+dname+"/a/", []string{"a/2.tif", "a/sub/3.tif"}, geocubeStorage.StartAfter("file://"+dname+"/a/1.tif"))
+	test(dname+"/c/", nil)
+}
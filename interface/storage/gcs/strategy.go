@@ -3,11 +3,13 @@ package gcs
 import (
 	"bytes"
 	"context"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"sync"
 	"syscall"
@@ -16,6 +18,7 @@ import (
 	"github.com/airbusgeo/geocube/internal/log"
 
 	"google.golang.org/api/googleapi"
+	"google.golang.org/api/iterator"
 
 	"cloud.google.com/go/storage"
 	geocubeStorage "github.com/airbusgeo/geocube/interface/storage"
@@ -175,6 +178,61 @@ func (s gsStrategy) Delete(ctx context.Context, uri string, options ...geocubeSt
 	return s.deleteObject(ctx, bucket, object, options...)
 }
 
+func (s gsStrategy) BulkDelete(ctx context.Context, uris []string, options ...geocubeStorage.Option) error {
+	return geocubeStorage.ParallelDelete(ctx, s.Delete, uris, options...)
+}
+
+// List returns the objects whose name starts with prefix (gs://bucket/prefix)
+func (s gsStrategy) List(ctx context.Context, prefix string, options ...geocubeStorage.Option) (geocubeStorage.ObjectIterator, error) {
+	bucket, object := parse(prefix)
+	if bucket == "" {
+		return nil, fmt.Errorf("failed to decode URI %s : missing bucket", prefix)
+	}
+
+	opts := geocubeStorage.Apply(options...)
+	query := &storage.Query{Prefix: object}
+	if err := query.SetAttrSelection([]string{"Name", "Size", "StorageClass", "Updated"}); err != nil {
+		return nil, err
+	}
+	var startAfter string
+	if opts.StartAfter != "" {
+		// StartOffset is inclusive
+		_, startAfter = parse(opts.StartAfter)
+		query.StartOffset = startAfter
+	}
+	it := s.gsClient.Bucket(bucket).Objects(ctx, query)
+	it.PageInfo().MaxSize = opts.PageSize
+
+	return &objectIterator{it: it, bucket: bucket, startAfter: startAfter}, nil
+}
+
+type objectIterator struct {
+	it         *storage.ObjectIterator
+	bucket     string
+	startAfter string
+}
+
+func (it *objectIterator) Next() (geocubeStorage.ObjectAttrs, error) {
+	for {
+		attrs, err := it.it.Next()
+		if err == iterator.Done {
+			return geocubeStorage.ObjectAttrs{}, geocubeStorage.ErrIteratorDone
+		}
+		if err != nil {
+			return geocubeStorage.ObjectAttrs{}, fmt.Errorf("gs.list[%s]: %w", it.bucket, GsError(err))
+		}
+		if attrs.Name == it.startAfter {
+			continue
+		}
+		return geocubeStorage.ObjectAttrs{
+			URI:          "gs://" + it.bucket + "/" + attrs.Name,
+			StorageClass: attrs.StorageClass,
+			Size:         attrs.Size,
+			ModTime:      attrs.Updated,
+		}, nil
+	}
+}
+
 func (s gsStrategy) Exist(ctx context.Context, uri string) (bool, error) {
 	bucket, object, err := s.decodeURI(ctx, uri)
 	if err != nil {
@@ -212,6 +270,11 @@ func (s gsStrategy) GetAttrs(ctx context.Context, uri string) (geocubeStorage.At
 		StorageClass: attrs.StorageClass,
 		ContentType:  attrs.ContentType,
 		Size:         attrs.Size,
+		Version:      strconv.FormatInt(attrs.Generation, 10),
+		Checksums: geocubeStorage.Checksums{
+			CRC32C: fmt.Sprintf("%08x", attrs.CRC32C),
+			MD5:    hex.EncodeToString(attrs.MD5), // MD5 is not available for composite objects
+		},
 	}, nil
 }
 
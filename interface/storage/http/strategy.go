@@ -0,0 +1,347 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	netHttp "net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	geocubeStorage "github.com/airbusgeo/geocube/interface/storage"
+	"github.com/airbusgeo/geocube/internal/utils"
+)
+
+// httpStrategy is a read-only strategy to access files over http(s) (e.g. open data)
+// The etags of the files are remembered, so that a file modified during its reading is detected
+type httpStrategy struct {
+	httpClient *netHttp.Client
+	etags      *sync.Map
+	ctx        context.Context
+}
+
+// ErrFileChanged is returned when the remote file has been modified since it was first read
+var ErrFileChanged = errors.New("remote file has changed")
+
+var retriableSuffixErrors = []string{
+	"connection reset by peer",
+	"cannot assign requested address",
+	"connection refused",
+	"broken pipe",
+	"EOF", // Unexpected EOF is a temporary error
+}
+
+// StatusError is returned when the server does not respond with a successful status code
+type StatusError struct {
+	StatusCode int
+	Status     string
+}
+
+func (e StatusError) Error() string {
+	return fmt.Sprintf("unexpected http status: %s", e.Status)
+}
+
+func HTTPError(err error) error {
+	if err == nil {
+		return nil
+	}
+	var statusErr StatusError
+	if errors.As(err, &statusErr) {
+		switch code := statusErr.StatusCode; {
+		case code == netHttp.StatusNotFound || code == netHttp.StatusGone:
+			return geocubeStorage.ErrFileNotFound
+		case code == netHttp.StatusPreconditionFailed:
+			return ErrFileChanged
+		case code == netHttp.StatusTooManyRequests || code == netHttp.StatusRequestTimeout || (code >= 500 && code < 600):
+			return utils.MakeTemporary(err)
+		}
+		return err
+	}
+	if utils.Temporary(err) {
+		return err
+	}
+	for _, e := range retriableSuffixErrors {
+		if strings.HasSuffix(err.Error(), e) {
+			return utils.MakeTemporary(err)
+		}
+	}
+	return err
+}
+
+// NewHTTPStrategy creates a read-only strategy using the default http client
+func NewHTTPStrategy(ctx context.Context) (geocubeStorage.Strategy, error) {
+	return NewHTTPStrategyWithClient(ctx, netHttp.DefaultClient)
+}
+
+// NewHTTPStrategyWithClient creates a read-only strategy using a custom http client
+func NewHTTPStrategyWithClient(ctx context.Context, client *netHttp.Client) (geocubeStorage.Strategy, error) {
+	return httpStrategy{
+		httpClient: client,
+		etags:      &sync.Map{},
+		ctx:        ctx,
+	}, nil
+}
+
+func (s httpStrategy) Download(ctx context.Context, uri string, options ...geocubeStorage.Option) ([]byte, error) {
+	if _, err := s.decodeURI(ctx, uri); err != nil {
+		return nil, fmt.Errorf("failed to decode URI %s : %w", uri, err)
+	}
+
+	buf := &bytes.Buffer{}
+	if err := s.downloadTo(ctx, uri, buf, options...); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (s httpStrategy) DownloadToFile(ctx context.Context, source, destination string, options ...geocubeStorage.Option) error {
+	if _, err := s.decodeURI(ctx, source); err != nil {
+		return fmt.Errorf("failed to decode URI %s : %w", source, err)
+	}
+
+	if _, err := os.Stat(filepath.Dir(destination)); os.IsNotExist(err) {
+		if err := os.MkdirAll(filepath.Dir(destination), os.ModePerm); err != nil {
+			return err
+		}
+	}
+
+	writer, err := os.Create(destination)
+	if err != nil {
+		return fmt.Errorf("failed to create destination file")
+	}
+
+	if err = s.downloadTo(ctx, source, writer, options...); err != nil {
+		writer.Close()
+		return fmt.Errorf("failed to download file to destination: %w", err)
+	}
+
+	if err = writer.Close(); err != nil {
+		return fmt.Errorf("DownloadToFile: failed to close writer: %w", err)
+	}
+
+	return nil
+}
+
+func (s httpStrategy) Upload(ctx context.Context, uri string, data []byte, options ...geocubeStorage.Option) error {
+	return fmt.Errorf("upload %s: %w", uri, geocubeStorage.ErrReadOnly)
+}
+
+func (s httpStrategy) UploadFile(ctx context.Context, uri string, data io.ReadCloser, options ...geocubeStorage.Option) error {
+	return fmt.Errorf("upload %s: %w", uri, geocubeStorage.ErrReadOnly)
+}
+
+func (s httpStrategy) Delete(ctx context.Context, uri string, options ...geocubeStorage.Option) error {
+	return fmt.Errorf("delete %s: %w", uri, geocubeStorage.ErrReadOnly)
+}
+
+func (s httpStrategy) BulkDelete(ctx context.Context, uris []string, options ...geocubeStorage.Option) error {
+	return geocubeStorage.ParallelDelete(ctx, s.Delete, uris, options...)
+}
+
+// List is not supported: http does not provide a standard way to list files
+func (s httpStrategy) List(ctx context.Context, prefix string, options ...geocubeStorage.Option) (geocubeStorage.ObjectIterator, error) {
+	return nil, fmt.Errorf("list %s: %w", prefix, errors.ErrUnsupported)
+}
+
+func (s httpStrategy) Exist(ctx context.Context, uri string) (bool, error) {
+	if _, err := s.decodeURI(ctx, uri); err != nil {
+		return false, fmt.Errorf("failed to decode URI %s : %w", uri, err)
+	}
+
+	if _, err := s.head(ctx, uri); err != nil {
+		if errors.Is(err, geocubeStorage.ErrFileNotFound) {
+			return false, geocubeStorage.ErrFileNotFound
+		}
+		return false, fmt.Errorf("failed to check if file exist on storage: %w", err)
+	}
+
+	return true, nil
+}
+
+// GetAttrs returns the attributes of the file using a HEAD request.
+// Http files do not have storage class and are considered STANDARD.
+func (s httpStrategy) GetAttrs(ctx context.Context, uri string) (geocubeStorage.Attrs, error) {
+	if _, err := s.decodeURI(ctx, uri); err != nil {
+		return geocubeStorage.Attrs{}, fmt.Errorf("failed to decode URI %s : %w", uri, err)
+	}
+
+	resp, err := s.head(ctx, uri)
+	if errors.Is(err, geocubeStorage.ErrFileNotFound) {
+		return geocubeStorage.Attrs{}, geocubeStorage.ErrFileNotFound
+	} else if err != nil {
+		return geocubeStorage.Attrs{}, fmt.Errorf("failed to get file attributes : %w", err)
+	}
+
+	return geocubeStorage.Attrs{
+		StorageClass: "STANDARD",
+		ContentType:  resp.Header.Get("Content-Type"),
+		Size:         resp.ContentLength,
+		Version:      resp.Header.Get("ETag"),
+	}, nil
+}
+
+// StreamAt streams the file using a Range request.
+// If the file has been read before, its etag is checked and ErrFileChanged is returned if it has been modified.
+func (s httpStrategy) StreamAt(key string, off int64, n int64) (io.ReadCloser, int64, error) {
+	if _, err := Parse(key); err != nil {
+		return nil, 0, err
+	}
+
+	header := netHttp.Header{}
+	header.Set("Range", byteRange(off, n))
+	etag, _ := s.etags.Load(key)
+	if etag != nil {
+		header.Set("If-Match", etag.(string))
+	}
+	resp, err := s.get(s.ctx, key, header)
+	if err != nil {
+		var statusErr StatusError
+		if off > 0 && errors.As(err, &statusErr) && statusErr.StatusCode == netHttp.StatusRequestedRangeNotSatisfiable {
+			return nil, 0, io.EOF
+		}
+		if errors.Is(HTTPError(err), geocubeStorage.ErrFileNotFound) {
+			return nil, -1, syscall.ENOENT
+		}
+		return nil, 0, fmt.Errorf("new reader for %s: %w", key, HTTPError(err))
+	}
+	if etag == nil {
+		if e := strongETag(resp.Header.Get("ETag")); e != "" {
+			s.etags.Store(key, e)
+		}
+	}
+
+	if resp.StatusCode == netHttp.StatusPartialContent {
+		size, ok := totalSize(resp.Header.Get("Content-Range"))
+		if !ok {
+			size = resp.ContentLength
+		}
+		return resp.Body, size, nil
+	}
+
+	// The server does not support range requests: skip the first bytes
+	if _, err := io.CopyN(io.Discard, resp.Body, off); err != nil {
+		resp.Body.Close()
+		if err == io.EOF {
+			return nil, 0, io.EOF
+		}
+		return nil, 0, fmt.Errorf("new reader for %s: %w", key, HTTPError(err))
+	}
+	body := io.ReadCloser(resp.Body)
+	if n > 0 {
+		body = struct {
+			io.Reader
+			io.Closer
+		}{io.LimitReader(resp.Body, n), resp.Body}
+	}
+	return body, resp.ContentLength, nil
+}
+
+func (s *httpStrategy) decodeURI(_ context.Context, uri string) (string, error) {
+	if _, err := Parse(uri); err != nil {
+		return "", fmt.Errorf("failed to parse URI : %s : %w", uri, err)
+	}
+
+	return uri, nil
+}
+
+// head sends a HEAD request and returns the response (with a closed body)
+func (s httpStrategy) head(ctx context.Context, uri string) (*netHttp.Response, error) {
+	resp, err := s.do(ctx, netHttp.MethodHead, uri, nil)
+	if err != nil {
+		return nil, HTTPError(err)
+	}
+	resp.Body.Close()
+	return resp, nil
+}
+
+// get sends a GET request and returns the response. The body must be closed by the caller.
+func (s httpStrategy) get(ctx context.Context, uri string, header netHttp.Header) (*netHttp.Response, error) {
+	return s.do(ctx, netHttp.MethodGet, uri, header)
+}
+
+func (s httpStrategy) do(ctx context.Context, method, uri string, header netHttp.Header) (*netHttp.Response, error) {
+	req, err := netHttp.NewRequestWithContext(ctx, method, uri, nil)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		resp.Body.Close()
+		return nil, StatusError{StatusCode: resp.StatusCode, Status: resp.Status}
+	}
+	return resp, nil
+}
+
+// downloadTo downloads the file to w. In case of retry, the download is resumed
+// if the server supports range requests and the etag of the file has not changed.
+func (s httpStrategy) downloadTo(ctx context.Context, uri string, w io.Writer, opts ...geocubeStorage.Option) error {
+	op := geocubeStorage.Apply(opts...)
+	d := op.Delay
+	var err error
+	var etag string
+	curOffset := op.Offset
+	bytesRemaining := op.Length
+	for try := 0; try < op.MaxTries; try++ {
+		if try > 0 {
+			time.Sleep(d)
+			d *= 2
+		}
+		header := netHttp.Header{}
+		if curOffset > 0 || bytesRemaining > 0 {
+			header.Set("Range", byteRange(curOffset, bytesRemaining))
+		}
+		if etag != "" {
+			header.Set("If-Match", etag)
+		}
+		var resp *netHttp.Response
+		resp, err = s.get(ctx, uri, header)
+		if err != nil {
+			err = HTTPError(err)
+			if utils.Retriable(err) {
+				continue
+			} else {
+				return fmt.Errorf("http.download.get: %w", err)
+			}
+		}
+		if header.Get("Range") != "" && resp.StatusCode != netHttp.StatusPartialContent {
+			resp.Body.Close()
+			return fmt.Errorf("http.download.get: %s does not support range requests", uri)
+		}
+		if etag == "" {
+			etag = strongETag(resp.Header.Get("ETag"))
+		}
+
+		var n int64
+		n, err = io.Copy(w, resp.Body)
+		resp.Body.Close()
+		if err == nil {
+			return nil
+		}
+		err = HTTPError(err)
+		if !utils.Retriable(err) {
+			return fmt.Errorf("http.download.copy: %w", err)
+		}
+		if etag == "" {
+			// Without etag, it cannot be ensured that the file has not changed
+			return fmt.Errorf("http.download.copy: unable to resume download: %w", err)
+		}
+
+		curOffset += n
+		if bytesRemaining > 0 {
+			bytesRemaining -= n
+		}
+	}
+	return fmt.Errorf("failed after %d retries: %w", op.MaxTries, err)
+}
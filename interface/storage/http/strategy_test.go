@@ -0,0 +1,158 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	netHttp "net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	geocubeStorage "github.com/airbusgeo/geocube/interface/storage"
+)
+
+// fakeServer serves files with etags and range requests (using http.ServeContent)
+type fakeServer struct {
+	mu       sync.Mutex
+	files    map[string][]byte
+	versions map[string]int
+}
+
+func (f *fakeServer) set(path string, data []byte) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.files[path] = data
+	f.versions[path]++
+}
+
+func (f *fakeServer) ServeHTTP(w netHttp.ResponseWriter, r *netHttp.Request) {
+	f.mu.Lock()
+	data, ok := f.files[r.URL.Path]
+	version := f.versions[r.URL.Path]
+	f.mu.Unlock()
+	if !ok {
+		netHttp.NotFound(w, r)
+		return
+	}
+	w.Header().Set("ETag", fmt.Sprintf(`"%d"`, version))
+	w.Header().Set("Content-Type", "image/tiff")
+	netHttp.ServeContent(w, r, "", time.Time{}, bytes.NewReader(data))
+}
+
+func newTestServer(t *testing.T) (*fakeServer, string) {
+	f := &fakeServer{files: map[string][]byte{}, versions: map[string]int{}}
+	server := httptest.NewServer(f)
+	t.Cleanup(server.Close)
+	return f, server.URL
+}
+
+func TestParse(t *testing.T) {
+	test := func(u string, mustErr bool) {
+		t.Helper()
+		_, err := Parse(u)
+		if mustErr && err == nil {
+			t.Errorf("%s: error not raised", u)
+		} else if !mustErr && err != nil {
+			t.Errorf("%s: %v", u, err)
+		}
+	}
+	test("https://host/path/to/file.tif", false)
+	test("http://host:8080/file.tif?token=abc", false)
+	test("https://host", true)
+	test("https://host/path/", true)
+	test("gs://bucket/file.tif", true)
+}
+
+func TestReadOnly(t *testing.T) {
+	ctx := context.Background()
+	s, _ := NewHTTPStrategy(ctx)
+	uri := "https://host/file.tif"
+	if err := s.Upload(ctx, uri, []byte("data")); !errors.Is(err, geocubeStorage.ErrReadOnly) {
+		t.Errorf("Upload: expecting ErrReadOnly, found %v", err)
+	}
+	if err := s.UploadFile(ctx, uri, io.NopCloser(strings.NewReader("data"))); !errors.Is(err, geocubeStorage.ErrReadOnly) {
+		t.Errorf("UploadFile: expecting ErrReadOnly, found %v", err)
+	}
+	if err := s.Delete(ctx, uri, geocubeStorage.IgnoreNotFound()); !errors.Is(err, geocubeStorage.ErrReadOnly) {
+		t.Errorf("Delete: expecting ErrReadOnly, found %v", err)
+	}
+}
+
+func TestDownload(t *testing.T) {
+	ctx := context.Background()
+	f, url := newTestServer(t)
+	s, _ := NewHTTPStrategy(ctx)
+	uri := url + "/path/to/file.tif"
+	data := []byte("0123456789")
+	f.set("/path/to/file.tif", data)
+
+	if exist, err := s.Exist(ctx, uri); !exist || err != nil {
+		t.Errorf("Exist: expecting true, found %v, %v", exist, err)
+	}
+	attrs, err := s.GetAttrs(ctx, uri)
+	if err != nil {
+		t.Fatalf("GetAttrs: %v", err)
+	}
+	if attrs.Size != int64(len(data)) || attrs.StorageClass != "STANDARD" || attrs.ContentType != "image/tiff" {
+		t.Errorf("GetAttrs: unexpected attributes %+v", attrs)
+	}
+
+	got, err := s.Download(ctx, uri, geocubeStorage.Offset(2), geocubeStorage.Length(3))
+	if err != nil {
+		t.Fatalf("Download: %v", err)
+	}
+	if !bytes.Equal(got, data[2:5]) {
+		t.Errorf("Download: expecting %s, found %s", data[2:5], got)
+	}
+
+	dest := t.TempDir() + "/sub/file.tif"
+	if err := s.DownloadToFile(ctx, uri, dest); err != nil {
+		t.Fatalf("DownloadToFile: %v", err)
+	}
+	if got, _ := os.ReadFile(dest); !bytes.Equal(got, data) {
+		t.Errorf("DownloadToFile: expecting %s, found %s", data, got)
+	}
+
+	if _, err := s.Exist(ctx, url+"/notfound.tif"); !errors.Is(err, geocubeStorage.ErrFileNotFound) {
+		t.Errorf("Exist: expecting ErrFileNotFound, found %v", err)
+	}
+	if _, err := s.Download(ctx, url+"/notfound.tif"); !errors.Is(err, geocubeStorage.ErrFileNotFound) {
+		t.Errorf("Download: expecting ErrFileNotFound, found %v", err)
+	}
+}
+
+func TestStreamAt(t *testing.T) {
+	f, url := newTestServer(t)
+	s, _ := NewHTTPStrategy(context.Background())
+	uri := url + "/file.tif"
+	data := []byte("0123456789")
+	f.set("/file.tif", data)
+
+	r, size, err := s.StreamAt(uri, 4, 100)
+	if err != nil {
+		t.Fatalf("StreamAt: %v", err)
+	}
+	got, _ := io.ReadAll(r)
+	r.Close()
+	if size != int64(len(data)) || !bytes.Equal(got, data[4:]) {
+		t.Errorf("StreamAt: expecting %s (size %d), found %s (size %d)", data[4:], len(data), got, size)
+	}
+	if _, _, err := s.StreamAt(uri, 100, 10); err != io.EOF {
+		t.Errorf("StreamAt: expecting EOF, found %v", err)
+	}
+	if _, size, err := s.StreamAt(url+"/notfound.tif", 0, 10); size != -1 || err == nil {
+		t.Errorf("StreamAt: expecting ENOENT, found %d, %v", size, err)
+	}
+
+	// The file is modified: the etag does not match anymore
+	f.set("/file.tif", []byte("9876543210"))
+	if _, _, err := s.StreamAt(uri, 0, 10); !errors.Is(err, ErrFileChanged) {
+		t.Errorf("StreamAt: expecting ErrFileChanged, found %v", err)
+	}
+}
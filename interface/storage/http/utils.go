@@ -0,0 +1,53 @@
+package http
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Parse checks that the uri is an absolute http(s) url and returns it
+func Parse(httpUri string) (*url.URL, error) {
+	u, err := url.Parse(httpUri)
+	if err != nil {
+		return nil, err
+	}
+	switch strings.ToLower(u.Scheme) {
+	case "http", "https":
+	default:
+		return nil, fmt.Errorf("unsupported scheme: %s", u.Scheme)
+	}
+	if u.Host == "" || u.Path == "" || strings.HasSuffix(u.Path, "/") {
+		return nil, fmt.Errorf("missing host or path")
+	}
+	return u, nil
+}
+
+// byteRange formats a http Range header value (n <= 0 means until the end of the file)
+func byteRange(off, n int64) string {
+	if n <= 0 {
+		return fmt.Sprintf("bytes=%d-", off)
+	}
+	return fmt.Sprintf("bytes=%d-%d", off, off+n-1)
+}
+
+// totalSize parses the total size of the file from a Content-Range header value (e.g. "bytes 0-99/1234")
+func totalSize(contentRange string) (int64, bool) {
+	idx := strings.LastIndex(contentRange, "/")
+	if idx == -1 || contentRange[idx+1:] == "*" {
+		return 0, false
+	}
+	var size int64
+	if _, err := fmt.Sscanf(contentRange[idx+1:], "%d", &size); err != nil {
+		return 0, false
+	}
+	return size, true
+}
+
+// strongETag returns the etag if it can be used in a If-Match header (weak etags cannot)
+func strongETag(etag string) string {
+	if strings.HasPrefix(etag, "W/") {
+		return ""
+	}
+	return etag
+}
@@ -0,0 +1,122 @@
+package mem
+
+import (
+	"bytes"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	geocubeStorage "github.com/airbusgeo/geocube/interface/storage"
+)
+
+// Store is a thread-safe in-memory object storage, indexed by uri (mem://bucket/path/to/object).
+// It implements godal.KeySizerReaderAt, so that it can be registered as a GDAL VSI handler and
+// the objects can be opened by GDAL (the default store is registered by NewMemStrategy).
+type Store struct {
+	mutex      sync.RWMutex
+	objects    map[string]*object
+	generation int64
+}
+
+// object is never modified once stored, so that its data can be read without lock
+type object struct {
+	data         []byte
+	storageClass string
+	modTime      time.Time
+	generation   int64
+	checksums    geocubeStorage.Checksums
+}
+
+// NewStore creates an empty store
+func NewStore() *Store {
+	return &Store{objects: map[string]*object{}}
+}
+
+var defaultStore = NewStore()
+
+// DefaultStore returns the store shared by all the strategies created with NewMemStrategy
+func DefaultStore() *Store {
+	return defaultStore
+}
+
+// Reset removes all the objects of the store
+func (st *Store) Reset() {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.objects = map[string]*object{}
+}
+
+// ReadAt reads len(buf) bytes of the object at offset off (implements godal.KeySizerReaderAt)
+func (st *Store) ReadAt(key string, buf []byte, off int64) (int, error) {
+	o, ok := st.get(key)
+	if !ok {
+		return 0, syscall.ENOENT
+	}
+	if off >= int64(len(o.data)) {
+		return 0, io.EOF
+	}
+	n := copy(buf, o.data[off:])
+	if n < len(buf) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+// Size returns the size of the object (implements godal.KeySizerReaderAt)
+func (st *Store) Size(key string) (int64, error) {
+	o, ok := st.get(key)
+	if !ok {
+		return -1, syscall.ENOENT
+	}
+	return int64(len(o.data)), nil
+}
+
+func (st *Store) get(uri string) (*object, bool) {
+	st.mutex.RLock()
+	defer st.mutex.RUnlock()
+	o, ok := st.objects[uri]
+	return o, ok
+}
+
+// put stores the data (that must not be modified afterward) and returns the new object
+func (st *Store) put(uri string, data []byte, storageClass string) *object {
+	checksums, _, _ := geocubeStorage.ComputeChecksums(bytes.NewReader(data))
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.generation++
+	o := &object{
+		data:         data,
+		storageClass: storageClass,
+		modTime:      time.Now(),
+		generation:   st.generation,
+		checksums:    checksums,
+	}
+	st.objects[uri] = o
+	return o
+}
+
+// delete removes the object and returns false if it does not exist
+func (st *Store) delete(uri string) bool {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	_, ok := st.objects[uri]
+	delete(st.objects, uri)
+	return ok
+}
+
+// list returns the uris starting with prefix and greater than startAfter, sorted in lexicographical order
+func (st *Store) list(prefix, startAfter string) []string {
+	st.mutex.RLock()
+	var uris []string
+	for uri := range st.objects {
+		if strings.HasPrefix(uri, prefix) && uri > startAfter {
+			uris = append(uris, uri)
+		}
+	}
+	st.mutex.RUnlock()
+	sort.Strings(uris)
+	return uris
+}
@@ -0,0 +1,205 @@
+package mem
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+
+	geocubeStorage "github.com/airbusgeo/geocube/interface/storage"
+	"github.com/airbusgeo/godal"
+)
+
+// memStrategy stores the files in memory (in a Store).
+// It is intended for the tests and the all-in-one mode: the files are lost when the process exits.
+type memStrategy struct {
+	store *Store
+}
+
+// NewMemStrategy creates a strategy using the default store, shared by the whole process.
+// The default store is registered as the GDAL VSI handler of "mem://", so that the files of the strategy can be opened by GDAL.
+func NewMemStrategy(ctx context.Context) (geocubeStorage.Strategy, error) {
+	if err := registerVSIHandler(); err != nil {
+		return nil, fmt.Errorf("NewMemStrategy: %w", err)
+	}
+	return NewMemStrategyWithStore(ctx, defaultStore)
+}
+
+var (
+	registerVSIHandlerOnce sync.Once
+	registerVSIHandlerErr  error
+)
+
+// registerVSIHandler registers the default store as the GDAL VSI handler of "mem://" (once per process)
+func registerVSIHandler() error {
+	registerVSIHandlerOnce.Do(func() {
+		if !godal.HasVSIHandler("mem://") {
+			registerVSIHandlerErr = godal.RegisterVSIHandler("mem://", defaultStore)
+		}
+	})
+	return registerVSIHandlerErr
+}
+
+// NewMemStrategyWithStore creates a strategy using a custom store
+func NewMemStrategyWithStore(ctx context.Context, store *Store) (geocubeStorage.Strategy, error) {
+	return memStrategy{store: store}, nil
+}
+
+func (s memStrategy) Download(ctx context.Context, uri string, options ...geocubeStorage.Option) ([]byte, error) {
+	o, err := s.getObject(uri)
+	if err != nil {
+		return nil, err
+	}
+	opts := geocubeStorage.Apply(options...)
+	data := o.data
+	if opts.Offset >= int64(len(data)) {
+		return []byte{}, nil
+	}
+	data = data[opts.Offset:]
+	if opts.Length > 0 && opts.Length < int64(len(data)) {
+		data = data[:opts.Length]
+	}
+	return bytes.Clone(data), nil
+}
+
+func (s memStrategy) DownloadToFile(ctx context.Context, source, destination string, options ...geocubeStorage.Option) error {
+	data, err := s.Download(ctx, source, options...)
+	if err != nil {
+		return err
+	}
+
+	if _, err := os.Stat(filepath.Dir(destination)); os.IsNotExist(err) {
+		if err := os.MkdirAll(filepath.Dir(destination), os.ModePerm); err != nil {
+			return err
+		}
+	}
+
+	if err := os.WriteFile(destination, data, 0666); err != nil {
+		return fmt.Errorf("failed to download file to destination: %w", err)
+	}
+	return nil
+}
+
+func (s memStrategy) Upload(ctx context.Context, uri string, data []byte, options ...geocubeStorage.Option) error {
+	if _, _, err := Parse(uri); err != nil {
+		return fmt.Errorf("failed to decode URI %s : %w", uri, err)
+	}
+	opts := geocubeStorage.Apply(options...)
+	storageClass := opts.StorageClass
+	if storageClass == "" {
+		storageClass = "STANDARD"
+	}
+	s.store.put(uri, bytes.Clone(data), storageClass)
+	return nil
+}
+
+func (s memStrategy) UploadFile(ctx context.Context, uri string, data io.ReadCloser, options ...geocubeStorage.Option) error {
+	defer data.Close()
+	b, err := io.ReadAll(data)
+	if err != nil {
+		return fmt.Errorf("failed to read data: %w", err)
+	}
+	return s.Upload(ctx, uri, b, options...)
+}
+
+func (s memStrategy) Delete(ctx context.Context, uri string, options ...geocubeStorage.Option) error {
+	if _, _, err := Parse(uri); err != nil {
+		return fmt.Errorf("failed to decode URI %s : %w", uri, err)
+	}
+	opts := geocubeStorage.Apply(options...)
+	if !s.store.delete(uri) && !opts.IgnoreNotFound {
+		return fmt.Errorf("failed to delete file %s: %w", uri, geocubeStorage.ErrFileNotFound)
+	}
+	return nil
+}
+
+func (s memStrategy) BulkDelete(ctx context.Context, uris []string, options ...geocubeStorage.Option) error {
+	return geocubeStorage.ParallelDelete(ctx, s.Delete, uris, options...)
+}
+
+// List returns the files whose uri starts with prefix.
+// The list of uris is retrieved at once (PageSize is not used), but the files deleted during the iteration are skipped.
+func (s memStrategy) List(ctx context.Context, prefix string, options ...geocubeStorage.Option) (geocubeStorage.ObjectIterator, error) {
+	if !strings.HasPrefix(prefix, "mem://") {
+		return nil, fmt.Errorf("failed to decode URI %s : missing mem:// prefix", prefix)
+	}
+	opts := geocubeStorage.Apply(options...)
+	return &objectIterator{store: s.store, uris: s.store.list(prefix, opts.StartAfter)}, nil
+}
+
+type objectIterator struct {
+	store *Store
+	uris  []string
+}
+
+func (it *objectIterator) Next() (geocubeStorage.ObjectAttrs, error) {
+	for len(it.uris) > 0 {
+		uri := it.uris[0]
+		it.uris = it.uris[1:]
+		if o, ok := it.store.get(uri); ok {
+			return geocubeStorage.ObjectAttrs{
+				URI:          uri,
+				StorageClass: o.storageClass,
+				Size:         int64(len(o.data)),
+				ModTime:      o.modTime,
+			}, nil
+		}
+	}
+	return geocubeStorage.ObjectAttrs{}, geocubeStorage.ErrIteratorDone
+}
+
+func (s memStrategy) Exist(ctx context.Context, uri string) (bool, error) {
+	if _, err := s.getObject(uri); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (s memStrategy) GetAttrs(ctx context.Context, uri string) (geocubeStorage.Attrs, error) {
+	o, err := s.getObject(uri)
+	if err != nil {
+		return geocubeStorage.Attrs{}, err
+	}
+	return geocubeStorage.Attrs{
+		ContentType:  http.DetectContentType(o.data),
+		StorageClass: o.storageClass,
+		Size:         int64(len(o.data)),
+		Version:      strconv.FormatInt(o.generation, 10),
+		Checksums:    o.checksums,
+	}, nil
+}
+
+func (s memStrategy) StreamAt(key string, off int64, n int64) (io.ReadCloser, int64, error) {
+	o, ok := s.store.get(key)
+	if !ok {
+		return nil, -1, syscall.ENOENT
+	}
+	size := int64(len(o.data))
+	if off >= size && off > 0 {
+		return nil, 0, io.EOF
+	}
+	end := size
+	if n > 0 && off+n < end {
+		end = off + n
+	}
+	return io.NopCloser(bytes.NewReader(o.data[off:end])), size, nil
+}
+
+// getObject returns the object or ErrFileNotFound
+func (s memStrategy) getObject(uri string) (*object, error) {
+	if _, _, err := Parse(uri); err != nil {
+		return nil, fmt.Errorf("failed to decode URI %s : %w", uri, err)
+	}
+	o, ok := s.store.get(uri)
+	if !ok {
+		return nil, geocubeStorage.ErrFileNotFound
+	}
+	return o, nil
+}
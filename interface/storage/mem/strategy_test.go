@@ -0,0 +1,183 @@
+package mem
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"syscall"
+	"testing"
+
+	geocubeStorage "github.com/airbusgeo/geocube/interface/storage"
+)
+
+func TestParse(t *testing.T) {
+	test := func(u, bucket, key string, mustErr bool) {
+		t.Helper()
+		b, k, err := Parse(u)
+		if mustErr {
+			if err == nil {
+				t.Errorf("%s: error not raised", u)
+			}
+			return
+		}
+		if err != nil || b != bucket || k != key {
+			t.Errorf("%s: expecting %s, %s, found %s, %s, %v", u, bucket, key, b, k, err)
+		}
+	}
+	test("mem://bucket/path/to/file.tif", "bucket", "path/to/file.tif", false)
+	test("mem://bucket", "", "", true)
+	test("mem://bucket/", "", "", true)
+	test("gs://bucket/file.tif", "", "", true)
+}
+
+func TestStrategy(t *testing.T) {
+	ctx := context.Background()
+	s, _ := NewMemStrategyWithStore(ctx, NewStore())
+	uri := "mem://bucket/path/to/file.tif"
+	data := []byte("0123456789")
+
+	if _, err := s.Download(ctx, uri); !errors.Is(err, geocubeStorage.ErrFileNotFound) {
+		t.Errorf("Download: expecting ErrFileNotFound, found %v", err)
+	}
+	if err := s.UploadFile(ctx, uri, io.NopCloser(bytes.NewReader(data)), geocubeStorage.StorageClass("ARCHIVE")); err != nil {
+		t.Fatalf("UploadFile: %v", err)
+	}
+	if exist, err := s.Exist(ctx, uri); !exist || err != nil {
+		t.Errorf("Exist: expecting true, found %v, %v", exist, err)
+	}
+	attrs, err := s.GetAttrs(ctx, uri)
+	if err != nil || attrs.Size != int64(len(data)) || attrs.StorageClass != "ARCHIVE" || attrs.Version == "" {
+		t.Errorf("GetAttrs: unexpected attributes %+v, %v", attrs, err)
+	}
+	if expected, _, _ := geocubeStorage.ComputeChecksums(bytes.NewReader(data)); attrs.Checksums != expected {
+		t.Errorf("GetAttrs: expecting checksums %s, found %s", expected, attrs.Checksums)
+	}
+
+	got, err := s.Download(ctx, uri, geocubeStorage.Offset(2), geocubeStorage.Length(3))
+	if err != nil || !bytes.Equal(got, data[2:5]) {
+		t.Errorf("Download: expecting %s, found %s, %v", data[2:5], got, err)
+	}
+	dest := t.TempDir() + "/sub/file.tif"
+	if err := s.DownloadToFile(ctx, uri, dest); err != nil {
+		t.Fatalf("DownloadToFile: %v", err)
+	}
+	if got, _ := os.ReadFile(dest); !bytes.Equal(got, data) {
+		t.Errorf("DownloadToFile: expecting %s, found %s", data, got)
+	}
+
+	// A new upload changes the version
+	if err := s.Upload(ctx, uri, []byte("9876543210")); err != nil {
+		t.Fatalf("Upload: %v", err)
+	}
+	if newAttrs, _ := s.GetAttrs(ctx, uri); newAttrs.Version == attrs.Version {
+		t.Errorf("GetAttrs: version has not changed: %s", newAttrs.Version)
+	}
+
+	if err := s.Delete(ctx, uri); err != nil {
+		t.Errorf("Delete: %v", err)
+	}
+	if err := s.Delete(ctx, uri); !errors.Is(err, geocubeStorage.ErrFileNotFound) {
+		t.Errorf("Delete: expecting ErrFileNotFound, found %v", err)
+	}
+	if err := s.Delete(ctx, uri, geocubeStorage.IgnoreNotFound()); err != nil {
+		t.Errorf("Delete: %v", err)
+	}
+	if err := s.Upload(ctx, "gs://bucket/file.tif", data); err == nil {
+		t.Errorf("Upload: error not raised")
+	}
+}
+
+func TestStreamAt(t *testing.T) {
+	ctx := context.Background()
+	store := NewStore()
+	s, _ := NewMemStrategyWithStore(ctx, store)
+	uri := "mem://bucket/file.tif"
+	data := []byte("0123456789")
+	s.Upload(ctx, uri, data)
+
+	r, size, err := s.StreamAt(uri, 4, 100)
+	if err != nil {
+		t.Fatalf("StreamAt: %v", err)
+	}
+	got, _ := io.ReadAll(r)
+	if size != int64(len(data)) || !bytes.Equal(got, data[4:]) {
+		t.Errorf("StreamAt: expecting %s (size %d), found %s (size %d)", data[4:], len(data), got, size)
+	}
+	if _, _, err := s.StreamAt(uri, 100, 10); err != io.EOF {
+		t.Errorf("StreamAt: expecting EOF, found %v", err)
+	}
+	if _, size, err := s.StreamAt("mem://bucket/notfound.tif", 0, 10); err != syscall.ENOENT || size != -1 {
+		t.Errorf("StreamAt: expecting ENOENT, found %d, %v", size, err)
+	}
+
+	// VSI handler
+	buf := make([]byte, 4)
+	if n, err := store.ReadAt(uri, buf, 8); n != 2 || err != io.EOF || string(buf[:n]) != "89" {
+		t.Errorf("ReadAt: expecting 89, EOF, found %s, %v", buf[:n], err)
+	}
+	if size, err := store.Size(uri); size != int64(len(data)) || err != nil {
+		t.Errorf("Size: expecting %d, found %d, %v", len(data), size, err)
+	}
+	if _, err := store.Size("mem://bucket/notfound.tif"); err != syscall.ENOENT {
+		t.Errorf("Size: expecting ENOENT, found %v", err)
+	}
+	store.Reset()
+	if _, err := store.Size(uri); err != syscall.ENOENT {
+		t.Errorf("Size: expecting ENOENT after Reset, found %v", err)
+	}
+}
+
+func TestList(t *testing.T) {
+	ctx := context.Background()
+	s, _ := NewMemStrategyWithStore(ctx, NewStore())
+	prefix := "mem://bucket/list/"
+	names := []string{"a.tif", "b.tif", "c/d.tif", "e.tif", "f.tif"}
+	for _, name := range names {
+		if err := s.Upload(ctx, prefix+name, []byte(name)); err != nil {
+			t.Fatalf("Upload: %v", err)
+		}
+	}
+	s.Upload(ctx, "mem://bucket/other.tif", []byte("other"))
+
+	it, err := s.List(ctx, prefix, geocubeStorage.StartAfter(prefix+"a.tif"))
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	objects, err := geocubeStorage.ListAll(it)
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(objects) != len(names)-1 {
+		t.Fatalf("List: expecting %d objects, found %d", len(names)-1, len(objects))
+	}
+	for i, o := range objects {
+		if o.URI != prefix+names[i+1] || o.Size != int64(len(names[i+1])) || o.StorageClass != "STANDARD" || o.ModTime.IsZero() {
+			t.Errorf("List: unexpected object %+v", o)
+		}
+	}
+
+	var deleted []string
+	mu := sync.Mutex{}
+	uris := []string{prefix + "a.tif", prefix + "b.tif", prefix + "notfound.tif"}
+	if err := s.BulkDelete(ctx, uris, geocubeStorage.IgnoreNotFound(), geocubeStorage.OnDelete(func(uri string, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil {
+			t.Errorf("BulkDelete(%s): %v", uri, err)
+		}
+		deleted = append(deleted, uri)
+	})); err != nil || len(deleted) != 3 {
+		t.Errorf("BulkDelete: expecting 3 deletions, found %v, %v", deleted, err)
+	}
+	it, _ = s.List(ctx, prefix)
+	objects, _ = geocubeStorage.ListAll(it)
+	for _, o := range objects {
+		if strings.HasSuffix(o.URI, "/a.tif") || strings.HasSuffix(o.URI, "/b.tif") {
+			t.Errorf("List: %s has not been deleted", o.URI)
+		}
+	}
+}
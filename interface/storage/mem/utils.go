@@ -0,0 +1,24 @@
+package mem
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Parse takes in a string in the form mem://bucket/path/to/object
+// and returns the bucket and the object key
+func Parse(memUri string) (bucket, key string, err error) {
+	if !strings.HasPrefix(memUri, "mem://") {
+		return "", "", fmt.Errorf("missing mem:// prefix")
+	}
+	memUri = strings.TrimPrefix(memUri, "mem://")
+	firstSlash := strings.Index(memUri, "/")
+	if firstSlash != -1 {
+		bucket = memUri[0:firstSlash]
+		key = memUri[firstSlash+1:]
+	}
+	if len(bucket) == 0 || len(key) == 0 {
+		err = fmt.Errorf("missing bucket or key")
+	}
+	return
+}
@@ -0,0 +1,567 @@
+package s3
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	awsConfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+
+	geocubeStorage "github.com/airbusgeo/geocube/interface/storage"
+	"github.com/airbusgeo/geocube/internal/utils"
+)
+
+// multipartChunkSize is the size of the parts of a multipart upload (s3 requires at least 5Mb)
+const multipartChunkSize = 16 * 1024 * 1024
+
+type s3Strategy struct {
+	s3Client *s3.Client
+	ctx      context.Context
+}
+
+// Config of the S3 client. Empty fields fall back on the default aws configuration (environment variables, shared config files...)
+type Config struct {
+	Region                string
+	Endpoint              string // Custom endpoint (e.g. MinIO server). If defined, path-style addressing is used
+	SharedCredentialsFile string
+}
+
+var defaultConfig Config
+
+// SetDefaultConfig defines the configuration used by NewS3Strategy
+func SetDefaultConfig(config Config) {
+	defaultConfig = config
+}
+
+var retriableSuffixErrors = []string{
+	"connection reset by peer",
+	"cannot assign requested address",
+	"broken pipe",
+	"EOF", // Unexpected EOF is a temporary error
+}
+
+func S3Error(err error) error {
+	if err == nil {
+		return nil
+	}
+	var noSuchKey *types.NoSuchKey
+	var notFound *types.NotFound
+	if errors.As(err, &noSuchKey) || errors.As(err, &notFound) {
+		return geocubeStorage.ErrFileNotFound
+	}
+	var respErr *awshttp.ResponseError
+	if errors.As(err, &respErr) {
+		code := respErr.HTTPStatusCode()
+		if code == 404 && !strings.Contains(err.Error(), "NoSuchBucket") {
+			return geocubeStorage.ErrFileNotFound
+		}
+		if code == 429 || (code >= 500 && code < 600) {
+			return utils.MakeTemporary(err)
+		}
+	}
+	if utils.Temporary(err) {
+		return err
+	}
+	for _, e := range retriableSuffixErrors {
+		if strings.HasSuffix(err.Error(), e) {
+			return utils.MakeTemporary(err)
+		}
+	}
+	return err
+}
+
+// NewClient creates a s3 client using the configuration
+func NewClient(ctx context.Context, config Config) (*s3.Client, error) {
+	var opts []func(*awsConfig.LoadOptions) error
+	if config.Region != "" {
+		opts = append(opts, awsConfig.WithRegion(config.Region))
+	}
+	if config.SharedCredentialsFile != "" {
+		opts = append(opts, awsConfig.WithSharedCredentialsFiles([]string{config.SharedCredentialsFile}))
+	}
+	awsCfg, err := awsConfig.LoadDefaultConfig(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load aws config: %w", err)
+	}
+	return s3.NewFromConfig(awsCfg, func(o *s3.Options) {
+		if config.Endpoint != "" {
+			o.BaseEndpoint = aws.String(config.Endpoint)
+			o.UsePathStyle = true
+		}
+	}), nil
+}
+
+// NewS3Strategy creates a s3 strategy using the default configuration (see SetDefaultConfig)
+func NewS3Strategy(ctx context.Context) (geocubeStorage.Strategy, error) {
+	return NewS3StrategyWithConfig(ctx, defaultConfig)
+}
+
+// NewS3StrategyWithConfig creates a s3 strategy using a custom configuration
+func NewS3StrategyWithConfig(ctx context.Context, config Config) (geocubeStorage.Strategy, error) {
+	s3Client, err := NewClient(ctx, config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create s3 Client : %w", S3Error(err))
+	}
+
+	return s3Strategy{
+		s3Client: s3Client,
+		ctx:      ctx,
+	}, nil
+}
+
+func (s s3Strategy) Download(ctx context.Context, uri string, options ...geocubeStorage.Option) ([]byte, error) {
+	bucket, key, err := s.decodeURI(ctx, uri)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode URI %s : %w", uri, err)
+	}
+
+	buf := &bytes.Buffer{}
+	if err := s.downloadObjectTo(ctx, bucket, key, buf, options...); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (s s3Strategy) DownloadToFile(ctx context.Context, source, destination string, options ...geocubeStorage.Option) error {
+	bucket, key, err := s.decodeURI(ctx, source)
+	if err != nil {
+		return fmt.Errorf("failed to decode URI %s : %w", source, err)
+	}
+
+	if _, err := os.Stat(filepath.Dir(destination)); os.IsNotExist(err) {
+		if err := os.MkdirAll(filepath.Dir(destination), os.ModePerm); err != nil {
+			return err
+		}
+	}
+
+	writer, err := os.Create(destination)
+	if err != nil {
+		return fmt.Errorf("failed to create destination file")
+	}
+
+	if err = s.downloadObjectTo(ctx, bucket, key, writer, options...); err != nil {
+		writer.Close()
+		return fmt.Errorf("failed to download object to destination: %w", err)
+	}
+
+	if err = writer.Close(); err != nil {
+		return fmt.Errorf("DownloadToFile: failed to close writer: %w", err)
+	}
+
+	return nil
+}
+
+func (s s3Strategy) Upload(ctx context.Context, uri string, data []byte, options ...geocubeStorage.Option) error {
+	bucket, key, err := s.decodeURI(ctx, uri)
+	if err != nil {
+		return fmt.Errorf("failed to decode URI %s : %w", uri, err)
+	}
+
+	return s.putObject(ctx, bucket, key, data, options...)
+}
+
+// UploadFile uploads the content of data using a multipart upload (if data is bigger than multipartChunkSize)
+func (s s3Strategy) UploadFile(ctx context.Context, uri string, data io.ReadCloser, options ...geocubeStorage.Option) error {
+	bucket, key, err := s.decodeURI(ctx, uri)
+	if err != nil {
+		return fmt.Errorf("failed to decode URI %s : %w", uri, err)
+	}
+
+	chunk := make([]byte, multipartChunkSize)
+	n, err := io.ReadFull(data, chunk)
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		// Small file: a single request is enough
+		if err = s.putObject(ctx, bucket, key, chunk[:n], options...); err != nil {
+			return fmt.Errorf("UploadFile: %w", err)
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("UploadFile: failed to read: %w", err)
+	}
+
+	if err = s.multipartUpload(ctx, bucket, key, chunk, data, options...); err != nil {
+		return fmt.Errorf("UploadFile: %w", err)
+	}
+	return nil
+}
+
+func (s s3Strategy) Delete(ctx context.Context, uri string, options ...geocubeStorage.Option) error {
+	bucket, key, err := s.decodeURI(ctx, uri)
+	if err != nil {
+		return fmt.Errorf("failed to decode URI %s : %w", uri, err)
+	}
+
+	return s.deleteObject(ctx, bucket, key, options...)
+}
+
+func (s s3Strategy) BulkDelete(ctx context.Context, uris []string, options ...geocubeStorage.Option) error {
+	return geocubeStorage.ParallelDelete(ctx, s.Delete, uris, options...)
+}
+
+// List returns the objects whose key starts with prefix (s3://bucket/prefix)
+func (s s3Strategy) List(ctx context.Context, prefix string, options ...geocubeStorage.Option) (geocubeStorage.ObjectIterator, error) {
+	bucket, key := parse(prefix)
+	if bucket == "" {
+		return nil, fmt.Errorf("failed to decode URI %s : missing bucket", prefix)
+	}
+
+	opts := geocubeStorage.Apply(options...)
+	input := &s3.ListObjectsV2Input{
+		Bucket:  aws.String(bucket),
+		Prefix:  aws.String(key),
+		MaxKeys: aws.Int32(int32(opts.PageSize)),
+	}
+	if opts.StartAfter != "" {
+		_, startAfter := parse(opts.StartAfter)
+		input.StartAfter = aws.String(startAfter)
+	}
+
+	return &objectIterator{
+		ctx:       ctx,
+		bucket:    bucket,
+		paginator: s3.NewListObjectsV2Paginator(s.s3Client, input),
+	}, nil
+}
+
+type objectIterator struct {
+	ctx       context.Context
+	bucket    string
+	paginator *s3.ListObjectsV2Paginator
+	page      []types.Object
+}
+
+func (it *objectIterator) Next() (geocubeStorage.ObjectAttrs, error) {
+	for len(it.page) == 0 {
+		if !it.paginator.HasMorePages() {
+			return geocubeStorage.ObjectAttrs{}, geocubeStorage.ErrIteratorDone
+		}
+		page, err := it.paginator.NextPage(it.ctx)
+		if err != nil {
+			return geocubeStorage.ObjectAttrs{}, fmt.Errorf("s3.list[%s]: %w", it.bucket, S3Error(err))
+		}
+		it.page = page.Contents
+	}
+	o := it.page[0]
+	it.page = it.page[1:]
+
+	storageClass := string(o.StorageClass)
+	if storageClass == "" {
+		storageClass = string(types.StorageClassStandard)
+	}
+	return geocubeStorage.ObjectAttrs{
+		URI:          "s3://" + it.bucket + "/" + aws.ToString(o.Key),
+		StorageClass: storageClass,
+		Size:         aws.ToInt64(o.Size),
+		ModTime:      aws.ToTime(o.LastModified),
+	}, nil
+}
+
+func (s s3Strategy) Exist(ctx context.Context, uri string) (bool, error) {
+	bucket, key, err := s.decodeURI(ctx, uri)
+	if err != nil {
+		return false, fmt.Errorf("failed to decode URI %s : %w", uri, err)
+	}
+
+	if _, err = s.headObject(ctx, bucket, key); err != nil {
+		if errors.Is(err, geocubeStorage.ErrFileNotFound) {
+			return false, geocubeStorage.ErrFileNotFound
+		}
+		return false, fmt.Errorf("failed to check if file exist on storage: %w", err)
+	}
+
+	return true, nil
+}
+
+func (s s3Strategy) GetAttrs(ctx context.Context, uri string) (geocubeStorage.Attrs, error) {
+	bucket, key, err := s.decodeURI(ctx, uri)
+	if err != nil {
+		return geocubeStorage.Attrs{}, fmt.Errorf("failed to decode URI %s : %w", uri, err)
+	}
+
+	attrs, err := s.headObject(ctx, bucket, key)
+	if errors.Is(err, geocubeStorage.ErrFileNotFound) {
+		return geocubeStorage.Attrs{}, geocubeStorage.ErrFileNotFound
+	} else if err != nil {
+		return geocubeStorage.Attrs{}, fmt.Errorf("failed to get file attributes from S3 : %w", err)
+	}
+
+	// S3 does not return the storage class of STANDARD objects
+	storageClass := string(attrs.StorageClass)
+	if storageClass == "" {
+		storageClass = string(types.StorageClassStandard)
+	}
+
+	return geocubeStorage.Attrs{
+		StorageClass: storageClass,
+		ContentType:  aws.ToString(attrs.ContentType),
+		Size:         aws.ToInt64(attrs.ContentLength),
+		Version:      aws.ToString(attrs.ETag),
+		Checksums: geocubeStorage.Checksums{
+			// The checksum of a multipart upload is a checksum of the checksums of the parts
+			CRC32C: fullObjectChecksum(aws.ToString(attrs.ChecksumCRC32C)),
+		},
+	}, nil
+}
+
+func (s s3Strategy) StreamAt(key string, off int64, n int64) (io.ReadCloser, int64, error) {
+	bucket, object, err := Parse(key)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	r, err := s.s3Client.GetObject(s.ctx, &s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(object),
+		Range:  aws.String(byteRange(off, n)),
+	})
+	if err != nil {
+		var respErr *awshttp.ResponseError
+		if off > 0 && errors.As(err, &respErr) && respErr.HTTPStatusCode() == 416 {
+			return nil, 0, io.EOF
+		}
+		if errors.Is(S3Error(err), geocubeStorage.ErrFileNotFound) {
+			return nil, -1, syscall.ENOENT
+		}
+		return nil, 0, fmt.Errorf("new reader for s3://%s/%s: %w", bucket, object, S3Error(err))
+	}
+
+	size, ok := totalSize(aws.ToString(r.ContentRange))
+	if !ok {
+		size = aws.ToInt64(r.ContentLength)
+	}
+	return r.Body, size, nil
+}
+
+func (s *s3Strategy) decodeURI(_ context.Context, uri string) (string, string, error) {
+	bucket, key, err := Parse(uri)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to parse URI : %s : %w", uri, err)
+	}
+
+	return bucket, key, nil
+}
+
+func (s s3Strategy) headObject(ctx context.Context, bucket, key string) (*s3.HeadObjectOutput, error) {
+	attrs, err := s.s3Client.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket:       aws.String(bucket),
+		Key:          aws.String(key),
+		ChecksumMode: types.ChecksumModeEnabled,
+	})
+	return attrs, S3Error(err)
+}
+
+func (s s3Strategy) downloadObjectTo(ctx context.Context, bucket, key string, w io.Writer, opts ...geocubeStorage.Option) error {
+	op := geocubeStorage.Apply(opts...)
+	d := op.Delay
+	var err error
+	curOffset := op.Offset
+	bytesRemaining := op.Length
+	for try := 0; try < op.MaxTries; try++ {
+		if try > 0 {
+			time.Sleep(d)
+			d *= 2
+		}
+		input := &s3.GetObjectInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		}
+		if curOffset > 0 || bytesRemaining > 0 {
+			input.Range = aws.String(byteRange(curOffset, bytesRemaining))
+		}
+		var r *s3.GetObjectOutput
+		r, err = s.s3Client.GetObject(ctx, input)
+		if err != nil {
+			err = S3Error(err)
+			if utils.Retriable(err) {
+				continue
+			} else {
+				return fmt.Errorf("s3.download.getobject: %w", err)
+			}
+		}
+
+		var n int64
+		n, err = io.Copy(w, r.Body)
+		r.Body.Close()
+		if err == nil {
+			return nil
+		}
+		err = S3Error(err)
+		if !utils.Retriable(err) {
+			return fmt.Errorf("s3.download.copy: %w", err)
+		}
+
+		curOffset += n
+		if bytesRemaining > 0 {
+			bytesRemaining -= n
+		}
+	}
+	return fmt.Errorf("failed after %d retries: %w", op.MaxTries, err)
+}
+
+func (s s3Strategy) putObject(ctx context.Context, bucket, key string, data []byte, opts ...geocubeStorage.Option) error {
+	op := geocubeStorage.Apply(opts...)
+	d := op.Delay
+	var err error
+	for try := 0; try < op.MaxTries; try++ {
+		if try > 0 {
+			time.Sleep(d)
+			d *= 2
+		}
+		input := &s3.PutObjectInput{
+			Bucket:        aws.String(bucket),
+			Key:           aws.String(key),
+			Body:          bytes.NewReader(data),
+			ContentLength: aws.Int64(int64(len(data))),
+			// The checksum is verified by S3 and returned by HeadObject
+			ChecksumCRC32C: aws.String(crc32cBase64(data)),
+		}
+		if op.StorageClass != "" {
+			input.StorageClass = types.StorageClass(op.StorageClass)
+		}
+		_, err = s.s3Client.PutObject(ctx, input)
+		err = S3Error(err)
+		if err == nil {
+			return nil
+		}
+		if !utils.Retriable(err) {
+			return fmt.Errorf("s3.upload.putobject: %w", err)
+		}
+	}
+	return fmt.Errorf("failed after %d retries: %w", op.MaxTries, err)
+}
+
+// multipartUpload uploads firstChunk then the rest of r, part by part. Each part is retried independently.
+func (s s3Strategy) multipartUpload(ctx context.Context, bucket, key string, firstChunk []byte, r io.Reader, opts ...geocubeStorage.Option) error {
+	op := geocubeStorage.Apply(opts...)
+	input := &s3.CreateMultipartUploadInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	}
+	if op.StorageClass != "" {
+		input.StorageClass = types.StorageClass(op.StorageClass)
+	}
+	upload, err := s.s3Client.CreateMultipartUpload(ctx, input)
+	if err != nil {
+		return fmt.Errorf("s3.upload.createmultipart: %w", S3Error(err))
+	}
+
+	parts, err := s.uploadParts(ctx, bucket, key, upload.UploadId, firstChunk, r, opts...)
+	if err == nil {
+		_, err = s.s3Client.CompleteMultipartUpload(ctx, &s3.CompleteMultipartUploadInput{
+			Bucket:          aws.String(bucket),
+			Key:             aws.String(key),
+			UploadId:        upload.UploadId,
+			MultipartUpload: &types.CompletedMultipartUpload{Parts: parts},
+		})
+		if err == nil {
+			return nil
+		}
+		err = fmt.Errorf("s3.upload.completemultipart: %w", S3Error(err))
+	}
+
+	// Do not use ctx, as it may be cancelled
+	if _, e := s.s3Client.AbortMultipartUpload(context.Background(), &s3.AbortMultipartUploadInput{
+		Bucket:   aws.String(bucket),
+		Key:      aws.String(key),
+		UploadId: upload.UploadId,
+	}); e != nil {
+		err = utils.MergeErrors(true, err, fmt.Errorf("s3.upload.abortmultipart: %w", S3Error(e)))
+	}
+	return err
+}
+
+func (s s3Strategy) uploadParts(ctx context.Context, bucket, key string, uploadID *string, chunk []byte, r io.Reader, opts ...geocubeStorage.Option) ([]types.CompletedPart, error) {
+	var parts []types.CompletedPart
+	nextChunk := make([]byte, multipartChunkSize)
+	for partNumber := int32(1); len(chunk) > 0; partNumber++ {
+		etag, err := s.uploadPart(ctx, bucket, key, uploadID, partNumber, chunk, opts...)
+		if err != nil {
+			return nil, err
+		}
+		parts = append(parts, types.CompletedPart{ETag: etag, PartNumber: aws.Int32(partNumber)})
+
+		n, err := io.ReadFull(r, nextChunk)
+		if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+			return nil, fmt.Errorf("s3.upload.read: %w", err)
+		}
+		chunk, nextChunk = nextChunk[:n], chunk[:cap(chunk)]
+	}
+	return parts, nil
+}
+
+func (s s3Strategy) uploadPart(ctx context.Context, bucket, key string, uploadID *string, partNumber int32, data []byte, opts ...geocubeStorage.Option) (*string, error) {
+	op := geocubeStorage.Apply(opts...)
+	d := op.Delay
+	var err error
+	for try := 0; try < op.MaxTries; try++ {
+		if try > 0 {
+			time.Sleep(d)
+			d *= 2
+		}
+		var part *s3.UploadPartOutput
+		part, err = s.s3Client.UploadPart(ctx, &s3.UploadPartInput{
+			Bucket:        aws.String(bucket),
+			Key:           aws.String(key),
+			UploadId:      uploadID,
+			PartNumber:    aws.Int32(partNumber),
+			Body:          bytes.NewReader(data),
+			ContentLength: aws.Int64(int64(len(data))),
+		})
+		err = S3Error(err)
+		if err == nil {
+			return part.ETag, nil
+		}
+		if !utils.Retriable(err) {
+			return nil, fmt.Errorf("s3.upload.part[%d]: %w", partNumber, err)
+		}
+	}
+	return nil, fmt.Errorf("part %d failed after %d retries: %w", partNumber, op.MaxTries, err)
+}
+
+func (s s3Strategy) deleteObject(ctx context.Context, bucket, key string, opts ...geocubeStorage.Option) error {
+	op := geocubeStorage.Apply(opts...)
+	d := op.Delay
+	var err error
+	for try := 0; try < op.MaxTries; try++ {
+		if try > 0 {
+			time.Sleep(d)
+			d *= 2
+		}
+		// S3 does not return an error when the object does not exist
+		if !op.IgnoreNotFound {
+			if _, err = s.headObject(ctx, bucket, key); err != nil {
+				if errors.Is(err, geocubeStorage.ErrFileNotFound) || !utils.Retriable(err) {
+					return fmt.Errorf("s3.deleteObject[%s/%s]: %w", bucket, key, err)
+				}
+				continue
+			}
+		}
+		_, err = s.s3Client.DeleteObject(ctx, &s3.DeleteObjectInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		})
+		err = S3Error(err)
+		if err == nil {
+			return nil
+		}
+		if op.IgnoreNotFound && errors.Is(err, geocubeStorage.ErrFileNotFound) {
+			return nil
+		}
+		if !utils.Retriable(err) {
+			return fmt.Errorf("s3.deleteObject[%s/%s]: %w", bucket, key, err)
+		}
+	}
+	return fmt.Errorf("failed after %d retries: %w", op.MaxTries, err)
+}
@@ -0,0 +1,325 @@
+package s3
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	geocubeStorage "github.com/airbusgeo/geocube/interface/storage"
+)
+
+// fakeS3 is a minimal s3-compatible server (path-style addressing) implementing the requests used by the strategy
+type fakeS3 struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+	classes map[string]string
+	crc32c  map[string]string
+	uploads map[string]map[int][]byte
+}
+
+func newFakeS3() *fakeS3 {
+	return &fakeS3{objects: map[string][]byte{}, classes: map[string]string{}, crc32c: map[string]string{}, uploads: map[string]map[int][]byte{}}
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	key := strings.TrimPrefix(r.URL.Path, "/")
+	q := r.URL.Query()
+	notFound := func() {
+		w.WriteHeader(http.StatusNotFound)
+		if r.Method != http.MethodHead {
+			fmt.Fprint(w, `<Error><Code>NoSuchKey</Code><Message>not found</Message></Error>`)
+		}
+	}
+	switch {
+	case r.Method == http.MethodGet && q.Get("list-type") == "2":
+		f.list(w, key, q.Get("prefix"), q.Get("start-after"), q.Get("continuation-token"), q.Get("max-keys"))
+	case r.Method == http.MethodPost && q.Has("uploads"):
+		id := strconv.Itoa(len(f.uploads) + 1)
+		f.uploads[id] = map[int][]byte{}
+		fmt.Fprintf(w, `<InitiateMultipartUploadResult><UploadId>%s</UploadId></InitiateMultipartUploadResult>`, id)
+	case r.Method == http.MethodPut && q.Has("uploadId"):
+		n, _ := strconv.Atoi(q.Get("partNumber"))
+		data, _ := io.ReadAll(r.Body)
+		f.uploads[q.Get("uploadId")][n] = data
+		w.Header().Set("ETag", fmt.Sprintf(`"%d"`, n))
+	case r.Method == http.MethodPost && q.Has("uploadId"):
+		parts := f.uploads[q.Get("uploadId")]
+		var data []byte
+		for i := 1; i <= len(parts); i++ {
+			data = append(data, parts[i]...)
+		}
+		f.objects[key] = data
+		delete(f.crc32c, key)
+		delete(f.uploads, q.Get("uploadId"))
+		fmt.Fprintf(w, `<CompleteMultipartUploadResult><Key>%s</Key></CompleteMultipartUploadResult>`, key)
+	case r.Method == http.MethodDelete && q.Has("uploadId"):
+		delete(f.uploads, q.Get("uploadId"))
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodPut:
+		data, _ := io.ReadAll(r.Body)
+		f.objects[key] = data
+		f.classes[key] = r.Header.Get("x-amz-storage-class")
+		f.crc32c[key] = r.Header.Get("x-amz-checksum-crc32c")
+	case r.Method == http.MethodDelete:
+		delete(f.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodHead:
+		data, ok := f.objects[key]
+		if !ok {
+			notFound()
+			return
+		}
+		if f.classes[key] != "" {
+			w.Header().Set("x-amz-storage-class", f.classes[key])
+		}
+		if f.crc32c[key] != "" && r.Header.Get("x-amz-checksum-mode") == "ENABLED" {
+			w.Header().Set("x-amz-checksum-crc32c", f.crc32c[key])
+		}
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+	case r.Method == http.MethodGet:
+		data, ok := f.objects[key]
+		if !ok {
+			notFound()
+			return
+		}
+		var from, to int
+		if n, _ := fmt.Sscanf(r.Header.Get("Range"), "bytes=%d-%d", &from, &to); n == 0 {
+			w.Write(data)
+			return
+		} else if n == 1 || to >= len(data) {
+			to = len(data) - 1
+		}
+		if from >= len(data) {
+			w.WriteHeader(http.StatusRequestedRangeNotSatisfiable)
+			fmt.Fprint(w, `<Error><Code>InvalidRange</Code><Message>invalid range</Message></Error>`)
+			return
+		}
+		w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", from, to, len(data)))
+		w.Header().Set("Content-Length", strconv.Itoa(to-from+1))
+		w.WriteHeader(http.StatusPartialContent)
+		w.Write(data[from : to+1])
+	default:
+		w.WriteHeader(http.StatusNotImplemented)
+	}
+}
+
+// list implements ListObjectsV2 (the continuation token is the last returned key)
+func (f *fakeS3) list(w http.ResponseWriter, bucket, prefix, startAfter, token, maxKeys string) {
+	if token != "" {
+		startAfter = token
+	}
+	max, _ := strconv.Atoi(maxKeys)
+	var keys []string
+	for key := range f.objects {
+		if k := strings.TrimPrefix(key, bucket+"/"); k != key && strings.HasPrefix(k, prefix) && k > startAfter {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	truncated := max > 0 && len(keys) > max
+	if truncated {
+		keys = keys[:max]
+	}
+	fmt.Fprintf(w, `<ListBucketResult><Name>%s</Name><KeyCount>%d</KeyCount><IsTruncated>%v</IsTruncated>`, bucket, len(keys), truncated)
+	for _, k := range keys {
+		fmt.Fprintf(w, `<Contents><Key>%s</Key><Size>%d</Size><LastModified>2024-01-01T00:00:00.000Z</LastModified></Contents>`, k, len(f.objects[bucket+"/"+k]))
+	}
+	if truncated {
+		fmt.Fprintf(w, `<NextContinuationToken>%s</NextContinuationToken>`, keys[len(keys)-1])
+	}
+	fmt.Fprint(w, `</ListBucketResult>`)
+}
+
+// newTestStrategy returns a strategy connected to GEOCUBE_TEST_S3_ENDPOINT (e.g. a local MinIO server) or to a fake s3 server
+// and the bucket to use for the tests (GEOCUBE_TEST_S3_BUCKET)
+func newTestStrategy(t *testing.T) (geocubeStorage.Strategy, string) {
+	endpoint, bucket := os.Getenv("GEOCUBE_TEST_S3_ENDPOINT"), os.Getenv("GEOCUBE_TEST_S3_BUCKET")
+	if endpoint == "" {
+		server := httptest.NewServer(newFakeS3())
+		t.Cleanup(server.Close)
+		endpoint, bucket = server.URL, "bucket"
+		t.Setenv("AWS_ACCESS_KEY_ID", "key")
+		t.Setenv("AWS_SECRET_ACCESS_KEY", "secret")
+	}
+	s, err := NewS3StrategyWithConfig(context.Background(), Config{Region: "us-east-1", Endpoint: endpoint})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s, bucket
+}
+
+func TestParse(t *testing.T) {
+	test := func(u string, mustErr bool, expb, expk string) {
+		t.Helper()
+		b, k, err := Parse(u)
+		if mustErr {
+			if err == nil {
+				t.Error("error not raised")
+			}
+			return
+		}
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if b != expb || k != expk {
+			t.Errorf("got \"%s\", \"%s\" expected \"%s\", \"%s\"", b, k, expb, expk)
+		}
+	}
+	test("s3://bucket/object.foo", false, "bucket", "object.foo")
+	test("s3://bucket/path/to/object.foo", false, "bucket", "path/to/object.foo")
+	test("/bucket/path/to/object.foo", false, "bucket", "path/to/object.foo")
+	test("s3://bucket", true, "", "")
+	test("s3://bucket/", true, "", "")
+}
+
+func TestUploadDownload(t *testing.T) {
+	ctx := context.Background()
+	s, bucket := newTestStrategy(t)
+	uri := "s3://" + bucket + "/path/to/object.bin"
+	data := []byte("0123456789")
+
+	if err := s.Upload(ctx, uri, data); err != nil {
+		t.Fatalf("Upload: %v", err)
+	}
+	if exist, err := s.Exist(ctx, uri); !exist || err != nil {
+		t.Errorf("Exist: expecting true, found %v, %v", exist, err)
+	}
+	attrs, err := s.GetAttrs(ctx, uri)
+	if err != nil {
+		t.Fatalf("GetAttrs: %v", err)
+	}
+	if attrs.Size != int64(len(data)) || attrs.StorageClass != "STANDARD" {
+		t.Errorf("GetAttrs: unexpected attributes %+v", attrs)
+	}
+	if expected, _, _ := geocubeStorage.ComputeChecksums(bytes.NewReader(data)); attrs.CRC32C != expected.CRC32C {
+		t.Errorf("GetAttrs: expecting crc32c %s, found %s", expected.CRC32C, attrs.CRC32C)
+	}
+
+	got, err := s.Download(ctx, uri, geocubeStorage.Offset(2), geocubeStorage.Length(3))
+	if err != nil {
+		t.Fatalf("Download: %v", err)
+	}
+	if !bytes.Equal(got, data[2:5]) {
+		t.Errorf("Download: expecting %s, found %s", data[2:5], got)
+	}
+
+	r, size, err := s.StreamAt(uri, 4, 100)
+	if err != nil {
+		t.Fatalf("StreamAt: %v", err)
+	}
+	got, _ = io.ReadAll(r)
+	r.Close()
+	if size != int64(len(data)) || !bytes.Equal(got, data[4:]) {
+		t.Errorf("StreamAt: expecting %s (size %d), found %s (size %d)", data[4:], len(data), got, size)
+	}
+	if _, _, err := s.StreamAt(uri, 100, 10); err != io.EOF {
+		t.Errorf("StreamAt: expecting EOF, found %v", err)
+	}
+
+	dest := t.TempDir() + "/sub/object.bin"
+	if err := s.DownloadToFile(ctx, uri, dest); err != nil {
+		t.Fatalf("DownloadToFile: %v", err)
+	}
+	if got, _ := os.ReadFile(dest); !bytes.Equal(got, data) {
+		t.Errorf("DownloadToFile: expecting %s, found %s", data, got)
+	}
+
+	if err := s.Delete(ctx, uri); err != nil {
+		t.Errorf("Delete: expecting nil error, found %v", err)
+	}
+	if _, err := s.Exist(ctx, uri); !errors.Is(err, geocubeStorage.ErrFileNotFound) {
+		t.Errorf("Exist: expecting ErrFileNotFound, found %v", err)
+	}
+	if err := s.Delete(ctx, uri); !errors.Is(err, geocubeStorage.ErrFileNotFound) {
+		t.Errorf("Delete: expecting ErrFileNotFound, found %v", err)
+	}
+	if err := s.Delete(ctx, uri, geocubeStorage.IgnoreNotFound()); err != nil {
+		t.Errorf("Delete: expecting nil error, found %v", err)
+	}
+	if _, err := s.Download(ctx, uri); !errors.Is(err, geocubeStorage.ErrFileNotFound) {
+		t.Errorf("Download: expecting ErrFileNotFound, found %v", err)
+	}
+}
+
+func TestMultipartUpload(t *testing.T) {
+	ctx := context.Background()
+	s, bucket := newTestStrategy(t)
+	uri := "s3://" + bucket + "/multipart.bin"
+	data := make([]byte, 2*multipartChunkSize+123)
+	for i := range data {
+		data[i] = byte(i % 251)
+	}
+
+	if err := s.UploadFile(ctx, uri, io.NopCloser(bytes.NewReader(data))); err != nil {
+		t.Fatalf("UploadFile: %v", err)
+	}
+	defer s.Delete(ctx, uri, geocubeStorage.IgnoreNotFound())
+
+	got, err := s.Download(ctx, uri)
+	if err != nil {
+		t.Fatalf("Download: %v", err)
+	}
+	if !bytes.Equal(got, data) {
+		t.Errorf("Download: uploaded and downloaded data are different (%d/%d bytes)", len(got), len(data))
+	}
+}
+
+func TestList(t *testing.T) {
+	ctx := context.Background()
+	s, bucket := newTestStrategy(t)
+	prefix := "s3://" + bucket + "/list/"
+	names := []string{"a.tif", "b.tif", "c/d.tif", "e.tif", "f.tif"}
+	for _, name := range names {
+		if err := s.Upload(ctx, prefix+name, []byte(name)); err != nil {
+			t.Fatalf("Upload: %v", err)
+		}
+		defer s.Delete(ctx, prefix+name, geocubeStorage.IgnoreNotFound())
+	}
+
+	it, err := s.List(ctx, prefix, geocubeStorage.PageSize(2), geocubeStorage.StartAfter(prefix+"a.tif"))
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	objects, err := geocubeStorage.ListAll(it)
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(objects) != len(names)-1 {
+		t.Fatalf("List: expecting %d objects, found %d", len(names)-1, len(objects))
+	}
+	for i, o := range objects {
+		if o.URI != prefix+names[i+1] || o.Size != int64(len(names[i+1])) || o.StorageClass != "STANDARD" || o.ModTime.IsZero() {
+			t.Errorf("List: unexpected object %+v", o)
+		}
+	}
+
+	var deleted []string
+	mu := sync.Mutex{}
+	if err := s.BulkDelete(ctx, []string{prefix + "a.tif", prefix + "b.tif"}, geocubeStorage.OnDelete(func(uri string, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil {
+			t.Errorf("BulkDelete(%s): %v", uri, err)
+		}
+		deleted = append(deleted, uri)
+	})); err != nil || len(deleted) != 2 {
+		t.Errorf("BulkDelete: expecting 2 deletions, found %v, %v", deleted, err)
+	}
+	if _, err := s.Exist(ctx, prefix+"a.tif"); !errors.Is(err, geocubeStorage.ErrFileNotFound) {
+		t.Errorf("Exist: expecting ErrFileNotFound, found %v", err)
+	}
+}
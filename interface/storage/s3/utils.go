@@ -0,0 +1,77 @@
+package s3
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"hash/crc32"
+	"strings"
+)
+
+// Parse takes in a string in the form s3://bucket/path/to/object or
+// bucket/path/to/object or /bucket/path/to/object and returns the
+// bucket and object key as usable by the s3 Client
+func Parse(s3Uri string) (bucket, key string, err error) {
+	bucket, key = parse(s3Uri)
+	if len(bucket) == 0 || len(key) == 0 {
+		err = fmt.Errorf("missing bucket or key")
+	}
+	return
+}
+
+func parse(s3Uri string) (bucket, key string) {
+	if strings.HasPrefix(s3Uri, "s3://") {
+		s3Uri = strings.TrimPrefix(s3Uri, "s3://")
+	} else {
+		s3Uri = strings.TrimPrefix(s3Uri, "/")
+	}
+	firstSlash := strings.Index(s3Uri, "/")
+	if firstSlash == -1 {
+		bucket = s3Uri
+		key = ""
+	} else {
+		bucket = s3Uri[0:firstSlash]
+		key = s3Uri[firstSlash+1:]
+	}
+	return
+}
+
+// byteRange formats a http Range header value (n <= 0 means until the end of the object)
+func byteRange(off, n int64) string {
+	if n <= 0 {
+		return fmt.Sprintf("bytes=%d-", off)
+	}
+	return fmt.Sprintf("bytes=%d-%d", off, off+n-1)
+}
+
+// totalSize parses the total size of the object from a Content-Range header value (e.g. "bytes 0-99/1234")
+func totalSize(contentRange string) (int64, bool) {
+	idx := strings.LastIndex(contentRange, "/")
+	if idx == -1 || contentRange[idx+1:] == "*" {
+		return 0, false
+	}
+	var size int64
+	if _, err := fmt.Sscanf(contentRange[idx+1:], "%d", &size); err != nil {
+		return 0, false
+	}
+	return size, true
+}
+
+// crc32cBase64 returns the CRC32C checksum of data, encoded as expected by S3 (base64 of the big-endian value)
+func crc32cBase64(data []byte) string {
+	return base64.StdEncoding.EncodeToString(binary.BigEndian.AppendUint32(nil, crc32.Checksum(data, crc32.MakeTable(crc32.Castagnoli))))
+}
+
+// fullObjectChecksum converts a base64-encoded checksum returned by S3 to hex.
+// It returns "" if the checksum is not available or if it is the checksum of a multipart upload (e.g. "xxxx-3")
+func fullObjectChecksum(checksum string) string {
+	if checksum == "" || strings.Contains(checksum, "-") {
+		return ""
+	}
+	b, err := base64.StdEncoding.DecodeString(checksum)
+	if err != nil {
+		return ""
+	}
+	return hex.EncodeToString(b)
+}
@@ -3,12 +3,18 @@ package storage
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io"
+	"sync/atomic"
 	"time"
+
+	"github.com/airbusgeo/geocube/internal/utils"
 )
 
 var (
 	ErrFileNotFound = errors.New("file not found")
+	ErrReadOnly     = errors.New("read-only storage")
+	ErrIteratorDone = errors.New("no more items in iterator")
 )
 
 type Strategy interface {
@@ -17,6 +23,8 @@ type Strategy interface {
 	Upload(ctx context.Context, uri string, data []byte, options ...Option) error
 	UploadFile(ctx context.Context, uri string, data io.ReadCloser, options ...Option) error
 	Delete(ctx context.Context, uri string, options ...Option) error
+	BulkDelete(ctx context.Context, uris []string, options ...Option) error
+	List(ctx context.Context, prefix string, options ...Option) (ObjectIterator, error)
 	Exist(ctx context.Context, uri string) (bool, error)
 	GetAttrs(ctx context.Context, uri string) (Attrs, error)
 	StreamAt(key string, off int64, n int64) (io.ReadCloser, int64, error)
@@ -65,6 +73,20 @@ func (c *Client) Delete(ctx context.Context, uri string, options ...Option) erro
 	return c.StorageStrategy.Delete(ctx, uri, options...)
 }
 
+/*
+BulkDelete enables to delete a list of files.
+*/
+func (c *Client) BulkDelete(ctx context.Context, uris []string, options ...Option) error {
+	return c.StorageStrategy.BulkDelete(ctx, uris, options...)
+}
+
+/*
+List enables to iterate over the files whose uri starts with prefix.
+*/
+func (c *Client) List(ctx context.Context, prefix string, options ...Option) (ObjectIterator, error) {
+	return c.StorageStrategy.List(ctx, prefix, options...)
+}
+
 /*
 Exist checks if file exist.
 */
@@ -97,14 +119,51 @@ type option struct {
 	Exclude        ExcludeFunc
 	Concurrency    int
 	IgnoreNotFound bool
+	PageSize       int
+	StartAfter     string
+	OnDelete       DeleteFunc
 }
 
 type ExcludeFunc func(objectName string) bool
 
+// DeleteFunc is called by BulkDelete with the result of the deletion of each uri
+type DeleteFunc func(uri string, err error)
+
 type Attrs struct {
 	ContentType  string
 	StorageClass string
 	Size         int64
+	Version      string // Generation or etag of the file, that changes each time the file is modified
+	Checksums           // Checksums of the file, if provided by the storage
+}
+
+// ObjectAttrs are the attributes of a file returned by List
+type ObjectAttrs struct {
+	URI          string
+	StorageClass string
+	Size         int64
+	ModTime      time.Time
+}
+
+// ObjectIterator iterates over the files returned by List, in lexicographic order.
+// Next returns ErrIteratorDone when there are no more files.
+type ObjectIterator interface {
+	Next() (ObjectAttrs, error)
+}
+
+// ListAll returns all the remaining files of the iterator
+func ListAll(it ObjectIterator) ([]ObjectAttrs, error) {
+	var objects []ObjectAttrs
+	for {
+		o, err := it.Next()
+		if err == ErrIteratorDone {
+			return objects, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, o)
+	}
 }
 
 func MaxTries(n int) Option {
@@ -163,6 +222,30 @@ func Concurrency(c int) Option {
 	}
 }
 
+// PageSize defines the number of files retrieved by each request of List
+func PageSize(n int) Option {
+	if n <= 0 {
+		panic("page size must be >= 1")
+	}
+	return func(o *option) {
+		o.PageSize = n
+	}
+}
+
+// StartAfter lists the files whose uri is lexicographically greater than uri (e.g. to resume a listing)
+func StartAfter(uri string) Option {
+	return func(o *option) {
+		o.StartAfter = uri
+	}
+}
+
+// OnDelete defines a function called by BulkDelete with the result of the deletion of each uri
+func OnDelete(f DeleteFunc) Option {
+	return func(o *option) {
+		o.OnDelete = f
+	}
+}
+
 func IgnoreNotFound() Option {
 	return func(o *option) {
 		o.IgnoreNotFound = true
@@ -176,10 +259,53 @@ func Apply(opts ...Option) option {
 		Offset:      0,
 		Length:      -1,
 		Concurrency: 5,
+		PageSize:    1000,
 		Exclude:     func(_ string) bool { return false },
+		OnDelete:    func(_ string, _ error) {},
 	}
 	for _, o := range opts {
 		o(&opt)
 	}
 	return opt
 }
+
+// ParallelDelete deletes the uris using deleteFn with several workers.
+// The result of each deletion is sent to the OnDelete option. At most 100 errors are returned.
+// It can be used by the strategies that do not support batch deletion.
+func ParallelDelete(ctx context.Context, deleteFn func(ctx context.Context, uri string, options ...Option) error, uris []string, options ...Option) error {
+	workers := 20
+	maxErrors := int64(100)
+	if len(uris) < workers {
+		workers = len(uris)
+	}
+	onDelete := Apply(options...).OnDelete
+	tasks := make(chan string)
+	wg := utils.ErrWaitGroup{}
+
+	nbErrors := atomic.Int64{}
+	for range workers {
+		wg.Go(func() error {
+			for uri := range tasks {
+				err := deleteFn(ctx, uri, options...)
+				onDelete(uri, err)
+				if err != nil {
+					if nbErrors.Add(1) < maxErrors {
+						wg.AppendError(err)
+					}
+				}
+			}
+			return nil
+		})
+	}
+	for _, uri := range uris {
+		tasks <- uri
+	}
+	close(tasks)
+
+	errs := utils.MergeErrors(true, nil, wg.Wait()...)
+	if nbErrors.Load() >= maxErrors {
+		errs = utils.MergeErrors(true, errs, utils.MakeTemporary(fmt.Errorf("[...] total: %d errors", nbErrors.Load())))
+	}
+
+	return errs
+}
@@ -9,8 +9,12 @@ import (
 	"strings"
 
 	"github.com/airbusgeo/geocube/interface/storage"
+	"github.com/airbusgeo/geocube/interface/storage/azure"
 	"github.com/airbusgeo/geocube/interface/storage/filesystem"
 	"github.com/airbusgeo/geocube/interface/storage/gcs"
+	storageHttp "github.com/airbusgeo/geocube/interface/storage/http"
+	"github.com/airbusgeo/geocube/interface/storage/mem"
+	"github.com/airbusgeo/geocube/interface/storage/s3"
 	"github.com/airbusgeo/geocube/internal/utils"
 )
 
@@ -42,7 +46,7 @@ func (f *DefaultFactory) NewUri(provider, bucketName, path string) Uri {
 	return NewUri(provider, bucketName, path)
 }
 
-// ParseUri parse a storage uri (e.g. gcs://bucket-name/path/to/file)
+// ParseUri parse a storage uri (e.g. gs://bucket-name/path/to/file, s3://bucket-name/path/to/file, az://container/path/to/file, https://host/path/to/file or mem://bucket/path/to/file)
 func ParseUri(rawURI string) (DefaultUri, error) {
 	if strings.HasPrefix(rawURI, "/") {
 		//local path
@@ -124,6 +128,15 @@ func (u DefaultUri) String() string {
 	return fmt.Sprintf("%s://%s/%s", u.protocol, u.bucket, u.path)
 }
 
+// ReadOnly returns true if the uri refers to a storage that cannot be written or deleted (http(s))
+func (u DefaultUri) ReadOnly() bool {
+	switch strings.ToLower(u.protocol) {
+	case "http", "https":
+		return true
+	}
+	return false
+}
+
 func (u DefaultUri) NewStorageStrategy(ctx context.Context) (storage.Strategy, error) {
 	return u.getStrategy(ctx)
 }
@@ -135,7 +148,13 @@ func (u DefaultUri) getStrategy(ctx context.Context) (storage.Strategy, error) {
 	case "file", "":
 		return filesystem.NewFileSystemStrategy(ctx)
 	case "s3":
-		return nil, fmt.Errorf("not supported yet")
+		return s3.NewS3Strategy(ctx)
+	case "az":
+		return azure.NewAzStrategy(ctx)
+	case "http", "https":
+		return storageHttp.NewHTTPStrategy(ctx)
+	case "mem":
+		return mem.NewMemStrategy(ctx)
 	default:
 		return nil, fmt.Errorf("failed to determine storage strategy")
 	}
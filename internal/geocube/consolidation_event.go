@@ -1,10 +1,7 @@
 package geocube
 
 import (
-	"bytes"
-	"encoding/gob"
 	"fmt"
-	"io"
 	"strings"
 
 	"github.com/airbusgeo/geocube/internal/utils/proj"
@@ -12,36 +9,6 @@ import (
 	"github.com/airbusgeo/geocube/internal/utils/grid"
 )
 
-// Event is a common interface for all job-related events
-type Event interface{}
-
-func gobRegisterEvent() {
-	gob.Register(TaskEvent{})
-	gob.Register(JobEvent{})
-}
-
-// MarshalEvent returns bytes representation of a job-related event
-func MarshalEvent(evt Event) ([]byte, error) {
-	var data bytes.Buffer
-	gobRegisterEvent()
-	if err := gob.NewEncoder(&data).Encode(&evt); err != nil {
-		return nil, err
-	}
-
-	return data.Bytes(), nil
-}
-
-// UnmarshalEvent returns the event stored in the Reader
-func UnmarshalEvent(r io.Reader) (Event, error) {
-	var evt Event
-	gobRegisterEvent()
-	if err := gob.NewDecoder(r).Decode(&evt); err != nil {
-		return nil, err
-	}
-
-	return evt, nil
-}
-
 /********************************************************************/
 /**                        TASK EVENTS                              */
 /********************************************************************/
@@ -68,6 +35,9 @@ type TaskEvent struct {
 	TaskID string
 	Status TaskStatus
 	Error  string
+	// Size and Checksum of the file produced by a successful consolidation task (see storage.Checksums)
+	Size     int64
+	Checksum string
 }
 
 // NewTaskEvent returns a new task event
@@ -108,6 +78,19 @@ type ConsolidationEvent struct {
 	Container ConsolidationContainer
 }
 
+// Attributes of the messages of the consolidation events.
+// They carry the ids of the job and of the task outside the payload,
+// so that the failure of a task can be reported even if its consolidation event cannot be read.
+const (
+	ConsolidationJobIDAttribute  = "job_id"
+	ConsolidationTaskIDAttribute = "task_id"
+)
+
+// ConsolidationEventAttributes returns the attributes of the message of the consolidation event of the task
+func ConsolidationEventAttributes(jobID, taskID string) map[string]string {
+	return map[string]string{ConsolidationJobIDAttribute: jobID, ConsolidationTaskIDAttribute: taskID}
+}
+
 // ConsolidationRecord contains the date and the list of datasets to consolidate
 type ConsolidationRecord struct {
 	ID         string
@@ -199,26 +182,6 @@ func NewConsolidationDataset(d *Dataset) *ConsolidationDataset {
 	}
 }
 
-// MarshalConsolidationEvent is used to send a ConsolidationEvent to messagery
-func MarshalConsolidationEvent(evt ConsolidationEvent) ([]byte, error) {
-	var data bytes.Buffer
-	if err := gob.NewEncoder(&data).Encode(&evt); err != nil {
-		return nil, err
-	}
-
-	return data.Bytes(), nil
-}
-
-// UnmarshalConsolidationEvent is used to retrieve a ConsolidationEvent from messagery
-func UnmarshalConsolidationEvent(r io.Reader) (*ConsolidationEvent, error) {
-	var evt ConsolidationEvent
-	if err := gob.NewDecoder(r).Decode(&evt); err != nil {
-		return nil, fmt.Errorf("UnmarshalConsolidationEvent: %w", err)
-	}
-
-	return &evt, nil
-}
-
 // InGroupOfContainers returns true if the dataset is in the group of containers with the base name
 func (d *ConsolidationDataset) InGroupOfContainers(c *ConsolidationContainer) bool {
 	return strings.HasPrefix(d.URI, c.URI)
@@ -294,6 +257,7 @@ type JobEvent struct {
 	JobID  string
 	Status JobStatus
 	Error  string
+	State  *JobState // State of the job when the event was sent (optional)
 }
 
 func statusWithError(status JobStatus) bool {
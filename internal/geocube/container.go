@@ -26,6 +26,7 @@ type Container struct {
 	URI          string
 	Managed      bool
 	StorageClass StorageClass
+	Checksum     string // Checksum of the file (see storage.Checksums), only known for the consolidated containers
 	Datasets     []*Dataset
 }
 
@@ -46,13 +47,14 @@ func NewContainerFromProtobuf(pbc *pb.Container) (*Container, error) {
 
 // NewContainerFromConsolidation creates a new container from the output of a consolidation task
 // Only returns ValidationError
-func NewContainerFromConsolidation(oc *ConsolidationContainer) (*Container, error) {
+func NewContainerFromConsolidation(oc *ConsolidationContainer, checksum string) (*Container, error) {
 	var err error
 	c := Container{
 		persistenceState: persistenceStateNEW,
 		URI:              oc.URI,
 		Managed:          true,
 		StorageClass:     oc.StorageClass,
+		Checksum:         checksum,
 	}
 	if err = c.validate(); err != nil {
 		return nil, NewValidationError("%v", err)
@@ -193,16 +195,16 @@ func (c Container) numActiveDatasets() int {
 	return n
 }
 
-// ToGcStorageClass returns the geocube storage class equivalent
+// ToGcStorageClass returns the geocube storage class equivalent (GCS or S3 storage class, Azure access tier)
 func ToGcStorageClass(s string) (StorageClass, error) {
 	switch strings.ToUpper(s) {
-	case "STANDARD", "REGIONAL":
+	case "STANDARD", "REGIONAL", "REDUCED_REDUNDANCY", "HOT":
 		return StorageClassSTANDARD, nil
-	case "NEARLINE":
+	case "NEARLINE", "STANDARD_IA", "ONEZONE_IA", "INTELLIGENT_TIERING", "COOL":
 		return StorageClassINFREQUENT, nil
-	case "COLDLINE":
+	case "COLDLINE", "GLACIER_IR", "COLD":
 		return StorageClassARCHIVE, nil
-	case "ARCHIVE":
+	case "ARCHIVE", "GLACIER", "DEEP_ARCHIVE":
 		return StorageClassDEEPARCHIVE, nil
 	}
 	return StorageClassUNDEFINED, NewValidationError("Unknown storage class: %s", s)
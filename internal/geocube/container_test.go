@@ -0,0 +1,35 @@
+package geocube
+
+import (
+	"testing"
+)
+
+func TestToGcStorageClass(t *testing.T) {
+	test := func(s string, expected StorageClass) {
+		t.Helper()
+		sc, err := ToGcStorageClass(s)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", s, err)
+		} else if sc != expected {
+			t.Errorf("%s: expecting %s, found %s", s, expected, sc)
+		}
+	}
+	// GCS
+	test("STANDARD", StorageClassSTANDARD)
+	test("NEARLINE", StorageClassINFREQUENT)
+	test("COLDLINE", StorageClassARCHIVE)
+	test("ARCHIVE", StorageClassDEEPARCHIVE)
+	// S3
+	test("STANDARD_IA", StorageClassINFREQUENT)
+	test("GLACIER_IR", StorageClassARCHIVE)
+	test("DEEP_ARCHIVE", StorageClassDEEPARCHIVE)
+	// Azure
+	test("Hot", StorageClassSTANDARD)
+	test("Cool", StorageClassINFREQUENT)
+	test("Cold", StorageClassARCHIVE)
+	test("Archive", StorageClassDEEPARCHIVE)
+
+	if _, err := ToGcStorageClass("unknown"); !IsError(err, EntityValidationError) {
+		t.Errorf("'unknown' does not fail")
+	}
+}
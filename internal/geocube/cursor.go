@@ -0,0 +1,59 @@
+package geocube
+
+import (
+	"encoding/base64"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Cursor is the position of an item in a list, to resume the list after this item (keyset pagination).
+// Records are sorted by (Time, ID), the other entities by ID (Time is zero). ID is a UUID.
+type Cursor struct {
+	Time time.Time
+	ID   string
+}
+
+const cursorVersion = "1"
+
+// Token returns the opaque representation of the cursor
+func (c Cursor) Token() string {
+	t := ""
+	if !c.Time.IsZero() {
+		t = c.Time.UTC().Format(time.RFC3339Nano)
+	}
+	return base64.RawURLEncoding.EncodeToString([]byte(cursorVersion + "|" + t + "|" + c.ID))
+}
+
+// ParseCursor parses a token returned by Cursor.Token
+// Returns nil if the token is empty or a ValidationError if it is invalid
+func ParseCursor(token string) (*Cursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, NewValidationError("invalid page token: %v", err)
+	}
+	parts := strings.SplitN(string(b), "|", 3)
+	if len(parts) != 3 || parts[0] != cursorVersion {
+		return nil, NewValidationError("invalid page token")
+	}
+	id, err := uuid.Parse(parts[2])
+	if err != nil {
+		return nil, NewValidationError("invalid page token: %v", err)
+	}
+	c := Cursor{ID: id.String()}
+	if parts[1] != "" {
+		if c.Time, err = time.Parse(time.RFC3339Nano, parts[1]); err != nil {
+			return nil, NewValidationError("invalid page token: %v", err)
+		}
+	}
+	return &c, nil
+}
+
+// Cursor returns the position of the record in a list sorted by (Time, ID)
+func (r *Record) Cursor() Cursor {
+	return Cursor{Time: r.Time, ID: r.ID}
+}
@@ -0,0 +1,44 @@
+package geocube
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+)
+
+func TestCursorToken(t *testing.T) {
+	for _, c := range []Cursor{
+		{ID: "2d5c5d38-6e3f-4bb6-a5b6-5d1e9d0a5a8a"},
+		{Time: time.Date(2021, 3, 4, 5, 6, 7, 891000, time.UTC), ID: "00000000-0000-0000-0000-000000000001"},
+		{Time: time.Date(2021, 3, 4, 5, 6, 7, 0, time.FixedZone("CET", 3600)), ID: "ffffffff-ffff-ffff-ffff-ffffffffffff"},
+	} {
+		got, err := ParseCursor(c.Token())
+		if err != nil {
+			t.Errorf("ParseCursor(%v): %v", c, err)
+			continue
+		}
+		if got.ID != c.ID || !got.Time.Equal(c.Time) {
+			t.Errorf("ParseCursor(%v) = %v", c, *got)
+		}
+	}
+
+	if c, err := ParseCursor(""); c != nil || err != nil {
+		t.Errorf("ParseCursor(\"\") = %v, %v; want nil, nil", c, err)
+	}
+}
+
+func TestParseCursorInvalid(t *testing.T) {
+	encode := func(s string) string { return base64.RawURLEncoding.EncodeToString([]byte(s)) }
+	for _, token := range []string{
+		"not a token!",
+		encode("2d5c5d38-6e3f-4bb6-a5b6-5d1e9d0a5a8a"),
+		encode("2||2d5c5d38-6e3f-4bb6-a5b6-5d1e9d0a5a8a"),
+		encode("1||"),
+		encode("1||not-a-uuid"),
+		encode("1|yesterday|2d5c5d38-6e3f-4bb6-a5b6-5d1e9d0a5a8a"),
+	} {
+		if _, err := ParseCursor(token); !IsError(err, EntityValidationError) {
+			t.Errorf("ParseCursor(%q): want a validation error, got %v", token, err)
+		}
+	}
+}
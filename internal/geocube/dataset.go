@@ -39,11 +39,15 @@ type Dataset struct {
 	GeogShape       proj.GeographicShape ///< Approximation of the valid shape in geographic coordinates
 	GeomShape       proj.GeometricShape  ///< Approximation of the valid shape in 4326 coordinates
 	Overviews       bool
+
+	defaultRangeExt bool ///< True if the real range of values has not been defined by the user (see SetRangeExtFromScaleOffset)
 }
 
 // NewDatasetFromProtobuf creates a new dataset from protobuf
+// If defaultRangeExt and real_min_value, real_max_value and exponent are not defined, the values are not scaled (RangeExt = Range),
+// unless the real range is deduced from the file (see SetRangeExtFromScaleOffset). Otherwise, they must be defined.
 // Only returns validationError
-func NewDatasetFromProtobuf(pbd *pb.Dataset, uri string) (*Dataset, error) {
+func NewDatasetFromProtobuf(pbd *pb.Dataset, uri string, defaultRangeExt bool) (*Dataset, error) {
 	d := Dataset{
 		persistenceState: persistenceStateNEW,
 		ID:               uuid.New().String(),
@@ -59,6 +63,12 @@ func NewDatasetFromProtobuf(pbd *pb.Dataset, uri string) (*Dataset, error) {
 		},
 		Status: DatasetStatusACTIVE}
 
+	if defaultRangeExt && pbd.GetRealMinValue() == 0 && pbd.GetRealMaxValue() == 0 && pbd.GetExponent() == 0 {
+		d.DataMapping.RangeExt = d.DataMapping.Range
+		d.DataMapping.Exponent = 1
+		d.defaultRangeExt = true
+	}
+
 	if err := d.validate(); err != nil {
 		return nil, err
 	}
@@ -186,6 +196,25 @@ func (d *Dataset) SetDataType(dtype bitmap.DType) error {
 	return nil
 }
 
+// SetRangeExtFromScaleOffset sets the real range of values of a new dataset from the scale and the offset of its bands (real value = value * scale + offset),
+// only if the real range has not been defined by the user at the creation of the dataset.
+// Returns true if the real range has been set.
+// Only returns ValidationError
+func (d *Dataset) SetRangeExtFromScaleOffset(scale, offset float64) (bool, error) {
+	if !d.IsNew() {
+		return false, NewValidationError("Set RangeExt of a dataset that is not new is forbidden")
+	}
+	if !d.defaultRangeExt {
+		return false, nil
+	}
+	if scale <= 0 {
+		return false, NewValidationError("scale must be strictly positive (found %f)", scale)
+	}
+	d.DataMapping.RangeExt = Range{Min: d.DataMapping.Range.Min*scale + offset, Max: d.DataMapping.Range.Max*scale + offset}
+	d.DataMapping.Exponent = 1
+	return true, nil
+}
+
 // ValidateWithVariable validates the instance using the full definition of the variable
 // Only returns ValidationError
 func (d *Dataset) ValidateWithVariable(v *Variable) error {
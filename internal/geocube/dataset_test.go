@@ -0,0 +1,48 @@
+package geocube
+
+import (
+	"testing"
+
+	pb "github.com/airbusgeo/geocube/internal/pb"
+)
+
+func TestSetRangeExtFromScaleOffset(t *testing.T) {
+	pbd := &pb.Dataset{
+		RecordId:   "11111111-1111-1111-1111-111111111111",
+		InstanceId: "22222222-2222-2222-2222-222222222222",
+		Bands:      []int64{1},
+		Dformat:    &pb.DataFormat{Dtype: pb.DataFormat_UInt16, NoData: 0, MinValue: 0, MaxValue: 10000},
+	}
+
+	// Real range not defined and not deduced from the file: rejected
+	if _, err := NewDatasetFromProtobuf(pbd, "gs://bucket/file.tif", false); !IsError(err, EntityValidationError) {
+		t.Errorf("NewDatasetFromProtobuf(): want a validation error, got %v", err)
+	}
+
+	// Real range not defined: the values are not scaled
+	d, err := NewDatasetFromProtobuf(pbd, "gs://bucket/file.tif", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.DataMapping.RangeExt != d.DataMapping.Range || d.DataMapping.Exponent != 1 {
+		t.Errorf("NewDatasetFromProtobuf(): %+v", d.DataMapping)
+	}
+	if _, err := d.SetRangeExtFromScaleOffset(-1, 0); !IsError(err, EntityValidationError) {
+		t.Errorf("SetRangeExtFromScaleOffset(-1, 0): want a validation error, got %v", err)
+	}
+	if ok, err := d.SetRangeExtFromScaleOffset(0.0001, -0.1); err != nil || !ok {
+		t.Fatalf("SetRangeExtFromScaleOffset() = %v, %v", ok, err)
+	}
+	if d.DataMapping.RangeExt.Min != -0.1 || d.DataMapping.RangeExt.Max != 0.9 {
+		t.Errorf("SetRangeExtFromScaleOffset(): %+v", d.DataMapping.RangeExt)
+	}
+
+	// Real range defined by the user
+	pbd.RealMinValue, pbd.RealMaxValue, pbd.Exponent = 0, 1, 1
+	if d, err = NewDatasetFromProtobuf(pbd, "gs://bucket/file.tif", true); err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := d.SetRangeExtFromScaleOffset(0.0001, -0.1); err != nil || ok || d.DataMapping.RangeExt.Max != 1 {
+		t.Errorf("SetRangeExtFromScaleOffset() = %v, %v: %+v", ok, err, d.DataMapping.RangeExt)
+	}
+}
@@ -0,0 +1,352 @@
+package geocube
+
+import (
+	"bytes"
+	"encoding/gob"
+	"fmt"
+	"io"
+
+	pb "github.com/airbusgeo/geocube/internal/pb"
+	"github.com/airbusgeo/geocube/internal/utils/proj"
+	"google.golang.org/protobuf/proto"
+)
+
+// EventSchemaVersion is the version of the protobuf schema of the events (see pb.Event)
+// It must be incremented each time an incompatible change is made to the events.
+const EventSchemaVersion = 1
+
+// EventEncoding is the encoding used to marshal the events sent to the messaging system
+type EventEncoding int
+
+// Supported event encodings
+const (
+	// EventEncodingProtobuf encodes the events as pb.Event (language-neutral and versioned)
+	EventEncodingProtobuf EventEncoding = iota
+	// EventEncodingGob encodes the events with encoding/gob (legacy, only readable by the Go binaries of the same version)
+	EventEncodingGob
+)
+
+var eventEncoding = EventEncodingProtobuf
+
+// ParseEventEncoding returns the EventEncoding corresponding to "protobuf" or "gob"
+func ParseEventEncoding(s string) (EventEncoding, error) {
+	switch s {
+	case "protobuf", "":
+		return EventEncodingProtobuf, nil
+	case "gob":
+		return EventEncodingGob, nil
+	}
+	return 0, fmt.Errorf("unknown event encoding: %s (must be protobuf or gob)", s)
+}
+
+// SetEventEncoding sets the encoding used by MarshalEvent and MarshalConsolidationEvent (default: protobuf)
+// Whatever the encoding, UnmarshalEvent and UnmarshalConsolidationEvent decode both protobuf and gob events,
+// so that the producers can keep emitting gob events during a rolling upgrade of the consumers.
+func SetEventEncoding(e EventEncoding) {
+	eventEncoding = e
+}
+
+// Event is a common interface for all job-related events
+type Event interface{}
+
+func gobRegisterEvent() {
+	gob.Register(TaskEvent{})
+	gob.Register(JobEvent{})
+}
+
+// MarshalEvent returns bytes representation of a job-related event (TaskEvent or JobEvent)
+func MarshalEvent(evt Event) ([]byte, error) {
+	if eventEncoding == EventEncodingGob {
+		var data bytes.Buffer
+		gobRegisterEvent()
+		if err := gob.NewEncoder(&data).Encode(&evt); err != nil {
+			return nil, err
+		}
+		return data.Bytes(), nil
+	}
+
+	pbevt := pb.Event{SchemaVersion: EventSchemaVersion}
+	switch e := evt.(type) {
+	case TaskEvent:
+		pbevt.Payload = &pb.Event_Task{Task: e.ToProtobuf()}
+	case *TaskEvent:
+		pbevt.Payload = &pb.Event_Task{Task: e.ToProtobuf()}
+	case JobEvent:
+		pbevt.Payload = &pb.Event_Job{Job: e.ToProtobuf()}
+	case *JobEvent:
+		pbevt.Payload = &pb.Event_Job{Job: e.ToProtobuf()}
+	default:
+		return nil, fmt.Errorf("MarshalEvent: unsupported event type %T", evt)
+	}
+	return proto.Marshal(&pbevt)
+}
+
+// UnmarshalEvent returns the event stored in the Reader (TaskEvent or JobEvent)
+// The event can be encoded in protobuf or in gob (legacy)
+func UnmarshalEvent(r io.Reader) (Event, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("UnmarshalEvent: %w", err)
+	}
+
+	if pbevt, err := unmarshalProtobufEvent(data); err != nil {
+		return nil, fmt.Errorf("UnmarshalEvent: %w", err)
+	} else if pbevt != nil {
+		switch p := pbevt.Payload.(type) {
+		case *pb.Event_Task:
+			return *NewTaskEventFromProtobuf(p.Task), nil
+		case *pb.Event_Job:
+			return *NewJobEventFromProtobuf(p.Job), nil
+		}
+		return nil, fmt.Errorf("UnmarshalEvent: unexpected payload %T", pbevt.Payload)
+	}
+
+	// Fallback: gob-encoded event
+	var evt Event
+	gobRegisterEvent()
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&evt); err != nil {
+		return nil, fmt.Errorf("UnmarshalEvent: neither a protobuf nor a gob event: %w", err)
+	}
+
+	return evt, nil
+}
+
+// MarshalConsolidationEvent is used to send a ConsolidationEvent to messagery
+func MarshalConsolidationEvent(evt ConsolidationEvent) ([]byte, error) {
+	if eventEncoding == EventEncodingGob {
+		var data bytes.Buffer
+		if err := gob.NewEncoder(&data).Encode(&evt); err != nil {
+			return nil, err
+		}
+		return data.Bytes(), nil
+	}
+
+	pbevt, err := evt.ToProtobuf()
+	if err != nil {
+		return nil, fmt.Errorf("MarshalConsolidationEvent: %w", err)
+	}
+	return proto.Marshal(&pb.Event{
+		SchemaVersion: EventSchemaVersion,
+		Payload:       &pb.Event_Consolidation{Consolidation: pbevt},
+	})
+}
+
+// UnmarshalConsolidationEvent is used to retrieve a ConsolidationEvent from messagery
+// The event can be encoded in protobuf or in gob (legacy)
+func UnmarshalConsolidationEvent(r io.Reader) (*ConsolidationEvent, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("UnmarshalConsolidationEvent: %w", err)
+	}
+
+	if pbevt, err := unmarshalProtobufEvent(data); err != nil {
+		return nil, fmt.Errorf("UnmarshalConsolidationEvent: %w", err)
+	} else if pbevt != nil {
+		p, ok := pbevt.Payload.(*pb.Event_Consolidation)
+		if !ok {
+			return nil, fmt.Errorf("UnmarshalConsolidationEvent: unexpected payload %T", pbevt.Payload)
+		}
+		evt, err := NewConsolidationEventFromProtobuf(p.Consolidation)
+		if err != nil {
+			return nil, fmt.Errorf("UnmarshalConsolidationEvent: %w", err)
+		}
+		return evt, nil
+	}
+
+	// Fallback: gob-encoded event
+	var evt ConsolidationEvent
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&evt); err != nil {
+		return nil, fmt.Errorf("UnmarshalConsolidationEvent: %w", err)
+	}
+
+	return &evt, nil
+}
+
+// unmarshalProtobufEvent returns the protobuf event or nil if data is not a protobuf event (legacy gob encoding)
+// It returns an error if the schema version of the event is not supported.
+func unmarshalProtobufEvent(data []byte) (*pb.Event, error) {
+	var pbevt pb.Event
+	if err := proto.Unmarshal(data, &pbevt); err != nil || pbevt.SchemaVersion == 0 || pbevt.Payload == nil {
+		return nil, nil
+	}
+	if pbevt.SchemaVersion > EventSchemaVersion {
+		return nil, NewUnhandledEvent("unsupported event schema version: %d (max supported: %d)", pbevt.SchemaVersion, EventSchemaVersion)
+	}
+	return &pbevt, nil
+}
+
+// ToProtobuf converts a TaskEvent to protobuf
+func (evt TaskEvent) ToProtobuf() *pb.TaskEvent {
+	return &pb.TaskEvent{
+		JobId:    evt.JobID,
+		TaskId:   evt.TaskID,
+		Status:   pb.TaskEvent_Status(evt.Status),
+		Error:    evt.Error,
+		Size:     evt.Size,
+		Checksum: evt.Checksum,
+	}
+}
+
+// NewTaskEventFromProtobuf converts a protobuf TaskEvent
+func NewTaskEventFromProtobuf(pbevt *pb.TaskEvent) *TaskEvent {
+	return &TaskEvent{
+		JobID:    pbevt.GetJobId(),
+		TaskID:   pbevt.GetTaskId(),
+		Status:   TaskStatus(pbevt.GetStatus()),
+		Error:    pbevt.GetError(),
+		Size:     pbevt.GetSize(),
+		Checksum: pbevt.GetChecksum(),
+	}
+}
+
+// ToProtobuf converts a JobEvent to protobuf
+func (evt JobEvent) ToProtobuf() *pb.JobEvent {
+	pbevt := &pb.JobEvent{
+		JobId:  evt.JobID,
+		Status: pb.JobEvent_Status(evt.Status),
+		Error:  evt.Error,
+	}
+	if evt.State != nil {
+		pbevt.State = evt.State.String()
+	}
+	return pbevt
+}
+
+// NewJobEventFromProtobuf converts a protobuf JobEvent
+func NewJobEventFromProtobuf(pbevt *pb.JobEvent) *JobEvent {
+	evt := &JobEvent{
+		JobID:  pbevt.GetJobId(),
+		Status: JobStatus(pbevt.GetStatus()),
+		Error:  pbevt.GetError(),
+	}
+	if state, err := JobStateString(pbevt.GetState()); err == nil {
+		evt.State = &state
+	}
+	return evt
+}
+
+// ToProtobuf converts a ConsolidationEvent to protobuf
+func (evt ConsolidationEvent) ToProtobuf() (*pb.ConsolidationEvent, error) {
+	pbevt := &pb.ConsolidationEvent{
+		JobId:   evt.JobID,
+		TaskId:  evt.TaskID,
+		Records: make([]*pb.ConsolidationRecord, len(evt.Records)),
+		Container: &pb.ConsolidationContainer{
+			Uri:                evt.Container.URI,
+			Dformat:            evt.Container.DatasetFormat.DataFormat.ToProtobuf(),
+			RealMinValue:       evt.Container.DatasetFormat.RangeExt.Min,
+			RealMaxValue:       evt.Container.DatasetFormat.RangeExt.Max,
+			Exponent:           evt.Container.DatasetFormat.Exponent,
+			Crs:                evt.Container.CRS,
+			Transform:          evt.Container.Transform[:],
+			Width:              int32(evt.Container.Width),
+			Height:             int32(evt.Container.Height),
+			Cutline:            evt.Container.Cutline,
+			BandsCount:         int32(evt.Container.BandsCount),
+			BlockXSize:         int32(evt.Container.BlockXSize),
+			BlockYSize:         int32(evt.Container.BlockYSize),
+			InterlacingPattern: evt.Container.InterlacingPattern,
+			OverviewsMinSize:   int32(evt.Container.OverviewsMinSize),
+			ResamplingAlg:      pb.Resampling(evt.Container.ResamplingAlg),
+			OvrResamplingAlg:   pb.Resampling(evt.Container.OvrResamplingAlg),
+			OptimizeExtent:     evt.Container.OptimizeExtent,
+			CreationParams:     evt.Container.CreationParams,
+			StorageClass:       pb.StorageClass(evt.Container.StorageClass),
+		},
+	}
+	for i, r := range evt.Records {
+		pbr := &pb.ConsolidationRecord{
+			Id:       r.ID,
+			Datetime: r.DateTime,
+			Datasets: make([]*pb.ConsolidationDataset, len(r.Datasets)),
+		}
+		if r.ValidShape != nil {
+			var err error
+			if pbr.ValidShape, err = r.ValidShape.MarshalBinary(); err != nil {
+				return nil, fmt.Errorf("record %s: %w", r.ID, err)
+			}
+		}
+		for j, d := range r.Datasets {
+			pbr.Datasets[j] = &pb.ConsolidationDataset{
+				Uri:          d.URI,
+				Subdir:       d.Subdir,
+				Bands:        d.Bands,
+				Overviews:    d.Overviews,
+				Dformat:      d.DatasetFormat.DataFormat.ToProtobuf(),
+				RealMinValue: d.DatasetFormat.RangeExt.Min,
+				RealMaxValue: d.DatasetFormat.RangeExt.Max,
+				Exponent:     d.DatasetFormat.Exponent,
+			}
+		}
+		pbevt.Records[i] = pbr
+	}
+	return pbevt, nil
+}
+
+// NewConsolidationEventFromProtobuf converts a protobuf ConsolidationEvent
+func NewConsolidationEventFromProtobuf(pbevt *pb.ConsolidationEvent) (*ConsolidationEvent, error) {
+	pbc := pbevt.GetContainer()
+	if pbc == nil {
+		return nil, fmt.Errorf("missing container")
+	}
+	if len(pbc.GetTransform()) != 6 {
+		return nil, fmt.Errorf("container transform must have 6 coefficients (found %d)", len(pbc.GetTransform()))
+	}
+	evt := &ConsolidationEvent{
+		JobID:   pbevt.GetJobId(),
+		TaskID:  pbevt.GetTaskId(),
+		Records: make([]ConsolidationRecord, len(pbevt.GetRecords())),
+		Container: ConsolidationContainer{
+			URI:                pbc.GetUri(),
+			DatasetFormat:      newDataMappingFromProtobuf(pbc.GetDformat(), pbc.GetRealMinValue(), pbc.GetRealMaxValue(), pbc.GetExponent()),
+			CRS:                pbc.GetCrs(),
+			Width:              int(pbc.GetWidth()),
+			Height:             int(pbc.GetHeight()),
+			Cutline:            pbc.GetCutline(),
+			BandsCount:         int(pbc.GetBandsCount()),
+			BlockXSize:         int(pbc.GetBlockXSize()),
+			BlockYSize:         int(pbc.GetBlockYSize()),
+			InterlacingPattern: pbc.GetInterlacingPattern(),
+			OverviewsMinSize:   int(pbc.GetOverviewsMinSize()),
+			ResamplingAlg:      Resampling(pbc.GetResamplingAlg()),
+			OvrResamplingAlg:   Resampling(pbc.GetOvrResamplingAlg()),
+			OptimizeExtent:     pbc.GetOptimizeExtent(),
+			CreationParams:     pbc.GetCreationParams(),
+			StorageClass:       StorageClass(pbc.GetStorageClass()),
+		},
+	}
+	copy(evt.Container.Transform[:], pbc.GetTransform())
+
+	for i, pbr := range pbevt.GetRecords() {
+		r := ConsolidationRecord{
+			ID:       pbr.GetId(),
+			DateTime: pbr.GetDatetime(),
+			Datasets: make([]ConsolidationDataset, len(pbr.GetDatasets())),
+		}
+		if len(pbr.GetValidShape()) > 0 {
+			r.ValidShape = &proj.Shape{}
+			if err := r.ValidShape.UnmarshalBinary(pbr.GetValidShape()); err != nil {
+				return nil, fmt.Errorf("record %s: %w", r.ID, err)
+			}
+		}
+		for j, pbd := range pbr.GetDatasets() {
+			r.Datasets[j] = ConsolidationDataset{
+				URI:           pbd.GetUri(),
+				Subdir:        pbd.GetSubdir(),
+				Bands:         pbd.GetBands(),
+				Overviews:     pbd.GetOverviews(),
+				DatasetFormat: newDataMappingFromProtobuf(pbd.GetDformat(), pbd.GetRealMinValue(), pbd.GetRealMaxValue(), pbd.GetExponent()),
+			}
+		}
+		evt.Records[i] = r
+	}
+	return evt, nil
+}
+
+func newDataMappingFromProtobuf(pbdf *pb.DataFormat, realMin, realMax, exponent float64) DataMapping {
+	return DataMapping{
+		DataFormat: *NewDataFormatFromProtobuf(pbdf),
+		RangeExt:   Range{Min: realMin, Max: realMax},
+		Exponent:   exponent,
+	}
+}
@@ -0,0 +1,167 @@
+package geocube
+
+import (
+	"bytes"
+	"encoding/gob"
+	"reflect"
+	"testing"
+
+	pb "github.com/airbusgeo/geocube/internal/pb"
+	"github.com/airbusgeo/geocube/internal/utils/bitmap"
+	"github.com/airbusgeo/geocube/internal/utils/proj"
+	"github.com/twpayne/go-geom"
+	"google.golang.org/protobuf/proto"
+)
+
+func testConsolidationEvent() ConsolidationEvent {
+	shape := proj.NewShape(4326, geom.NewMultiPolygon(geom.XY).MustSetCoords([][][]geom.Coord{{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}}}))
+	dm := DataMapping{
+		DataFormat: DataFormat{DType: bitmap.DTypeUINT16, NoData: 0, Range: Range{Min: 1, Max: 10000}},
+		RangeExt:   Range{Min: 0, Max: 1},
+		Exponent:   1,
+	}
+	return ConsolidationEvent{
+		JobID:  "job",
+		TaskID: "task",
+		Records: []ConsolidationRecord{
+			{
+				ID:       "record",
+				DateTime: "2018-01-01 12:00:00",
+				Datasets: []ConsolidationDataset{
+					{URI: "gs://bucket/dataset.tif", Subdir: "GTIFF_DIR:1", Bands: []int64{1, 2}, Overviews: true, DatasetFormat: dm},
+				},
+				ValidShape: &shape,
+			},
+			{ID: "record2", DateTime: "2018-01-02 12:00:00", Datasets: []ConsolidationDataset{{URI: "gs://bucket/dataset2.tif", Bands: []int64{1}, DatasetFormat: dm}}},
+		},
+		Container: ConsolidationContainer{
+			URI:                "gs://bucket/container.tif",
+			DatasetFormat:      dm,
+			CRS:                "+init=epsg:32631",
+			Transform:          [6]float64{500000, 10, 0, 4000000, 0, -10},
+			Width:              4096,
+			Height:             4096,
+			BandsCount:         2,
+			BlockXSize:         256,
+			BlockYSize:         256,
+			InterlacingPattern: "L>T>I>P",
+			OverviewsMinSize:   OVERVIEWS_DEFAULT_MIN_SIZE,
+			ResamplingAlg:      ResamplingBILINEAR,
+			OvrResamplingAlg:   ResamplingAVERAGE,
+			CreationParams:     map[string]string{"COMPRESS": "ZSTD"},
+			StorageClass:       StorageClassARCHIVE,
+		},
+	}
+}
+
+func compareConsolidationEvents(t *testing.T, expected, actual *ConsolidationEvent) {
+	t.Helper()
+	e, a := *expected, *actual
+	e.Records = append([]ConsolidationRecord{}, e.Records...)
+	a.Records = append([]ConsolidationRecord{}, a.Records...)
+	for i := range e.Records {
+		if (e.Records[i].ValidShape == nil) != (a.Records[i].ValidShape == nil) {
+			t.Fatalf("record %d: valid shape: expecting %v, found %v", i, e.Records[i].ValidShape, a.Records[i].ValidShape)
+		}
+		if e.Records[i].ValidShape != nil {
+			eb, _ := e.Records[i].ValidShape.MarshalBinary()
+			ab, _ := a.Records[i].ValidShape.MarshalBinary()
+			if !bytes.Equal(eb, ab) {
+				t.Errorf("record %d: valid shape: expecting %s, found %s", i, eb, ab)
+			}
+		}
+		e.Records[i].ValidShape, a.Records[i].ValidShape = nil, nil
+	}
+	if !reflect.DeepEqual(e, a) {
+		t.Errorf("expecting %+v, found %+v", e, a)
+	}
+}
+
+func TestConsolidationEventEncoding(t *testing.T) {
+	evt := testConsolidationEvent()
+
+	for _, encoding := range []EventEncoding{EventEncodingProtobuf, EventEncodingGob} {
+		SetEventEncoding(encoding)
+		data, err := MarshalConsolidationEvent(evt)
+		if err != nil {
+			t.Fatalf("MarshalConsolidationEvent(%d): %v", encoding, err)
+		}
+		decoded, err := UnmarshalConsolidationEvent(bytes.NewReader(data))
+		if err != nil {
+			t.Fatalf("UnmarshalConsolidationEvent(%d): %v", encoding, err)
+		}
+		compareConsolidationEvents(t, &evt, decoded)
+	}
+	SetEventEncoding(EventEncodingProtobuf)
+}
+
+func TestEventEncoding(t *testing.T) {
+	created := JobStateCREATED
+	events := []Event{
+		TaskEvent{JobID: "job", TaskID: "task", Status: TaskSuccessful, Size: 1024, Checksum: "crc32c=AAAAAA=="},
+		TaskEvent{JobID: "job", TaskID: "task", Status: TaskFailed, Error: "failed"},
+		JobEvent{JobID: "job", Status: ConsolidationFailed, Error: "failed"},
+		JobEvent{JobID: "job", Status: Continue},
+		JobEvent{JobID: "job", Status: OrdersPrepared, State: &created},
+	}
+	for _, encoding := range []EventEncoding{EventEncodingProtobuf, EventEncodingGob} {
+		SetEventEncoding(encoding)
+		for _, evt := range events {
+			data, err := MarshalEvent(evt)
+			if err != nil {
+				t.Fatalf("MarshalEvent(%d): %v", encoding, err)
+			}
+			decoded, err := UnmarshalEvent(bytes.NewReader(data))
+			if err != nil {
+				t.Fatalf("UnmarshalEvent(%d): %v", encoding, err)
+			}
+			if !reflect.DeepEqual(evt, decoded) {
+				t.Errorf("UnmarshalEvent(%d): expecting %+v, found %+v", encoding, evt, decoded)
+			}
+		}
+	}
+	SetEventEncoding(EventEncodingProtobuf)
+
+	// Pointers are encoded as values
+	data, _ := MarshalEvent(&JobEvent{JobID: "job", Status: JobCreated})
+	if decoded, err := UnmarshalEvent(bytes.NewReader(data)); err != nil || decoded != (JobEvent{JobID: "job", Status: JobCreated}) {
+		t.Errorf("UnmarshalEvent(*JobEvent): found %+v, %v", decoded, err)
+	}
+}
+
+func TestEventGobFallback(t *testing.T) {
+	// Event encoded by a previous version of the Geocube
+	var data bytes.Buffer
+	gobRegisterEvent()
+	var evt Event = TaskEvent{JobID: "job", TaskID: "task", Status: TaskCancelled}
+	if err := gob.NewEncoder(&data).Encode(&evt); err != nil {
+		t.Fatal(err)
+	}
+	if decoded, err := UnmarshalEvent(&data); err != nil || decoded != evt {
+		t.Errorf("UnmarshalEvent: expecting %+v, found %+v, %v", evt, decoded, err)
+	}
+}
+
+func TestEventSchemaVersion(t *testing.T) {
+	data, _ := proto.Marshal(&pb.Event{SchemaVersion: EventSchemaVersion + 1, Payload: &pb.Event_Job{Job: &pb.JobEvent{JobId: "job"}}})
+	if _, err := UnmarshalEvent(bytes.NewReader(data)); !IsError(err, UnhandledEvent) {
+		t.Errorf("UnmarshalEvent: expecting UnhandledEvent error, found %v", err)
+	}
+}
+
+func TestEventStatusProtobuf(t *testing.T) {
+	// The protobuf enums must stay in line with the Go constants
+	for _, s := range JobStatusValues() {
+		if name := pb.JobEvent_Status_name[int32(s)]; name != s.String() {
+			t.Errorf("JobStatus %d: expecting %s, found %s in protobuf", s, s.String(), name)
+		}
+	}
+	if len(pb.JobEvent_Status_name) != len(JobStatusValues()) {
+		t.Errorf("JobStatus: expecting %d values in protobuf, found %d", len(JobStatusValues()), len(pb.JobEvent_Status_name))
+	}
+	for _, s := range []TaskStatus{TaskSuccessful, TaskFailed, TaskCancelled} {
+		if name := pb.TaskEvent_Status_name[int32(s)]; name != s.String() {
+			t.Errorf("TaskStatus %d: expecting %s, found %s in protobuf", s, s.String(), name)
+		}
+	}
+}
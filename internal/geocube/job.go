@@ -720,6 +720,10 @@ func (j *Job) UpdateTask(evt TaskEvent) error {
 		// Task has probably been retried, but pending task finished meanwhile
 	}
 
+	if newState == TaskStateDONE {
+		task.Checksum = evt.Checksum
+	}
+
 	// Change the task state
 	j.setTaskState(task, newState)
 
@@ -0,0 +1,509 @@
+package geocube
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// TagsQuery is a boolean expression on the tags of a record (see ParseTagsQuery)
+// The database backends translate the expression using a type switch on the concrete types of this file
+type TagsQuery interface {
+	// Match returns true if the tags satisfy the expression
+	Match(tags Metadata) bool
+}
+
+// TagsAnd is true if all its expressions are true
+type TagsAnd []TagsQuery
+
+// TagsOr is true if at least one of its expressions is true
+type TagsOr []TagsQuery
+
+// TagsNot negates an expression
+type TagsNot struct {
+	Query TagsQuery
+}
+
+// TagExists is true if the record has the tag
+type TagExists struct {
+	Key string
+}
+
+// TagCompare compares the value of the tag to a value using one of the operators "=", "!=", "<", "<=", ">", ">="
+// If Numeric, the value of the tag is cast to a number (a tag that is not a number does not match).
+// Otherwise, the values are compared as strings (byte-wise).
+type TagCompare struct {
+	Key      string
+	Operator string
+	Value    string
+	Numeric  bool
+}
+
+// TagIn is true if the value of the tag is one of the values
+type TagIn struct {
+	Key    string
+	Values []string
+}
+
+// TagLike is true if the value of the tag matches the pattern, where * and ? stand for any sequence of characters and any character
+type TagLike struct {
+	Key             string
+	Pattern         string
+	CaseInsensitive bool
+}
+
+// NumericTagPattern is the regular expression (compatible with Go and PostgreSQL) of a tag that can be cast to a number
+const NumericTagPattern = `^\s*[-+]?([0-9]+\.?[0-9]*|\.[0-9]+)([eE][-+]?[0-9]+)?\s*$`
+
+var numericTagRegexp = regexp.MustCompile(NumericTagPattern)
+
+// Match implements TagsQuery
+func (q TagsAnd) Match(tags Metadata) bool {
+	for _, e := range q {
+		if !e.Match(tags) {
+			return false
+		}
+	}
+	return true
+}
+
+// Match implements TagsQuery
+func (q TagsOr) Match(tags Metadata) bool {
+	for _, e := range q {
+		if e.Match(tags) {
+			return true
+		}
+	}
+	return false
+}
+
+// Match implements TagsQuery
+func (q TagsNot) Match(tags Metadata) bool {
+	return !q.Query.Match(tags)
+}
+
+// Match implements TagsQuery
+func (q TagExists) Match(tags Metadata) bool {
+	_, ok := tags[q.Key]
+	return ok
+}
+
+// Match implements TagsQuery
+func (q TagCompare) Match(tags Metadata) bool {
+	v, ok := tags[q.Key]
+	if !ok {
+		return false
+	}
+	var c int
+	if q.Numeric {
+		if !numericTagRegexp.MatchString(v) {
+			return false
+		}
+		f1, _ := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		f2, _ := strconv.ParseFloat(q.Value, 64)
+		switch {
+		case f1 < f2:
+			c = -1
+		case f1 > f2:
+			c = 1
+		}
+	} else {
+		c = strings.Compare(v, q.Value)
+	}
+	switch q.Operator {
+	case "=":
+		return c == 0
+	case "!=":
+		return c != 0
+	case "<":
+		return c < 0
+	case "<=":
+		return c <= 0
+	case ">":
+		return c > 0
+	case ">=":
+		return c >= 0
+	}
+	return false
+}
+
+// Match implements TagsQuery
+func (q TagIn) Match(tags Metadata) bool {
+	v, ok := tags[q.Key]
+	if !ok {
+		return false
+	}
+	for _, value := range q.Values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// Match implements TagsQuery
+func (q TagLike) Match(tags Metadata) bool {
+	v, ok := tags[q.Key]
+	if !ok {
+		return false
+	}
+	expr := "(?s)^"
+	if q.CaseInsensitive {
+		expr = "(?is)^"
+	}
+	for _, r := range q.Pattern {
+		switch r {
+		case '*':
+			expr += ".*"
+		case '?':
+			expr += "."
+		default:
+			expr += regexp.QuoteMeta(string(r))
+		}
+	}
+	return regexp.MustCompile(expr + "$").MatchString(v)
+}
+
+// TagsQueryFromMetadata converts the legacy tags filters (map key/value) to a TagsQuery:
+// an empty value only checks the existence of the tag, a value with * or ? is a pattern (case-insensitive with the (?i) suffix)
+// and any other value must be equal to the value of the tag.
+// Returns nil if there is no filter.
+func TagsQueryFromMetadata(tags Metadata) TagsQuery {
+	if len(tags) == 0 {
+		return nil
+	}
+	q := make(TagsAnd, 0, len(tags))
+	for k, v := range tags {
+		switch {
+		case v == "":
+			q = append(q, TagExists{Key: k})
+		case strings.HasSuffix(v, "(?i)"):
+			q = append(q, TagLike{Key: k, Pattern: strings.TrimSuffix(v, "(?i)"), CaseInsensitive: true})
+		case strings.ContainsAny(v, "*?"):
+			q = append(q, TagLike{Key: k, Pattern: v})
+		default:
+			q = append(q, TagCompare{Key: k, Operator: "=", Value: v})
+		}
+	}
+	return q
+}
+
+// NewTagsQuery combines the legacy tags filters (see TagsQueryFromMetadata) and a query (see ParseTagsQuery)
+// Returns nil if there is no filter or a ValidationError if the query is invalid.
+func NewTagsQuery(tags Metadata, query string) (TagsQuery, error) {
+	q, err := ParseTagsQuery(query)
+	if err != nil {
+		return nil, err
+	}
+	t := TagsQueryFromMetadata(tags)
+	switch {
+	case q == nil:
+		return t, nil
+	case t == nil:
+		return q, nil
+	}
+	return TagsAnd{t, q}, nil
+}
+
+// ParseTagsQuery parses an expression on the tags of a record. Returns nil if the query is empty.
+// The expression is a combination of AND, OR, NOT and parentheses of the following conditions:
+//   - key EXISTS, key NOT EXISTS
+//   - key = value, key != value (or <>)
+//   - key < value, key <= value, key > value, key >= value
+//   - key IN (value, value...), key NOT IN (value...)
+//   - key LIKE pattern, key ILIKE pattern (case-insensitive), key NOT LIKE pattern (* and ? for any characters and any character)
+//
+// Keys and values are either words or quoted with ' or " (a quote is escaped by doubling it).
+// If the value of a comparison is an unquoted number, the value of the tag is cast to a number.
+// Keywords are case-insensitive. A condition on a missing tag is false.
+// Returns a ValidationError if the query is invalid.
+func ParseTagsQuery(query string) (TagsQuery, error) {
+	if strings.TrimSpace(query) == "" {
+		return nil, nil
+	}
+	tokens, err := tokenizeTagsQuery(query)
+	if err != nil {
+		return nil, err
+	}
+	p := tagsQueryParser{tokens: tokens}
+	q, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != tokenEOF {
+		return nil, p.errorf(t, "unexpected %s", t)
+	}
+	return q, nil
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenWord
+	tokenQuoted
+	tokenOperator
+	tokenLParen
+	tokenRParen
+	tokenComma
+)
+
+type tagsQueryToken struct {
+	kind  tokenKind
+	value string
+	pos   int
+}
+
+func (t tagsQueryToken) String() string {
+	switch t.kind {
+	case tokenEOF:
+		return "end of query"
+	case tokenQuoted:
+		return strconv.Quote(t.value)
+	}
+	return "'" + t.value + "'"
+}
+
+// isKeyword returns true if the token is the (unquoted) keyword
+func (t tagsQueryToken) isKeyword(keyword string) bool {
+	return t.kind == tokenWord && strings.EqualFold(t.value, keyword)
+}
+
+func isTagsQueryWordRune(r rune) bool {
+	return !unicode.IsSpace(r) && !strings.ContainsRune(`()=!<>,'"`, r)
+}
+
+func tokenizeTagsQuery(query string) ([]tagsQueryToken, error) {
+	var tokens []tagsQueryToken
+	runes := []rune(query)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(':
+			tokens = append(tokens, tagsQueryToken{kind: tokenLParen, value: "(", pos: i})
+			i++
+		case r == ')':
+			tokens = append(tokens, tagsQueryToken{kind: tokenRParen, value: ")", pos: i})
+			i++
+		case r == ',':
+			tokens = append(tokens, tagsQueryToken{kind: tokenComma, value: ",", pos: i})
+			i++
+		case r == '=' || r == '!' || r == '<' || r == '>':
+			op := string(r)
+			if i+1 < len(runes) && (runes[i+1] == '=' || (r == '<' && runes[i+1] == '>')) {
+				op += string(runes[i+1])
+			}
+			switch op {
+			case "!":
+				return nil, NewValidationError("invalid tags query at position %d: unexpected '!'", i)
+			case "<>", "==":
+				tokens = append(tokens, tagsQueryToken{kind: tokenOperator, value: map[string]string{"<>": "!=", "==": "="}[op], pos: i})
+			default:
+				tokens = append(tokens, tagsQueryToken{kind: tokenOperator, value: op, pos: i})
+			}
+			i += len(op)
+		case r == '\'' || r == '"':
+			start := i
+			var sb strings.Builder
+			for i++; ; i++ {
+				if i == len(runes) {
+					return nil, NewValidationError("invalid tags query at position %d: unterminated quoted string", start)
+				}
+				if runes[i] == r {
+					if i+1 < len(runes) && runes[i+1] == r {
+						i++
+					} else {
+						break
+					}
+				}
+				sb.WriteRune(runes[i])
+			}
+			i++
+			tokens = append(tokens, tagsQueryToken{kind: tokenQuoted, value: sb.String(), pos: start})
+		default:
+			start := i
+			for i < len(runes) && isTagsQueryWordRune(runes[i]) {
+				i++
+			}
+			tokens = append(tokens, tagsQueryToken{kind: tokenWord, value: string(runes[start:i]), pos: start})
+		}
+	}
+	return append(tokens, tagsQueryToken{kind: tokenEOF, pos: len(runes)}), nil
+}
+
+type tagsQueryParser struct {
+	tokens []tagsQueryToken
+	i      int
+}
+
+func (p *tagsQueryParser) peek() tagsQueryToken {
+	return p.tokens[p.i]
+}
+
+func (p *tagsQueryParser) next() tagsQueryToken {
+	t := p.tokens[p.i]
+	if t.kind != tokenEOF {
+		p.i++
+	}
+	return t
+}
+
+func (p *tagsQueryParser) errorf(t tagsQueryToken, format string, a ...interface{}) error {
+	return NewValidationError("invalid tags query at position %d: %s", t.pos, fmt.Sprintf(format, a...))
+}
+
+// parseOr parses: and (OR and)*
+func (p *tagsQueryParser) parseOr() (TagsQuery, error) {
+	var q TagsOr
+	for {
+		e, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		q = append(q, e)
+		if !p.peek().isKeyword("OR") {
+			break
+		}
+		p.next()
+	}
+	if len(q) == 1 {
+		return q[0], nil
+	}
+	return q, nil
+}
+
+// parseAnd parses: unary (AND unary)*
+func (p *tagsQueryParser) parseAnd() (TagsQuery, error) {
+	var q TagsAnd
+	for {
+		e, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		q = append(q, e)
+		if !p.peek().isKeyword("AND") {
+			break
+		}
+		p.next()
+	}
+	if len(q) == 1 {
+		return q[0], nil
+	}
+	return q, nil
+}
+
+// parseUnary parses: NOT unary | ( or ) | condition
+func (p *tagsQueryParser) parseUnary() (TagsQuery, error) {
+	t := p.peek()
+	switch {
+	case t.isKeyword("NOT"):
+		p.next()
+		e, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return TagsNot{Query: e}, nil
+	case t.kind == tokenLParen:
+		p.next()
+		e, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if t := p.next(); t.kind != tokenRParen {
+			return nil, p.errorf(t, "expecting ')', got %s", t)
+		}
+		return e, nil
+	}
+	return p.parseCondition()
+}
+
+// parseCondition parses: key (EXISTS | operator value | [NOT] IN (values) | [NOT] [I]LIKE pattern)
+func (p *tagsQueryParser) parseCondition() (TagsQuery, error) {
+	key, err := p.parseValue("key")
+	if err != nil {
+		return nil, err
+	}
+
+	t := p.next()
+	if t.kind == tokenOperator {
+		value := p.peek()
+		v, err := p.parseValue("value")
+		if err != nil {
+			return nil, err
+		}
+		numeric := value.kind == tokenWord && numericTagRegexp.MatchString(v)
+		return TagCompare{Key: key, Operator: t.value, Value: v, Numeric: numeric}, nil
+	}
+
+	if t.isKeyword("EXISTS") {
+		return TagExists{Key: key}, nil
+	}
+
+	not := t.isKeyword("NOT")
+	if not {
+		t = p.next()
+		if t.isKeyword("EXISTS") {
+			return TagsNot{Query: TagExists{Key: key}}, nil
+		}
+	}
+	var q TagsQuery
+	switch {
+	case t.isKeyword("IN"):
+		values, err := p.parseValues()
+		if err != nil {
+			return nil, err
+		}
+		q = TagIn{Key: key, Values: values}
+	case t.isKeyword("LIKE"), t.isKeyword("ILIKE"):
+		pattern, err := p.parseValue("pattern")
+		if err != nil {
+			return nil, err
+		}
+		q = TagLike{Key: key, Pattern: pattern, CaseInsensitive: t.isKeyword("ILIKE")}
+	default:
+		if not {
+			return nil, p.errorf(t, "expecting EXISTS, IN or LIKE after NOT, got %s", t)
+		}
+		return nil, p.errorf(t, "expecting an operator, EXISTS, IN or LIKE after the key %q, got %s", key, t)
+	}
+	if not {
+		// The condition is false if the tag does not exist
+		return TagsAnd{TagExists{Key: key}, TagsNot{Query: q}}, nil
+	}
+	return q, nil
+}
+
+// parseValues parses: ( value (, value)* )
+func (p *tagsQueryParser) parseValues() ([]string, error) {
+	if t := p.next(); t.kind != tokenLParen {
+		return nil, p.errorf(t, "expecting '(', got %s", t)
+	}
+	var values []string
+	for {
+		v, err := p.parseValue("value")
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, v)
+		t := p.next()
+		if t.kind == tokenRParen {
+			return values, nil
+		}
+		if t.kind != tokenComma {
+			return nil, p.errorf(t, "expecting ',' or ')', got %s", t)
+		}
+	}
+}
+
+// parseValue parses a word or a quoted string
+func (p *tagsQueryParser) parseValue(name string) (string, error) {
+	t := p.next()
+	if t.kind != tokenWord && t.kind != tokenQuoted {
+		return "", p.errorf(t, "expecting a %s, got %s", name, t)
+	}
+	return t.value, nil
+}
@@ -0,0 +1,140 @@
+package geocube
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseTagsQuery(t *testing.T) {
+	for _, test := range []struct {
+		query string
+		want  TagsQuery
+	}{
+		{"", nil},
+		{"  ", nil},
+		{"cloud_cover < 20", TagCompare{Key: "cloud_cover", Operator: "<", Value: "20", Numeric: true}},
+		{"cloud_cover<='20'", TagCompare{Key: "cloud_cover", Operator: "<=", Value: "20"}},
+		{"date >= 2021-01-01", TagCompare{Key: "date", Operator: ">=", Value: "2021-01-01"}},
+		{"a <> -1.5e3", TagCompare{Key: "a", Operator: "!=", Value: "-1.5e3", Numeric: true}},
+		{`"my key" == "it's"`, TagCompare{Key: "my key", Operator: "=", Value: "it's"}},
+		{"a = 'it''s'", TagCompare{Key: "a", Operator: "=", Value: "it's"}},
+		{"satellite in (S2A, 'S2B')", TagIn{Key: "satellite", Values: []string{"S2A", "S2B"}}},
+		{"satellite NOT IN (S2A)", TagsAnd{TagExists{Key: "satellite"}, TagsNot{Query: TagIn{Key: "satellite", Values: []string{"S2A"}}}}},
+		{"name LIKE S2*", TagLike{Key: "name", Pattern: "S2*"}},
+		{"name ilike 's2?'", TagLike{Key: "name", Pattern: "s2?", CaseInsensitive: true}},
+		{"processing_level exists", TagExists{Key: "processing_level"}},
+		{"processing_level NOT EXISTS", TagsNot{Query: TagExists{Key: "processing_level"}}},
+		{"a exists or b exists and not c exists", TagsOr{
+			TagExists{Key: "a"},
+			TagsAnd{TagExists{Key: "b"}, TagsNot{Query: TagExists{Key: "c"}}},
+		}},
+		{"(a exists OR b exists) AND c = 1", TagsAnd{
+			TagsOr{TagExists{Key: "a"}, TagExists{Key: "b"}},
+			TagCompare{Key: "c", Operator: "=", Value: "1", Numeric: true},
+		}},
+		{"'AND' exists", TagExists{Key: "AND"}},
+	} {
+		have, err := ParseTagsQuery(test.query)
+		if err != nil {
+			t.Errorf("%s: %v", test.query, err)
+			continue
+		}
+		if !reflect.DeepEqual(have, test.want) {
+			t.Errorf("%s: expect %#v, have %#v", test.query, test.want, have)
+		}
+	}
+}
+
+func TestParseTagsQueryErrors(t *testing.T) {
+	for _, query := range []string{
+		"cloud_cover",
+		"cloud_cover <",
+		"cloud_cover ! 20",
+		"a = 'unterminated",
+		"a IN S2A",
+		"a IN (S2A",
+		"a IN ()",
+		"a NOT = 1",
+		"(a exists",
+		"a exists b exists",
+		"a exists AND",
+		"NOT",
+		") a exists",
+	} {
+		_, err := ParseTagsQuery(query)
+		if err == nil {
+			t.Errorf("%s: expecting an error", query)
+			continue
+		}
+		if !IsError(err, EntityValidationError) {
+			t.Errorf("%s: expecting a validation error, have %v", query, err)
+		}
+	}
+}
+
+func TestTagsQueryMatch(t *testing.T) {
+	tags := Metadata{"cloud_cover": "12.5", "satellite": "S2B", "level": "L2A", "count": " 7 ", "text": "abc"}
+	for _, test := range []struct {
+		query string
+		want  bool
+	}{
+		{"cloud_cover < 20", true},
+		{"cloud_cover < 9", false},
+		{"cloud_cover = 12.50", true},
+		{"cloud_cover = '12.50'", false},
+		{"count >= 7", true},
+		{"text < 20", false},
+		{"text > 20", false},
+		{"text < 'b'", true},
+		{"missing < 20", false},
+		{"NOT missing < 20", true},
+		{"missing != 20", false},
+		{"satellite IN (S2A, S2B)", true},
+		{"satellite NOT IN (S2A, S2B)", false},
+		{"missing NOT IN (S2A)", false},
+		{"level LIKE L2*", true},
+		{"level LIKE l2*", false},
+		{"level ILIKE l2?", true},
+		{"level NOT LIKE L1*", true},
+		{"text LIKE 'a.c'", false},
+		{"level EXISTS AND missing NOT EXISTS", true},
+		{"missing EXISTS OR satellite = S2B", true},
+		{"NOT (missing EXISTS OR satellite = S2B)", false},
+	} {
+		q, err := ParseTagsQuery(test.query)
+		if err != nil {
+			t.Errorf("%s: %v", test.query, err)
+			continue
+		}
+		if have := q.Match(tags); have != test.want {
+			t.Errorf("%s: expect %v, have %v", test.query, test.want, have)
+		}
+	}
+}
+
+func TestNewTagsQuery(t *testing.T) {
+	q, err := NewTagsQuery(nil, "")
+	if err != nil || q != nil {
+		t.Errorf("expect nil, have %v, %v", q, err)
+	}
+	if _, err := NewTagsQuery(Metadata{"a": "b"}, "a <"); !IsError(err, EntityValidationError) {
+		t.Errorf("expecting a validation error, have %v", err)
+	}
+	q, err = NewTagsQuery(Metadata{"satellite": "S2*", "level": ""}, "cloud_cover < 20")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range []struct {
+		tags Metadata
+		want bool
+	}{
+		{Metadata{"satellite": "S2A", "level": "L1C", "cloud_cover": "5"}, true},
+		{Metadata{"satellite": "S2A", "cloud_cover": "5"}, false},
+		{Metadata{"satellite": "L8", "level": "L1C", "cloud_cover": "5"}, false},
+		{Metadata{"satellite": "S2A", "level": "L1C", "cloud_cover": "50"}, false},
+	} {
+		if have := q.Match(test.tags); have != test.want {
+			t.Errorf("%v: expect %v, have %v", test.tags, test.want, have)
+		}
+	}
+}
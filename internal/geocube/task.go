@@ -26,9 +26,10 @@ const (
 
 type Task struct {
 	persistenceState
-	ID      string
-	State   TaskState
-	Payload []byte
+	ID       string
+	State    TaskState
+	Payload  []byte
+	Checksum string // Checksum of the output of a successful consolidation task
 }
 
 // newConsolidationTask creates a new task with the consolidation event provided
@@ -3,8 +3,11 @@ package grpc
 import (
 	"context"
 
+	"github.com/airbusgeo/geocube/interface/messaging"
+	"github.com/airbusgeo/geocube/interface/storage/cache"
 	"github.com/airbusgeo/geocube/internal/geocube"
 	pb "github.com/airbusgeo/geocube/internal/pb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // GeocubeServiceAdmin contains all the admin services
@@ -15,6 +18,18 @@ type GeocubeServiceAdmin interface {
 	UpdateDatasets(ctx context.Context, simulate bool, instanceID string, RecordIds []string, dmapping geocube.DataMapping) (map[string]int64, error)
 	// DeleteDatasets given the instance id
 	DeleteDatasets(ctx context.Context, jobName string, instanceIDs, recordIDs, datasetPatterns []string, executionLevel geocube.ExecutionLevel) (*geocube.Job, error)
+	// ComputeValidShapes recomputes the shapes of the datasets from their valid pixels
+	ComputeValidShapes(ctx context.Context, simulate bool, instanceID string, recordIds []string) (int64, map[string]string, error)
+	// ListDeadLetters of the queue (all the queues if empty)
+	ListDeadLetters(ctx context.Context, queue string, page, limit int) ([]*messaging.DeadLetter, error)
+	// GetDeadLetter given its id
+	GetDeadLetter(ctx context.Context, id int64) (*messaging.DeadLetter, error)
+	// RequeueDeadLetters publishes the messages again and removes the dead letters
+	RequeueDeadLetters(ctx context.Context, ids []int64) (int64, error)
+	// PurgeDeadLetters given their ids or all the dead letters of the queue
+	PurgeDeadLetters(ctx context.Context, queue string, ids []int64) (int64, error)
+	// StorageCacheStats returns the statistics of the storage cache (nil if the cache is disabled)
+	StorageCacheStats(ctx context.Context) *cache.Stats
 }
 
 // ServiceAdmin is the GRPC service
@@ -82,3 +97,87 @@ func (svc *ServiceAdmin) DeleteDatasets(ctx context.Context, req *pb.DeleteDatas
 		Job: jobpb,
 	}, nil
 }
+
+// ComputeValidShapes implements AdminServer
+func (svc *ServiceAdmin) ComputeValidShapes(ctx context.Context, req *pb.ComputeValidShapesRequest) (*pb.ComputeValidShapesResponse, error) {
+	nb, failures, err := svc.gsvca.ComputeValidShapes(ctx, req.GetSimulate(), req.GetInstanceId(), req.GetRecordIds())
+	if err != nil {
+		return nil, formatError("backend.%w", err)
+	}
+	return &pb.ComputeValidShapesResponse{
+		NbUpdated: nb,
+		Failures:  failures,
+	}, nil
+}
+
+// ListDeadLetters implements AdminServer
+func (svc *ServiceAdmin) ListDeadLetters(ctx context.Context, req *pb.ListDeadLettersRequest) (*pb.ListDeadLettersResponse, error) {
+	deadLetters, err := svc.gsvca.ListDeadLetters(ctx, req.Queue, int(req.Page), int(req.Limit))
+	if err != nil {
+		return nil, formatError("backend.%w", err)
+	}
+	resp := &pb.ListDeadLettersResponse{DeadLetters: make([]*pb.DeadLetter, len(deadLetters))}
+	for i, dl := range deadLetters {
+		resp.DeadLetters[i] = deadLetterToProtobuf(dl)
+	}
+	return resp, nil
+}
+
+// GetDeadLetter implements AdminServer
+func (svc *ServiceAdmin) GetDeadLetter(ctx context.Context, req *pb.GetDeadLetterRequest) (*pb.GetDeadLetterResponse, error) {
+	deadLetter, err := svc.gsvca.GetDeadLetter(ctx, req.Id)
+	if err != nil {
+		return nil, formatError("backend.%w", err)
+	}
+	return &pb.GetDeadLetterResponse{DeadLetter: deadLetterToProtobuf(deadLetter)}, nil
+}
+
+// RequeueDeadLetters implements AdminServer
+func (svc *ServiceAdmin) RequeueDeadLetters(ctx context.Context, req *pb.RequeueDeadLettersRequest) (*pb.RequeueDeadLettersResponse, error) {
+	nb, err := svc.gsvca.RequeueDeadLetters(ctx, req.Ids)
+	if err != nil {
+		return nil, formatError("backend.%w", err)
+	}
+	return &pb.RequeueDeadLettersResponse{Nb: nb}, nil
+}
+
+// PurgeDeadLetters implements AdminServer
+func (svc *ServiceAdmin) PurgeDeadLetters(ctx context.Context, req *pb.PurgeDeadLettersRequest) (*pb.PurgeDeadLettersResponse, error) {
+	if req.Queue == "" && len(req.Ids) == 0 {
+		return nil, newValidationError("Queue or Ids must be defined")
+	}
+	nb, err := svc.gsvca.PurgeDeadLetters(ctx, req.Queue, req.Ids)
+	if err != nil {
+		return nil, formatError("backend.%w", err)
+	}
+	return &pb.PurgeDeadLettersResponse{Nb: nb}, nil
+}
+
+// GetStorageCacheStats implements AdminServer
+func (svc *ServiceAdmin) GetStorageCacheStats(ctx context.Context, req *pb.GetStorageCacheStatsRequest) (*pb.GetStorageCacheStatsResponse, error) {
+	stats := svc.gsvca.StorageCacheStats(ctx)
+	if stats == nil {
+		return &pb.GetStorageCacheStatsResponse{}, nil
+	}
+	return &pb.GetStorageCacheStatsResponse{
+		Enabled:   true,
+		Hits:      stats.Hits,
+		Misses:    stats.Misses,
+		Evictions: stats.Evictions,
+		Size:      stats.Size,
+		MaxSize:   stats.MaxSize,
+	}, nil
+}
+
+func deadLetterToProtobuf(dl *messaging.DeadLetter) *pb.DeadLetter {
+	return &pb.DeadLetter{
+		Id:          dl.ID,
+		Queue:       dl.Queue,
+		Data:        dl.Message.Data,
+		Attributes:  dl.Message.Attributes,
+		Error:       dl.Error,
+		TryCount:    int32(dl.Message.TryCount),
+		PublishedAt: timestamppb.New(dl.Message.PublishTime),
+		FailedAt:    timestamppb.New(dl.FailedAt),
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/airbusgeo/geocube/interface/storage/cache"
 	"github.com/airbusgeo/geocube/interface/storage/gcs"
 	"github.com/airbusgeo/geocube/internal/geocube"
 	"github.com/airbusgeo/geocube/internal/log"
@@ -20,7 +21,7 @@ import (
 type GeocubeDownloaderService interface {
 	// GetCubeFromMetadatas requests a cube of data from metadatas generated with a previous call to GetCube()
 	GetCubeFromMetadatas(ctx context.Context, metadatas []internal.SliceMeta, grecords [][]*geocube.Record,
-		refDf geocube.DataFormat, crs *godal.SpatialRef, pixToCRS *affine.Affine, width, height int, options internal.GetCubeOptions) (internal.CubeInfo, <-chan internal.CubeSlice, error)
+		groups []internal.BandGroup, crs *godal.SpatialRef, pixToCRS *affine.Affine, width, height int, options internal.GetCubeOptions) (internal.CubeInfo, <-chan internal.CubeSlice, error)
 }
 
 // DownloaderService is the GRPC service
@@ -78,13 +79,12 @@ func (svc *DownloaderService) DownloadCube(req *pb.GetCubeMetadataRequest, strea
 		return newValidationError("number of datasetsMeta must be equal to the number of record lists : each datasetMeta is attached to a record list")
 	}
 	sliceMetas := make([]internal.SliceMeta, 0, len(req.GetDatasetsMeta()))
-	for i, metadata := range req.GetDatasetsMeta() {
+	for _, metadata := range req.GetDatasetsMeta() {
 		sliceMetas = append(sliceMetas, *internal.NewSliceMetaFromProtobuf(metadata))
-		for _, element := range sliceMetas[i].Datasets {
-			if len(element.Bands) != len(sliceMetas[0].Datasets[0].Bands) {
-				return newValidationError("Bands number is not constant")
-			}
-		}
+	}
+	groups, err := downloadCubeBandGroups(req, sliceMetas)
+	if err != nil {
+		return err
 	}
 	grecords := make([][]*geocube.Record, 0, len(req.GetGroupedRecords()))
 	for _, pbgrecords := range req.GetGroupedRecords() {
@@ -101,11 +101,7 @@ func (svc *DownloaderService) DownloadCube(req *pb.GetCubeMetadataRequest, strea
 	info, slicesQueue, err := svc.gdsvc.GetCubeFromMetadatas(ctx,
 		sliceMetas,
 		grecords,
-		geocube.DataFormat{DType: bitmap.DType(req.GetRefDformat().Dtype),
-			NoData: req.GetRefDformat().NoData,
-			Range: geocube.Range{Min: req.GetRefDformat().GetMinValue(),
-				Max: req.GetRefDformat().GetMaxValue()},
-		},
+		groups,
 		crs,
 		pixToCRS,
 		width,
@@ -115,6 +111,9 @@ func (svc *DownloaderService) DownloadCube(req *pb.GetCubeMetadataRequest, strea
 			Resampling:           geocube.Resampling(req.GetResamplingAlg()),
 			Predownload:          req.Predownload,
 			FilterPartialImagePc: 0, // Filter only empty images
+			SkipIncomplete:       req.SkipIncomplete,
+			MixedDTypes:          req.MixedDtypes,
+			Compositing:          downloadCubeCompositing(req.GetCompositing()),
 		})
 	if err != nil {
 		return formatError("GetCube.%w", err)
@@ -122,6 +121,7 @@ func (svc *DownloaderService) DownloadCube(req *pb.GetCubeMetadataRequest, strea
 
 	globalHeader.Count = int64(info.NbImages)
 	globalHeader.NbDatasets = int64(info.NbDatasets)
+	globalHeader.BandGroups = bandGroupsToProtobuf(info.BandGroups)
 	if err := stream.Send(&pb.GetCubeMetadataResponse{Response: &pb.GetCubeMetadataResponse_GlobalHeader{GlobalHeader: globalHeader}}); err != nil {
 		return formatError("GetCube.Send: %w", err)
 	}
@@ -154,5 +154,75 @@ func (svc *DownloaderService) DownloadCube(req *pb.GetCubeMetadataRequest, strea
 	log.Logger(ctx).Sugar().Infof("GetCube: %d images streamed from %d datasets (%v)\n", info.NbImages, info.NbDatasets, time.Since(start))
 
 	defer gcs.GetMetrics(ctx)
+	defer cache.LogStats(ctx)
 	return ctx.Err()
 }
+
+// downloadCubeCompositing returns the compositing options of the cube (nil if not defined).
+// The slices of the cube have already been binned by GetCube, so the binning rule is ignored.
+func downloadCubeCompositing(c *pb.Compositing) *internal.CompositingOptions {
+	options := compositingFromProtobuf(c)
+	if options != nil {
+		options.PeriodDays, options.PeriodMonths, options.DateRanges = 0, 0, nil
+	}
+	return options
+}
+
+// downloadCubeBandGroups returns the band groups of the cube and checks that the datasets are consistent with them.
+// If the request does not define any group, all the datasets are merged in a single group defined by ref_dformat and resampling_alg.
+func downloadCubeBandGroups(req *pb.GetCubeMetadataRequest, sliceMetas []internal.SliceMeta) ([]internal.BandGroup, error) {
+	if len(req.GetBandGroups()) == 0 {
+		var groups []internal.BandGroup
+		for _, slice := range sliceMetas {
+			for _, d := range slice.Datasets {
+				if groups == nil {
+					groups = []internal.BandGroup{{
+						Bands: len(d.Bands),
+						DataFormat: geocube.DataFormat{DType: bitmap.DType(req.GetRefDformat().Dtype),
+							NoData: req.GetRefDformat().NoData,
+							Range: geocube.Range{Min: req.GetRefDformat().GetMinValue(),
+								Max: req.GetRefDformat().GetMaxValue()},
+						},
+						Resampling: geocube.Resampling(req.GetResamplingAlg()),
+					}}
+				} else if len(d.Bands) != groups[0].Bands {
+					return nil, newValidationError("Bands number is not constant")
+				}
+			}
+		}
+		if groups == nil {
+			return nil, newValidationError("At least one dataset must be provided")
+		}
+		return groups, nil
+	}
+
+	groups := make([]internal.BandGroup, len(req.GetBandGroups()))
+	for i, pbgroup := range req.GetBandGroups() {
+		groups[i] = *internal.NewBandGroupFromProtobuf(pbgroup)
+		groups[i].Size = 0
+		if groups[i].Bands <= 0 {
+			return nil, newValidationError(fmt.Sprintf("Invalid number of bands for the group %d: %d", i, groups[i].Bands))
+		}
+		if len(groups) > 1 && groups[i].InstanceID == "" {
+			return nil, newValidationError(fmt.Sprintf("The instance of the group %d must be defined", i))
+		}
+	}
+	for _, slice := range sliceMetas {
+		for _, d := range slice.Datasets {
+			found := false
+			for _, group := range groups {
+				if len(groups) == 1 || d.InstanceID == group.InstanceID {
+					if len(d.Bands) != group.Bands {
+						return nil, newValidationError(fmt.Sprintf("Bands number of the dataset %s is not consistent with its band group", d.URI))
+					}
+					found = true
+					break
+				}
+			}
+			if !found {
+				return nil, newValidationError(fmt.Sprintf("No band group for the instance %s of the dataset %s", d.InstanceID, d.URI))
+			}
+		}
+	}
+	return groups, nil
+}
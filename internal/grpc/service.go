@@ -11,6 +11,7 @@ import (
 
 	"github.com/airbusgeo/geocube/interface/database"
 
+	"github.com/airbusgeo/geocube/interface/storage/cache"
 	"github.com/airbusgeo/geocube/interface/storage/gcs"
 
 	"github.com/airbusgeo/godal"
@@ -20,10 +21,13 @@ import (
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/airbusgeo/geocube/internal/geocube"
+	internalImage "github.com/airbusgeo/geocube/internal/image"
 	"github.com/airbusgeo/geocube/internal/log"
 	pb "github.com/airbusgeo/geocube/internal/pb"
+	"github.com/airbusgeo/geocube/internal/stac"
 	internal "github.com/airbusgeo/geocube/internal/svc"
 	"github.com/airbusgeo/geocube/internal/utils"
 	"github.com/airbusgeo/geocube/internal/utils/affine"
@@ -42,7 +46,7 @@ type GeocubeService interface {
 	CreateRecords(ctx context.Context, records []*geocube.Record) error
 	GetRecords(ctx context.Context, ids []string) ([]*geocube.Record, error)
 	DeleteRecords(ctx context.Context, ids []string, noFail bool) (int64, error)
-	ListRecords(ctx context.Context, namelike string, tags geocube.Metadata, fromTime, toTime time.Time, aoi *geocube.AOI, page, limit int, withAOI bool) ([]*geocube.Record, error)
+	ListRecords(ctx context.Context, namelike string, tags geocube.TagsQuery, fromTime, toTime time.Time, aoi *geocube.AOI, page, limit int, after *geocube.Cursor, withAOI bool) ([]*geocube.Record, error)
 	AddRecordsTags(ctx context.Context, ids []string, tags geocube.Metadata) (int64, error)
 	RemoveRecordsTags(ctx context.Context, ids []string, tagsKey []string) (int64, error)
 
@@ -51,7 +55,7 @@ type GeocubeService interface {
 	// Retrieves variable with the first not-empty parameter
 	GetVariable(ctx context.Context, variableID, instanceID, variableName string) (*geocube.Variable, error)
 	InstantiateVariable(ctx context.Context, variableID string, instance *geocube.VariableInstance) error
-	ListVariables(ctx context.Context, namelike string, page, limit int) ([]*geocube.Variable, error)
+	ListVariables(ctx context.Context, namelike string, page, limit int, after *geocube.Cursor) ([]*geocube.Variable, error)
 	UpdateInstance(ctx context.Context, id string, name *string, newMetadata map[string]string, delMetadataKeys []string) error
 	// DeleteVariable delete the variable and all its instances iif not used anymore
 	DeleteVariable(ctx context.Context, id string) error
@@ -61,14 +65,16 @@ type GeocubeService interface {
 
 	// Index datasets that are not fully known. Checks that the container is reachable and get some missing informations.
 	GetContainers(ctx context.Context, containerUris []string) ([]*geocube.Container, error)
-	IndexExternalDatasets(ctx context.Context, container *geocube.Container, datasets []*geocube.Dataset) error
-	ListDatasets(ctx context.Context, instanceID string, recordsID []string, recordTags geocube.Metadata, fromTime, toTime time.Time) ([]internal.SliceMeta, []*geocube.Record, error)
+	IndexExternalDatasets(ctx context.Context, container *geocube.Container, datasets []*geocube.Dataset, options internal.IndexationOptions) ([]string, error)
+	// ImportSTACItems creates the AOI and the record of each item (or reuses identical ones) and indexes the mapped assets as datasets
+	ImportSTACItems(ctx context.Context, items []*stac.Item, mapping *stac.Mapping) ([]internal.STACImportResult, error)
+	ListDatasets(ctx context.Context, instanceID string, recordsID []string, recordTags geocube.TagsQuery, fromTime, toTime time.Time, limit int, after *geocube.Cursor) ([]internal.SliceMeta, []*geocube.Record, *geocube.Cursor, error)
 	DeleteDatasets(ctx context.Context, jobName string, instanceIDs, recordIDs, datasetPatterns []string, executionLevel geocube.ExecutionLevel) (*geocube.Job, error)
 	ConfigConsolidation(ctx context.Context, variableID string, params geocube.ConsolidationParams) error
 	GetConsolidationParams(ctx context.Context, ID string) (*geocube.ConsolidationParams, error)
 	ConsolidateFromRecords(ctx context.Context, job *geocube.Job, recordsID []string) error
-	ConsolidateFromFilters(ctx context.Context, job *geocube.Job, tags map[string]string, fromTime, toTime time.Time) error
-	ListJobs(ctx context.Context, nameLike string, page, limit int) ([]*geocube.Job, error)
+	ConsolidateFromFilters(ctx context.Context, job *geocube.Job, tags geocube.TagsQuery, fromTime, toTime time.Time) error
+	ListJobs(ctx context.Context, nameLike string, page, limit int, after *geocube.Cursor) ([]*geocube.Job, error)
 	GetJob(ctx context.Context, jobID string, opts ...database.ReadJobOptions) (*geocube.Job, error)
 	RetryJob(ctx context.Context, jobID string, forceAnyState bool) error
 	CancelJob(ctx context.Context, jobID string, forceAnyState bool) error
@@ -82,13 +88,15 @@ type GeocubeService interface {
 	CreateLayout(ctx context.Context, layout *geocube.Layout) error
 	DeleteLayout(ctx context.Context, name string) error
 	ListLayouts(ctx context.Context, nameLike string) ([]*geocube.Layout, error)
-	FindContainerLayouts(ctx context.Context, instanceId string, aoi *geocube.AOI, recordIds []string, tags map[string]string, fromTime, toTime time.Time) ([]string, [][]string, error)
+	FindContainerLayouts(ctx context.Context, instanceId string, aoi *geocube.AOI, recordIds []string, tags geocube.TagsQuery, fromTime, toTime time.Time) ([]string, [][]string, error)
 	TileAOI(ctx context.Context, aoi *geocube.AOI, layoutName string, layout *geocube.Layout) (<-chan geocube.StreamedCell, error)
 
 	GetXYZTile(ctx context.Context, instanceID string, recordsID []string, a, b, z int, min, max float64) ([]byte, error)
-	GetXYZTileFromFilters(ctx context.Context, instanceID string, recordTags geocube.Metadata, fromTime, toTime time.Time, a, b, z int, min, max float64) ([]byte, error)
+	GetXYZTileFromFilters(ctx context.Context, instanceID string, recordTags geocube.TagsQuery, fromTime, toTime time.Time, a, b, z int, min, max float64) ([]byte, error)
 	GetCubeFromRecords(ctx context.Context, recordsID [][]string, instancesID []string, crs *godal.SpatialRef, pixToCRS *affine.Affine, width, height int, options internal.GetCubeOptions) (internal.CubeInfo, <-chan internal.CubeSlice, error)
-	GetCubeFromFilters(ctx context.Context, recordTags geocube.Metadata, fromTime, toTime time.Time, instancesID []string, crs *godal.SpatialRef, pixToCRS *affine.Affine, width, height int, options internal.GetCubeOptions) (internal.CubeInfo, <-chan internal.CubeSlice, error)
+	GetCubeFromFilters(ctx context.Context, recordTags geocube.TagsQuery, fromTime, toTime time.Time, instancesID []string, crs *godal.SpatialRef, pixToCRS *affine.Affine, width, height int, options internal.GetCubeOptions) (internal.CubeInfo, <-chan internal.CubeSlice, error)
+	GetTimeSeries(ctx context.Context, instanceID string, locations []internal.TimeSeriesLocation, crs *godal.SpatialRef, recordsID []string, recordTags geocube.TagsQuery, fromTime, toTime time.Time) (<-chan internal.TimeSeries, error)
+	GetZonalStatistics(ctx context.Context, instanceID string, zones []internal.Zone, recordsID []string, recordTags geocube.TagsQuery, fromTime, toTime time.Time, options internalImage.ZonalStatisticsOptions) (<-chan internal.ZonalStatistics, error)
 }
 
 // Service is the GRPC service
@@ -293,15 +301,27 @@ func (svc *Service) ListRecords(req *pb.ListRecordsRequest, stream pb.Geocube_Li
 		return formatError("", err) // ValidationError
 	}
 
+	// Convert tags
+	tags, err := geocube.NewTagsQuery(req.GetTags(), req.GetTagsQuery())
+	if err != nil {
+		return formatError("", err) // ValidationError
+	}
+
+	// Convert page token
+	after, err := geocube.ParseCursor(req.GetPageToken())
+	if err != nil {
+		return formatError("", err) // ValidationError
+	}
+
 	// List records
-	records, err := svc.gsvc.ListRecords(ctx, req.GetName(), req.GetTags(), fromTime, toTime, aoi, int(req.GetPage()), limit, req.WithAoi)
+	records, err := svc.gsvc.ListRecords(ctx, req.GetName(), tags, fromTime, toTime, aoi, int(req.GetPage()), limit, after, req.WithAoi)
 	if err != nil {
 		return formatError("backend.%w", err)
 	}
 
 	// Format response
 	for _, record := range records {
-		if err := stream.Send(&pb.ListRecordsResponseItem{Record: record.ToProtobuf(req.WithAoi)}); err != nil {
+		if err := stream.Send(&pb.ListRecordsResponseItem{Record: record.ToProtobuf(req.WithAoi), PageToken: record.Cursor().Token()}); err != nil {
 			return formatError("backend.ListRecords.send: %w", err)
 		}
 	}
@@ -410,16 +430,20 @@ func (svc *Service) ListVariables(req *pb.ListVariablesRequest, stream pb.Geocub
 	// Convert request
 	ctx := stream.Context()
 	limit := int(req.GetLimit())
+	after, err := geocube.ParseCursor(req.GetPageToken())
+	if err != nil {
+		return formatError("", err) // ValidationError
+	}
 
 	// List variables
-	variables, err := svc.gsvc.ListVariables(ctx, req.GetName(), int(req.GetPage()), limit)
+	variables, err := svc.gsvc.ListVariables(ctx, req.GetName(), int(req.GetPage()), limit, after)
 	if err != nil {
 		return formatError("backend.ListVariables.%w", err)
 	}
 
 	// Format response
 	for _, variable := range variables {
-		if err := stream.Send(&pb.ListVariablesResponseItem{Variable: variable.ToProtobuf()}); err != nil {
+		if err := stream.Send(&pb.ListVariablesResponseItem{Variable: variable.ToProtobuf(), PageToken: geocube.Cursor{ID: variable.ID}.Token()}); err != nil {
 			return formatError("backend.ListVariables: %w", err)
 		}
 	}
@@ -546,21 +570,65 @@ func (svc *Service) IndexDatasets(ctx context.Context, req *pb.IndexDatasetsRequ
 	}
 
 	// Convert []pb.NewDataset to datasets
+	// The real range of values can be undefined only if it is deduced from the file
 	datasets := make([]*geocube.Dataset, len(req.GetContainer().GetDatasets()))
 	for i, dataset := range req.GetContainer().GetDatasets() {
-		d, err := geocube.NewDatasetFromProtobuf(dataset, container.URI)
+		d, err := geocube.NewDatasetFromProtobuf(dataset, container.URI, req.GetMetadata().GetDataMapping())
 		if err != nil {
 			return nil, formatError("", err) // ValidationError
 		}
 		datasets[i] = d
 	}
 
+	// Indexation options
+	options := internal.IndexationOptions{ValidShape: req.GetValidShape()}
+	if m := req.GetMetadata(); m != nil {
+		options.Metadata = &internal.MetadataOptions{
+			Domains:     m.GetDomains(),
+			Tags:        m.GetTags(),
+			DataMapping: m.GetDataMapping(),
+		}
+	}
+
 	// Create datasets
-	if err := svc.gsvc.IndexExternalDatasets(ctx, container, datasets); err != nil {
+	warnings, err := svc.gsvc.IndexExternalDatasets(ctx, container, datasets, options)
+	if err != nil {
 		return nil, formatError("backend.%w", err)
 	}
 
-	return &pb.IndexDatasetsResponse{}, nil
+	return &pb.IndexDatasetsResponse{Warnings: warnings}, nil
+}
+
+// ImportSTAC imports STAC Items as records and datasets
+func (svc *Service) ImportSTAC(ctx context.Context, req *pb.ImportSTACRequest) (*pb.ImportSTACResponse, error) {
+	// Convert request
+	items, err := stac.ParseItems(req.GetItems())
+	if err != nil {
+		return nil, formatError("", err) // ValidationError
+	}
+	mapping, err := stac.NewMappingFromProtobuf(req.GetMapping())
+	if err != nil {
+		return nil, formatError("", err) // ValidationError
+	}
+
+	// Import items
+	results, err := svc.gsvc.ImportSTACItems(ctx, items, mapping)
+	if err != nil {
+		return nil, formatError("backend.%w", err)
+	}
+
+	// Format response
+	resp := pb.ImportSTACResponse{Items: make([]*pb.STACImportedItem, len(results))}
+	for i, r := range results {
+		resp.Items[i] = &pb.STACImportedItem{
+			ItemId:     r.ItemID,
+			RecordId:   r.RecordID,
+			AoiId:      r.AOIID,
+			NewRecord:  r.NewRecord,
+			NbDatasets: int32(r.NbDatasets),
+		}
+	}
+	return &resp, nil
 }
 
 // ListDatasets retrieves datasets given records & instance
@@ -569,12 +637,22 @@ func (svc *Service) ListDatasets(ctx context.Context, req *pb.ListDatasetsReques
 	// Convert times
 	fromTime := timeFromTimestamp(filters.GetFromTime())
 	toTime := timeFromTimestamp(filters.GetToTime())
-	metadata, records, err := svc.gsvc.ListDatasets(ctx,
+	tags, err := geocube.NewTagsQuery(filters.GetTags(), filters.GetTagsQuery())
+	if err != nil {
+		return &pb.ListDatasetsResponse{}, formatError("", err) // ValidationError
+	}
+	after, err := geocube.ParseCursor(req.GetPageToken())
+	if err != nil {
+		return &pb.ListDatasetsResponse{}, formatError("", err) // ValidationError
+	}
+	metadata, records, next, err := svc.gsvc.ListDatasets(ctx,
 		req.InstanceId,
 		req.GetRecords().GetIds(), // Either records id or tags/fromTime/toTime is nil
-		filters.GetTags(),
+		tags,
 		fromTime,
-		toTime)
+		toTime,
+		int(req.GetLimit()),
+		after)
 	if err != nil {
 		return &pb.ListDatasetsResponse{}, formatError("backend.%w", err)
 	}
@@ -583,6 +661,9 @@ func (svc *Service) ListDatasets(ctx context.Context, req *pb.ListDatasetsReques
 		Records:      make([]*pb.Record, len(records)),
 		DatasetMetas: make([]*pb.DatasetMeta, len(records)),
 	}
+	if next != nil {
+		response.NextPageToken = next.Token()
+	}
 	for i, record := range records {
 		response.Records[i] = record.ToProtobuf(false)
 		response.DatasetMetas[i] = metadata[i].ToProtobuf()
@@ -676,8 +757,12 @@ func (svc *Service) Consolidate(ctx context.Context, req *pb.ConsolidateRequest)
 		// Convert times
 		fromTime := timeFromTimestamp(filters.GetFromTime())
 		toTime := timeFromTimestamp(filters.GetToTime())
+		var tags geocube.TagsQuery
+		if tags, err = geocube.NewTagsQuery(filters.GetTags(), filters.GetTagsQuery()); err != nil {
+			return nil, formatError("", err) // ValidationError
+		}
 		job.LogMsg(geocube.INFO, "Consolidate from filters")
-		err = svc.gsvc.ConsolidateFromFilters(ctx, job, filters.GetTags(), fromTime, toTime)
+		err = svc.gsvc.ConsolidateFromFilters(ctx, job, tags, fromTime, toTime)
 	} else {
 		if len(req.GetRecords().GetIds()) == 0 {
 			return &pb.ConsolidateResponse{}, newValidationError("At least one record must be provided")
@@ -716,14 +801,22 @@ func (svc *Service) CleanJobs(ctx context.Context, req *pb.CleanJobsRequest) (*p
 
 // ListJobs list job with name like nameLike
 func (svc *Service) ListJobs(ctx context.Context, req *pb.ListJobsRequest) (*pb.ListJobsResponse, error) {
+	after, err := geocube.ParseCursor(req.GetPageToken())
+	if err != nil {
+		return nil, formatError("", err) // ValidationError
+	}
+
 	// List jobs
-	jobs, err := svc.gsvc.ListJobs(ctx, req.GetNameLike(), int(req.Page), int(req.Limit))
+	jobs, err := svc.gsvc.ListJobs(ctx, req.GetNameLike(), int(req.Page), int(req.Limit), after)
 	if err != nil {
 		return nil, formatError("backend.%w", err)
 	}
 
 	// Format response
 	resp := pb.ListJobsResponse{}
+	if req.Limit > 0 && len(jobs) == int(req.Limit) {
+		resp.NextPageToken = geocube.Cursor{ID: jobs[len(jobs)-1].ID}.Token()
+	}
 	for _, job := range jobs {
 		pbjob, err := job.ToProtobuf(0)
 		if err != nil {
@@ -871,6 +964,25 @@ func (svc *Service) prepareGetCube(req *pb.GetCubeRequest) (*cubeInfo, error) {
 	return &init, nil
 }
 
+// compositingFromProtobuf converts the compositing options (nil if not defined)
+func compositingFromProtobuf(c *pb.Compositing) *internal.CompositingOptions {
+	if c == nil {
+		return nil
+	}
+	options := internal.CompositingOptions{
+		Reducer:             internalImage.Reducer(c.GetReducer()),
+		PeriodDays:          int(c.GetPeriodDays()),
+		PeriodMonths:        int(c.GetPeriodMonths()),
+		Origin:              timeFromTimestamp(c.GetOrigin()),
+		QualityInstanceID:   c.GetQualityInstanceId(),
+		QualityLowestIsBest: c.GetQualityLowestIsBest(),
+	}
+	for _, r := range c.GetDateRanges().GetRanges() {
+		options.DateRanges = append(options.DateRanges, [2]time.Time{timeFromTimestamp(r.GetFromTime()), timeFromTimestamp(r.GetToTime())})
+	}
+	return &options
+}
+
 // GetCube retrieves, rescale and reproject datasets and serves them as a cube
 func (svc *Service) GetCube(req *pb.GetCubeRequest, stream pb.Geocube_GetCubeServer) error {
 	chunkSize := 512 * 1024
@@ -900,6 +1012,9 @@ func (svc *Service) GetCube(req *pb.GetCubeRequest, stream pb.Geocube_GetCubeSer
 		HeadersOnly:          req.HeadersOnly,
 		Resampling:           geocube.Resampling(req.ResamplingAlg),
 		FilterPartialImagePc: 0, // Filter only empty images
+		SkipIncomplete:       req.SkipIncomplete,
+		MixedDTypes:          req.MixedDtypes,
+		Compositing:          compositingFromProtobuf(req.GetCompositing()),
 	}
 
 	if req.GetRecords() == nil && req.GetGroupedRecords() == nil {
@@ -907,8 +1022,12 @@ func (svc *Service) GetCube(req *pb.GetCubeRequest, stream pb.Geocube_GetCubeSer
 		// Convert times
 		fromTime := timeFromTimestamp(filters.GetFromTime())
 		toTime := timeFromTimestamp(filters.GetToTime())
+		var tags geocube.TagsQuery
+		if tags, err = geocube.NewTagsQuery(filters.GetTags(), filters.GetTagsQuery()); err != nil {
+			return formatError("", err) // ValidationError
+		}
 		info, slicesQueue, err = svc.gsvc.GetCubeFromFilters(ctx,
-			filters.GetTags(),
+			tags,
 			fromTime,
 			toTime,
 			cubeInfo.instancesID,
@@ -941,6 +1060,7 @@ func (svc *Service) GetCube(req *pb.GetCubeRequest, stream pb.Geocube_GetCubeSer
 		RefDformat:    info.RefDataFormat.ToProtobuf(),
 		Geotransform:  req.PixToCrs,
 		Crs:           req.Crs,
+		BandGroups:    bandGroupsToProtobuf(info.BandGroups),
 	}}}); err != nil {
 		return formatError("backend.GetCube.%w", err)
 	}
@@ -980,6 +1100,7 @@ func (svc *Service) GetCube(req *pb.GetCubeRequest, stream pb.Geocube_GetCubeSer
 		log.Logger(ctx).Sugar().Infof("GetCube (%d, %d): %d image(s) from %d dataset(s) in %v (preparation: %v)\n", cubeInfo.width, cubeInfo.height, info.NbImages, info.NbDatasets, time.Since(start), metadataPreparationTime)
 	}
 	defer gcs.GetMetrics(ctx)
+	defer cache.LogStats(ctx)
 	return ctx.Err()
 }
 
@@ -1050,6 +1171,15 @@ func compressChunksSlicesQueue(ctx context.Context, sliceQueue <-chan internal.C
 	}
 }
 
+// bandGroupsToProtobuf converts the band groups of a cube or a slice to protobuf
+func bandGroupsToProtobuf(groups []internal.BandGroup) []*pb.BandGroup {
+	pbgroups := make([]*pb.BandGroup, len(groups))
+	for i := range groups {
+		pbgroups[i] = groups[i].ToProtobuf()
+	}
+	return pbgroups
+}
+
 // getCubeCreateHeader
 // chunkSize in bytes, 4Mo maximum by default
 func getCubeCreateHeader(slice *internal.CubeSlice, chunkSize int, compression bool) *pb.ImageHeader {
@@ -1077,8 +1207,11 @@ func getCubeCreateHeader(slice *internal.CubeSlice, chunkSize int, compression b
 			RangeMin:        d.DataMapping.RangeExt.Min,
 			RangeMax:        d.DataMapping.RangeExt.Max,
 			Exponent:        d.DataMapping.Exponent,
+			InstanceId:      d.InstanceID,
+			RecordId:        d.RecordID,
 		}
 	}
+	header.BandGroups = bandGroupsToProtobuf(slice.BandGroups)
 
 	if slice.Err != nil {
 		// Only send a header with the error
@@ -1132,7 +1265,11 @@ func (svc *Service) GetXYZTile(ctx context.Context, req *pb.GetTileRequest) (*pb
 	} else if filters := req.GetFilters(); filters != nil {
 		fromTime := timeFromTimestamp(filters.GetFromTime())
 		toTime := timeFromTimestamp(filters.GetToTime())
-		if image, err = svc.gsvc.GetXYZTileFromFilters(ctx, req.GetInstanceId(), filters.Tags, fromTime, toTime, int(req.GetX()), int(req.GetY()), int(req.GetZ()), float64(req.Min), float64(req.Max)); err != nil {
+		var tags geocube.TagsQuery
+		if tags, err = geocube.NewTagsQuery(filters.GetTags(), filters.GetTagsQuery()); err != nil {
+			return nil, formatError("", err) // ValidationError
+		}
+		if image, err = svc.gsvc.GetXYZTileFromFilters(ctx, req.GetInstanceId(), tags, fromTime, toTime, int(req.GetX()), int(req.GetY()), int(req.GetZ()), float64(req.Min), float64(req.Max)); err != nil {
 			return nil, formatError("backend.%w", err)
 		}
 	} else {
@@ -1143,6 +1280,146 @@ func (svc *Service) GetXYZTile(ctx context.Context, req *pb.GetTileRequest) (*pb
 	return &pb.GetTileResponse{Image: &pb.ImageFile{Data: image}}, nil
 }
 
+// recordsFromProtobuf returns the records defined by a list of ids or by filters (only one of them must be provided)
+// Only returns ValidationError
+func recordsFromProtobuf(records *pb.RecordIdList, filters *pb.RecordFilters) (recordsID []string, tags geocube.TagsQuery, fromTime, toTime time.Time, err error) {
+	if records != nil {
+		if len(records.GetIds()) == 0 {
+			return nil, nil, fromTime, toTime, newValidationError("At least one record must be provided")
+		}
+		for _, id := range records.GetIds() {
+			if _, err := uuid.Parse(id); err != nil {
+				return nil, nil, fromTime, toTime, newValidationError("Invalid Record.uuid " + id + ": " + err.Error())
+			}
+		}
+		return records.GetIds(), nil, fromTime, toTime, nil
+	}
+	if filters != nil {
+		if tags, err = geocube.NewTagsQuery(filters.GetTags(), filters.GetTagsQuery()); err != nil {
+			return nil, nil, fromTime, toTime, formatError("", err) // ValidationError
+		}
+		return nil, tags, timeFromTimestamp(filters.GetFromTime()), timeFromTimestamp(filters.GetToTime()), nil
+	}
+	return nil, nil, fromTime, toTime, newValidationError("Either record ids or record filters must be provided")
+}
+
+// GetTimeSeries streams the time series of the locations
+func (svc *Service) GetTimeSeries(req *pb.GetTimeSeriesRequest, stream pb.Geocube_GetTimeSeriesServer) error {
+	ctx, cancel := context.WithTimeout(stream.Context(), svc.maxConnectionAge*time.Second)
+	defer cancel()
+
+	if _, err := uuid.Parse(req.GetInstanceId()); err != nil {
+		return newValidationError("Invalid Instance.uuid " + req.GetInstanceId() + ": " + err.Error())
+	}
+
+	// Locations
+	crsInput := req.GetCrs()
+	if crsInput == "" {
+		crsInput = "4326"
+	}
+	crs, _, err := proj.CRSFromUserInput(crsInput)
+	if err != nil {
+		return newValidationError(fmt.Sprintf("Invalid crs: %s (%v)", req.GetCrs(), err))
+	}
+	defer crs.Close()
+	locations := make([]internal.TimeSeriesLocation, len(req.GetLocations()))
+	for i, l := range req.GetLocations() {
+		locations[i] = internal.TimeSeriesLocation{ID: l.GetId()}
+		locations[i].X, locations[i].Y = l.GetX(), l.GetY()
+	}
+
+	recordsID, tags, fromTime, toTime, err := recordsFromProtobuf(req.GetRecords(), req.GetFilters())
+	if err != nil {
+		return err
+	}
+
+	timeSeries, err := svc.gsvc.GetTimeSeries(ctx, req.GetInstanceId(), locations, crs, recordsID, tags, fromTime, toTime)
+	if err != nil {
+		return formatError("backend.%w", err)
+	}
+	for ts := range timeSeries {
+		resp := pb.GetTimeSeriesResponse{LocationIndex: int32(ts.Index), LocationId: ts.ID, Values: make([]*pb.TimeSeriesValue, len(ts.Values))}
+		if ts.Err != nil {
+			resp.Error = ts.Err.Error()
+		}
+		for i, v := range ts.Values {
+			resp.Values[i] = &pb.TimeSeriesValue{
+				Datetime: timestamppb.New(v.Record.Time),
+				RecordId: v.Record.ID,
+				Values:   v.Values,
+				NbPixels: make([]int32, len(v.NbPixels)),
+			}
+			for b, n := range v.NbPixels {
+				resp.Values[i].NbPixels[b] = int32(n)
+			}
+		}
+		if err := stream.Send(&resp); err != nil {
+			return formatError("backend.GetTimeSeries.Send: %w", err)
+		}
+	}
+	return ctx.Err()
+}
+
+// GetZonalStatistics streams the statistics of the zones for each record
+func (svc *Service) GetZonalStatistics(req *pb.GetZonalStatisticsRequest, stream pb.Geocube_GetZonalStatisticsServer) error {
+	ctx, cancel := context.WithTimeout(stream.Context(), svc.maxConnectionAge*time.Second)
+	defer cancel()
+
+	if _, err := uuid.Parse(req.GetInstanceId()); err != nil {
+		return newValidationError("Invalid Instance.uuid " + req.GetInstanceId() + ": " + err.Error())
+	}
+	zones, err := internal.ZonesFromGeoJSON(req.GetZones(), req.GetIdProperty())
+	if err != nil {
+		return formatError("", err) // ValidationError
+	}
+	recordsID, tags, fromTime, toTime, err := recordsFromProtobuf(req.GetRecords(), req.GetFilters())
+	if err != nil {
+		return err
+	}
+	options := internalImage.ZonalStatisticsOptions{
+		Statistics:    make([]internalImage.ZonalStatistic, len(req.GetStatistics())),
+		Percentiles:   req.GetPercentiles(),
+		HistogramBins: int(req.GetHistogram().GetNbBins()),
+		HistogramMin:  req.GetHistogram().GetMin(),
+		HistogramMax:  req.GetHistogram().GetMax(),
+		AllTouched:    req.GetAllTouched(),
+		Weighted:      req.GetWeighted(),
+	}
+	for i, s := range req.GetStatistics() {
+		options.Statistics[i] = internalImage.ZonalStatistic(s)
+	}
+
+	statistics, err := svc.gsvc.GetZonalStatistics(ctx, req.GetInstanceId(), zones, recordsID, tags, fromTime, toTime, options)
+	if err != nil {
+		return formatError("backend.%w", err)
+	}
+	for stats := range statistics {
+		resp := pb.GetZonalStatisticsResponse{
+			ZoneIndex: int32(stats.ZoneIndex),
+			ZoneId:    stats.ZoneID,
+			Bands:     make([]*pb.ZonalStatistics, len(stats.Bands)),
+		}
+		if stats.Record != nil {
+			resp.Datetime, resp.RecordId = timestamppb.New(stats.Record.Time), stats.Record.ID
+		}
+		if stats.Err != nil {
+			resp.Error = stats.Err.Error()
+		}
+		for i, b := range stats.Bands {
+			resp.Bands[i] = &pb.ZonalStatistics{
+				Values:      b.Values,
+				Percentiles: b.Percentiles,
+				Histogram:   b.Histogram,
+				NbPixels:    int32(b.NbPixels),
+			}
+		}
+		if err := stream.Send(&resp); err != nil {
+			return formatError("backend.GetZonalStatistics.Send: %w", err)
+		}
+	}
+	return ctx.Err()
+}
+
 // CreateGrid
 func (svc *Service) CreateGrid(stream pb.Geocube_CreateGridServer) error {
 	// Receiving grid
@@ -1254,6 +1531,7 @@ func (svc *Service) FindContainerLayouts(req *pb.FindContainerLayoutsRequest, st
 	}
 	var (
 		aoi              *geocube.AOI
+		tags             geocube.TagsQuery
 		fromTime, toTime time.Time
 		err              error
 	)
@@ -1270,8 +1548,11 @@ func (svc *Service) FindContainerLayouts(req *pb.FindContainerLayoutsRequest, st
 				return newValidationError("invalid aoi: " + err.Error())
 			}
 		}
+		if tags, err = geocube.NewTagsQuery(req.GetFilters().GetFilters().GetTags(), req.GetFilters().GetFilters().GetTagsQuery()); err != nil {
+			return formatError("", err) // ValidationError
+		}
 	}
-	layouts, containers, err := svc.gsvc.FindContainerLayouts(stream.Context(), req.InstanceId, aoi, req.GetRecords().GetIds(), req.GetFilters().GetFilters().GetTags(), fromTime, toTime)
+	layouts, containers, err := svc.gsvc.FindContainerLayouts(stream.Context(), req.InstanceId, aoi, req.GetRecords().GetIds(), tags, fromTime, toTime)
 	if err != nil {
 		return formatError("backend.%w", err)
 	}
@@ -0,0 +1,321 @@
+package image
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/airbusgeo/geocube/internal/utils"
+	"github.com/airbusgeo/geocube/internal/utils/affine"
+	"github.com/airbusgeo/godal"
+)
+
+// Reducer defines how the observations of a pixel are reduced into a temporal composite
+type Reducer int
+
+// Supported reducers
+const (
+	ReducerMedian Reducer = iota
+	ReducerMean
+	ReducerMin
+	ReducerMax
+	ReducerCount     // Number of valid observations
+	ReducerBestPixel // Values of the observation having the best quality
+)
+
+// CompositeQuality defines the band driving a best-pixel composite
+type CompositeQuality struct {
+	Output       int  // Index of the output of the quality band
+	Band         int  // Index of the quality band in the output (from 0)
+	LowestIsBest bool // By default, the best observation has the highest quality
+}
+
+// compositeMaxStripBytes is the maximum memory used to store the observations of a strip of lines of a median composite
+const compositeMaxStripBytes = 256 * 1024 * 1024
+
+// CompositeReducer reduces the observations of several outputs into a composite, pixel by pixel.
+// The values of an output are interleaved by pixel and NaN if the pixel is not valid.
+type CompositeReducer interface {
+	// Add adds an observation (values[j] is nil if the output j has no value for this observation)
+	Add(values [][]float64)
+	// Result returns the composite of each output (NaN if the pixel has no valid observation, except for ReducerCount)
+	Result() [][]float64
+}
+
+// NewCompositeReducer returns a reducer of nbPixels pixels for outputs having the given number of bands
+func NewCompositeReducer(reducer Reducer, nbPixels int, bands []int, quality *CompositeQuality) (CompositeReducer, error) {
+	switch reducer {
+	case ReducerMedian:
+		return &medianReducer{nbPixels: nbPixels, bands: bands, observations: make([][][]float64, len(bands))}, nil
+	case ReducerMean, ReducerMin, ReducerMax, ReducerCount:
+		r := &accumulateReducer{reducer: reducer, values: make([][]float64, len(bands)), counts: make([][]float64, len(bands))}
+		for j, b := range bands {
+			r.values[j], r.counts[j] = make([]float64, nbPixels*b), make([]float64, nbPixels*b)
+		}
+		return r, nil
+	case ReducerBestPixel:
+		if quality == nil || quality.Output < 0 || quality.Output >= len(bands) || quality.Band < 0 || quality.Band >= bands[quality.Output] {
+			return nil, fmt.Errorf("NewCompositeReducer: invalid quality band")
+		}
+		r := &bestPixelReducer{quality: *quality, bands: bands, best: nanSlice(nbPixels), values: make([][]float64, len(bands))}
+		for j, b := range bands {
+			r.values[j] = nanSlice(nbPixels * b)
+		}
+		return r, nil
+	}
+	return nil, fmt.Errorf("NewCompositeReducer: unsupported reducer %d", reducer)
+}
+
+func nanSlice(n int) []float64 {
+	s := make([]float64, n)
+	for i := range s {
+		s[i] = math.NaN()
+	}
+	return s
+}
+
+// medianReducer keeps all the observations in memory
+type medianReducer struct {
+	nbPixels     int
+	bands        []int
+	observations [][][]float64
+}
+
+func (r *medianReducer) Add(values [][]float64) {
+	for j, v := range values {
+		if v != nil {
+			r.observations[j] = append(r.observations[j], v)
+		}
+	}
+}
+
+func (r *medianReducer) Result() [][]float64 {
+	results := make([][]float64, len(r.bands))
+	for j, b := range r.bands {
+		results[j] = make([]float64, r.nbPixels*b)
+		buf := make([]float64, 0, len(r.observations[j]))
+		for i := range results[j] {
+			buf = buf[:0]
+			for _, obs := range r.observations[j] {
+				if !math.IsNaN(obs[i]) {
+					buf = append(buf, obs[i])
+				}
+			}
+			switch n := len(buf); {
+			case n == 0:
+				results[j][i] = math.NaN()
+			case n%2 == 1:
+				sort.Float64s(buf)
+				results[j][i] = buf[n/2]
+			default:
+				sort.Float64s(buf)
+				results[j][i] = (buf[n/2-1] + buf[n/2]) / 2
+			}
+		}
+	}
+	return results
+}
+
+// accumulateReducer updates the composite with each observation (mean, min, max and count)
+type accumulateReducer struct {
+	reducer        Reducer
+	values, counts [][]float64
+}
+
+func (r *accumulateReducer) Add(values [][]float64) {
+	for j, v := range values {
+		if v == nil {
+			continue
+		}
+		acc, counts := r.values[j], r.counts[j]
+		for i, x := range v {
+			if math.IsNaN(x) {
+				continue
+			}
+			counts[i]++
+			switch {
+			case r.reducer == ReducerMean:
+				acc[i] += x
+			case counts[i] == 1, r.reducer == ReducerMin && x < acc[i], r.reducer == ReducerMax && x > acc[i]:
+				acc[i] = x
+			}
+		}
+	}
+}
+
+func (r *accumulateReducer) Result() [][]float64 {
+	if r.reducer == ReducerCount {
+		return r.counts
+	}
+	for j, acc := range r.values {
+		for i, count := range r.counts[j] {
+			if count == 0 {
+				acc[i] = math.NaN()
+			} else if r.reducer == ReducerMean {
+				acc[i] /= count
+			}
+		}
+	}
+	return r.values
+}
+
+// bestPixelReducer keeps the values of all the outputs of the observation having the best quality
+type bestPixelReducer struct {
+	quality CompositeQuality
+	bands   []int
+	best    []float64
+	values  [][]float64
+}
+
+func (r *bestPixelReducer) Add(values [][]float64) {
+	q := values[r.quality.Output]
+	if q == nil {
+		return
+	}
+	qBands := r.bands[r.quality.Output]
+	for p, best := range r.best {
+		v := q[p*qBands+r.quality.Band]
+		if math.IsNaN(v) || (!math.IsNaN(best) && (r.quality.LowestIsBest && v >= best || !r.quality.LowestIsBest && v <= best)) {
+			continue
+		}
+		r.best[p] = v
+		for j, b := range r.bands {
+			if values[j] == nil {
+				for i := p * b; i < (p+1)*b; i++ {
+					r.values[j][i] = math.NaN()
+				}
+			} else {
+				copy(r.values[j][p*b:(p+1)*b], values[j][p*b:(p+1)*b])
+			}
+		}
+	}
+}
+
+func (r *bestPixelReducer) Result() [][]float64 {
+	return r.values
+}
+
+// CompositeDatasets computes the per-pixel temporal composite of several observations (e.g. records) for several outputs (e.g. instances).
+// observations[i][j] are the datasets of the i-th observation for the j-th output (mosaicked as in MergeDatasets) and outDescs[j] describes the j-th output.
+// The pixels that are not valid (nodata of the datasets, according to their DataMapping) are ignored. A pixel without valid observation is set to nodata.
+// The observations are warped and reduced one by one, so that the memory is bounded by the size of the outputs,
+// except for the median that keeps all the observations of a strip of lines (whose height depends on compositeMaxStripBytes).
+// Returns one dataset per output (nil if the output has no observation). The caller is responsible to close the output datasets.
+func CompositeDatasets(ctx context.Context, observations [][][]*Dataset, outDescs []*GdalDatasetDescriptor, reducer Reducer, quality *CompositeQuality) ([]*godal.Dataset, error) {
+	width, height := outDescs[0].Width, outDescs[0].Height
+	bands := make([]int, len(outDescs))
+	hasObservation := make([]bool, len(outDescs))
+	for _, obs := range observations {
+		for j, datasets := range obs {
+			hasObservation[j] = hasObservation[j] || len(datasets) > 0
+		}
+	}
+	for j, outDesc := range outDescs {
+		if hasObservation[j] {
+			bands[j] = outDesc.Bands
+		}
+	}
+	if reducer == ReducerBestPixel && (quality == nil || !hasObservation[quality.Output]) {
+		return make([]*godal.Dataset, len(outDescs)), nil
+	}
+
+	// Height of the strips of lines
+	stripHeight := height
+	if reducer == ReducerMedian {
+		rowSize := 0
+		for _, b := range bands {
+			rowSize += len(observations) * width * b * 8
+		}
+		stripHeight = utils.MaxI(1, utils.MinI(height, compositeMaxStripBytes/utils.MaxI(1, rowSize)))
+	}
+
+	outputs := make([]*godal.Dataset, len(outDescs))
+	closeOutputs := func() {
+		for _, ds := range outputs {
+			if ds != nil {
+				ds.Close()
+			}
+		}
+	}
+	for j, outDesc := range outDescs {
+		if hasObservation[j] {
+			var err error
+			if outputs[j], err = NewNoDataDataset(outDesc); err != nil {
+				closeOutputs()
+				return nil, fmt.Errorf("CompositeDatasets.%w", err)
+			}
+		}
+	}
+
+	for y0 := 0; y0 < height; y0 += stripHeight {
+		h := utils.MinI(stripHeight, height-y0)
+		r, err := NewCompositeReducer(reducer, width*h, bands, quality)
+		if err != nil {
+			closeOutputs()
+			return nil, fmt.Errorf("CompositeDatasets.%w", err)
+		}
+		for _, obs := range observations {
+			if err := ctx.Err(); err != nil {
+				closeOutputs()
+				return nil, err
+			}
+			values := make([][]float64, len(outDescs))
+			for j, datasets := range obs {
+				if len(datasets) > 0 {
+					if values[j], err = readObservation(ctx, datasets, outDescs[j], y0, h); err != nil {
+						closeOutputs()
+						return nil, fmt.Errorf("CompositeDatasets.%w", err)
+					}
+				}
+			}
+			r.Add(values)
+		}
+
+		// Write the strip of each output
+		for j, result := range r.Result() {
+			if outputs[j] == nil {
+				continue
+			}
+			nodata := outDescs[j].DataMapping.NoData
+			for i, v := range result {
+				if math.IsNaN(v) {
+					result[i] = nodata
+				}
+			}
+			if err := outputs[j].Write(0, y0, result, width, h); err != nil {
+				closeOutputs()
+				return nil, fmt.Errorf("CompositeDatasets.Write: %w", err)
+			}
+		}
+	}
+	return outputs, nil
+}
+
+// readObservation merges the datasets on the lines [y0, y0+h[ of the output and returns their values (NaN if not valid)
+func readObservation(ctx context.Context, datasets []*Dataset, outDesc *GdalDatasetDescriptor, y0, h int) ([]float64, error) {
+	desc := *outDesc
+	desc.PixToCRS = outDesc.PixToCRS.Multiply(affine.Translation(0, float64(y0)))
+	desc.Height = h
+	desc.ValidPixPc = -1
+	desc.FileOut = ""
+	desc.CreationParams = nil
+	ds, err := MergeDatasets(ctx, datasets, &desc)
+	if err != nil {
+		return nil, fmt.Errorf("readObservation.%w", err)
+	}
+	defer ds.Close()
+
+	values := make([]float64, desc.Width*h*desc.Bands)
+	if err := ds.Read(0, 0, values, desc.Width, h); err != nil {
+		return nil, fmt.Errorf("readObservation.Read: %w", err)
+	}
+	if nodata := desc.DataMapping.NoData; !math.IsNaN(nodata) {
+		for i, v := range values {
+			if v == nodata {
+				values[i] = math.NaN()
+			}
+		}
+	}
+	return values, nil
+}
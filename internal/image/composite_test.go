@@ -0,0 +1,130 @@
+package image_test
+
+import (
+	"math"
+
+	"github.com/airbusgeo/geocube/internal/image"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CompositeReducer", func() {
+
+	var (
+		nan            = math.NaN()
+		reducer        image.Reducer
+		quality        *image.CompositeQuality
+		observations   [][][]float64
+		returnedResult [][]float64
+		returnedError  error
+	)
+
+	BeforeEach(func() {
+		quality = nil
+		// Two outputs (one band and two bands) of two pixels, three observations
+		observations = [][][]float64{
+			{{1, nan}, {10, 20, 30, 40}},
+			{{5, nan}, nil},
+			{{3, 2}, {11, nan, 31, 41}},
+		}
+	})
+
+	JustBeforeEach(func() {
+		var r image.CompositeReducer
+		returnedResult = nil
+		if r, returnedError = image.NewCompositeReducer(reducer, 2, []int{1, 2}, quality); returnedError != nil {
+			return
+		}
+		for _, obs := range observations {
+			r.Add(obs)
+		}
+		returnedResult = r.Result()
+	})
+
+	Context("median", func() {
+		BeforeEach(func() {
+			reducer = image.ReducerMedian
+		})
+		It("should return the median of the valid observations", func() {
+			Expect(returnedError).To(BeNil())
+			Expect(returnedResult[0]).To(Equal([]float64{3, 2}))
+			Expect(returnedResult[1]).To(Equal([]float64{10.5, 20, 30.5, 40.5}))
+		})
+	})
+
+	Context("mean", func() {
+		BeforeEach(func() {
+			reducer = image.ReducerMean
+		})
+		It("should return the mean of the valid observations", func() {
+			Expect(returnedError).To(BeNil())
+			Expect(returnedResult[0]).To(Equal([]float64{3, 2}))
+			Expect(returnedResult[1]).To(Equal([]float64{10.5, 20, 30.5, 40.5}))
+		})
+	})
+
+	Context("min and max", func() {
+		It("should return the min of the valid observations", func() {
+			r, err := image.NewCompositeReducer(image.ReducerMin, 2, []int{1, 2}, nil)
+			Expect(err).To(BeNil())
+			for _, obs := range observations {
+				r.Add(obs)
+			}
+			Expect(r.Result()[0]).To(Equal([]float64{1, 2}))
+		})
+		It("should return the max of the valid observations", func() {
+			r, err := image.NewCompositeReducer(image.ReducerMax, 2, []int{1, 2}, nil)
+			Expect(err).To(BeNil())
+			for _, obs := range observations {
+				r.Add(obs)
+			}
+			Expect(r.Result()[1]).To(Equal([]float64{11, 20, 31, 41}))
+		})
+	})
+
+	Context("count", func() {
+		BeforeEach(func() {
+			reducer = image.ReducerCount
+			observations = append(observations, [][]float64{{nan, nan}, nil})
+		})
+		It("should return the number of valid observations", func() {
+			Expect(returnedError).To(BeNil())
+			Expect(returnedResult[0]).To(Equal([]float64{3, 1}))
+			Expect(returnedResult[1]).To(Equal([]float64{2, 1, 2, 2}))
+		})
+	})
+
+	Context("best pixel", func() {
+		BeforeEach(func() {
+			reducer = image.ReducerBestPixel
+			quality = &image.CompositeQuality{Output: 0, Band: 0}
+		})
+		It("should return the values of the observation with the highest quality", func() {
+			Expect(returnedError).To(BeNil())
+			Expect(returnedResult[0]).To(Equal([]float64{5, 2}))
+			Expect(math.IsNaN(returnedResult[1][0])).To(BeTrue())
+			Expect(returnedResult[1][2:]).To(Equal([]float64{31, 41}))
+		})
+
+		Context("when the lowest is the best", func() {
+			BeforeEach(func() {
+				quality.LowestIsBest = true
+			})
+			It("should return the values of the observation with the lowest quality", func() {
+				Expect(returnedError).To(BeNil())
+				Expect(returnedResult[0]).To(Equal([]float64{1, 2}))
+				Expect(returnedResult[1]).To(Equal([]float64{10, 20, 31, 41}))
+			})
+		})
+
+		Context("without quality band", func() {
+			BeforeEach(func() {
+				quality = nil
+			})
+			It("should return an error", func() {
+				Expect(returnedError).NotTo(BeNil())
+			})
+		})
+	})
+})
@@ -0,0 +1,182 @@
+package image
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/airbusgeo/geocube/internal/geocube"
+	"github.com/airbusgeo/geocube/internal/utils/affine"
+	"github.com/airbusgeo/geocube/internal/utils/bitmap"
+)
+
+// CubeFileVariable describes a variable of a cube file, stored as one array per band
+type CubeFileVariable struct {
+	Name        string
+	Bands       []string // Name of the bands, at least one (the arrays are named <Name>_<Band> if there are several bands)
+	Unit        string
+	DataMapping geocube.DataMapping // Mapping of the values of the variable to their real values
+}
+
+// CubeFileDescriptor describes a cube file: a stack of images of several variables on the same grid
+type CubeFileDescriptor struct {
+	WktCRS        string
+	PixToCRS      *affine.Affine
+	Width, Height int
+	Variables     []CubeFileVariable
+}
+
+// CubeFileWriter writes the images of a cube in a single file (with one time per image)
+type CubeFileWriter interface {
+	// WriteSlice appends an image to the cube. data contains the raw image of each variable (interleaved by pixel)
+	WriteSlice(datetime time.Time, data [][]byte, byteOrder binary.ByteOrder) error
+	// Finalize writes the global attributes and returns a reader streaming the content of the file.
+	// The writer must not be closed before the reader is done.
+	Finalize(attributes map[string]string) (*CubeFileReader, error)
+	// Close releases the resources of the writer (and stops the reader)
+	Close() error
+}
+
+// MaxCubeFileSize is the maximum size of a cube file (in bytes)
+var MaxCubeFileSize int64 = 16 << 30
+
+// checkCubeFileSize returns a ValidationError if the size exceeds MaxCubeFileSize
+func checkCubeFileSize(size int64) error {
+	if size > MaxCubeFileSize {
+		return geocube.NewValidationError("the cube file exceeds the maximum size of %d bytes: reduce the size or the number of images of the cube", MaxCubeFileSize)
+	}
+	return nil
+}
+
+// CubeFileReader implements bitmap.ChunkReader to stream the content of a cube file.
+// It can only be read once and it is done as soon as the whole file has been read or an error occurred.
+type CubeFileReader struct {
+	r      io.Reader
+	size   int64
+	offset int64
+	done   chan struct{}
+	once   sync.Once
+}
+
+func newCubeFileReader(r io.Reader, size int64) *CubeFileReader {
+	return &CubeFileReader{r: r, size: size, done: make(chan struct{})}
+}
+
+// Next implements bitmap.ChunkReader
+func (cr *CubeFileReader) Next(chunkSize int) ([]byte, error) {
+	if cr.offset >= cr.size {
+		cr.setDone()
+		return nil, io.EOF
+	}
+	chunk := make([]byte, min(int64(chunkSize), cr.size-cr.offset))
+	if _, err := io.ReadFull(cr.r, chunk); err != nil {
+		cr.setDone()
+		return nil, fmt.Errorf("CubeFileReader.Next: %w", err)
+	}
+	if cr.offset += int64(len(chunk)); cr.offset >= cr.size {
+		cr.setDone()
+	}
+	return chunk, nil
+}
+
+// Len implements bitmap.ChunkReader
+func (cr *CubeFileReader) Len() int {
+	return int(cr.size)
+}
+
+// Restart implements bitmap.ChunkReader
+func (cr *CubeFileReader) Restart() error {
+	return fmt.Errorf("cannot restart a CubeFileReader, it can only be read once")
+}
+
+// Done returns a channel that is closed when the reader is done
+func (cr *CubeFileReader) Done() <-chan struct{} {
+	return cr.done
+}
+
+func (cr *CubeFileReader) setDone() {
+	cr.once.Do(func() { close(cr.done) })
+}
+
+const (
+	timeUnits = "seconds since 1970-01-01 00:00:00"
+	// gridMappingName is the name of the variable describing the CRS of the cube
+	gridMappingName = "spatial_ref"
+)
+
+var invalidNameChars = regexp.MustCompile(`[^A-Za-z0-9_]`)
+
+// arrayNames returns the name of the arrays of the variables (one array per band)
+func (desc *CubeFileDescriptor) arrayNames() [][]string {
+	names := make([][]string, len(desc.Variables))
+	for i, v := range desc.Variables {
+		name := invalidNameChars.ReplaceAllString(v.Name, "_")
+		if name == "" {
+			name = "data"
+		}
+		if len(v.Bands) <= 1 {
+			names[i] = []string{name}
+			continue
+		}
+		for b, band := range v.Bands {
+			if band = invalidNameChars.ReplaceAllString(band, "_"); band == "" {
+				band = fmt.Sprint(b + 1)
+			}
+			names[i] = append(names[i], name+"_"+band)
+		}
+	}
+	return names
+}
+
+// validate checks that the descriptor can be written in a cube file
+func (desc *CubeFileDescriptor) validate() error {
+	if desc.PixToCRS[2] != 0 || desc.PixToCRS[4] != 0 {
+		return fmt.Errorf("rotated transforms are not supported")
+	}
+	names := map[string]bool{}
+	for i, arrays := range desc.arrayNames() {
+		if len(desc.Variables[i].Bands) == 0 {
+			return fmt.Errorf("the variable %s has no band", desc.Variables[i].Name)
+		}
+		for _, name := range arrays {
+			if names[name] || name == "time" || name == "x" || name == "y" || name == gridMappingName {
+				return fmt.Errorf("the name %s is used twice", name)
+			}
+			names[name] = true
+		}
+	}
+	return nil
+}
+
+// scaleOffset returns the linear transform from the values of the variable to their real values (ok=false if the mapping is not linear)
+func scaleOffset(dm geocube.DataMapping) (scale, offset float64, ok bool) {
+	if dm.Exponent != 1 || dm.Range.Interval() == 0 {
+		return 1, 0, false
+	}
+	scale = dm.RangeExt.Interval() / dm.Range.Interval()
+	return scale, dm.RangeExt.Min - dm.Range.Min*scale, true
+}
+
+// bandData returns the data of the band b of a raw image interleaved by pixel, in little endian
+func bandData(data []byte, nbBands, b int, dtype bitmap.DType, byteOrder binary.ByteOrder) []byte {
+	size := dtype.Size()
+	out := make([]byte, len(data)/nbBands)
+	for i, j := b*size, 0; j < len(out); i, j = i+nbBands*size, j+size {
+		copy(out[j:j+size], data[i:i+size])
+	}
+	if byteOrder == binary.BigEndian && size > 1 {
+		// Swap the bytes of each element (or of each part of a complex)
+		if dtype == bitmap.DTypeCOMPLEX64 {
+			size = 4
+		}
+		for i := 0; i < len(out); i += size {
+			for l, r := i, i+size-1; l < r; l, r = l+1, r-1 {
+				out[l], out[r] = out[r], out[l]
+			}
+		}
+	}
+	return out
+}
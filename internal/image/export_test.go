@@ -0,0 +1,54 @@
+package image
+
+import (
+	"context"
+
+	"github.com/airbusgeo/geocube/interface/storage/uri"
+)
+
+// MergeByteRanges merges the ranges [offset, size]
+func MergeByteRanges(ranges [][2]int64, maxGap, maxSize int64) [][2]int64 {
+	rs := make([]byteRange, len(ranges))
+	for i, r := range ranges {
+		rs[i] = byteRange{off: r[0], n: r[1]}
+	}
+	var merged [][2]int64
+	for _, r := range mergeByteRanges(rs, maxGap, maxSize) {
+		merged = append(merged, [2]int64{r.off, r.n})
+	}
+	return merged
+}
+
+// LocationWindow returns the window [x, y, w, h] and the mask of the location (nil window if outside)
+func LocationWindow(x, y []float64, width, height int) ([]int, []bool, error) {
+	w, err := locationWindow(x, y, width, height)
+	if w == nil || err != nil {
+		return nil, nil, err
+	}
+	return []int{w.x, w.y, w.w, w.h}, w.mask, nil
+}
+
+// BlockFile is a blockFile
+type BlockFile = blockFile
+
+// NewBlockFile creates a blockFile reading the uri
+func NewBlockFile(ctx context.Context, rawURI string) (*BlockFile, error) {
+	u, err := uri.ParseUri(rawURI)
+	if err != nil {
+		return nil, err
+	}
+	return newBlockFile(ctx, u, rawURI)
+}
+
+// Fetch fetches a range of the file
+func (f *blockFile) Fetch(ctx context.Context, off, n int64) error {
+	return f.fetch(ctx, off, n)
+}
+
+// NbFetchedRanges returns the number of ranges kept in memory
+func (f *blockFile) NbFetchedRanges() int {
+	return len(f.ranges)
+}
+
+var ZonalStatisticsOf = zonalStatistics
+var ZoneWeights = zoneWeights
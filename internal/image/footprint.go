@@ -0,0 +1,167 @@
+package image
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/airbusgeo/godal"
+	"github.com/twpayne/go-geom"
+	"github.com/twpayne/go-geom/encoding/wkb"
+)
+
+const (
+	// validShapeMaxSize is the maximum size (in pixels) of the mask vectorized to compute the valid shape
+	validShapeMaxSize = 1024
+	// validShapeSieveThreshold is the size (in pixels of the mask) of the smallest valid area or hole kept in the valid shape
+	validShapeSieveThreshold = 4
+	// gmfAllValid is the GDAL mask flag of a band without any nodata
+	gmfAllValid = 0x01
+)
+
+// ComputeValidShape vectorizes the valid pixels of the bands of the dataset and returns their footprint in the CRS of the dataset.
+// A pixel is valid if it is valid in at least one band, according to the mask band of the band (nodata, alpha or mask band)
+// or, if the band has no mask, if its value is not nodata (ignored if NaN).
+// The mask is computed at a reduced resolution (at most validShapeMaxSize pixels), then simplified with a tolerance of one pixel of the mask.
+func ComputeValidShape(ds *godal.Dataset, bands []int64, nodata float64) (*geom.MultiPolygon, error) {
+	structure := ds.Structure()
+	gt, err := ds.GeoTransform()
+	if err != nil {
+		return nil, fmt.Errorf("ComputeValidShape.%w", err)
+	}
+
+	// Size of the reduced mask
+	factor := int(math.Ceil(float64(max(structure.SizeX, structure.SizeY)) / validShapeMaxSize))
+	width, height := (structure.SizeX+factor-1)/factor, (structure.SizeY+factor-1)/factor
+
+	// Merge the masks of the bands
+	mask := make([]byte, width*height)
+	dsBands := ds.Bands()
+	for _, b := range bands {
+		if b <= 0 || int(b) > len(dsBands) {
+			return nil, fmt.Errorf("ComputeValidShape: band %d not found", b)
+		}
+		band := dsBands[b-1]
+		if band.MaskFlags()&gmfAllValid != 0 {
+			values := make([]float64, width*height)
+			if err := band.Read(0, 0, values, width, height, godal.Window(structure.SizeX, structure.SizeY)); err != nil {
+				return nil, fmt.Errorf("ComputeValidShape.Read: %w", err)
+			}
+			for i, v := range values {
+				if !math.IsNaN(v) && (math.IsNaN(nodata) || v != nodata) {
+					mask[i] = 255
+				}
+			}
+		} else {
+			bandMask := make([]byte, width*height)
+			if err := band.MaskBand().Read(0, 0, bandMask, width, height, godal.Window(structure.SizeX, structure.SizeY)); err != nil {
+				return nil, fmt.Errorf("ComputeValidShape.ReadMask: %w", err)
+			}
+			for i, v := range bandMask {
+				if v != 0 {
+					mask[i] = 255
+				}
+			}
+		}
+	}
+
+	// Vectorize the mask
+	maskDs, err := godal.Create(godal.Memory, "", 1, godal.Byte, width, height)
+	if err != nil {
+		return nil, fmt.Errorf("ComputeValidShape.Create: %w", err)
+	}
+	defer maskDs.Close()
+	pixelSizeX, pixelSizeY := gt[1]*float64(structure.SizeX)/float64(width), gt[5]*float64(structure.SizeY)/float64(height)
+	if err := maskDs.SetGeoTransform([6]float64{gt[0], pixelSizeX, gt[2], gt[3], gt[4], pixelSizeY}); err != nil {
+		return nil, fmt.Errorf("ComputeValidShape.SetGeoTransform: %w", err)
+	}
+	maskBand := maskDs.Bands()[0]
+	if err := maskBand.Write(0, 0, mask, width, height); err != nil {
+		return nil, fmt.Errorf("ComputeValidShape.Write: %w", err)
+	}
+	if err := maskBand.SieveFilter(validShapeSieveThreshold, godal.NoMask()); err != nil {
+		return nil, fmt.Errorf("ComputeValidShape.SieveFilter: %w", err)
+	}
+	if err := maskBand.SetNoData(0); err != nil {
+		return nil, fmt.Errorf("ComputeValidShape.SetNoData: %w", err)
+	}
+
+	vectorDs, err := godal.CreateVector(godal.Memory, "")
+	if err != nil {
+		return nil, fmt.Errorf("ComputeValidShape.CreateVector: %w", err)
+	}
+	defer vectorDs.Close()
+	layer, err := vectorDs.CreateLayer("shape", nil, godal.GTPolygon)
+	if err != nil {
+		return nil, fmt.Errorf("ComputeValidShape.CreateLayer: %w", err)
+	}
+	if err := maskBand.Polygonize(layer); err != nil {
+		return nil, fmt.Errorf("ComputeValidShape.Polygonize: %w", err)
+	}
+
+	// Merge the polygons
+	var shape *godal.Geometry
+	defer func() {
+		if shape != nil {
+			shape.Close()
+		}
+	}()
+	layer.ResetReading()
+	for f := layer.NextFeature(); f != nil; f = layer.NextFeature() {
+		g := f.Geometry()
+		if shape == nil {
+			shape, err = g.Buffer(0, 0)
+		} else {
+			var union *godal.Geometry
+			if union, err = shape.Union(g); err == nil {
+				shape.Close()
+				shape = union
+			}
+		}
+		f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("ComputeValidShape.Union: %w", err)
+		}
+	}
+	if shape == nil || shape.Empty() {
+		return nil, fmt.Errorf("ComputeValidShape: no valid pixel")
+	}
+
+	// Simplify
+	simplified, err := shape.Simplify(math.Max(math.Abs(pixelSizeX), math.Abs(pixelSizeY)))
+	if err != nil {
+		return nil, fmt.Errorf("ComputeValidShape.Simplify: %w", err)
+	}
+	defer simplified.Close()
+	valid, err := simplified.Buffer(0, 0)
+	if err != nil {
+		return nil, fmt.Errorf("ComputeValidShape.Buffer: %w", err)
+	}
+	defer valid.Close()
+	if valid.Empty() {
+		return nil, fmt.Errorf("ComputeValidShape: no valid pixel")
+	}
+	return toMultiPolygon(valid)
+}
+
+// toMultiPolygon converts a (multi)polygon godal.Geometry to a geom.MultiPolygon
+func toMultiPolygon(g *godal.Geometry) (*geom.MultiPolygon, error) {
+	b, err := g.WKB()
+	if err != nil {
+		return nil, fmt.Errorf("toMultiPolygon.WKB: %w", err)
+	}
+	t, err := wkb.Unmarshal(b)
+	if err != nil {
+		return nil, fmt.Errorf("toMultiPolygon.Unmarshal: %w", err)
+	}
+	switch t := t.(type) {
+	case *geom.MultiPolygon:
+		return t, nil
+	case *geom.Polygon:
+		mp := geom.NewMultiPolygon(t.Layout())
+		if err := mp.Push(t); err != nil {
+			return nil, fmt.Errorf("toMultiPolygon.Push: %w", err)
+		}
+		return mp, nil
+	}
+	return nil, fmt.Errorf("toMultiPolygon: unexpected geometry type %T", t)
+}
@@ -0,0 +1,96 @@
+package image_test
+
+import (
+	"math"
+
+	"github.com/airbusgeo/geocube/internal/image"
+	"github.com/twpayne/go-geom"
+
+	"github.com/airbusgeo/godal"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ComputeValidShape", func() {
+
+	var (
+		ds            *godal.Dataset
+		bandNoData    bool
+		validWidth    int
+		nodata        float64
+		returnedShape *geom.MultiPolygon
+		returnedError error
+	)
+
+	BeforeEach(func() {
+		godal.RegisterAll()
+		bandNoData = true
+		validWidth = 1000
+		nodata = 0
+	})
+
+	JustBeforeEach(func() {
+		// 2000x1000 pixels of 0.01°, valid on the validWidth first columns
+		var err error
+		ds, err = godal.Create(godal.Memory, "", 1, godal.Byte, 2000, 1000)
+		Expect(err).To(BeNil())
+		Expect(ds.SetGeoTransform([6]float64{0, 0.01, 0, 10, 0, -0.01})).To(BeNil())
+		buf := make([]byte, 2000*1000)
+		for i := range buf {
+			if i%2000 < validWidth {
+				buf[i] = 1
+			}
+		}
+		band := ds.Bands()[0]
+		Expect(band.Write(0, 0, buf, 2000, 1000)).To(BeNil())
+		if bandNoData {
+			Expect(band.SetNoData(0)).To(BeNil())
+		}
+		returnedShape, returnedError = image.ComputeValidShape(ds, []int64{1}, nodata)
+	})
+
+	JustAfterEach(func() {
+		ds.Close()
+	})
+
+	var (
+		itShouldReturnTheValidArea = func() {
+			It("should return the footprint of the valid pixels", func() {
+				Expect(returnedError).To(BeNil())
+				Expect(math.Abs(returnedShape.Area() - 100)).To(BeNumerically("<", 1))
+				Expect(returnedShape.Bounds().Max(0)).To(BeNumerically("~", 10, 0.1))
+			})
+		}
+	)
+
+	Context("with a nodata band", func() {
+		itShouldReturnTheValidArea()
+	})
+
+	Context("with a band without nodata", func() {
+		BeforeEach(func() {
+			bandNoData = false
+		})
+		itShouldReturnTheValidArea()
+	})
+
+	Context("with a band without nodata and a NaN nodata", func() {
+		BeforeEach(func() {
+			bandNoData = false
+			nodata = math.NaN()
+		})
+		It("should return the extent of the dataset", func() {
+			Expect(returnedError).To(BeNil())
+			Expect(returnedShape.Area()).To(BeNumerically("~", 200, 1e-6))
+		})
+	})
+
+	Context("without valid pixel", func() {
+		BeforeEach(func() {
+			validWidth = 0
+		})
+		It("should return an error", func() {
+			Expect(returnedError).NotTo(BeNil())
+		})
+	})
+})
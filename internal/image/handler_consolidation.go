@@ -3,6 +3,7 @@ package image
 import (
 	"context"
 	"fmt"
+	"io"
 	"math"
 	"os"
 	"path"
@@ -34,7 +35,13 @@ func (e ErrorConst) Error() string {
 }
 
 type Handler interface {
-	Consolidate(ctx context.Context, cEvent *geocube.ConsolidationEvent, workspace string) error
+	Consolidate(ctx context.Context, cEvent *geocube.ConsolidationEvent, workspace string) (ConsolidationOutput, error)
+}
+
+// ConsolidationOutput describes the file uploaded by a successful consolidation
+type ConsolidationOutput struct {
+	Size      int64
+	Checksums storage.Checksums
 }
 
 type handlerConsolidation struct {
@@ -56,21 +63,21 @@ func NewHandleConsolidation(c CogGenerator, m MucogGenerator, cancelledJobsStora
 }
 
 // Consolidate generate MUCOG file from list of COG (Cloud Optimized Geotiff).
-func (h *handlerConsolidation) Consolidate(ctx context.Context, cEvent *geocube.ConsolidationEvent, workspace string) error {
+func (h *handlerConsolidation) Consolidate(ctx context.Context, cEvent *geocube.ConsolidationEvent, workspace string) (ConsolidationOutput, error) {
 	workDir := path.Join(workspace, cEvent.TaskID)
 	if err := os.MkdirAll(workDir, 0777); err != nil {
-		return err
+		return ConsolidationOutput{}, err
 	}
 	defer h.cleanWorkspace(ctx, workDir)
 
 	var tmpFileMutex sync.Mutex
 	datasetsByRecords, tmpFileCounter, err := h.getLocalDatasetsByRecord(ctx, cEvent, workDir)
 	if err != nil {
-		return fmt.Errorf("failed to get local records datasets: %w", err)
+		return ConsolidationOutput{}, fmt.Errorf("failed to get local records datasets: %w", err)
 	}
 
 	if h.isCancelled(ctx, cEvent) {
-		return TaskCancelledConsolidationError
+		return ConsolidationOutput{}, TaskCancelledConsolidationError
 	}
 
 	log.Logger(ctx).Sugar().Infof("starting to create COG files")
@@ -198,39 +205,40 @@ func (h *handlerConsolidation) Consolidate(ctx context.Context, cEvent *geocube.
 	close(records)
 
 	if err := g.Wait(); err != nil {
-		return err
+		return ConsolidationOutput{}, err
 	}
 
 	log.Logger(ctx).Sugar().Infof("%d COGs have been generated", len(cogListFile))
 
 	if h.isCancelled(ctx, cEvent) {
-		return TaskCancelledConsolidationError
+		return ConsolidationOutput{}, TaskCancelledConsolidationError
 	}
 
+	var output ConsolidationOutput
 	if len(cogListFile) == 1 {
-		if err := uploadFile(ctx, cogListFile[0], cEvent.Container.URI); err != nil {
-			return fmt.Errorf("failed to upload file on: %s : %w", cEvent.Container.URI, err)
+		if output, err = uploadFile(ctx, cogListFile[0], cEvent.Container.URI); err != nil {
+			return ConsolidationOutput{}, fmt.Errorf("failed to upload file on: %s : %w", cEvent.Container.URI, err)
 		}
 
 		log.Logger(ctx).Sugar().Infof("Upload cog on : %s", cEvent.Container.URI)
 	} else {
 		mucogFilePath, err := h.mucog.Create(workDir, cogListFile, cEvent.Container.InterlacingPattern)
 		if err != nil {
-			return fmt.Errorf("failed to create mucog: %w", err)
+			return ConsolidationOutput{}, fmt.Errorf("failed to create mucog: %w", err)
 		}
 		log.Logger(ctx).Sugar().Debugf("mucog has been generated : %s", mucogFilePath)
-		if err := uploadFile(ctx, mucogFilePath, cEvent.Container.URI); err != nil {
-			return fmt.Errorf("failed to upload file on: %s : %w", cEvent.Container.URI, err)
+		if output, err = uploadFile(ctx, mucogFilePath, cEvent.Container.URI); err != nil {
+			return ConsolidationOutput{}, fmt.Errorf("failed to upload file on: %s : %w", cEvent.Container.URI, err)
 		}
 
 		log.Logger(ctx).Sugar().Infof("Upload mucog on : %s", cEvent.Container.URI)
 	}
 
 	if h.isCancelled(ctx, cEvent) {
-		return TaskCancelledConsolidationError
+		return ConsolidationOutput{}, TaskCancelledConsolidationError
 	}
 
-	return nil
+	return output, nil
 }
 
 type FileToDownload struct {
@@ -341,24 +349,34 @@ func (h *handlerConsolidation) getLocalDatasetsByRecord(ctx context.Context, cEv
 }
 
 // uploadFile upload content from local file to storage file (URI) destination.
-func uploadFile(ctx context.Context, source, destination string) error {
+// It returns the size and the checksums of the local file.
+func uploadFile(ctx context.Context, source, destination string) (ConsolidationOutput, error) {
 	gsURI, err := uri.ParseUri(destination)
 	if err != nil {
-		return fmt.Errorf("failed to parse uri: %w", err)
+		return ConsolidationOutput{}, fmt.Errorf("failed to parse uri: %w", err)
 	}
 
 	f, err := os.Open(source)
 	if err != nil {
-		return fmt.Errorf("failed to open file: %w", err)
+		return ConsolidationOutput{}, fmt.Errorf("failed to open file: %w", err)
 	}
 
 	defer f.Close()
 
+	// The checksums are computed independently of the upload, so that a truncated upload can be detected
+	checksums, size, err := storage.ComputeChecksums(f)
+	if err != nil {
+		return ConsolidationOutput{}, fmt.Errorf("failed to compute checksums: %w", err)
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return ConsolidationOutput{}, fmt.Errorf("failed to seek file: %w", err)
+	}
+
 	if err := gsURI.UploadFile(ctx, f); err != nil {
-		return fmt.Errorf("failed to upload file: %w", err)
+		return ConsolidationOutput{}, fmt.Errorf("failed to upload file: %w", err)
 	}
 
-	return nil
+	return ConsolidationOutput{Size: size, Checksums: checksums}, nil
 }
 
 // cleanWorkspace remove local workspace content.
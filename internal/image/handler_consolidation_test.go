@@ -6,6 +6,8 @@ import (
 	"os"
 	"path"
 
+	"github.com/airbusgeo/geocube/interface/storage"
+	"github.com/airbusgeo/geocube/interface/storage/mem"
 	"github.com/airbusgeo/geocube/internal/geocube"
 	"github.com/airbusgeo/geocube/internal/image"
 	"github.com/airbusgeo/godal"
@@ -22,18 +24,22 @@ var _ = Describe("HandleConsolidation", func() {
 		workspace               string
 		pwd, _                  = os.Getwd()
 
-		returnedError error
+		returnedOutput image.ConsolidationOutput
+		returnedError  error
 
 		cogGenerator   = image.NewCogGenerator()
 		mucogGenerator = image.NewMucogGenerator()
 
 		handleConsolidation image.Handler
+
+		memStrategy storage.Strategy
 	)
 
 	BeforeEach(func() {
 		godal.RegisterAll()
 		workspace = os.TempDir()
 		handleConsolidation = image.NewHandleConsolidation(cogGenerator, mucogGenerator, os.TempDir(), 2, 0)
+		memStrategy, _ = mem.NewMemStrategy(ctx)
 	})
 
 	var (
@@ -90,7 +96,7 @@ var _ = Describe("HandleConsolidation", func() {
 	Describe("Consolidate", func() {
 
 		JustBeforeEach(func() {
-			returnedError = handleConsolidation.Consolidate(ctx, consolidationEventToUse, workspace)
+			returnedOutput, returnedError = handleConsolidation.Consolidate(ctx, consolidationEventToUse, workspace)
 		})
 
 		AfterEach(func() {
@@ -166,6 +172,41 @@ var _ = Describe("HandleConsolidation", func() {
 			})
 		})
 
+		Context("with in-memory storage", func() {
+			BeforeEach(func() {
+				data, err := os.ReadFile("test_data/image_warp3.tif")
+				Expect(err).To(BeNil())
+				Expect(memStrategy.Upload(ctx, "mem://test_data/image_warp3.tif", data)).To(Succeed())
+
+				event := *ConsolidationEvent1Record
+				record := event.Records[0]
+				record.Datasets = append([]geocube.ConsolidationDataset{}, record.Datasets...)
+				record.Datasets[0].URI = "mem://test_data/image_warp3.tif"
+				event.Records = []geocube.ConsolidationRecord{record}
+				event.Container.URI = "mem://test_data/mucog.tif"
+				consolidationEventToUse = &event
+			})
+
+			AfterEach(func() {
+				mem.DefaultStore().Reset()
+			})
+
+			itShouldNotReturnAnError()
+			It("it should create mucog in memory", func() {
+				attrs, err := memStrategy.GetAttrs(ctx, "mem://test_data/mucog.tif")
+				Expect(err).To(BeNil())
+				Expect(attrs.Size).To(Equal(returnedOutput.Size))
+				Expect(attrs.Checksums).To(Equal(returnedOutput.Checksums))
+
+				dataset, err := godal.Open("mem://test_data/mucog.tif")
+				Expect(err).To(BeNil())
+				defer dataset.Close()
+				Expect(dataset.GeoTransform()).To(Equal(consolidationEventToUse.Container.Transform))
+				Expect(dataset.Structure().SizeX).To(Equal(consolidationEventToUse.Container.Width))
+				Expect(dataset.Structure().NBands).To(Equal(consolidationEventToUse.Container.BandsCount))
+			})
+		})
+
 		Context("when cogs is already usable", func() {
 			BeforeEach(func() {
 				consolidationEventToUse = ConsolidationEvent
@@ -27,6 +27,8 @@ type Dataset struct {
 	SubDir      string
 	Bands       []int64
 	DataMapping geocube.DataMapping
+	InstanceID  string // Instance of the dataset (optional, to group the datasets of a cube by instance)
+	RecordID    string // Record of the dataset (optional, to group the datasets of a composite by observation)
 }
 
 func (d Dataset) GDALURI() string {
@@ -383,6 +385,38 @@ func MergeDatasets(ctx context.Context, datasets []*Dataset, outDesc *GdalDatase
 	return mergedDs, nil
 }
 
+// NewNoDataDataset creates an in-memory dataset described by outDesc and filled with nodata
+// The caller is responsible to close the output dataset
+func NewNoDataDataset(outDesc *GdalDatasetDescriptor) (*godal.Dataset, error) {
+	ds, err := godal.Create(godal.Memory, "", outDesc.Bands, outDesc.DataMapping.DType.ToGDAL(), outDesc.Width, outDesc.Height)
+	if err != nil {
+		return nil, fmt.Errorf("NewNoDataDataset.Create: %w", err)
+	}
+	if err := ds.SetGeoTransform(*outDesc.PixToCRS); err != nil {
+		ds.Close()
+		return nil, fmt.Errorf("NewNoDataDataset.SetGeoTransform: %w", err)
+	}
+	if err := ds.SetProjection(outDesc.WktCRS); err != nil {
+		ds.Close()
+		return nil, fmt.Errorf("NewNoDataDataset.SetProjection: %w", err)
+	}
+	nodata := outDesc.DataMapping.NoData
+	for _, band := range ds.Bands() {
+		if math.IsNaN(nodata) && !outDesc.DataMapping.DType.IsFloatingPointFormat() {
+			continue
+		}
+		if err := band.SetNoData(nodata); err != nil {
+			ds.Close()
+			return nil, fmt.Errorf("NewNoDataDataset.SetNoData: %w", err)
+		}
+		if err := band.Fill(nodata, 0); err != nil {
+			ds.Close()
+			return nil, fmt.Errorf("NewNoDataDataset.Fill: %w", err)
+		}
+	}
+	return ds, nil
+}
+
 // isASuite return true if s = [1, 2, 3, ..., N]
 func isASuite(s []int64) bool {
 	for i, si := range s {
@@ -0,0 +1,51 @@
+package image
+
+import (
+	"strconv"
+
+	"github.com/airbusgeo/godal"
+)
+
+// BandMetadataKey returns the key of the metadata of the band (starting at 1) in the map returned by ReadMetadata
+func BandMetadataKey(band int, key string) string {
+	return "band" + strconv.Itoa(band) + ":" + key
+}
+
+// BandDescriptionKey is the key of the description of a band (see BandMetadataKey)
+const BandDescriptionKey = "DESCRIPTION"
+
+// ReadMetadata returns the metadata of the dataset and of its bands in the given domains (default domain if empty).
+// The keys of the metadata of the bands are prefixed with the number of the band (see BandMetadataKey),
+// and the description of the bands is returned with the key BandDescriptionKey.
+// If a key is defined in several domains, the value of the first domain is returned.
+func ReadMetadata(ds *godal.Dataset, domains []string) map[string]string {
+	if len(domains) == 0 {
+		domains = []string{""}
+	}
+	metadata := map[string]string{}
+	for _, domain := range domains {
+		for k, v := range ds.Metadatas(godal.Domain(domain)) {
+			if !hasKey(metadata, k) {
+				metadata[k] = v
+			}
+		}
+		for i, band := range ds.Bands() {
+			for k, v := range band.Metadatas(godal.Domain(domain)) {
+				if k = BandMetadataKey(i+1, k); !hasKey(metadata, k) {
+					metadata[k] = v
+				}
+			}
+		}
+	}
+	for i, band := range ds.Bands() {
+		if desc := band.Description(); desc != "" {
+			metadata[BandMetadataKey(i+1, BandDescriptionKey)] = desc
+		}
+	}
+	return metadata
+}
+
+func hasKey(m map[string]string, k string) bool {
+	_, ok := m[k]
+	return ok
+}
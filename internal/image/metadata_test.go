@@ -0,0 +1,59 @@
+package image_test
+
+import (
+	"github.com/airbusgeo/geocube/internal/image"
+
+	"github.com/airbusgeo/godal"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ReadMetadata", func() {
+
+	var (
+		ds               *godal.Dataset
+		domains          []string
+		returnedMetadata map[string]string
+	)
+
+	BeforeEach(func() {
+		godal.RegisterAll()
+		domains = nil
+	})
+
+	JustBeforeEach(func() {
+		var err error
+		ds, err = godal.Create(godal.Memory, "", 2, godal.Byte, 10, 10)
+		Expect(err).To(BeNil())
+		Expect(ds.SetMetadata("TIFFTAG_DATETIME", "2023:01:02 10:47:11")).To(BeNil())
+		Expect(ds.SetMetadata("CLOUD_COVER", "12.5", godal.Domain("IMAGERY"))).To(BeNil())
+		Expect(ds.SetMetadata("TIFFTAG_DATETIME", "2024:01:01 00:00:00", godal.Domain("IMAGERY"))).To(BeNil())
+		Expect(ds.Bands()[1].SetMetadata("WAVELENGTH", "0.665")).To(BeNil())
+		Expect(ds.Bands()[1].SetDescription("red")).To(BeNil())
+		returnedMetadata = image.ReadMetadata(ds, domains)
+	})
+
+	JustAfterEach(func() {
+		ds.Close()
+	})
+
+	Context("in the default domain", func() {
+		It("should return the metadata of the dataset and of the bands", func() {
+			Expect(returnedMetadata).To(HaveKeyWithValue("TIFFTAG_DATETIME", "2023:01:02 10:47:11"))
+			Expect(returnedMetadata).To(HaveKeyWithValue(image.BandMetadataKey(2, "WAVELENGTH"), "0.665"))
+			Expect(returnedMetadata).To(HaveKeyWithValue(image.BandMetadataKey(2, image.BandDescriptionKey), "red"))
+			Expect(returnedMetadata).NotTo(HaveKey("CLOUD_COVER"))
+		})
+	})
+
+	Context("in several domains", func() {
+		BeforeEach(func() {
+			domains = []string{"IMAGERY", ""}
+		})
+		It("should return the value of the first domain", func() {
+			Expect(returnedMetadata).To(HaveKeyWithValue("CLOUD_COVER", "12.5"))
+			Expect(returnedMetadata).To(HaveKeyWithValue("TIFFTAG_DATETIME", "2024:01:01 00:00:00"))
+			Expect(returnedMetadata).To(HaveKeyWithValue(image.BandMetadataKey(2, "WAVELENGTH"), "0.665"))
+		})
+	})
+})
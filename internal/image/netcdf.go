@@ -0,0 +1,238 @@
+package image
+
+import (
+	"encoding/binary"
+	"encoding/xml"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/airbusgeo/godal"
+)
+
+// netCDFWriter implements CubeFileWriter for the NetCDF4 format.
+// The images are appended to raw files (one per variable) in a temporary directory, then each band of each variable
+// is described as a VRT (one band per time) and translated to a variable of the NetCDF file by GDAL.
+// The NetCDF file is streamed from the temporary directory.
+type netCDFWriter struct {
+	desc    CubeFileDescriptor
+	arrays  [][]string
+	dir     string
+	files   []*os.File
+	rawSize int64
+	times   []float64
+	output  *os.File
+}
+
+// NewNetCDFWriter returns a CubeFileWriter creating a NetCDF4 file following the CF conventions.
+// The file has the time, y and x dimensions (written by GDAL), a grid mapping describing the CRS
+// and one variable per band of each variable, with its nodata, scale and offset.
+// The temporary files are created in workDir (the temporary directory if empty).
+func NewNetCDFWriter(desc CubeFileDescriptor, workDir string) (CubeFileWriter, error) {
+	if err := desc.validate(); err != nil {
+		return nil, fmt.Errorf("NewNetCDFWriter: %w", err)
+	}
+	dir, err := os.MkdirTemp(workDir, "geocube-netcdf-")
+	if err != nil {
+		return nil, fmt.Errorf("NewNetCDFWriter.MkdirTemp: %w", err)
+	}
+	w := &netCDFWriter{desc: desc, arrays: desc.arrayNames(), dir: dir}
+	for i := range desc.Variables {
+		f, err := os.Create(filepath.Join(dir, fmt.Sprintf("variable%d.raw", i)))
+		if err != nil {
+			w.Close()
+			return nil, fmt.Errorf("NewNetCDFWriter.Create: %w", err)
+		}
+		w.files = append(w.files, f)
+	}
+	return w, nil
+}
+
+// WriteSlice implements CubeFileWriter
+func (w *netCDFWriter) WriteSlice(datetime time.Time, data [][]byte, byteOrder binary.ByteOrder) error {
+	if len(data) != len(w.desc.Variables) {
+		return fmt.Errorf("netCDF.WriteSlice: %d variables expected, got %d", len(w.desc.Variables), len(data))
+	}
+	for i, v := range w.desc.Variables {
+		nbBands := len(v.Bands)
+		if expected := w.desc.Width * w.desc.Height * nbBands * v.DataMapping.DType.Size(); len(data[i]) != expected {
+			return fmt.Errorf("netCDF.WriteSlice: %d bytes expected for %s, got %d", expected, v.Name, len(data[i]))
+		}
+		// The size is checked up front on the raw data (the NetCDF file is compressed, so it is usually smaller)
+		if err := checkCubeFileSize(w.rawSize + int64(len(data[i]))); err != nil {
+			return fmt.Errorf("netCDF.WriteSlice: %w", err)
+		}
+		w.rawSize += int64(len(data[i]))
+		// The raw file is band-sequential and little endian
+		for b := 0; b < nbBands; b++ {
+			if _, err := w.files[i].Write(bandData(data[i], nbBands, b, v.DataMapping.DType, byteOrder)); err != nil {
+				return fmt.Errorf("netCDF.WriteSlice.Write: %w", err)
+			}
+		}
+	}
+	w.times = append(w.times, float64(datetime.UnixNano())/1e9)
+	return nil
+}
+
+// vrtRawDataset is the subset of the VRT format describing a dataset of raw bands
+type vrtRawDataset struct {
+	XMLName      xml.Name     `xml:"VRTDataset"`
+	XSize        int          `xml:"rasterXSize,attr"`
+	YSize        int          `xml:"rasterYSize,attr"`
+	SRS          string       `xml:"SRS"`
+	GeoTransform string       `xml:"GeoTransform"`
+	Metadata     []vrtMDI     `xml:"Metadata>MDI"`
+	Bands        []vrtRawBand `xml:"VRTRasterBand"`
+}
+
+type vrtMDI struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
+
+type vrtRawBand struct {
+	DataType       string   `xml:"dataType,attr"`
+	Band           int      `xml:"band,attr"`
+	SubClass       string   `xml:"subClass,attr"`
+	SourceFilename string   `xml:"SourceFilename"`
+	ImageOffset    int      `xml:"ImageOffset"`
+	PixelOffset    int      `xml:"PixelOffset"`
+	LineOffset     int      `xml:"LineOffset"`
+	ByteOrder      string   `xml:"ByteOrder"`
+	NoDataValue    string   `xml:"NoDataValue"`
+	Offset         *float64 `xml:"Offset,omitempty"`
+	Scale          *float64 `xml:"Scale,omitempty"`
+	UnitType       string   `xml:"UnitType,omitempty"`
+	Metadata       []vrtMDI `xml:"Metadata>MDI"`
+}
+
+// Finalize implements CubeFileWriter
+func (w *netCDFWriter) Finalize(attributes map[string]string) (*CubeFileReader, error) {
+	if len(w.times) == 0 {
+		return nil, fmt.Errorf("netCDF.Finalize: empty cube")
+	}
+	for _, f := range w.files {
+		if err := f.Sync(); err != nil {
+			return nil, fmt.Errorf("netCDF.Finalize.Sync: %w", err)
+		}
+	}
+
+	timeValues := make([]string, len(w.times))
+	for t, v := range w.times {
+		timeValues[t] = strconv.FormatFloat(v, 'f', -1, 64)
+	}
+	gt := w.desc.PixToCRS
+	metadata := []vrtMDI{
+		{Key: "NETCDF_DIM_EXTRA", Value: "{time}"},
+		{Key: "NETCDF_DIM_time_DEF", Value: fmt.Sprintf("{%d,6}", len(w.times))}, // 6: NC_DOUBLE
+		{Key: "NETCDF_DIM_time_VALUES", Value: "{" + strings.Join(timeValues, ",") + "}"},
+		{Key: "time#standard_name", Value: "time"},
+		{Key: "time#axis", Value: "T"},
+		{Key: "time#units", Value: timeUnits},
+		{Key: "time#calendar", Value: "standard"},
+	}
+	for k, v := range attributes {
+		metadata = append(metadata, vrtMDI{Key: "NC_GLOBAL#" + k, Value: v})
+	}
+
+	output := filepath.Join(w.dir, "cube.nc")
+	for i, v := range w.desc.Variables {
+		size := v.DataMapping.DType.Size()
+		bandSize := w.desc.Width * w.desc.Height * size
+		nbBands := len(v.Bands)
+		for b, name := range w.arrays[i] {
+			vrt := vrtRawDataset{
+				XSize:        w.desc.Width,
+				YSize:        w.desc.Height,
+				SRS:          w.desc.WktCRS,
+				GeoTransform: fmt.Sprintf("%v, %v, %v, %v, %v, %v", gt[0], gt[1], gt[2], gt[3], gt[4], gt[5]),
+				Metadata:     metadata,
+			}
+			bandMetadata := []vrtMDI{
+				{Key: "long_name", Value: v.Name},
+				{Key: "valid_min", Value: strconv.FormatFloat(v.DataMapping.Range.Min, 'g', -1, 64)},
+				{Key: "valid_max", Value: strconv.FormatFloat(v.DataMapping.Range.Max, 'g', -1, 64)},
+			}
+			if nbBands > 1 {
+				bandMetadata[0].Value = v.Name + " " + v.Bands[b]
+			}
+			var scalePtr, offsetPtr *float64
+			if scale, offset, ok := scaleOffset(v.DataMapping); ok && (scale != 1 || offset != 0) {
+				scalePtr, offsetPtr = &scale, &offset
+			}
+			for t, value := range timeValues {
+				vrt.Bands = append(vrt.Bands, vrtRawBand{
+					DataType:       v.DataMapping.DType.ToGDAL().String(),
+					Band:           t + 1,
+					SubClass:       "VRTRawRasterBand",
+					SourceFilename: w.files[i].Name(),
+					ImageOffset:    (t*nbBands + b) * bandSize,
+					PixelOffset:    size,
+					LineOffset:     w.desc.Width * size,
+					ByteOrder:      "LSB",
+					NoDataValue:    strconv.FormatFloat(v.DataMapping.NoData, 'g', -1, 64),
+					Offset:         offsetPtr,
+					Scale:          scalePtr,
+					UnitType:       v.Unit,
+					Metadata:       append([]vrtMDI{{Key: "NETCDF_DIM_time", Value: value}}, bandMetadata...),
+				})
+			}
+			if err := w.appendVariable(output, name, vrt, i > 0 || b > 0); err != nil {
+				return nil, fmt.Errorf("netCDF.Finalize.%w", err)
+			}
+		}
+	}
+
+	f, err := os.Open(output)
+	if err != nil {
+		return nil, fmt.Errorf("netCDF.Finalize.Open: %w", err)
+	}
+	w.output = f
+	info, err := f.Stat()
+	if err != nil {
+		return nil, fmt.Errorf("netCDF.Finalize.Stat: %w", err)
+	}
+	if err := checkCubeFileSize(info.Size()); err != nil {
+		return nil, fmt.Errorf("netCDF.Finalize: %w", err)
+	}
+	return newCubeFileReader(f, info.Size()), nil
+}
+
+// appendVariable translates the VRT to a variable of the NetCDF file
+func (w *netCDFWriter) appendVariable(output, name string, vrt vrtRawDataset, appendSubdataset bool) error {
+	vrtXML, err := xml.Marshal(vrt)
+	if err != nil {
+		return fmt.Errorf("appendVariable.Marshal: %w", err)
+	}
+	ds, err := godal.Open(string(vrtXML), ErrLogger)
+	if err != nil {
+		return fmt.Errorf("appendVariable.Open: %w", err)
+	}
+	defer ds.Close()
+	options := []string{"-of", "netCDF", "-co", "FORMAT=NC4", "-co", "COMPRESS=DEFLATE", "-co", "VARIABLE_NAME=" + name}
+	if appendSubdataset {
+		options = append(options, "-co", "APPEND_SUBDATASET=YES")
+	}
+	outDs, err := ds.Translate(output, options, ErrLogger)
+	if err != nil {
+		return fmt.Errorf("appendVariable.Translate(%s): %w", name, err)
+	}
+	if err := outDs.Close(); err != nil {
+		return fmt.Errorf("appendVariable.Close: %w", err)
+	}
+	return nil
+}
+
+// Close implements CubeFileWriter
+func (w *netCDFWriter) Close() error {
+	for _, f := range w.files {
+		f.Close()
+	}
+	if w.output != nil {
+		w.output.Close()
+	}
+	return os.RemoveAll(w.dir)
+}
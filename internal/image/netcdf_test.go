@@ -0,0 +1,127 @@
+package image_test
+
+import (
+	"encoding/binary"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/airbusgeo/geocube/internal/geocube"
+	"github.com/airbusgeo/geocube/internal/image"
+	"github.com/airbusgeo/geocube/internal/utils/affine"
+	"github.com/airbusgeo/geocube/internal/utils/bitmap"
+	"github.com/airbusgeo/godal"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NetCDFWriter", func() {
+
+	var (
+		desc          image.CubeFileDescriptor
+		dir           string
+		returnedFile  string
+		returnedError error
+	)
+
+	BeforeEach(func() {
+		godal.RegisterAll()
+		if _, ok := godal.RasterDriver("netCDF"); !ok {
+			Skip("the netCDF driver of GDAL is not available")
+		}
+		var err error
+		dir, err = os.MkdirTemp("", "netcdf_test")
+		Expect(err).To(BeNil())
+		desc = image.CubeFileDescriptor{
+			WktCRS:   "EPSG:4326",
+			PixToCRS: affine.NewAffine(10, 1, 0, 20, 0, -1),
+			Width:    2,
+			Height:   2,
+			Variables: []image.CubeFileVariable{
+				{
+					Name:  "rgb",
+					Bands: []string{"R", "G"},
+					Unit:  "m",
+					DataMapping: geocube.DataMapping{
+						DataFormat: geocube.DataFormat{DType: bitmap.DTypeUINT16, NoData: 0, Range: geocube.Range{Min: 0, Max: 10000}},
+						RangeExt:   geocube.Range{Min: 0, Max: 1},
+						Exponent:   1,
+					},
+				},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	JustBeforeEach(func() {
+		returnedFile = ""
+		w, err := image.NewNetCDFWriter(desc, dir)
+		if returnedError = err; err != nil {
+			return
+		}
+		defer w.Close()
+		// Two pixel-interleaved images: R=i, G=10+i for the image i
+		for i := 0; i < 2; i++ {
+			rgb := make([]byte, 2*2*2*2)
+			for p := 0; p < 4; p++ {
+				binary.BigEndian.PutUint16(rgb[4*p:], uint16(i))
+				binary.BigEndian.PutUint16(rgb[4*p+2:], uint16(10+i))
+			}
+			if returnedError = w.WriteSlice(time.Unix(int64(1000*i), 0), [][]byte{rgb}, binary.BigEndian); returnedError != nil {
+				return
+			}
+		}
+		reader, err := w.Finalize(map[string]string{"constellation": "sentinel2"})
+		if returnedError = err; err != nil {
+			return
+		}
+		var file []byte
+		for {
+			chunk, err := reader.Next(1000)
+			if err == io.EOF {
+				break
+			}
+			Expect(err).To(BeNil())
+			file = append(file, chunk...)
+		}
+		Expect(file).To(HaveLen(reader.Len()))
+		Eventually(reader.Done()).Should(BeClosed())
+		returnedFile = filepath.Join(dir, "cube.nc")
+		Expect(os.WriteFile(returnedFile, file, 0644)).To(Succeed())
+	})
+
+	Context("default", func() {
+		It("should write a variable per band with one band per time", func() {
+			Expect(returnedError).To(BeNil())
+			ds, err := godal.Open("NETCDF:\"" + returnedFile + "\":rgb_G")
+			Expect(err).To(BeNil())
+			defer ds.Close()
+			Expect(ds.Structure().SizeX).To(Equal(2))
+			Expect(ds.Structure().SizeY).To(Equal(2))
+			Expect(ds.Structure().NBands).To(Equal(2))
+			Expect(ds.Metadata("NC_GLOBAL#constellation")).To(Equal("sentinel2"))
+
+			data := make([]uint16, 4)
+			Expect(ds.Bands()[1].Read(0, 0, data, 2, 2)).To(Succeed())
+			Expect(data).To(Equal([]uint16{11, 11, 11, 11}))
+		})
+	})
+
+	Context("with a cube exceeding the maximum size", func() {
+		var maxSize int64
+		BeforeEach(func() {
+			maxSize, image.MaxCubeFileSize = image.MaxCubeFileSize, 20
+		})
+		AfterEach(func() {
+			image.MaxCubeFileSize = maxSize
+		})
+		It("should return a validation error", func() {
+			Expect(geocube.IsError(returnedError, geocube.EntityValidationError)).To(BeTrue())
+		})
+	})
+})
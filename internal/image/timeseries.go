@@ -0,0 +1,476 @@
+package image
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"path"
+	"sort"
+	"strconv"
+	"sync"
+	"syscall"
+
+	"github.com/airbusgeo/geocube/interface/storage"
+	"github.com/airbusgeo/geocube/interface/storage/uri"
+	"github.com/airbusgeo/geocube/internal/geocube"
+	"github.com/airbusgeo/geocube/internal/utils/affine"
+	"github.com/airbusgeo/godal"
+	"github.com/google/uuid"
+)
+
+const (
+	// timeSeriesPrefix is the prefix of the GDAL VSI handler reading the remote containers through a blockFile
+	timeSeriesPrefix = "geocube-ts://"
+	// timeSeriesMaxGap is the maximum gap between two blocks fetched with the same request
+	timeSeriesMaxGap = 16 * 1024
+	// timeSeriesMaxRequestBytes is the maximum size of a request fetching contiguous blocks
+	timeSeriesMaxRequestBytes = 64 * 1024 * 1024
+	// timeSeriesMaxPixels is the maximum number of pixels of a location in a dataset
+	timeSeriesMaxPixels = 1024 * 1024
+)
+
+// TimeSeriesLocation is a point or a polygon (vertices of its exterior ring) in the CRS of the TimeSeriesReader
+type TimeSeriesLocation struct {
+	X, Y []float64
+}
+
+// TimeSeriesValue is the value of a location in a dataset
+type TimeSeriesValue struct {
+	Values   []float64 // Value of each band (NaN if nodata). For a polygon, the mean of the valid pixels
+	NbPixels []int     // Number of valid pixels of each band
+}
+
+// TimeSeriesReader reads the values of locations in datasets. Only the blocks of the datasets covering the locations are read.
+// For remote containers, the blocks that are contiguous in the file are fetched with a single request
+// (e.g. the blocks of all the records and bands of a MUCOG whose interlacing pattern is Z=0>T>R>B).
+// The datasets are kept open until Close. A TimeSeriesReader is not safe for concurrent use.
+type TimeSeriesReader struct {
+	crs      *godal.SpatialRef
+	id       string
+	files    map[string]*blockFile         // Remote containers by uri
+	datasets map[string]*timeSeriesDataset // Opened datasets by GDAL uri
+}
+
+type timeSeriesDataset struct {
+	ds        *godal.Dataset
+	transform *godal.Transform // From the CRS of the reader to the CRS of the dataset (nil if they are the same)
+	crsToPix  *affine.Affine
+	file      *blockFile // nil if the container is not remote
+}
+
+// timeSeriesWindow is the window of a dataset covering a location
+type timeSeriesWindow struct {
+	x, y, w, h int
+	mask       []bool // Pixels of the window inside the location (nil if all)
+}
+
+// NewTimeSeriesReader creates a reader of the locations defined in crs
+// The caller is responsible to close the reader.
+func NewTimeSeriesReader(crs *godal.SpatialRef) (*TimeSeriesReader, error) {
+	if err := registerBlockFilesHandler(); err != nil {
+		return nil, fmt.Errorf("NewTimeSeriesReader.%w", err)
+	}
+	return &TimeSeriesReader{
+		crs:      crs,
+		id:       uuid.New().String(),
+		files:    map[string]*blockFile{},
+		datasets: map[string]*timeSeriesDataset{},
+	}, nil
+}
+
+// Close closes the datasets opened by the reader
+func (r *TimeSeriesReader) Close() {
+	for _, d := range r.datasets {
+		if d.transform != nil {
+			d.transform.Close()
+		}
+		d.ds.Close()
+	}
+	for _, f := range r.files {
+		blockFiles.Delete(f.key)
+	}
+	r.datasets, r.files = map[string]*timeSeriesDataset{}, map[string]*blockFile{}
+}
+
+// Read returns the value of the location in each dataset (nil if the location is outside the dataset)
+func (r *TimeSeriesReader) Read(ctx context.Context, datasets []*Dataset, location TimeSeriesLocation) ([]*TimeSeriesValue, error) {
+	if len(location.X) == 0 || len(location.X) != len(location.Y) {
+		return nil, geocube.NewValidationError("invalid location: x and y must have the same non-zero length")
+	}
+
+	// Windows of the datasets covering the location
+	tsDatasets := make([]*timeSeriesDataset, len(datasets))
+	windows := make([]*timeSeriesWindow, len(datasets))
+	for i, dataset := range datasets {
+		var err error
+		if tsDatasets[i], err = r.open(ctx, dataset); err != nil {
+			return nil, fmt.Errorf("TimeSeriesReader.Read.%w", err)
+		}
+		if windows[i], err = tsDatasets[i].window(location); err != nil {
+			return nil, fmt.Errorf("TimeSeriesReader.Read[%s].%w", dataset.GDALURI(), err)
+		}
+	}
+
+	// Fetch the blocks of the remote containers
+	defer func() {
+		for _, f := range r.files {
+			f.release()
+		}
+	}()
+	ranges := map[*blockFile][]byteRange{}
+	for i, d := range tsDatasets {
+		if d.file != nil && windows[i] != nil {
+			ranges[d.file] = append(ranges[d.file], d.blockRanges(datasets[i].Bands, windows[i])...)
+		}
+	}
+	for f, rs := range ranges {
+		for _, rg := range mergeByteRanges(rs, timeSeriesMaxGap, timeSeriesMaxRequestBytes) {
+			if err := f.fetch(ctx, rg.off, rg.n); err != nil {
+				return nil, fmt.Errorf("TimeSeriesReader.Read.%w", err)
+			}
+		}
+	}
+
+	// Read the values
+	values := make([]*TimeSeriesValue, len(datasets))
+	for i, d := range tsDatasets {
+		if windows[i] == nil {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		var err error
+		if values[i], err = d.read(datasets[i], windows[i]); err != nil {
+			return nil, fmt.Errorf("TimeSeriesReader.Read[%s].%w", datasets[i].GDALURI(), err)
+		}
+	}
+	return values, nil
+}
+
+// open returns the opened dataset (opening it if necessary)
+func (r *TimeSeriesReader) open(ctx context.Context, dataset *Dataset) (*timeSeriesDataset, error) {
+	gdalURI := dataset.GDALURI()
+	if d, ok := r.datasets[gdalURI]; ok {
+		return d, nil
+	}
+
+	d := timeSeriesDataset{}
+	if u, err := uri.ParseUri(dataset.URI); err == nil && u.Protocol() != "" && u.Protocol() != "file" {
+		if d.file = r.files[dataset.URI]; d.file == nil {
+			key := fmt.Sprintf("%s%s/%d/%s", timeSeriesPrefix, r.id, len(r.files), path.Base(dataset.URI))
+			if d.file, err = newBlockFile(ctx, u, key); err != nil {
+				return nil, fmt.Errorf("open.%w", err)
+			}
+			r.files[dataset.URI] = d.file
+			blockFiles.Store(key, d.file)
+		}
+		gdalURI = geocube.GDALURI(d.file.key, dataset.SubDir)
+	}
+
+	var err error
+	if d.ds, err = godal.Open(gdalURI, ErrLogger); err != nil {
+		return nil, fmt.Errorf("open: %w", err)
+	}
+	gt, err := d.ds.GeoTransform()
+	if err != nil {
+		d.ds.Close()
+		return nil, fmt.Errorf("open.%w", err)
+	}
+	pixToCRS := affine.Affine(gt)
+	if !pixToCRS.IsInvertible() {
+		d.ds.Close()
+		return nil, fmt.Errorf("open: geotransform of %s is not invertible", dataset.GDALURI())
+	}
+	d.crsToPix = pixToCRS.Inverse()
+	if crs := d.ds.SpatialRef(); crs != nil && !crs.IsSame(r.crs) {
+		if d.transform, err = godal.NewTransform(r.crs, crs); err != nil {
+			d.ds.Close()
+			return nil, fmt.Errorf("open: %w", err)
+		}
+	}
+	r.datasets[dataset.GDALURI()] = &d
+	return &d, nil
+}
+
+// window returns the window of the dataset covering the location (nil if the location is outside the dataset)
+// For a polygon, the pixels are those whose center is inside the polygon or, if none, the pixel containing the center of its bounding box.
+func (d *timeSeriesDataset) window(location TimeSeriesLocation) (*timeSeriesWindow, error) {
+	x, y := append([]float64(nil), location.X...), append([]float64(nil), location.Y...)
+	if d.transform != nil {
+		ok := make([]bool, len(x))
+		if err := d.transform.TransformEx(x, y, make([]float64, len(x)), ok); err != nil {
+			return nil, fmt.Errorf("window: %w", err)
+		}
+		for _, o := range ok {
+			if !o {
+				return nil, nil
+			}
+		}
+	}
+	for i := range x {
+		x[i], y[i] = d.crsToPix.Transform(x[i], y[i])
+	}
+	structure := d.ds.Structure()
+	return locationWindow(x, y, structure.SizeX, structure.SizeY)
+}
+
+// locationWindow returns the window of a raster of size width x height covering the location defined in pixel coordinates
+func locationWindow(x, y []float64, width, height int) (*timeSeriesWindow, error) {
+	pixelWindow := func(px, py float64) *timeSeriesWindow {
+		if px < 0 || py < 0 || px >= float64(width) || py >= float64(height) {
+			return nil
+		}
+		return &timeSeriesWindow{x: int(px), y: int(py), w: 1, h: 1}
+	}
+	if len(x) < 3 {
+		return pixelWindow(x[0], y[0]), nil
+	}
+
+	// Bounding box of the polygon
+	minX, maxX, minY, maxY := x[0], x[0], y[0], y[0]
+	for i := range x {
+		minX, maxX, minY, maxY = math.Min(minX, x[i]), math.Max(maxX, x[i]), math.Min(minY, y[i]), math.Max(maxY, y[i])
+	}
+	x0, x1 := int(math.Max(0, math.Floor(minX))), int(math.Min(float64(width), math.Ceil(maxX)))
+	y0, y1 := int(math.Max(0, math.Floor(minY))), int(math.Min(float64(height), math.Ceil(maxY)))
+	if x0 >= x1 || y0 >= y1 {
+		return nil, nil
+	}
+	if (x1-x0)*(y1-y0) > timeSeriesMaxPixels {
+		return nil, geocube.NewValidationError("the location is too large (%dx%d pixels)", x1-x0, y1-y0)
+	}
+
+	w := timeSeriesWindow{x: x0, y: y0, w: x1 - x0, h: y1 - y0, mask: make([]bool, (x1-x0)*(y1-y0))}
+	inside := false
+	for j := 0; j < w.h; j++ {
+		for i := 0; i < w.w; i++ {
+			if w.mask[j*w.w+i] = pointInPolygon(float64(x0+i)+0.5, float64(y0+j)+0.5, x, y); w.mask[j*w.w+i] {
+				inside = true
+			}
+		}
+	}
+	if !inside {
+		return pixelWindow((minX+maxX)/2, (minY+maxY)/2), nil
+	}
+	return &w, nil
+}
+
+// pointInPolygon returns true if (px, py) is inside the polygon (even-odd rule)
+func pointInPolygon(px, py float64, x, y []float64) bool {
+	inside := false
+	for i, j := 0, len(x)-1; i < len(x); j, i = i, i+1 {
+		if (y[i] > py) != (y[j] > py) && px < (x[j]-x[i])*(py-y[i])/(y[j]-y[i])+x[i] {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// blockRanges returns the byte ranges of the blocks of the bands covering the window (empty if the dataset is not a tiff)
+func (d *timeSeriesDataset) blockRanges(bands []int64, w *timeSeriesWindow) []byteRange {
+	var ranges []byteRange
+	dsBands := d.ds.Bands()
+	for _, b := range bands {
+		if b <= 0 || int(b) > len(dsBands) {
+			continue
+		}
+		band := dsBands[b-1]
+		structure := band.Structure()
+		for by := w.y / structure.BlockSizeY; by <= (w.y+w.h-1)/structure.BlockSizeY; by++ {
+			for bx := w.x / structure.BlockSizeX; bx <= (w.x+w.w-1)/structure.BlockSizeX; bx++ {
+				off, err1 := strconv.ParseInt(band.Metadata(fmt.Sprintf("BLOCK_OFFSET_%d_%d", bx, by), godal.Domain("TIFF")), 10, 64)
+				n, err2 := strconv.ParseInt(band.Metadata(fmt.Sprintf("BLOCK_SIZE_%d_%d", bx, by), godal.Domain("TIFF")), 10, 64)
+				if err1 == nil && err2 == nil && n > 0 {
+					ranges = append(ranges, byteRange{off: off, n: n})
+				}
+			}
+		}
+	}
+	return ranges
+}
+
+// read reads the window of the dataset and returns the mean of the valid pixels of each band
+func (d *timeSeriesDataset) read(dataset *Dataset, w *timeSeriesWindow) (*TimeSeriesValue, error) {
+	nbBands := len(dataset.Bands)
+	bands := make([]int, nbBands)
+	for i, b := range dataset.Bands {
+		bands[i] = int(b) - 1
+	}
+	buf := make([]float64, w.w*w.h*nbBands)
+	if err := d.ds.Read(w.x, w.y, buf, w.w, w.h, godal.Bands(bands...)); err != nil {
+		return nil, fmt.Errorf("read: %w", err)
+	}
+
+	dm := dataset.DataMapping
+	value := TimeSeriesValue{Values: make([]float64, nbBands), NbPixels: make([]int, nbBands)}
+	for p := 0; p < w.w*w.h; p++ {
+		if w.mask != nil && !w.mask[p] {
+			continue
+		}
+		for b := 0; b < nbBands; b++ {
+			if v := buf[p*nbBands+b]; !math.IsNaN(v) && v != dm.NoData {
+				value.Values[b] += castValue(v, dm.Range, dm.RangeExt, dm.Exponent)
+				value.NbPixels[b]++
+			}
+		}
+	}
+	for b, n := range value.NbPixels {
+		if n == 0 {
+			value.Values[b] = math.NaN()
+		} else {
+			value.Values[b] /= float64(n)
+		}
+	}
+	return &value, nil
+}
+
+/*******************************************************************/
+/*                          BLOCK FILES                            */
+/*******************************************************************/
+
+// byteRange is the range [off, off+n) of a file
+type byteRange struct {
+	off, n int64
+}
+
+// mergeByteRanges sorts the ranges and merges those that are separated by less than maxGap bytes, as long as the merged range is smaller than maxSize
+func mergeByteRanges(ranges []byteRange, maxGap, maxSize int64) []byteRange {
+	if len(ranges) == 0 {
+		return nil
+	}
+	sorted := append([]byteRange(nil), ranges...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].off < sorted[j].off })
+	merged := []byteRange{sorted[0]}
+	for _, rg := range sorted[1:] {
+		last := &merged[len(merged)-1]
+		end := max(last.off+last.n, rg.off+rg.n)
+		if rg.off <= last.off+last.n+maxGap && end-last.off <= maxSize {
+			last.n = end - last.off
+		} else {
+			merged = append(merged, rg)
+		}
+	}
+	return merged
+}
+
+var (
+	blockFiles             sync.Map // *blockFile by key
+	registerBlockFilesOnce sync.Once
+	errRegisterBlockFiles  error
+)
+
+// registerBlockFilesHandler registers the GDAL VSI handler of the block files (once)
+func registerBlockFilesHandler() error {
+	registerBlockFilesOnce.Do(func() {
+		errRegisterBlockFiles = godal.RegisterVSIHandler(timeSeriesPrefix, blockFilesHandler{})
+	})
+	return errRegisterBlockFiles
+}
+
+// blockFilesHandler is the GDAL VSI handler of the block files
+type blockFilesHandler struct{}
+
+// ReadAt implements godal.KeySizerReaderAt
+func (blockFilesHandler) ReadAt(key string, buf []byte, off int64) (int, error) {
+	f, ok := blockFiles.Load(key)
+	if !ok {
+		return 0, syscall.ENOENT
+	}
+	return f.(*blockFile).ReadAt(buf, off)
+}
+
+// Size implements godal.KeySizerReaderAt
+func (blockFilesHandler) Size(key string) (int64, error) {
+	f, ok := blockFiles.Load(key)
+	if !ok {
+		return 0, syscall.ENOENT
+	}
+	return f.(*blockFile).size, nil
+}
+
+// blockFile is a remote file read by ranges. The ranges that are fetched (prefetched blocks or ranges read by GDAL)
+// are kept in memory until release.
+type blockFile struct {
+	ctx      context.Context
+	key      string
+	uri      string
+	strategy storage.Strategy
+	size     int64
+
+	mutex  sync.Mutex
+	ranges []fetchedRange
+}
+
+type fetchedRange struct {
+	off  int64
+	data []byte
+}
+
+func newBlockFile(ctx context.Context, u uri.DefaultUri, key string) (*blockFile, error) {
+	strategy, err := u.NewStorageStrategy(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("newBlockFile[%s]: %w", u.String(), err)
+	}
+	attrs, err := strategy.GetAttrs(ctx, u.String())
+	if err != nil {
+		return nil, fmt.Errorf("newBlockFile[%s]: %w", u.String(), err)
+	}
+	return &blockFile{ctx: ctx, key: key, uri: u.String(), strategy: strategy, size: attrs.Size}, nil
+}
+
+// fetch downloads the range [off, off+n) and keeps it in memory
+func (f *blockFile) fetch(ctx context.Context, off, n int64) error {
+	if off >= f.size {
+		return nil
+	}
+	data, err := f.strategy.Download(ctx, f.uri, storage.Offset(off), storage.Length(n))
+	if err != nil {
+		return fmt.Errorf("fetch[%s]: %w", f.uri, err)
+	}
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.ranges = append(f.ranges, fetchedRange{off: off, data: data})
+	return nil
+}
+
+// release releases the fetched ranges
+func (f *blockFile) release() {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.ranges = nil
+}
+
+// ReadAt reads the file from the fetched ranges or, if the range has not been fetched, from the storage
+func (f *blockFile) ReadAt(buf []byte, off int64) (int, error) {
+	if off >= f.size {
+		return 0, io.EOF
+	}
+	n := min(int64(len(buf)), f.size-off)
+	if !f.readFetched(buf[:n], off) {
+		if err := f.fetch(f.ctx, off, n); err != nil {
+			return 0, err
+		}
+		if !f.readFetched(buf[:n], off) {
+			return 0, fmt.Errorf("ReadAt[%s]: unable to read %d bytes at %d", f.uri, n, off)
+		}
+	}
+	if n < int64(len(buf)) {
+		return int(n), io.EOF
+	}
+	return int(n), nil
+}
+
+// readFetched copies the fetched range containing [off, off+len(buf)) into buf. Returns false if there is no such range.
+func (f *blockFile) readFetched(buf []byte, off int64) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	for _, rg := range f.ranges {
+		if off >= rg.off && off+int64(len(buf)) <= rg.off+int64(len(rg.data)) {
+			copy(buf, rg.data[off-rg.off:])
+			return true
+		}
+	}
+	return false
+}
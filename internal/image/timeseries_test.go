@@ -0,0 +1,109 @@
+package image_test
+
+import (
+	"context"
+	"io"
+
+	"github.com/airbusgeo/geocube/interface/storage/mem"
+	"github.com/airbusgeo/geocube/internal/image"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TimeSeries", func() {
+
+	Describe("MergeByteRanges", func() {
+		It("should merge the contiguous ranges, up to the maximum size", func() {
+			ranges := [][2]int64{{300, 100}, {0, 100}, {100, 100}, {208, 50}, {1000, 10}}
+			Expect(image.MergeByteRanges(ranges, 50, 1000)).To(Equal([][2]int64{{0, 400}, {1000, 10}}))
+			Expect(image.MergeByteRanges(ranges, 16, 1000)).To(Equal([][2]int64{{0, 258}, {300, 100}, {1000, 10}}))
+			Expect(image.MergeByteRanges(ranges, 0, 1000)).To(Equal([][2]int64{{0, 200}, {208, 50}, {300, 100}, {1000, 10}}))
+			Expect(image.MergeByteRanges(ranges, 50, 250)).To(Equal([][2]int64{{0, 200}, {208, 192}, {1000, 10}}))
+		})
+	})
+
+	Describe("LocationWindow", func() {
+		It("should return the pixel of a point", func() {
+			window, mask, err := image.LocationWindow([]float64{2.5}, []float64{3.2}, 10, 10)
+			Expect(err).To(BeNil())
+			Expect(window).To(Equal([]int{2, 3, 1, 1}))
+			Expect(mask).To(BeNil())
+		})
+
+		It("should return nil if the point is outside the raster", func() {
+			window, _, err := image.LocationWindow([]float64{10.5}, []float64{3.2}, 10, 10)
+			Expect(err).To(BeNil())
+			Expect(window).To(BeNil())
+		})
+
+		It("should return the pixels whose center is inside the polygon", func() {
+			// Triangle (1,1), (4.2,1), (1,4.2)
+			window, mask, err := image.LocationWindow([]float64{1, 4.2, 1}, []float64{1, 1, 4.2}, 10, 10)
+			Expect(err).To(BeNil())
+			Expect(window).To(Equal([]int{1, 1, 4, 4}))
+			Expect(mask).To(Equal([]bool{
+				true, true, true, false,
+				true, true, false, false,
+				true, false, false, false,
+				false, false, false, false,
+			}))
+		})
+
+		It("should clip the polygon to the raster", func() {
+			window, mask, err := image.LocationWindow([]float64{-5, 2, 2, -5}, []float64{-5, -5, 2, 2}, 10, 10)
+			Expect(err).To(BeNil())
+			Expect(window).To(Equal([]int{0, 0, 2, 2}))
+			Expect(mask).To(Equal([]bool{true, true, true, true}))
+		})
+
+		It("should return the center pixel of a polygon smaller than a pixel", func() {
+			window, mask, err := image.LocationWindow([]float64{5.1, 5.3, 5.3}, []float64{6.1, 6.1, 6.3}, 10, 10)
+			Expect(err).To(BeNil())
+			Expect(window).To(Equal([]int{5, 6, 1, 1}))
+			Expect(mask).To(BeNil())
+		})
+	})
+
+	Describe("BlockFile", func() {
+		var (
+			ctx  = context.Background()
+			file *image.BlockFile
+			data = make([]byte, 1000)
+		)
+
+		BeforeEach(func() {
+			for i := range data {
+				data[i] = byte(i % 251)
+			}
+			mem.DefaultStore().Reset()
+			strategy, err := mem.NewMemStrategy(ctx)
+			Expect(err).To(BeNil())
+			Expect(strategy.Upload(ctx, "mem://bucket/file.tif", data)).To(Succeed())
+			file, err = image.NewBlockFile(ctx, "mem://bucket/file.tif")
+			Expect(err).To(BeNil())
+		})
+
+		It("should read the prefetched ranges", func() {
+			Expect(file.Fetch(ctx, 100, 200)).To(Succeed())
+			buf := make([]byte, 50)
+			n, err := file.ReadAt(buf, 150)
+			Expect(err).To(BeNil())
+			Expect(n).To(Equal(50))
+			Expect(buf).To(Equal(data[150:200]))
+			Expect(file.NbFetchedRanges()).To(Equal(1))
+		})
+
+		It("should read the other ranges from the storage", func() {
+			buf := make([]byte, 50)
+			n, err := file.ReadAt(buf, 980)
+			Expect(err).To(Equal(io.EOF))
+			Expect(n).To(Equal(20))
+			Expect(buf[:20]).To(Equal(data[980:]))
+			Expect(file.NbFetchedRanges()).To(Equal(1))
+
+			_, err = file.ReadAt(buf, 1000)
+			Expect(err).To(Equal(io.EOF))
+		})
+	})
+})
@@ -0,0 +1,408 @@
+package image
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/airbusgeo/geocube/internal/utils/bitmap"
+)
+
+// zarrCompressionLevel is the level of compression of the chunks of the data arrays (zlib for v2, gzip for v3)
+const zarrCompressionLevel = 6
+
+var zarrV2DTypes = map[bitmap.DType]string{
+	bitmap.DTypeUINT8: "|u1", bitmap.DTypeINT8: "|i1", bitmap.DTypeUINT16: "<u2", bitmap.DTypeINT16: "<i2", bitmap.DTypeUINT32: "<u4",
+	bitmap.DTypeINT32: "<i4", bitmap.DTypeFLOAT32: "<f4", bitmap.DTypeFLOAT64: "<f8", bitmap.DTypeCOMPLEX64: "<c8",
+}
+
+var zarrV3DTypes = map[bitmap.DType]string{
+	bitmap.DTypeUINT8: "uint8", bitmap.DTypeINT8: "int8", bitmap.DTypeUINT16: "uint16", bitmap.DTypeINT16: "int16", bitmap.DTypeUINT32: "uint32",
+	bitmap.DTypeINT32: "int32", bitmap.DTypeFLOAT32: "float32", bitmap.DTypeFLOAT64: "float64", bitmap.DTypeCOMPLEX64: "complex64",
+}
+
+// zarrWriter implements CubeFileWriter for the Zarr formats (v2 or v3), the store being a zip archive
+// The chunks of the data arrays are compressed and written in a temporary file as soon as the images are received,
+// the metadata are kept in memory. When the cube is finalized, the zip archive is streamed through a pipe.
+type zarrWriter struct {
+	desc      CubeFileDescriptor
+	version   int
+	arrays    [][]string
+	chunks    *os.File // Temporary file of the chunks
+	chunksLen int64
+	rawSize   int64
+	entries   []zarrEntry
+	times     []float64
+	metadata  map[string]interface{} // Consolidated metadata (v2 only)
+	pipe      *io.PipeReader
+	streamed  chan struct{} // Closed when the zip archive has been streamed
+}
+
+// zarrEntry is a file of the store, stored uncompressed in the zip archive
+type zarrEntry struct {
+	name   string
+	crc32  uint32
+	data   []byte // Content of the file or nil if the content is in the temporary file of the chunks
+	offset int64  // Offset of the content in the temporary file of the chunks
+	size   int64
+}
+
+// NewZarrWriter returns a CubeFileWriter creating a Zarr store (version 2 or 3) in a zip archive.
+// The store is a group with the time, y and x coordinates, a spatial_ref variable describing the CRS (crs_wkt and GeoTransform)
+// and one array per band of each variable, chunked by image.
+// The temporary file of the chunks is created in workDir (the temporary directory if empty).
+func NewZarrWriter(desc CubeFileDescriptor, version int, workDir string) (CubeFileWriter, error) {
+	if version != 2 && version != 3 {
+		return nil, fmt.Errorf("NewZarrWriter: unsupported version %d", version)
+	}
+	if err := desc.validate(); err != nil {
+		return nil, fmt.Errorf("NewZarrWriter: %w", err)
+	}
+	for _, v := range desc.Variables {
+		if _, ok := zarrV2DTypes[v.DataMapping.DType]; !ok {
+			return nil, fmt.Errorf("NewZarrWriter: unsupported data type %s", v.DataMapping.DType.String())
+		}
+	}
+	chunks, err := os.CreateTemp(workDir, "geocube-zarr-")
+	if err != nil {
+		return nil, fmt.Errorf("NewZarrWriter.CreateTemp: %w", err)
+	}
+	return &zarrWriter{
+		desc:     desc,
+		version:  version,
+		arrays:   desc.arrayNames(),
+		chunks:   chunks,
+		metadata: map[string]interface{}{},
+	}, nil
+}
+
+// WriteSlice implements CubeFileWriter
+func (w *zarrWriter) WriteSlice(datetime time.Time, data [][]byte, byteOrder binary.ByteOrder) error {
+	if len(data) != len(w.desc.Variables) {
+		return fmt.Errorf("zarr.WriteSlice: %d variables expected, got %d", len(w.desc.Variables), len(data))
+	}
+	t := len(w.times)
+	for i, v := range w.desc.Variables {
+		nbBands := len(v.Bands)
+		if expected := w.desc.Width * w.desc.Height * nbBands * v.DataMapping.DType.Size(); len(data[i]) != expected {
+			return fmt.Errorf("zarr.WriteSlice: %d bytes expected for %s, got %d", expected, v.Name, len(data[i]))
+		}
+		// The size is checked up front on the raw data (the chunks are compressed, so the file is usually smaller)
+		if err := checkCubeFileSize(w.rawSize + int64(len(data[i]))); err != nil {
+			return fmt.Errorf("zarr.WriteSlice: %w", err)
+		}
+		w.rawSize += int64(len(data[i]))
+		for b, name := range w.arrays[i] {
+			if err := w.writeChunk(name, []int{t, 0, 0}, bandData(data[i], nbBands, b, v.DataMapping.DType, byteOrder), true); err != nil {
+				return fmt.Errorf("zarr.WriteSlice.%w", err)
+			}
+		}
+	}
+	w.times = append(w.times, float64(datetime.UnixNano())/1e9)
+	return nil
+}
+
+// Finalize implements CubeFileWriter
+func (w *zarrWriter) Finalize(attributes map[string]string) (*CubeFileReader, error) {
+	if len(w.times) == 0 {
+		return nil, fmt.Errorf("zarr.Finalize: empty cube")
+	}
+	if err := w.finalize(attributes); err != nil {
+		return nil, fmt.Errorf("zarr.Finalize.%w", err)
+	}
+
+	// The size of the archive does not depend on the content of the files: it is computed with a dry run
+	size, err := w.writeZip(io.Discard, false)
+	if err != nil {
+		return nil, fmt.Errorf("zarr.Finalize.%w", err)
+	}
+	if err := checkCubeFileSize(size); err != nil {
+		return nil, fmt.Errorf("zarr.Finalize: %w", err)
+	}
+
+	pr, pw := io.Pipe()
+	w.pipe, w.streamed = pr, make(chan struct{})
+	go func() {
+		defer close(w.streamed)
+		_, err := w.writeZip(pw, true)
+		pw.CloseWithError(err)
+	}()
+	return newCubeFileReader(pr, size), nil
+}
+
+// writeZip writes the zip archive of the store and returns its size.
+// If withContent is false, the content of the chunks is replaced by zeros.
+func (w *zarrWriter) writeZip(out io.Writer, withContent bool) (int64, error) {
+	cw := &countingWriter{w: out}
+	zw := zip.NewWriter(cw)
+	for _, e := range w.entries {
+		f, err := zw.CreateRaw(&zip.FileHeader{Name: e.name, Method: zip.Store, CRC32: e.crc32, CompressedSize64: uint64(e.size), UncompressedSize64: uint64(e.size)})
+		if err != nil {
+			return 0, fmt.Errorf("writeZip.Create: %w", err)
+		}
+		var content io.Reader = bytes.NewReader(e.data)
+		if e.data == nil {
+			if withContent {
+				content = io.NewSectionReader(w.chunks, e.offset, e.size)
+			} else {
+				content = io.LimitReader(zeros{}, e.size)
+			}
+		}
+		if _, err := io.Copy(f, content); err != nil {
+			return 0, fmt.Errorf("writeZip.Write(%s): %w", e.name, err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return 0, fmt.Errorf("writeZip.Close: %w", err)
+	}
+	return cw.n, nil
+}
+
+func (w *zarrWriter) finalize(attributes map[string]string) error {
+	// Root group
+	attrs := map[string]interface{}{"Conventions": "CF-1.8"}
+	for k, v := range attributes {
+		attrs[k] = v
+	}
+	if w.version == 2 {
+		if err := w.writeJSON(".zgroup", map[string]interface{}{"zarr_format": 2}); err != nil {
+			return err
+		}
+		if err := w.writeJSON(".zattrs", attrs); err != nil {
+			return err
+		}
+	} else if err := w.writeJSON("zarr.json", map[string]interface{}{"zarr_format": 3, "node_type": "group", "attributes": attrs}); err != nil {
+		return err
+	}
+
+	// Coordinates
+	gt := w.desc.PixToCRS
+	xs, ys := make([]float64, w.desc.Width), make([]float64, w.desc.Height)
+	for i := range xs {
+		xs[i] = gt[0] + (float64(i)+0.5)*gt[1]
+	}
+	for i := range ys {
+		ys[i] = gt[3] + (float64(i)+0.5)*gt[5]
+	}
+	coordinates := []struct {
+		name   string
+		values []float64
+		attrs  map[string]interface{}
+	}{
+		{"time", w.times, map[string]interface{}{"standard_name": "time", "axis": "T", "units": timeUnits, "calendar": "standard"}},
+		{"y", ys, map[string]interface{}{"standard_name": "projection_y_coordinate", "axis": "Y"}},
+		{"x", xs, map[string]interface{}{"standard_name": "projection_x_coordinate", "axis": "X"}},
+	}
+	for _, c := range coordinates {
+		if err := w.writeArray(c.name, []int{len(c.values)}, []int{len(c.values)}, bitmap.DTypeFLOAT64, nil, false, []string{c.name}, c.attrs); err != nil {
+			return err
+		}
+		data := make([]byte, 8*len(c.values))
+		for i, v := range c.values {
+			binary.LittleEndian.PutUint64(data[8*i:], math.Float64bits(v))
+		}
+		if err := w.writeChunk(c.name, []int{0}, data, false); err != nil {
+			return err
+		}
+	}
+
+	// CRS (scalar array without data)
+	if err := w.writeArray(gridMappingName, []int{}, []int{}, bitmap.DTypeINT32, 0, false, []string{}, map[string]interface{}{
+		"crs_wkt":     w.desc.WktCRS,
+		"spatial_ref": w.desc.WktCRS,
+		"GeoTransform": strings.Join([]string{
+			strconv.FormatFloat(gt[0], 'f', -1, 64), strconv.FormatFloat(gt[1], 'f', -1, 64), strconv.FormatFloat(gt[2], 'f', -1, 64),
+			strconv.FormatFloat(gt[3], 'f', -1, 64), strconv.FormatFloat(gt[4], 'f', -1, 64), strconv.FormatFloat(gt[5], 'f', -1, 64)}, " "),
+	}); err != nil {
+		return err
+	}
+
+	// Data arrays
+	for i, v := range w.desc.Variables {
+		for b, name := range w.arrays[i] {
+			attrs := map[string]interface{}{
+				"grid_mapping": gridMappingName,
+				"long_name":    v.Name,
+				"valid_min":    v.DataMapping.Range.Min,
+				"valid_max":    v.DataMapping.Range.Max,
+			}
+			if len(v.Bands) > 1 {
+				attrs["long_name"] = v.Name + " " + v.Bands[b]
+			}
+			if v.Unit != "" {
+				attrs["units"] = v.Unit
+			}
+			if scale, offset, ok := scaleOffset(v.DataMapping); ok && (scale != 1 || offset != 0) {
+				attrs["scale_factor"], attrs["add_offset"] = scale, offset
+			}
+			if err := w.writeArray(name, []int{len(w.times), w.desc.Height, w.desc.Width}, []int{1, w.desc.Height, w.desc.Width},
+				v.DataMapping.DType, v.DataMapping.NoData, true, []string{"time", "y", "x"}, attrs); err != nil {
+				return err
+			}
+		}
+	}
+
+	if w.version == 2 {
+		if err := w.writeJSON(".zmetadata", map[string]interface{}{"metadata": w.metadata, "zarr_consolidated_format": 1}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Close implements CubeFileWriter
+func (w *zarrWriter) Close() error {
+	if w.pipe != nil {
+		// Stop the streaming of the archive (if it is still in progress)
+		w.pipe.Close()
+		<-w.streamed
+	}
+	w.chunks.Close()
+	return os.Remove(w.chunks.Name())
+}
+
+// writeArray writes the metadata of an array. fill is the fill value (nil for none, v2 only)
+func (w *zarrWriter) writeArray(name string, shape, chunks []int, dtype bitmap.DType, fill interface{}, compressed bool, dims []string, attrs map[string]interface{}) error {
+	if f, ok := fill.(float64); ok {
+		fill = zarrFillValue(f, dtype)
+	}
+	if w.version == 2 {
+		var compressor interface{}
+		if compressed {
+			compressor = map[string]interface{}{"id": "zlib", "level": zarrCompressionLevel}
+		}
+		if err := w.writeJSON(name+"/.zarray", map[string]interface{}{
+			"zarr_format": 2,
+			"shape":       shape,
+			"chunks":      chunks,
+			"dtype":       zarrV2DTypes[dtype],
+			"compressor":  compressor,
+			"fill_value":  fill,
+			"order":       "C",
+			"filters":     nil,
+		}); err != nil {
+			return err
+		}
+		attrs["_ARRAY_DIMENSIONS"] = dims
+		return w.writeJSON(name+"/.zattrs", attrs)
+	}
+
+	codecs := []interface{}{map[string]interface{}{"name": "bytes", "configuration": map[string]interface{}{"endian": "little"}}}
+	if compressed {
+		codecs = append(codecs, map[string]interface{}{"name": "gzip", "configuration": map[string]interface{}{"level": zarrCompressionLevel}})
+	}
+	if fill == nil {
+		fill = 0
+	}
+	return w.writeJSON(name+"/zarr.json", map[string]interface{}{
+		"zarr_format":        3,
+		"node_type":          "array",
+		"shape":              shape,
+		"data_type":          zarrV3DTypes[dtype],
+		"chunk_grid":         map[string]interface{}{"name": "regular", "configuration": map[string]interface{}{"chunk_shape": chunks}},
+		"chunk_key_encoding": map[string]interface{}{"name": "default", "configuration": map[string]interface{}{"separator": "/"}},
+		"fill_value":         fill,
+		"codecs":             codecs,
+		"attributes":         attrs,
+		"dimension_names":    dims,
+	})
+}
+
+// writeChunk writes the chunk of the array at the given index, compressing it if necessary
+func (w *zarrWriter) writeChunk(name string, index []int, data []byte, compressed bool) error {
+	key := make([]string, len(index))
+	for i, idx := range index {
+		key[i] = strconv.Itoa(idx)
+	}
+	if w.version == 2 {
+		name += "/" + strings.Join(key, ".")
+	} else {
+		name += "/c/" + strings.Join(key, "/")
+	}
+	if compressed {
+		var buf bytes.Buffer
+		var c io.WriteCloser
+		var err error
+		if w.version == 2 {
+			c, err = zlib.NewWriterLevel(&buf, zarrCompressionLevel)
+		} else {
+			c, err = gzip.NewWriterLevel(&buf, zarrCompressionLevel)
+		}
+		if err == nil {
+			if _, err = c.Write(data); err == nil {
+				err = c.Close()
+			}
+		}
+		if err != nil {
+			return fmt.Errorf("writeChunk: %w", err)
+		}
+		data = buf.Bytes()
+	}
+	if _, err := w.chunks.Write(data); err != nil {
+		return fmt.Errorf("writeChunk.Write: %w", err)
+	}
+	w.entries = append(w.entries, zarrEntry{name: name, crc32: crc32.ChecksumIEEE(data), offset: w.chunksLen, size: int64(len(data))})
+	w.chunksLen += int64(len(data))
+	return nil
+}
+
+// writeJSON writes a metadata file of the store (and adds it to the consolidated metadata)
+func (w *zarrWriter) writeJSON(name string, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("writeJSON.Marshal: %w", err)
+	}
+	w.entries = append(w.entries, zarrEntry{name: name, crc32: crc32.ChecksumIEEE(data), data: data, size: int64(len(data))})
+	w.metadata[name] = json.RawMessage(data)
+	return nil
+}
+
+// countingWriter counts the bytes written to w
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (cw *countingWriter) Write(p []byte) (int, error) {
+	n, err := cw.w.Write(p)
+	cw.n += int64(n)
+	return n, err
+}
+
+// zeros is a reader of zeros
+type zeros struct{}
+
+func (zeros) Read(p []byte) (int, error) {
+	clear(p)
+	return len(p), nil
+}
+
+// zarrFillValue returns the JSON representation of the fill value
+func zarrFillValue(v float64, dtype bitmap.DType) interface{} {
+	var fill interface{} = v
+	switch {
+	case math.IsNaN(v):
+		fill = "NaN"
+	case math.IsInf(v, 1):
+		fill = "Infinity"
+	case math.IsInf(v, -1):
+		fill = "-Infinity"
+	case !dtype.IsFloatingPointFormat():
+		fill = int64(v)
+	}
+	if dtype == bitmap.DTypeCOMPLEX64 {
+		return []interface{}{fill, 0}
+	}
+	return fill
+}
@@ -0,0 +1,199 @@
+package image_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"os"
+	"time"
+
+	"github.com/airbusgeo/geocube/internal/geocube"
+	"github.com/airbusgeo/geocube/internal/image"
+	"github.com/airbusgeo/geocube/internal/utils/affine"
+	"github.com/airbusgeo/geocube/internal/utils/bitmap"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ZarrWriter", func() {
+
+	var (
+		version       int
+		workDir       string
+		desc          image.CubeFileDescriptor
+		returnedFiles map[string][]byte
+		returnedError error
+	)
+
+	readJSON := func(name string) map[string]interface{} {
+		Expect(returnedFiles).To(HaveKey(name))
+		v := map[string]interface{}{}
+		Expect(json.Unmarshal(returnedFiles[name], &v)).To(BeNil())
+		return v
+	}
+
+	BeforeEach(func() {
+		var err error
+		workDir, err = os.MkdirTemp("", "zarr_test")
+		Expect(err).To(BeNil())
+		version = 2
+		desc = image.CubeFileDescriptor{
+			WktCRS:   "EPSG:4326",
+			PixToCRS: affine.NewAffine(10, 1, 0, 20, 0, -1),
+			Width:    2,
+			Height:   2,
+			Variables: []image.CubeFileVariable{
+				{
+					Name:  "rgb",
+					Bands: []string{"R", "G"},
+					Unit:  "m",
+					DataMapping: geocube.DataMapping{
+						DataFormat: geocube.DataFormat{DType: bitmap.DTypeUINT16, NoData: 0, Range: geocube.Range{Min: 0, Max: 10000}},
+						RangeExt:   geocube.Range{Min: 0, Max: 1},
+						Exponent:   1,
+					},
+				},
+				{
+					Name:  "mask",
+					Bands: []string{"mask"},
+					DataMapping: geocube.DataMapping{
+						DataFormat: geocube.DataFormat{DType: bitmap.DTypeUINT8, NoData: 255, Range: geocube.Range{Min: 0, Max: 1}},
+						RangeExt:   geocube.Range{Min: 0, Max: 1},
+						Exponent:   1,
+					},
+				},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(workDir)
+	})
+
+	JustBeforeEach(func() {
+		returnedFiles = nil
+		w, err := image.NewZarrWriter(desc, version, workDir)
+		if returnedError = err; err != nil {
+			return
+		}
+		defer w.Close()
+		tmpFiles, err := os.ReadDir(workDir)
+		Expect(err).To(BeNil())
+		Expect(tmpFiles).To(HaveLen(1))
+		// Two pixel-interleaved images: R=i, G=10+i for the image i and a mask of 1
+		for i := 0; i < 2; i++ {
+			rgb := make([]byte, 2*2*2*2)
+			for p := 0; p < 4; p++ {
+				binary.BigEndian.PutUint16(rgb[4*p:], uint16(i))
+				binary.BigEndian.PutUint16(rgb[4*p+2:], uint16(10+i))
+			}
+			mask := []byte{1, 1, 1, 1}
+			if returnedError = w.WriteSlice(time.Unix(int64(1000*i), 0), [][]byte{rgb, mask}, binary.BigEndian); returnedError != nil {
+				return
+			}
+		}
+		reader, err := w.Finalize(map[string]string{"constellation": "sentinel2"})
+		if returnedError = err; err != nil {
+			return
+		}
+		var file []byte
+		for {
+			chunk, err := reader.Next(100)
+			if err == io.EOF {
+				break
+			}
+			Expect(err).To(BeNil())
+			file = append(file, chunk...)
+		}
+		Expect(file).To(HaveLen(reader.Len()))
+		Eventually(reader.Done()).Should(BeClosed())
+		zr, err := zip.NewReader(bytes.NewReader(file), int64(len(file)))
+		Expect(err).To(BeNil())
+		returnedFiles = map[string][]byte{}
+		for _, f := range zr.File {
+			r, err := f.Open()
+			Expect(err).To(BeNil())
+			returnedFiles[f.Name], err = io.ReadAll(r)
+			Expect(err).To(BeNil())
+			r.Close()
+		}
+	})
+
+	Context("version 2", func() {
+		It("should write the metadata of the store", func() {
+			Expect(returnedError).To(BeNil())
+			Expect(readJSON(".zattrs")).To(HaveKeyWithValue("constellation", "sentinel2"))
+			Expect(readJSON(".zmetadata")["metadata"]).To(HaveKey("rgb_G/.zarray"))
+			Expect(readJSON("time/.zattrs")).To(HaveKeyWithValue("units", "seconds since 1970-01-01 00:00:00"))
+			zarray := readJSON("rgb_R/.zarray")
+			Expect(zarray["shape"]).To(Equal([]interface{}{2.0, 2.0, 2.0}))
+			Expect(zarray["dtype"]).To(Equal("<u2"))
+			zattrs := readJSON("rgb_R/.zattrs")
+			Expect(zattrs["_ARRAY_DIMENSIONS"]).To(Equal([]interface{}{"time", "y", "x"}))
+			Expect(zattrs).To(HaveKeyWithValue("scale_factor", 0.0001))
+			Expect(zattrs).To(HaveKeyWithValue("grid_mapping", "spatial_ref"))
+			Expect(readJSON("mask/.zattrs")).NotTo(HaveKey("scale_factor"))
+			Expect(readJSON("mask/.zarray")["fill_value"]).To(Equal(255.0))
+		})
+
+		It("should write the chunks in little endian", func() {
+			Expect(returnedError).To(BeNil())
+			Expect(returnedFiles).To(HaveKey("rgb_G/1.0.0"))
+			r, err := zlib.NewReader(bytes.NewReader(returnedFiles["rgb_G/1.0.0"]))
+			Expect(err).To(BeNil())
+			data, err := io.ReadAll(r)
+			Expect(err).To(BeNil())
+			Expect(data).To(Equal([]byte{11, 0, 11, 0, 11, 0, 11, 0}))
+
+			times := returnedFiles["time/0"]
+			Expect(times).To(HaveLen(16))
+			Expect(binary.LittleEndian.Uint64(times[8:])).To(Equal(uint64(0x408f400000000000))) // 1000.
+			Expect(returnedFiles["x/0"]).To(HaveLen(16))
+		})
+
+		It("should remove its temporary file from the work directory", func() {
+			Expect(returnedError).To(BeNil())
+			Expect(os.ReadDir(workDir)).To(BeEmpty())
+		})
+	})
+
+	Context("version 3", func() {
+		BeforeEach(func() {
+			version = 3
+		})
+		It("should write the metadata of the store", func() {
+			Expect(returnedError).To(BeNil())
+			Expect(readJSON("zarr.json")).To(HaveKeyWithValue("node_type", "group"))
+			array := readJSON("rgb_R/zarr.json")
+			Expect(array).To(HaveKeyWithValue("data_type", "uint16"))
+			Expect(array["dimension_names"]).To(Equal([]interface{}{"time", "y", "x"}))
+			Expect(returnedFiles).To(HaveKey("mask/c/1/0/0"))
+		})
+	})
+
+	Context("with a cube exceeding the maximum size", func() {
+		var maxSize int64
+		BeforeEach(func() {
+			maxSize, image.MaxCubeFileSize = image.MaxCubeFileSize, 30
+		})
+		AfterEach(func() {
+			image.MaxCubeFileSize = maxSize
+		})
+		It("should return a validation error", func() {
+			Expect(geocube.IsError(returnedError, geocube.EntityValidationError)).To(BeTrue())
+		})
+	})
+
+	Context("with twice the same name", func() {
+		BeforeEach(func() {
+			desc.Variables[1].Name = "rgb_R"
+		})
+		It("should return an error", func() {
+			Expect(returnedError).NotTo(BeNil())
+		})
+	})
+})
@@ -0,0 +1,350 @@
+package image
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/airbusgeo/geocube/internal/geocube"
+	"github.com/airbusgeo/geocube/internal/utils/affine"
+	"github.com/airbusgeo/geocube/internal/utils/bitmap"
+	"github.com/airbusgeo/geocube/internal/utils/proj"
+	"github.com/airbusgeo/godal"
+	"github.com/twpayne/go-geom"
+	"github.com/twpayne/go-geom/encoding/wkb"
+)
+
+const (
+	// zonalMaxPixels is the maximum number of pixels of a zone in the grid of a dataset
+	zonalMaxPixels = 2048 * 2048
+	// zonalSuperSampling is the number of sub-pixels (in each direction) used to compute the fraction of a pixel covered by a zone
+	zonalSuperSampling = 4
+)
+
+// ZonalStatistic is a statistic of the values of a zone
+type ZonalStatistic int
+
+// Same order as in the protobuf
+const (
+	ZonalMean ZonalStatistic = iota
+	ZonalMin
+	ZonalMax
+	ZonalStddev
+	ZonalSum   // Weighted sum of the values
+	ZonalCount // Sum of the weights of the valid pixels
+	ZonalMedian
+)
+
+// ZonalStatisticsOptions defines the statistics of a zone and how the pixels of the zone are selected
+type ZonalStatisticsOptions struct {
+	Statistics    []ZonalStatistic
+	Percentiles   []float64 // In [0, 100]
+	HistogramBins int       // Number of bins of the histogram (no histogram if 0)
+	HistogramMin  float64   // Lower bound of the first bin
+	HistogramMax  float64   // Upper bound of the last bin (included)
+	AllTouched    bool      // All the pixels touched by the zone are selected (otherwise, the pixels whose center is inside)
+	Weighted      bool      // Each pixel is weighted by the fraction of its area covered by the zone (otherwise, 1)
+}
+
+// ZonalStatistics are the statistics of a band inside a zone
+type ZonalStatistics struct {
+	Values      []float64 // One per statistic of the options (NaN if no valid pixel, except ZonalSum and ZonalCount)
+	Percentiles []float64 // One per percentile of the options (NaN if no valid pixel)
+	Histogram   []float64 // Sum of the weights of the valid pixels in each bin
+	NbPixels    int       // Number of valid pixels
+}
+
+// ZonalStatisticsReader computes the statistics of zones in datasets.
+// The datasets whose grid is used are kept open until Close, so that they are opened once for all the zones and the records.
+// A ZonalStatisticsReader is not safe for concurrent use.
+type ZonalStatisticsReader struct {
+	grids map[string]*zonalGrid // Opened datasets by GDAL uri
+}
+
+// zonalGrid is the grid of an opened dataset
+type zonalGrid struct {
+	ds       *godal.Dataset
+	pixToCRS *affine.Affine
+	crsToPix *affine.Affine
+}
+
+// NewZonalStatisticsReader creates a reader of the statistics of zones
+// The caller is responsible to close the reader.
+func NewZonalStatisticsReader() *ZonalStatisticsReader {
+	return &ZonalStatisticsReader{grids: map[string]*zonalGrid{}}
+}
+
+// Close closes the datasets opened by the reader
+func (r *ZonalStatisticsReader) Close() {
+	for _, g := range r.grids {
+		g.ds.Close()
+	}
+	r.grids = map[string]*zonalGrid{}
+}
+
+// Compute merges the datasets (as in MergeDatasets) on the grid of the first dataset (CRS and resolution) covering the zone,
+// and returns the statistics of each band inside the zone. The zone is a (multi)polygon in geographic coordinates (lon/lat).
+// outDesc defines the bands, the resampling and the dataformat of the merged datasets: the statistics are computed on the real values (see DataMapping.RangeExt).
+func (r *ZonalStatisticsReader) Compute(ctx context.Context, datasets []*Dataset, zone *geom.MultiPolygon, outDesc GdalDatasetDescriptor, options *ZonalStatisticsOptions) ([]ZonalStatistics, error) {
+	if len(datasets) == 0 {
+		return nil, fmt.Errorf("ZonalStatisticsReader.Compute: no dataset")
+	}
+	grid, err := r.grid(datasets[0])
+	if err != nil {
+		return nil, fmt.Errorf("ZonalStatisticsReader.Compute.%w", err)
+	}
+	weights, err := grid.zone(zone, &outDesc, options)
+	if err != nil {
+		return nil, fmt.Errorf("ZonalStatisticsReader.Compute.%w", err)
+	}
+
+	// Merge the datasets and read their real values
+	outDesc.DataMapping = geocube.DataMapping{
+		DataFormat: geocube.DataFormat{DType: bitmap.DTypeFLOAT64, NoData: math.NaN(), Range: outDesc.DataMapping.RangeExt},
+		RangeExt:   outDesc.DataMapping.RangeExt,
+		Exponent:   1,
+	}
+	outDesc.ValidPixPc = -1
+	outDesc.FileOut = ""
+	outDesc.CreationParams = nil
+	ds, err := MergeDatasets(ctx, datasets, &outDesc)
+	if err != nil {
+		return nil, fmt.Errorf("ZonalStatisticsReader.Compute.%w", err)
+	}
+	defer ds.Close()
+	nbPixels := outDesc.Width * outDesc.Height
+	values := make([]float64, nbPixels*outDesc.Bands)
+	if err := ds.Read(0, 0, values, outDesc.Width, outDesc.Height); err != nil {
+		return nil, fmt.Errorf("ZonalStatisticsReader.Compute.Read: %w", err)
+	}
+
+	stats := make([]ZonalStatistics, outDesc.Bands)
+	bandValues := make([]float64, nbPixels)
+	for b := range stats {
+		for i := range bandValues {
+			bandValues[i] = values[i*outDesc.Bands+b]
+		}
+		stats[b] = zonalStatistics(bandValues, weights, options)
+	}
+	return stats, nil
+}
+
+// grid returns the grid of the dataset (opening it if necessary)
+func (r *ZonalStatisticsReader) grid(dataset *Dataset) (*zonalGrid, error) {
+	gdalURI := dataset.GDALURI()
+	if g, ok := r.grids[gdalURI]; ok {
+		return g, nil
+	}
+	ds, err := godal.Open(gdalURI, ErrLogger)
+	if err != nil {
+		return nil, fmt.Errorf("grid.Open[%s]: %w", gdalURI, err)
+	}
+	gt, err := ds.GeoTransform()
+	if err != nil {
+		ds.Close()
+		return nil, fmt.Errorf("grid.GeoTransform: %w", err)
+	}
+	pixToCRS := affine.Affine(gt)
+	if !pixToCRS.IsInvertible() {
+		ds.Close()
+		return nil, fmt.Errorf("grid: the geotransform of %s is not invertible", gdalURI)
+	}
+	g := &zonalGrid{ds: ds, pixToCRS: &pixToCRS, crsToPix: pixToCRS.Inverse()}
+	r.grids[gdalURI] = g
+	return g, nil
+}
+
+// zone sets the CRS, the transform and the size of outDesc to the window of the grid covering the zone
+// and returns the weight of each pixel of the window (0 outside the zone).
+func (g *zonalGrid) zone(zone *geom.MultiPolygon, outDesc *GdalDatasetDescriptor, options *ZonalStatisticsOptions) ([]float64, error) {
+	ds, pixToCRS, crsToPix := g.ds, g.pixToCRS, g.crsToPix
+
+	// Zone in the CRS of the dataset
+	lonLat, err := proj.CRSFromEPSG(4326)
+	if err != nil {
+		return nil, fmt.Errorf("zone.%w", err)
+	}
+	b, err := wkb.Marshal(zone, wkb.NDR)
+	if err != nil {
+		return nil, fmt.Errorf("zone.Marshal: %w", err)
+	}
+	geometry, err := godal.NewGeometryFromWKB(b, lonLat)
+	if err != nil {
+		return nil, fmt.Errorf("zone.NewGeometryFromWKB: %w", err)
+	}
+	defer geometry.Close()
+	if err := geometry.Reproject(ds.SpatialRef()); err != nil {
+		return nil, fmt.Errorf("zone.Reproject: %w", err)
+	}
+	bounds, err := geometry.Bounds()
+	if err != nil {
+		return nil, fmt.Errorf("zone.Bounds: %w", err)
+	}
+
+	// Window of the grid of the dataset covering the zone
+	i0, j0, i1, j1 := math.Inf(1), math.Inf(1), math.Inf(-1), math.Inf(-1)
+	for _, corner := range [][2]float64{{bounds[0], bounds[1]}, {bounds[0], bounds[3]}, {bounds[2], bounds[1]}, {bounds[2], bounds[3]}} {
+		i, j := crsToPix.Transform(corner[0], corner[1])
+		i0, j0, i1, j1 = math.Min(i0, i), math.Min(j0, j), math.Max(i1, i), math.Max(j1, j)
+	}
+	i0, j0, i1, j1 = math.Floor(i0), math.Floor(j0), math.Max(math.Ceil(i1), math.Floor(i0)+1), math.Max(math.Ceil(j1), math.Floor(j0)+1)
+	if (i1-i0)*(j1-j0) > zonalMaxPixels {
+		return nil, geocube.NewValidationError("the zone is too large (%vx%v pixels)", i1-i0, j1-j0)
+	}
+	outDesc.WktCRS = ds.Projection()
+	outDesc.PixToCRS = pixToCRS.Multiply(affine.Translation(i0, j0))
+	outDesc.Width, outDesc.Height = int(i1-i0), int(j1-j0)
+
+	// Rasterize the zone
+	selection, err := rasterizeZone(geometry, outDesc, 1, options.AllTouched)
+	if err != nil {
+		return nil, fmt.Errorf("zone.%w", err)
+	}
+	var coverage []byte
+	if options.Weighted {
+		if coverage, err = rasterizeZone(geometry, outDesc, zonalSuperSampling, false); err != nil {
+			return nil, fmt.Errorf("zone.%w", err)
+		}
+	}
+	return zoneWeights(selection, coverage, outDesc.Width, outDesc.Height, zonalSuperSampling), nil
+}
+
+// rasterizeZone rasterizes the zone on the grid of outDesc, each pixel being divided into factor x factor sub-pixels
+func rasterizeZone(geometry *godal.Geometry, outDesc *GdalDatasetDescriptor, factor int, allTouched bool) ([]byte, error) {
+	width, height := outDesc.Width*factor, outDesc.Height*factor
+	ds, err := godal.Create(godal.Memory, "", 1, godal.Byte, width, height)
+	if err != nil {
+		return nil, fmt.Errorf("rasterizeZone.Create: %w", err)
+	}
+	defer ds.Close()
+	if err := ds.SetGeoTransform(*outDesc.PixToCRS.Multiply(affine.Scale(1/float64(factor), 1/float64(factor)))); err != nil {
+		return nil, fmt.Errorf("rasterizeZone.SetGeoTransform: %w", err)
+	}
+	if err := ds.SetProjection(outDesc.WktCRS); err != nil {
+		return nil, fmt.Errorf("rasterizeZone.SetProjection: %w", err)
+	}
+	opts := []godal.RasterizeGeometryOption{godal.Bands(0), godal.Values(1), ErrLogger}
+	if allTouched {
+		opts = append(opts, godal.AllTouched())
+	}
+	if err := ds.RasterizeGeometry(geometry, opts...); err != nil {
+		return nil, fmt.Errorf("rasterizeZone.RasterizeGeometry: %w", err)
+	}
+	mask := make([]byte, width*height)
+	if err := ds.Read(0, 0, mask, width, height); err != nil {
+		return nil, fmt.Errorf("rasterizeZone.Read: %w", err)
+	}
+	return mask, nil
+}
+
+// zoneWeights returns the weight of each pixel given the selected pixels (width x height)
+// and the sub-pixels covered by the zone (width*factor x height*factor, nil if the pixels are not weighted).
+// The weight of a selected pixel is the fraction of its sub-pixels covered by the zone (at least one sub-pixel).
+func zoneWeights(selection, coverage []byte, width, height, factor int) []float64 {
+	weights := make([]float64, width*height)
+	for j := 0; j < height; j++ {
+		for i := 0; i < width; i++ {
+			p := j*width + i
+			if selection[p] == 0 {
+				continue
+			}
+			if coverage == nil {
+				weights[p] = 1
+				continue
+			}
+			covered := 0
+			for sj := j * factor; sj < (j+1)*factor; sj++ {
+				for si := i * factor; si < (i+1)*factor; si++ {
+					if coverage[sj*width*factor+si] != 0 {
+						covered++
+					}
+				}
+			}
+			weights[p] = float64(max(covered, 1)) / float64(factor*factor)
+		}
+	}
+	return weights
+}
+
+// zonalStatistics computes the weighted statistics of the values (NaN if not valid) whose weight is not null
+func zonalStatistics(values, weights []float64, options *ZonalStatisticsOptions) ZonalStatistics {
+	type weightedValue struct{ v, w float64 }
+	var valid []weightedValue
+	sum, sumW, minV, maxV := 0.0, 0.0, math.Inf(1), math.Inf(-1)
+	for i, v := range values {
+		if w := weights[i]; w > 0 && !math.IsNaN(v) {
+			valid = append(valid, weightedValue{v: v, w: w})
+			sum += w * v
+			sumW += w
+			minV, maxV = math.Min(minV, v), math.Max(maxV, v)
+		}
+	}
+
+	stats := ZonalStatistics{NbPixels: len(valid)}
+	if options.HistogramBins > 0 {
+		stats.Histogram = make([]float64, options.HistogramBins)
+		binWidth := (options.HistogramMax - options.HistogramMin) / float64(options.HistogramBins)
+		for _, wv := range valid {
+			if wv.v < options.HistogramMin || wv.v > options.HistogramMax || binWidth <= 0 {
+				continue
+			}
+			bin := min(int((wv.v-options.HistogramMin)/binWidth), options.HistogramBins-1)
+			stats.Histogram[bin] += wv.w
+		}
+	}
+
+	// percentile returns the weighted percentile p (the first value whose cumulated weight reaches p% of the total weight)
+	sorted := false
+	percentile := func(p float64) float64 {
+		if len(valid) == 0 {
+			return math.NaN()
+		}
+		if !sorted {
+			sort.Slice(valid, func(i, j int) bool { return valid[i].v < valid[j].v })
+			sorted = true
+		}
+		target, cumW := p/100*sumW, 0.0
+		for _, wv := range valid {
+			if cumW += wv.w; cumW >= target {
+				return wv.v
+			}
+		}
+		return valid[len(valid)-1].v
+	}
+
+	mean := math.NaN()
+	if len(valid) > 0 {
+		mean = sum / sumW
+	} else {
+		minV, maxV = math.NaN(), math.NaN()
+	}
+	stats.Values = make([]float64, len(options.Statistics))
+	for i, s := range options.Statistics {
+		switch s {
+		case ZonalMean:
+			stats.Values[i] = mean
+		case ZonalMin:
+			stats.Values[i] = minV
+		case ZonalMax:
+			stats.Values[i] = maxV
+		case ZonalStddev:
+			variance := 0.0
+			for _, wv := range valid {
+				variance += wv.w * (wv.v - mean) * (wv.v - mean)
+			}
+			stats.Values[i] = math.Sqrt(variance / sumW)
+		case ZonalSum:
+			stats.Values[i] = sum
+		case ZonalCount:
+			stats.Values[i] = sumW
+		case ZonalMedian:
+			stats.Values[i] = percentile(50)
+		}
+	}
+	stats.Percentiles = make([]float64, len(options.Percentiles))
+	for i, p := range options.Percentiles {
+		stats.Percentiles[i] = percentile(p)
+	}
+	return stats
+}
@@ -0,0 +1,75 @@
+package image_test
+
+import (
+	"math"
+
+	"github.com/airbusgeo/geocube/internal/image"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ZonalStatistics", func() {
+
+	var options = image.ZonalStatisticsOptions{
+		Statistics: []image.ZonalStatistic{image.ZonalMean, image.ZonalMin, image.ZonalMax, image.ZonalStddev, image.ZonalSum, image.ZonalCount, image.ZonalMedian},
+	}
+
+	Describe("ZoneWeights", func() {
+		It("should return the selected pixels", func() {
+			Expect(image.ZoneWeights([]byte{0, 1, 1, 0}, nil, 2, 2, 2)).To(Equal([]float64{0, 1, 1, 0}))
+		})
+
+		It("should weight the selected pixels by their coverage", func() {
+			coverage := []byte{
+				1, 1, 0, 0,
+				1, 1, 0, 0,
+				1, 0, 0, 0,
+				0, 0, 0, 0,
+			}
+			Expect(image.ZoneWeights([]byte{1, 1, 1, 0}, coverage, 2, 2, 2)).To(Equal([]float64{1, 0.25, 0.25, 0}))
+		})
+	})
+
+	Describe("zonalStatistics", func() {
+		It("should compute the statistics of the valid pixels of the zone", func() {
+			values := []float64{1, 2, 3, math.NaN(), 100}
+			weights := []float64{1, 1, 1, 1, 0}
+			stats := image.ZonalStatisticsOf(values, weights, &options)
+			Expect(stats.NbPixels).To(Equal(3))
+			Expect(stats.Values[:3]).To(Equal([]float64{2, 1, 3}))
+			Expect(stats.Values[3]).To(BeNumerically("~", math.Sqrt(2./3), 1e-12))
+			Expect(stats.Values[4:]).To(Equal([]float64{6, 3, 2}))
+		})
+
+		It("should weight the statistics", func() {
+			values := []float64{1, 2, 4}
+			weights := []float64{0.5, 0.25, 0.25}
+			o := options
+			o.Percentiles = []float64{0, 50, 60, 100}
+			o.HistogramBins, o.HistogramMin, o.HistogramMax = 2, 0, 4
+			stats := image.ZonalStatisticsOf(values, weights, &o)
+			Expect(stats.NbPixels).To(Equal(3))
+			Expect(stats.Values).To(Equal([]float64{2, 1, 4, math.Sqrt(1.5), 2, 1, 1}))
+			Expect(stats.Percentiles).To(Equal([]float64{1, 1, 2, 4}))
+			Expect(stats.Histogram).To(Equal([]float64{0.5, 0.5}))
+		})
+
+		It("should return NaN if the zone has no valid pixel", func() {
+			o := options
+			o.Percentiles = []float64{50}
+			o.HistogramBins, o.HistogramMin, o.HistogramMax = 2, 0, 4
+			stats := image.ZonalStatisticsOf([]float64{math.NaN(), 1}, []float64{1, 0}, &o)
+			Expect(stats.NbPixels).To(Equal(0))
+			for i, s := range o.Statistics {
+				if s == image.ZonalSum || s == image.ZonalCount {
+					Expect(stats.Values[i]).To(Equal(0.))
+				} else {
+					Expect(math.IsNaN(stats.Values[i])).To(BeTrue())
+				}
+			}
+			Expect(math.IsNaN(stats.Percentiles[0])).To(BeTrue())
+			Expect(stats.Histogram).To(Equal([]float64{0, 0}))
+		})
+	})
+})
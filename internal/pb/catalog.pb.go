@@ -9,6 +9,7 @@ package geocube
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -73,8 +74,11 @@ func (ByteOrder) EnumDescriptor() ([]byte, []int) {
 type FileFormat int32
 
 const (
-	FileFormat_Raw   FileFormat = 0 // raw bitmap
-	FileFormat_GTiff FileFormat = 1
+	FileFormat_Raw     FileFormat = 0 // raw bitmap
+	FileFormat_GTiff   FileFormat = 1
+	FileFormat_NetCDF4 FileFormat = 2 // The whole cube as a single NetCDF4 file (CF conventions), with one variable per band group (and per band) along the time, y and x dimensions
+	FileFormat_ZarrV2  FileFormat = 3 // The whole cube as a single Zarr v2 store in a zip archive, with the same structure as NetCDF4
+	FileFormat_ZarrV3  FileFormat = 4 // The whole cube as a single Zarr v3 store in a zip archive, with the same structure as NetCDF4
 )
 
 // Enum value maps for FileFormat.
@@ -82,10 +86,16 @@ var (
 	FileFormat_name = map[int32]string{
 		0: "Raw",
 		1: "GTiff",
+		2: "NetCDF4",
+		3: "ZarrV2",
+		4: "ZarrV3",
 	}
 	FileFormat_value = map[string]int32{
-		"Raw":   0,
-		"GTiff": 1,
+		"Raw":     0,
+		"GTiff":   1,
+		"NetCDF4": 2,
+		"ZarrV2":  3,
+		"ZarrV3":  4,
 	}
 )
 
@@ -116,6 +126,125 @@ func (FileFormat) EnumDescriptor() ([]byte, []int) {
 	return file_pb_catalog_proto_rawDescGZIP(), []int{1}
 }
 
+type Compositing_Reducer int32
+
+const (
+	Compositing_MEDIAN     Compositing_Reducer = 0
+	Compositing_MEAN       Compositing_Reducer = 1
+	Compositing_MIN        Compositing_Reducer = 2
+	Compositing_MAX        Compositing_Reducer = 3
+	Compositing_COUNT      Compositing_Reducer = 4 // Number of valid observations (in the dataformat of the variable)
+	Compositing_BEST_PIXEL Compositing_Reducer = 5 // Values of the observation having the best quality (see quality_instance_id)
+)
+
+// Enum value maps for Compositing_Reducer.
+var (
+	Compositing_Reducer_name = map[int32]string{
+		0: "MEDIAN",
+		1: "MEAN",
+		2: "MIN",
+		3: "MAX",
+		4: "COUNT",
+		5: "BEST_PIXEL",
+	}
+	Compositing_Reducer_value = map[string]int32{
+		"MEDIAN":     0,
+		"MEAN":       1,
+		"MIN":        2,
+		"MAX":        3,
+		"COUNT":      4,
+		"BEST_PIXEL": 5,
+	}
+)
+
+func (x Compositing_Reducer) Enum() *Compositing_Reducer {
+	p := new(Compositing_Reducer)
+	*p = x
+	return p
+}
+
+func (x Compositing_Reducer) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Compositing_Reducer) Descriptor() protoreflect.EnumDescriptor {
+	return file_pb_catalog_proto_enumTypes[2].Descriptor()
+}
+
+func (Compositing_Reducer) Type() protoreflect.EnumType {
+	return &file_pb_catalog_proto_enumTypes[2]
+}
+
+func (x Compositing_Reducer) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Compositing_Reducer.Descriptor instead.
+func (Compositing_Reducer) EnumDescriptor() ([]byte, []int) {
+	return file_pb_catalog_proto_rawDescGZIP(), []int{10, 0}
+}
+
+type GetZonalStatisticsRequest_Statistic int32
+
+const (
+	GetZonalStatisticsRequest_MEAN   GetZonalStatisticsRequest_Statistic = 0
+	GetZonalStatisticsRequest_MIN    GetZonalStatisticsRequest_Statistic = 1
+	GetZonalStatisticsRequest_MAX    GetZonalStatisticsRequest_Statistic = 2
+	GetZonalStatisticsRequest_STDDEV GetZonalStatisticsRequest_Statistic = 3
+	GetZonalStatisticsRequest_SUM    GetZonalStatisticsRequest_Statistic = 4 // Weighted sum of the values
+	GetZonalStatisticsRequest_COUNT  GetZonalStatisticsRequest_Statistic = 5 // Sum of the weights of the valid pixels (number of valid pixels if not weighted)
+	GetZonalStatisticsRequest_MEDIAN GetZonalStatisticsRequest_Statistic = 6
+)
+
+// Enum value maps for GetZonalStatisticsRequest_Statistic.
+var (
+	GetZonalStatisticsRequest_Statistic_name = map[int32]string{
+		0: "MEAN",
+		1: "MIN",
+		2: "MAX",
+		3: "STDDEV",
+		4: "SUM",
+		5: "COUNT",
+		6: "MEDIAN",
+	}
+	GetZonalStatisticsRequest_Statistic_value = map[string]int32{
+		"MEAN":   0,
+		"MIN":    1,
+		"MAX":    2,
+		"STDDEV": 3,
+		"SUM":    4,
+		"COUNT":  5,
+		"MEDIAN": 6,
+	}
+)
+
+func (x GetZonalStatisticsRequest_Statistic) Enum() *GetZonalStatisticsRequest_Statistic {
+	p := new(GetZonalStatisticsRequest_Statistic)
+	*p = x
+	return p
+}
+
+func (x GetZonalStatisticsRequest_Statistic) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetZonalStatisticsRequest_Statistic) Descriptor() protoreflect.EnumDescriptor {
+	return file_pb_catalog_proto_enumTypes[3].Descriptor()
+}
+
+func (GetZonalStatisticsRequest_Statistic) Type() protoreflect.EnumType {
+	return &file_pb_catalog_proto_enumTypes[3]
+}
+
+func (x GetZonalStatisticsRequest_Statistic) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetZonalStatisticsRequest_Statistic.Descriptor instead.
+func (GetZonalStatisticsRequest_Statistic) EnumDescriptor() ([]byte, []int) {
+	return file_pb_catalog_proto_rawDescGZIP(), []int{22, 0}
+}
+
 // *
 // Shape of an image width x height x channels
 type Shape struct {
@@ -203,6 +332,7 @@ type ImageHeader struct {
 	GroupedRecords *GroupedRecords  `protobuf:"bytes,11,opt,name=grouped_records,json=groupedRecords,proto3" json:"grouped_records,omitempty"` // Group of records used to generate this image
 	DatasetMeta    *DatasetMeta     `protobuf:"bytes,10,opt,name=dataset_meta,json=datasetMeta,proto3" json:"dataset_meta,omitempty"`          // All information on the underlying datasets that composed the image
 	Error          string           `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`                                          // If not empty, an error occured and the image was not retrieved.
+	BandGroups     []*BandGroup     `protobuf:"bytes,12,rep,name=band_groups,json=bandGroups,proto3" json:"band_groups,omitempty"`             // Groups of bands of the image, one per instance (with several instances, "data + ImageChunk.data" is the concatenation of the groups and dtype is the type of the first group. With mixed_dtypes, each group has its own dformat.dtype)
 }
 
 func (x *ImageHeader) Reset() {
@@ -307,6 +437,127 @@ func (x *ImageHeader) GetError() string {
 	return ""
 }
 
+func (x *ImageHeader) GetBandGroups() []*BandGroup {
+	if x != nil {
+		return x.BandGroups
+	}
+	return nil
+}
+
+// *
+// Bands of an image corresponding to one instance.
+// When several instances are requested, the array of bytes of an image is the concatenation of the groups of bands, in the order of the instances.
+type BandGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InstanceId    string      `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	Dformat       *DataFormat `protobuf:"bytes,2,opt,name=dformat,proto3" json:"dformat,omitempty"` // Output dataformat of the bands of the group
+	NbBands       int32       `protobuf:"varint,3,opt,name=nb_bands,json=nbBands,proto3" json:"nb_bands,omitempty"`
+	ResamplingAlg Resampling  `protobuf:"varint,4,opt,name=resampling_alg,json=resamplingAlg,proto3,enum=geocube.Resampling" json:"resampling_alg,omitempty"` // Resampling algorithm used for the reprojection of the group
+	Size          int64       `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`                                                                // Size of the group in the full array of bytes of the image (ImageHeader only)
+	Variable      string      `protobuf:"bytes,6,opt,name=variable,proto3" json:"variable,omitempty"`                                                         // Name of the variable of the group (used by the NetCDF4 and Zarr formats)
+	Instance      string      `protobuf:"bytes,7,opt,name=instance,proto3" json:"instance,omitempty"`                                                         // Name of the instance of the group (used by the NetCDF4 and Zarr formats)
+	Bands         []string    `protobuf:"bytes,8,rep,name=bands,proto3" json:"bands,omitempty"`                                                               // Name of the bands of the group (used by the NetCDF4 and Zarr formats)
+	Unit          string      `protobuf:"bytes,9,opt,name=unit,proto3" json:"unit,omitempty"`                                                                 // Unit of the variable of the group (used by the NetCDF4 and Zarr formats)
+}
+
+func (x *BandGroup) Reset() {
+	*x = BandGroup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_catalog_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BandGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BandGroup) ProtoMessage() {}
+
+func (x *BandGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_catalog_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BandGroup.ProtoReflect.Descriptor instead.
+func (*BandGroup) Descriptor() ([]byte, []int) {
+	return file_pb_catalog_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BandGroup) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *BandGroup) GetDformat() *DataFormat {
+	if x != nil {
+		return x.Dformat
+	}
+	return nil
+}
+
+func (x *BandGroup) GetNbBands() int32 {
+	if x != nil {
+		return x.NbBands
+	}
+	return 0
+}
+
+func (x *BandGroup) GetResamplingAlg() Resampling {
+	if x != nil {
+		return x.ResamplingAlg
+	}
+	return Resampling_UNDEFINED
+}
+
+func (x *BandGroup) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *BandGroup) GetVariable() string {
+	if x != nil {
+		return x.Variable
+	}
+	return ""
+}
+
+func (x *BandGroup) GetInstance() string {
+	if x != nil {
+		return x.Instance
+	}
+	return ""
+}
+
+func (x *BandGroup) GetBands() []string {
+	if x != nil {
+		return x.Bands
+	}
+	return nil
+}
+
+func (x *BandGroup) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
 // *
 // Chunk of the full image, to handle the GRPC limit of 4Mbytes/message
 type ImageChunk struct {
@@ -321,7 +572,7 @@ type ImageChunk struct {
 func (x *ImageChunk) Reset() {
 	*x = ImageChunk{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_catalog_proto_msgTypes[2]
+		mi := &file_pb_catalog_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -334,7 +585,7 @@ func (x *ImageChunk) String() string {
 func (*ImageChunk) ProtoMessage() {}
 
 func (x *ImageChunk) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_catalog_proto_msgTypes[2]
+	mi := &file_pb_catalog_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -347,7 +598,7 @@ func (x *ImageChunk) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImageChunk.ProtoReflect.Descriptor instead.
 func (*ImageChunk) Descriptor() ([]byte, []int) {
-	return file_pb_catalog_proto_rawDescGZIP(), []int{2}
+	return file_pb_catalog_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ImageChunk) GetPart() int32 {
@@ -377,7 +628,7 @@ type ImageFile struct {
 func (x *ImageFile) Reset() {
 	*x = ImageFile{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_catalog_proto_msgTypes[3]
+		mi := &file_pb_catalog_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -390,7 +641,7 @@ func (x *ImageFile) String() string {
 func (*ImageFile) ProtoMessage() {}
 
 func (x *ImageFile) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_catalog_proto_msgTypes[3]
+	mi := &file_pb_catalog_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -403,7 +654,7 @@ func (x *ImageFile) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImageFile.ProtoReflect.Descriptor instead.
 func (*ImageFile) Descriptor() ([]byte, []int) {
-	return file_pb_catalog_proto_rawDescGZIP(), []int{3}
+	return file_pb_catalog_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ImageFile) GetData() []byte {
@@ -426,12 +677,14 @@ type ListDatasetsRequest struct {
 	//	*ListDatasetsRequest_Records
 	//	*ListDatasetsRequest_Filters
 	RecordsLister isListDatasetsRequest_RecordsLister `protobuf_oneof:"records_lister"`
+	Limit         int32                               `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`                         // [Optional] Limit the number of datasets returned. The records are paginated by datetime and id: all the datasets of a record are returned in the same page, so a page may have more datasets than limit.
+	PageToken     string                              `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // [Optional] Continuation token returned by the previous page (ListDatasetsResponse.next_page_token)
 }
 
 func (x *ListDatasetsRequest) Reset() {
 	*x = ListDatasetsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_catalog_proto_msgTypes[4]
+		mi := &file_pb_catalog_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -444,7 +697,7 @@ func (x *ListDatasetsRequest) String() string {
 func (*ListDatasetsRequest) ProtoMessage() {}
 
 func (x *ListDatasetsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_catalog_proto_msgTypes[4]
+	mi := &file_pb_catalog_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -457,7 +710,7 @@ func (x *ListDatasetsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListDatasetsRequest.ProtoReflect.Descriptor instead.
 func (*ListDatasetsRequest) Descriptor() ([]byte, []int) {
-	return file_pb_catalog_proto_rawDescGZIP(), []int{4}
+	return file_pb_catalog_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ListDatasetsRequest) GetInstanceId() string {
@@ -488,6 +741,20 @@ func (x *ListDatasetsRequest) GetFilters() *RecordFilters {
 	return nil
 }
 
+func (x *ListDatasetsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListDatasetsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
 type isListDatasetsRequest_RecordsLister interface {
 	isListDatasetsRequest_RecordsLister()
 }
@@ -511,14 +778,15 @@ type ListDatasetsResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Records      []*Record      `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`                               // List of records
-	DatasetMetas []*DatasetMeta `protobuf:"bytes,2,rep,name=dataset_metas,json=datasetMetas,proto3" json:"dataset_metas,omitempty"` // For each record, list of the datasets
+	Records       []*Record      `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`                                    // List of records
+	DatasetMetas  []*DatasetMeta `protobuf:"bytes,2,rep,name=dataset_metas,json=datasetMetas,proto3" json:"dataset_metas,omitempty"`      // For each record, list of the datasets
+	NextPageToken string         `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Token to get the next page (empty if it is the last page)
 }
 
 func (x *ListDatasetsResponse) Reset() {
 	*x = ListDatasetsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_catalog_proto_msgTypes[5]
+		mi := &file_pb_catalog_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -531,7 +799,7 @@ func (x *ListDatasetsResponse) String() string {
 func (*ListDatasetsResponse) ProtoMessage() {}
 
 func (x *ListDatasetsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_catalog_proto_msgTypes[5]
+	mi := &file_pb_catalog_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -544,7 +812,7 @@ func (x *ListDatasetsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListDatasetsResponse.ProtoReflect.Descriptor instead.
 func (*ListDatasetsResponse) Descriptor() ([]byte, []int) {
-	return file_pb_catalog_proto_rawDescGZIP(), []int{5}
+	return file_pb_catalog_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ListDatasetsResponse) GetRecords() []*Record {
@@ -561,6 +829,13 @@ func (x *ListDatasetsResponse) GetDatasetMetas() []*DatasetMeta {
 	return nil
 }
 
+func (x *ListDatasetsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 // *
 // Request a cube of data
 type GetCubeRequest struct {
@@ -574,21 +849,24 @@ type GetCubeRequest struct {
 	//	*GetCubeRequest_Filters
 	//	*GetCubeRequest_GroupedRecords
 	RecordsLister    isGetCubeRequest_RecordsLister `protobuf_oneof:"records_lister"`
-	InstancesId      []string                       `protobuf:"bytes,3,rep,name=instances_id,json=instancesId,proto3" json:"instances_id,omitempty"`                                 // Instances of a variable defining the kind of images requested. At least one, and all must be instance of the same variable. Only one is actually supported
+	InstancesId      []string                       `protobuf:"bytes,3,rep,name=instances_id,json=instancesId,proto3" json:"instances_id,omitempty"`                                 // Instances defining the kind of images requested. At least one. With several instances (possibly of different variables), each image is the stack of one group of bands per instance (see ImageHeader.band_groups)
 	Crs              string                         `protobuf:"bytes,4,opt,name=crs,proto3" json:"crs,omitempty"`                                                                    // Coordinates Reference System of the output images (images will be reprojected on the fly if necessary)
 	PixToCrs         *GeoTransform                  `protobuf:"bytes,5,opt,name=pix_to_crs,json=pixToCrs,proto3" json:"pix_to_crs,omitempty"`                                        // GeoTransform of the requested cube (images will be rescaled on the fly if necessary)
 	Size             *Size                          `protobuf:"bytes,6,opt,name=size,proto3" json:"size,omitempty"`                                                                  // Shape of the output images
 	CompressionLevel int32                          `protobuf:"varint,7,opt,name=compression_level,json=compressionLevel,proto3" json:"compression_level,omitempty"`                 // Define a level of compression to speed up the transfer, values: -3 to 9 (-2: Huffman only, -1:default, 0->9: level of compression from the fastest to the best compression, -3: disable the compression). The data is compressed by the server and decompressed by the Client. Use -3 or -2 if the bandwidth is not limited. 0 is level 0 of DEFLATE (thus, it must be decompressed by DEFLATE even though the data is not compressed). If the client can support -3, 0 is useless.
 	HeadersOnly      bool                           `protobuf:"varint,8,opt,name=headers_only,json=headersOnly,proto3" json:"headers_only,omitempty"`                                // Only returns headers (including all metadatas on datasets)
-	Format           FileFormat                     `protobuf:"varint,9,opt,name=format,proto3,enum=geocube.FileFormat" json:"format,omitempty"`                                     // Format of the output images
+	Format           FileFormat                     `protobuf:"varint,9,opt,name=format,proto3,enum=geocube.FileFormat" json:"format,omitempty"`                                     // Format of the output images. With NetCDF4 and Zarr formats, the cube is returned as a single image, whose data is the file
 	ResamplingAlg    Resampling                     `protobuf:"varint,10,opt,name=resampling_alg,json=resamplingAlg,proto3,enum=geocube.Resampling" json:"resampling_alg,omitempty"` // Resampling algorithm used for reprojecion. If undefined, the default resampling algorithm associated to the variable is used.
 	ProtocolV11X     bool                           `protobuf:"varint,13,opt,name=protocol_v11x,json=protocolV11x,proto3" json:"protocol_v11x,omitempty"`                            // For compatibility with older clients. Clients with version above 1.1.0 must set this field to true.
+	SkipIncomplete   bool                           `protobuf:"varint,14,opt,name=skip_incomplete,json=skipIncomplete,proto3" json:"skip_incomplete,omitempty"`                      // With several instances, skip the images that do not have a dataset for each instance (otherwise, the missing instances are filled with nodata)
+	Compositing      *Compositing                   `protobuf:"bytes,15,opt,name=compositing,proto3" json:"compositing,omitempty"`                                                   // If defined, the records are binned by period and one temporal composite is returned by period (instead of one image by record or group of records)
+	MixedDtypes      bool                           `protobuf:"varint,16,opt,name=mixed_dtypes,json=mixedDtypes,proto3" json:"mixed_dtypes,omitempty"`                               // With several instances of different data types, return the concatenation of the groups, each in the data type of its BandGroup (otherwise, the instances must have the same data type). Always allowed with the NetCDF4 and Zarr formats.
 }
 
 func (x *GetCubeRequest) Reset() {
 	*x = GetCubeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_catalog_proto_msgTypes[6]
+		mi := &file_pb_catalog_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -601,7 +879,7 @@ func (x *GetCubeRequest) String() string {
 func (*GetCubeRequest) ProtoMessage() {}
 
 func (x *GetCubeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_catalog_proto_msgTypes[6]
+	mi := &file_pb_catalog_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -614,7 +892,7 @@ func (x *GetCubeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCubeRequest.ProtoReflect.Descriptor instead.
 func (*GetCubeRequest) Descriptor() ([]byte, []int) {
-	return file_pb_catalog_proto_rawDescGZIP(), []int{6}
+	return file_pb_catalog_proto_rawDescGZIP(), []int{7}
 }
 
 func (m *GetCubeRequest) GetRecordsLister() isGetCubeRequest_RecordsLister {
@@ -708,6 +986,27 @@ func (x *GetCubeRequest) GetProtocolV11X() bool {
 	return false
 }
 
+func (x *GetCubeRequest) GetSkipIncomplete() bool {
+	if x != nil {
+		return x.SkipIncomplete
+	}
+	return false
+}
+
+func (x *GetCubeRequest) GetCompositing() *Compositing {
+	if x != nil {
+		return x.Compositing
+	}
+	return nil
+}
+
+func (x *GetCubeRequest) GetMixedDtypes() bool {
+	if x != nil {
+		return x.MixedDtypes
+	}
+	return false
+}
+
 type isGetCubeRequest_RecordsLister interface {
 	isGetCubeRequest_RecordsLister()
 }
@@ -731,37 +1030,33 @@ func (*GetCubeRequest_Filters) isGetCubeRequest_RecordsLister() {}
 func (*GetCubeRequest_GroupedRecords) isGetCubeRequest_RecordsLister() {}
 
 // *
-// Return global information on the requested cube
-type GetCubeResponseHeader struct {
+// Range of dates [from_time, to_time[
+type DateRange struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Count         int64         `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
-	NbDatasets    int64         `protobuf:"varint,2,opt,name=nb_datasets,json=nbDatasets,proto3" json:"nb_datasets,omitempty"`
-	RefDformat    *DataFormat   `protobuf:"bytes,3,opt,name=ref_dformat,json=refDformat,proto3" json:"ref_dformat,omitempty"`                                   // Output dataformat
-	ResamplingAlg Resampling    `protobuf:"varint,4,opt,name=resampling_alg,json=resamplingAlg,proto3,enum=geocube.Resampling" json:"resampling_alg,omitempty"` // Resampling algorithm to use for reprojection
-	Geotransform  *GeoTransform `protobuf:"bytes,5,opt,name=geotransform,proto3" json:"geotransform,omitempty"`                                                 // Geotransform used for mapping
-	Crs           string        `protobuf:"bytes,6,opt,name=crs,proto3" json:"crs,omitempty"`
+	FromTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from_time,json=fromTime,proto3" json:"from_time,omitempty"`
+	ToTime   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to_time,json=toTime,proto3" json:"to_time,omitempty"`
 }
 
-func (x *GetCubeResponseHeader) Reset() {
-	*x = GetCubeResponseHeader{}
+func (x *DateRange) Reset() {
+	*x = DateRange{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_catalog_proto_msgTypes[7]
+		mi := &file_pb_catalog_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetCubeResponseHeader) String() string {
+func (x *DateRange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCubeResponseHeader) ProtoMessage() {}
+func (*DateRange) ProtoMessage() {}
 
-func (x *GetCubeResponseHeader) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_catalog_proto_msgTypes[7]
+func (x *DateRange) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_catalog_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -772,42 +1067,281 @@ func (x *GetCubeResponseHeader) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCubeResponseHeader.ProtoReflect.Descriptor instead.
-func (*GetCubeResponseHeader) Descriptor() ([]byte, []int) {
-	return file_pb_catalog_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use DateRange.ProtoReflect.Descriptor instead.
+func (*DateRange) Descriptor() ([]byte, []int) {
+	return file_pb_catalog_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GetCubeResponseHeader) GetCount() int64 {
+func (x *DateRange) GetFromTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Count
+		return x.FromTime
 	}
-	return 0
+	return nil
 }
 
-func (x *GetCubeResponseHeader) GetNbDatasets() int64 {
+func (x *DateRange) GetToTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.NbDatasets
+		return x.ToTime
 	}
-	return 0
+	return nil
 }
 
-func (x *GetCubeResponseHeader) GetRefDformat() *DataFormat {
-	if x != nil {
-		return x.RefDformat
+type DateRanges struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ranges []*DateRange `protobuf:"bytes,1,rep,name=ranges,proto3" json:"ranges,omitempty"`
+}
+
+func (x *DateRanges) Reset() {
+	*x = DateRanges{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_catalog_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *GetCubeResponseHeader) GetResamplingAlg() Resampling {
-	if x != nil {
-		return x.ResamplingAlg
+func (x *DateRanges) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DateRanges) ProtoMessage() {}
+
+func (x *DateRanges) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_catalog_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return Resampling_UNDEFINED
+	return mi.MessageOf(x)
 }
 
-func (x *GetCubeResponseHeader) GetGeotransform() *GeoTransform {
+// Deprecated: Use DateRanges.ProtoReflect.Descriptor instead.
+func (*DateRanges) Descriptor() ([]byte, []int) {
+	return file_pb_catalog_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DateRanges) GetRanges() []*DateRange {
 	if x != nil {
-		return x.Geotransform
+		return x.Ranges
+	}
+	return nil
+}
+
+// *
+// Per-pixel temporal compositing of the records of a cube.
+// The records are binned by period. For each period, the observations (records or groups of records) are reduced pixel by pixel, ignoring the nodata.
+type Compositing struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reducer Compositing_Reducer `protobuf:"varint,1,opt,name=reducer,proto3,enum=geocube.Compositing_Reducer" json:"reducer,omitempty"`
+	// Types that are assignable to Binning:
+	//
+	//	*Compositing_PeriodDays
+	//	*Compositing_PeriodMonths
+	//	*Compositing_DateRanges
+	Binning             isCompositing_Binning  `protobuf_oneof:"binning"`
+	Origin              *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=origin,proto3" json:"origin,omitempty"`                                                           // Start of the first period (period_days: by default, from_time of the filters or the day of the first record; period_months: the month of the origin)
+	QualityInstanceId   string                 `protobuf:"bytes,6,opt,name=quality_instance_id,json=qualityInstanceId,proto3" json:"quality_instance_id,omitempty"`          // BEST_PIXEL: instance (one of instances_id) whose first band is the quality of the observations
+	QualityLowestIsBest bool                   `protobuf:"varint,7,opt,name=quality_lowest_is_best,json=qualityLowestIsBest,proto3" json:"quality_lowest_is_best,omitempty"` // BEST_PIXEL: the best observation has the lowest quality (highest by default)
+}
+
+func (x *Compositing) Reset() {
+	*x = Compositing{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_catalog_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Compositing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Compositing) ProtoMessage() {}
+
+func (x *Compositing) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_catalog_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Compositing.ProtoReflect.Descriptor instead.
+func (*Compositing) Descriptor() ([]byte, []int) {
+	return file_pb_catalog_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Compositing) GetReducer() Compositing_Reducer {
+	if x != nil {
+		return x.Reducer
+	}
+	return Compositing_MEDIAN
+}
+
+func (m *Compositing) GetBinning() isCompositing_Binning {
+	if m != nil {
+		return m.Binning
+	}
+	return nil
+}
+
+func (x *Compositing) GetPeriodDays() int32 {
+	if x, ok := x.GetBinning().(*Compositing_PeriodDays); ok {
+		return x.PeriodDays
+	}
+	return 0
+}
+
+func (x *Compositing) GetPeriodMonths() int32 {
+	if x, ok := x.GetBinning().(*Compositing_PeriodMonths); ok {
+		return x.PeriodMonths
+	}
+	return 0
+}
+
+func (x *Compositing) GetDateRanges() *DateRanges {
+	if x, ok := x.GetBinning().(*Compositing_DateRanges); ok {
+		return x.DateRanges
+	}
+	return nil
+}
+
+func (x *Compositing) GetOrigin() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Origin
+	}
+	return nil
+}
+
+func (x *Compositing) GetQualityInstanceId() string {
+	if x != nil {
+		return x.QualityInstanceId
+	}
+	return ""
+}
+
+func (x *Compositing) GetQualityLowestIsBest() bool {
+	if x != nil {
+		return x.QualityLowestIsBest
+	}
+	return false
+}
+
+type isCompositing_Binning interface {
+	isCompositing_Binning()
+}
+
+type Compositing_PeriodDays struct {
+	PeriodDays int32 `protobuf:"varint,2,opt,name=period_days,json=periodDays,proto3,oneof"` // Periods of N days
+}
+
+type Compositing_PeriodMonths struct {
+	PeriodMonths int32 `protobuf:"varint,3,opt,name=period_months,json=periodMonths,proto3,oneof"` // Periods of N calendar months
+}
+
+type Compositing_DateRanges struct {
+	DateRanges *DateRanges `protobuf:"bytes,4,opt,name=date_ranges,json=dateRanges,proto3,oneof"` // Explicit periods (possibly overlapping). The records outside the periods are ignored.
+}
+
+func (*Compositing_PeriodDays) isCompositing_Binning() {}
+
+func (*Compositing_PeriodMonths) isCompositing_Binning() {}
+
+func (*Compositing_DateRanges) isCompositing_Binning() {}
+
+// *
+// Return global information on the requested cube
+type GetCubeResponseHeader struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count         int64         `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"` // Number of images. Upper bound, as the images without valid pixels are not returned (exact with headers_only)
+	NbDatasets    int64         `protobuf:"varint,2,opt,name=nb_datasets,json=nbDatasets,proto3" json:"nb_datasets,omitempty"`
+	RefDformat    *DataFormat   `protobuf:"bytes,3,opt,name=ref_dformat,json=refDformat,proto3" json:"ref_dformat,omitempty"`                                   // Output dataformat
+	ResamplingAlg Resampling    `protobuf:"varint,4,opt,name=resampling_alg,json=resamplingAlg,proto3,enum=geocube.Resampling" json:"resampling_alg,omitempty"` // Resampling algorithm to use for reprojection
+	Geotransform  *GeoTransform `protobuf:"bytes,5,opt,name=geotransform,proto3" json:"geotransform,omitempty"`                                                 // Geotransform used for mapping
+	Crs           string        `protobuf:"bytes,6,opt,name=crs,proto3" json:"crs,omitempty"`
+	BandGroups    []*BandGroup  `protobuf:"bytes,7,rep,name=band_groups,json=bandGroups,proto3" json:"band_groups,omitempty"` // Groups of bands of the images, one per instance (ref_dformat and resampling_alg are those of the first group)
+}
+
+func (x *GetCubeResponseHeader) Reset() {
+	*x = GetCubeResponseHeader{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_catalog_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCubeResponseHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCubeResponseHeader) ProtoMessage() {}
+
+func (x *GetCubeResponseHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_catalog_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCubeResponseHeader.ProtoReflect.Descriptor instead.
+func (*GetCubeResponseHeader) Descriptor() ([]byte, []int) {
+	return file_pb_catalog_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetCubeResponseHeader) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GetCubeResponseHeader) GetNbDatasets() int64 {
+	if x != nil {
+		return x.NbDatasets
+	}
+	return 0
+}
+
+func (x *GetCubeResponseHeader) GetRefDformat() *DataFormat {
+	if x != nil {
+		return x.RefDformat
+	}
+	return nil
+}
+
+func (x *GetCubeResponseHeader) GetResamplingAlg() Resampling {
+	if x != nil {
+		return x.ResamplingAlg
+	}
+	return Resampling_UNDEFINED
+}
+
+func (x *GetCubeResponseHeader) GetGeotransform() *GeoTransform {
+	if x != nil {
+		return x.Geotransform
 	}
 	return nil
 }
@@ -819,6 +1353,13 @@ func (x *GetCubeResponseHeader) GetCrs() string {
 	return ""
 }
 
+func (x *GetCubeResponseHeader) GetBandGroups() []*BandGroup {
+	if x != nil {
+		return x.BandGroups
+	}
+	return nil
+}
+
 // *
 // Return either information on the cube, information on an image or a chunk of an image
 type GetCubeResponse struct {
@@ -837,7 +1378,7 @@ type GetCubeResponse struct {
 func (x *GetCubeResponse) Reset() {
 	*x = GetCubeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_catalog_proto_msgTypes[8]
+		mi := &file_pb_catalog_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -850,7 +1391,7 @@ func (x *GetCubeResponse) String() string {
 func (*GetCubeResponse) ProtoMessage() {}
 
 func (x *GetCubeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_catalog_proto_msgTypes[8]
+	mi := &file_pb_catalog_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -863,7 +1404,7 @@ func (x *GetCubeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCubeResponse.ProtoReflect.Descriptor instead.
 func (*GetCubeResponse) Descriptor() ([]byte, []int) {
-	return file_pb_catalog_proto_rawDescGZIP(), []int{8}
+	return file_pb_catalog_proto_rawDescGZIP(), []int{12}
 }
 
 func (m *GetCubeResponse) GetResponse() isGetCubeResponse_Response {
@@ -930,15 +1471,19 @@ type GetCubeMetadataRequest struct {
 	PixToCrs       *GeoTransform     `protobuf:"bytes,5,opt,name=pix_to_crs,json=pixToCrs,proto3" json:"pix_to_crs,omitempty"`
 	Crs            string            `protobuf:"bytes,6,opt,name=crs,proto3" json:"crs,omitempty"`
 	Size           *Size             `protobuf:"bytes,7,opt,name=size,proto3" json:"size,omitempty"`
-	Format         FileFormat        `protobuf:"varint,8,opt,name=format,proto3,enum=geocube.FileFormat" json:"format,omitempty"`          // Format of the output data
-	Predownload    bool              `protobuf:"varint,9,opt,name=predownload,proto3" json:"predownload,omitempty"`                        // Predownload the datasets before merging them. When the dataset is remote and all the dataset is required, it is more efficient to predownload it.
-	ProtocolV11X   bool              `protobuf:"varint,10,opt,name=protocol_v11x,json=protocolV11x,proto3" json:"protocol_v11x,omitempty"` // For compatibility with older clients. Clients with version above 1.1.0 must set this field to true.
+	Format         FileFormat        `protobuf:"varint,8,opt,name=format,proto3,enum=geocube.FileFormat" json:"format,omitempty"`                // Format of the output data
+	Predownload    bool              `protobuf:"varint,9,opt,name=predownload,proto3" json:"predownload,omitempty"`                              // Predownload the datasets before merging them. When the dataset is remote and all the dataset is required, it is more efficient to predownload it.
+	ProtocolV11X   bool              `protobuf:"varint,10,opt,name=protocol_v11x,json=protocolV11x,proto3" json:"protocol_v11x,omitempty"`       // For compatibility with older clients. Clients with version above 1.1.0 must set this field to true.
+	BandGroups     []*BandGroup      `protobuf:"bytes,11,rep,name=band_groups,json=bandGroups,proto3" json:"band_groups,omitempty"`              // Groups of bands of the cube, one per instance (provided by GetCubeResponseHeader.band_groups). If empty, ref_dformat and resampling_alg define a single group with all the datasets
+	SkipIncomplete bool              `protobuf:"varint,12,opt,name=skip_incomplete,json=skipIncomplete,proto3" json:"skip_incomplete,omitempty"` // With several band groups, skip the images that do not have a dataset for each group (otherwise, the missing groups are filled with nodata)
+	Compositing    *Compositing      `protobuf:"bytes,13,opt,name=compositing,proto3" json:"compositing,omitempty"`                              // If defined, each image is the temporal composite of the records of its group of records (the binning is ignored, the groups of records being the periods provided by GetCube). The datasets must have a record_id.
+	MixedDtypes    bool              `protobuf:"varint,14,opt,name=mixed_dtypes,json=mixedDtypes,proto3" json:"mixed_dtypes,omitempty"`          // With several band groups of different data types, return the concatenation of the groups, each in the data type of its BandGroup (see GetCubeRequest.mixed_dtypes)
 }
 
 func (x *GetCubeMetadataRequest) Reset() {
 	*x = GetCubeMetadataRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_catalog_proto_msgTypes[9]
+		mi := &file_pb_catalog_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -951,7 +1496,7 @@ func (x *GetCubeMetadataRequest) String() string {
 func (*GetCubeMetadataRequest) ProtoMessage() {}
 
 func (x *GetCubeMetadataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_catalog_proto_msgTypes[9]
+	mi := &file_pb_catalog_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -964,7 +1509,7 @@ func (x *GetCubeMetadataRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCubeMetadataRequest.ProtoReflect.Descriptor instead.
 func (*GetCubeMetadataRequest) Descriptor() ([]byte, []int) {
-	return file_pb_catalog_proto_rawDescGZIP(), []int{9}
+	return file_pb_catalog_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetCubeMetadataRequest) GetDatasetsMeta() []*DatasetMeta {
@@ -1037,6 +1582,34 @@ func (x *GetCubeMetadataRequest) GetProtocolV11X() bool {
 	return false
 }
 
+func (x *GetCubeMetadataRequest) GetBandGroups() []*BandGroup {
+	if x != nil {
+		return x.BandGroups
+	}
+	return nil
+}
+
+func (x *GetCubeMetadataRequest) GetSkipIncomplete() bool {
+	if x != nil {
+		return x.SkipIncomplete
+	}
+	return false
+}
+
+func (x *GetCubeMetadataRequest) GetCompositing() *Compositing {
+	if x != nil {
+		return x.Compositing
+	}
+	return nil
+}
+
+func (x *GetCubeMetadataRequest) GetMixedDtypes() bool {
+	if x != nil {
+		return x.MixedDtypes
+	}
+	return false
+}
+
 // *
 // Return either information on the cube, information on an image or a chunk of an image
 type GetCubeMetadataResponse struct {
@@ -1055,7 +1628,7 @@ type GetCubeMetadataResponse struct {
 func (x *GetCubeMetadataResponse) Reset() {
 	*x = GetCubeMetadataResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_catalog_proto_msgTypes[10]
+		mi := &file_pb_catalog_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1068,7 +1641,7 @@ func (x *GetCubeMetadataResponse) String() string {
 func (*GetCubeMetadataResponse) ProtoMessage() {}
 
 func (x *GetCubeMetadataResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_catalog_proto_msgTypes[10]
+	mi := &file_pb_catalog_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1081,7 +1654,7 @@ func (x *GetCubeMetadataResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCubeMetadataResponse.ProtoReflect.Descriptor instead.
 func (*GetCubeMetadataResponse) Descriptor() ([]byte, []int) {
-	return file_pb_catalog_proto_rawDescGZIP(), []int{10}
+	return file_pb_catalog_proto_rawDescGZIP(), []int{14}
 }
 
 func (m *GetCubeMetadataResponse) GetResponse() isGetCubeMetadataResponse_Response {
@@ -1157,7 +1730,7 @@ type GetTileRequest struct {
 func (x *GetTileRequest) Reset() {
 	*x = GetTileRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_catalog_proto_msgTypes[11]
+		mi := &file_pb_catalog_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1170,7 +1743,7 @@ func (x *GetTileRequest) String() string {
 func (*GetTileRequest) ProtoMessage() {}
 
 func (x *GetTileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_catalog_proto_msgTypes[11]
+	mi := &file_pb_catalog_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1183,7 +1756,7 @@ func (x *GetTileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTileRequest.ProtoReflect.Descriptor instead.
 func (*GetTileRequest) Descriptor() ([]byte, []int) {
-	return file_pb_catalog_proto_rawDescGZIP(), []int{11}
+	return file_pb_catalog_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GetTileRequest) GetInstanceId() string {
@@ -1278,7 +1851,7 @@ type GetTileResponse struct {
 func (x *GetTileResponse) Reset() {
 	*x = GetTileResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_catalog_proto_msgTypes[12]
+		mi := &file_pb_catalog_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1291,7 +1864,7 @@ func (x *GetTileResponse) String() string {
 func (*GetTileResponse) ProtoMessage() {}
 
 func (x *GetTileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_catalog_proto_msgTypes[12]
+	mi := &file_pb_catalog_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1304,7 +1877,7 @@ func (x *GetTileResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTileResponse.ProtoReflect.Descriptor instead.
 func (*GetTileResponse) Descriptor() ([]byte, []int) {
-	return file_pb_catalog_proto_rawDescGZIP(), []int{12}
+	return file_pb_catalog_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *GetTileResponse) GetImage() *ImageFile {
@@ -1314,300 +1887,1229 @@ func (x *GetTileResponse) GetImage() *ImageFile {
 	return nil
 }
 
-var File_pb_catalog_proto protoreflect.FileDescriptor
+// *
+// Location whose time series is extracted: a point or a small polygon
+type TimeSeriesLocation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_pb_catalog_proto_rawDesc = []byte{
-	0x0a, 0x10, 0x70, 0x62, 0x2f, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x12, 0x07, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x1a, 0x13, 0x70, 0x62, 0x2f,
-	0x64, 0x61, 0x74, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x1a, 0x10, 0x70, 0x62, 0x2f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x10, 0x70, 0x62, 0x2f, 0x6c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x73, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x70, 0x62, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74,
-	0x4d, 0x65, 0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x70, 0x62, 0x2f, 0x76,
-	0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x43,
-	0x0a, 0x05, 0x53, 0x68, 0x61, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x69, 0x6d, 0x31, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x64, 0x69, 0x6d, 0x31, 0x12, 0x12, 0x0a, 0x04, 0x64,
-	0x69, 0x6d, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x64, 0x69, 0x6d, 0x32, 0x12,
-	0x12, 0x0a, 0x04, 0x64, 0x69, 0x6d, 0x33, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x64,
-	0x69, 0x6d, 0x33, 0x22, 0x84, 0x03, 0x0a, 0x0b, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x48, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x05, 0x73, 0x68, 0x61, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x53, 0x68, 0x61,
-	0x70, 0x65, 0x52, 0x05, 0x73, 0x68, 0x61, 0x70, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x64, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75,
-	0x62, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x2e, 0x44, 0x74,
-	0x79, 0x70, 0x65, 0x52, 0x05, 0x64, 0x74, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x62,
-	0x5f, 0x70, 0x61, 0x72, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6e, 0x62,
-	0x50, 0x61, 0x72, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x28, 0x0a,
-	0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x67,
-	0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x72,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x63, 0x6f,
-	0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x0f, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x0b, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x65, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x0e, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x65, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x37, 0x0a, 0x0c, 0x64,
-	0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x14, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61,
-	0x73, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74,
-	0x4d, 0x65, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x34, 0x0a, 0x0a, 0x49, 0x6d,
-	0x61, 0x67, 0x65, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x72, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x22, 0x1f, 0x0a, 0x09, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x22, 0xaf, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65,
-	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x07, 0x72, 0x65,
-	0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x65,
-	0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x4c, 0x69,
-	0x73, 0x74, 0x48, 0x00, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x32, 0x0a,
-	0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
-	0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x46,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x48, 0x00, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x73, 0x42, 0x10, 0x0a, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x5f, 0x6c, 0x69, 0x73,
-	0x74, 0x65, 0x72, 0x22, 0x7c, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x73,
-	0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x72,
-	0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x67,
-	0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x72,
-	0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x39, 0x0a, 0x0d, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65,
-	0x74, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e,
-	0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x4d,
-	0x65, 0x74, 0x61, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61,
-	0x73, 0x22, 0xbe, 0x04, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x75, 0x62, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e,
-	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x00, 0x52, 0x07,
-	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x32, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75,
-	0x62, 0x65, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73,
-	0x48, 0x00, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x48, 0x0a, 0x0f, 0x67,
-	0x72, 0x6f, 0x75, 0x70, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x0c,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x65, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x73, 0x4c,
-	0x69, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x64, 0x52, 0x65,
-	0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x72, 0x73, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x72, 0x73, 0x12, 0x33, 0x0a, 0x0a, 0x70, 0x69,
-	0x78, 0x5f, 0x74, 0x6f, 0x5f, 0x63, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
-	0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x47, 0x65, 0x6f, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x08, 0x70, 0x69, 0x78, 0x54, 0x6f, 0x43, 0x72, 0x73, 0x12,
-	0x21, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
-	0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x53, 0x69, 0x7a, 0x65, 0x52, 0x04, 0x73, 0x69,
-	0x7a, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x63,
-	0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12,
-	0x21, 0x0a, 0x0c, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x4f, 0x6e,
-	0x6c, 0x79, 0x12, 0x2b, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x13, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x46, 0x69, 0x6c,
-	0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12,
-	0x3a, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x6c,
-	0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62,
-	0x65, 0x2e, 0x52, 0x65, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x72, 0x65,
-	0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x41, 0x6c, 0x67, 0x12, 0x23, 0x0a, 0x0d, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x76, 0x31, 0x31, 0x78, 0x18, 0x0d, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x56, 0x31, 0x31, 0x78,
-	0x42, 0x10, 0x0a, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x5f, 0x6c, 0x69, 0x73, 0x74,
-	0x65, 0x72, 0x22, 0x8d, 0x02, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x75, 0x62, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x62, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e, 0x62, 0x44, 0x61, 0x74, 0x61, 0x73,
-	0x65, 0x74, 0x73, 0x12, 0x34, 0x0a, 0x0b, 0x72, 0x65, 0x66, 0x5f, 0x64, 0x66, 0x6f, 0x72, 0x6d,
-	0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75,
-	0x62, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x0a, 0x72,
-	0x65, 0x66, 0x44, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x3a, 0x0a, 0x0e, 0x72, 0x65, 0x73,
-	0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x6c, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x13, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x61,
-	0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69,
-	0x6e, 0x67, 0x41, 0x6c, 0x67, 0x12, 0x39, 0x0a, 0x0c, 0x67, 0x65, 0x6f, 0x74, 0x72, 0x61, 0x6e,
-	0x73, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x65,
-	0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x47, 0x65, 0x6f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f,
-	0x72, 0x6d, 0x52, 0x0c, 0x67, 0x65, 0x6f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d,
-	0x12, 0x10, 0x0a, 0x03, 0x63, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63,
-	0x72, 0x73, 0x22, 0xc1, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x75, 0x62, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0d, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c,
-	0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
-	0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x75, 0x62, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x48, 0x00, 0x52,
-	0x0c, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x2e, 0x0a,
-	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
-	0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x48, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x48, 0x00, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x2b, 0x0a,
-	0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67,
-	0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x43, 0x68, 0x75, 0x6e,
-	0x6b, 0x48, 0x00, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xe5, 0x03, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x43, 0x75,
-	0x62, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x39, 0x0a, 0x0d, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x73, 0x5f, 0x6d, 0x65,
-	0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75,
-	0x62, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x0c,
-	0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x40, 0x0a, 0x0f,
-	0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x0e,
-	0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x34,
-	0x0a, 0x0b, 0x72, 0x65, 0x66, 0x5f, 0x64, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x44, 0x61,
-	0x74, 0x61, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x0a, 0x72, 0x65, 0x66, 0x44, 0x66, 0x6f,
-	0x72, 0x6d, 0x61, 0x74, 0x12, 0x3a, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69,
-	0x6e, 0x67, 0x5f, 0x61, 0x6c, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x67,
-	0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e,
-	0x67, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x41, 0x6c, 0x67,
-	0x12, 0x33, 0x0a, 0x0a, 0x70, 0x69, 0x78, 0x5f, 0x74, 0x6f, 0x5f, 0x63, 0x72, 0x73, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x47,
-	0x65, 0x6f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x08, 0x70, 0x69, 0x78,
-	0x54, 0x6f, 0x43, 0x72, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x63, 0x72, 0x73, 0x12, 0x21, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e,
-	0x53, 0x69, 0x7a, 0x65, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x2b, 0x0a, 0x06, 0x66, 0x6f,
-	0x72, 0x6d, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x67, 0x65, 0x6f,
-	0x63, 0x75, 0x62, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52,
-	0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x64, 0x6f,
-	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x70, 0x72,
-	0x65, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x76, 0x31, 0x31, 0x78, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0c, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x56, 0x31, 0x31, 0x78, 0x22, 0xc9,
-	0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x43, 0x75, 0x62, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0d, 0x67, 0x6c,
-	0x6f, 0x62, 0x61, 0x6c, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1e, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x43,
-	0x75, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x48, 0x00, 0x52, 0x0c, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x48, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x12, 0x2e, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x14, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x49, 0x6d, 0x61, 0x67,
-	0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x48, 0x00, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x12, 0x2b, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x13, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65,
-	0x43, 0x68, 0x75, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x42, 0x0a,
-	0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xfc, 0x01, 0x0a, 0x0e, 0x47,
-	0x65, 0x74, 0x54, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
-	0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x0c,
-	0x0a, 0x01, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01,
-	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x79, 0x12, 0x0c, 0x0a, 0x01, 0x7a, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x7a, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x02, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61,
-	0x78, 0x18, 0x09, 0x20, 0x01, 0x28, 0x02, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x12, 0x35, 0x0a, 0x07,
-	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x64, 0x52,
-	0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x73, 0x48, 0x00, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f,
-	0x72, 0x64, 0x73, 0x12, 0x32, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x52,
-	0x65, 0x63, 0x6f, 0x72, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x48, 0x00, 0x52, 0x07,
-	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x42, 0x10, 0x0a, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x72,
-	0x64, 0x73, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x72, 0x22, 0x3b, 0x0a, 0x0f, 0x47, 0x65, 0x74,
-	0x54, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05,
-	0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x65,
-	0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52,
-	0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x2a, 0x2c, 0x0a, 0x09, 0x42, 0x79, 0x74, 0x65, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x0c, 0x4c, 0x69, 0x74, 0x74, 0x6c, 0x65, 0x45, 0x6e, 0x64,
-	0x69, 0x61, 0x6e, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x42, 0x69, 0x67, 0x45, 0x6e, 0x64, 0x69,
-	0x61, 0x6e, 0x10, 0x01, 0x2a, 0x20, 0x0a, 0x0a, 0x46, 0x69, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x6d,
-	0x61, 0x74, 0x12, 0x07, 0x0a, 0x03, 0x52, 0x61, 0x77, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x47,
-	0x54, 0x69, 0x66, 0x66, 0x10, 0x01, 0x42, 0x0e, 0x5a, 0x0c, 0x2e, 0x2f, 0x70, 0x62, 0x3b, 0x67,
-	0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	Id string    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`        // Identifier of the location (optional), returned with its time series
+	X  []float64 `protobuf:"fixed64,2,rep,packed,name=x,proto3" json:"x,omitempty"` // X coordinates (e.g. longitudes) of the point or of the vertices of the exterior ring of the polygon
+	Y  []float64 `protobuf:"fixed64,3,rep,packed,name=y,proto3" json:"y,omitempty"` // Y coordinates (e.g. latitudes) of the point or of the vertices of the exterior ring of the polygon
 }
 
-var (
-	file_pb_catalog_proto_rawDescOnce sync.Once
-	file_pb_catalog_proto_rawDescData = file_pb_catalog_proto_rawDesc
-)
+func (x *TimeSeriesLocation) Reset() {
+	*x = TimeSeriesLocation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_catalog_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_pb_catalog_proto_rawDescGZIP() []byte {
-	file_pb_catalog_proto_rawDescOnce.Do(func() {
-		file_pb_catalog_proto_rawDescData = protoimpl.X.CompressGZIP(file_pb_catalog_proto_rawDescData)
-	})
-	return file_pb_catalog_proto_rawDescData
+func (x *TimeSeriesLocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_pb_catalog_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_pb_catalog_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
-var file_pb_catalog_proto_goTypes = []interface{}{
-	(ByteOrder)(0),                  // 0: geocube.ByteOrder
-	(FileFormat)(0),                 // 1: geocube.FileFormat
-	(*Shape)(nil),                   // 2: geocube.Shape
-	(*ImageHeader)(nil),             // 3: geocube.ImageHeader
-	(*ImageChunk)(nil),              // 4: geocube.ImageChunk
-	(*ImageFile)(nil),               // 5: geocube.ImageFile
-	(*ListDatasetsRequest)(nil),     // 6: geocube.ListDatasetsRequest
-	(*ListDatasetsResponse)(nil),    // 7: geocube.ListDatasetsResponse
-	(*GetCubeRequest)(nil),          // 8: geocube.GetCubeRequest
-	(*GetCubeResponseHeader)(nil),   // 9: geocube.GetCubeResponseHeader
-	(*GetCubeResponse)(nil),         // 10: geocube.GetCubeResponse
-	(*GetCubeMetadataRequest)(nil),  // 11: geocube.GetCubeMetadataRequest
-	(*GetCubeMetadataResponse)(nil), // 12: geocube.GetCubeMetadataResponse
-	(*GetTileRequest)(nil),          // 13: geocube.GetTileRequest
-	(*GetTileResponse)(nil),         // 14: geocube.GetTileResponse
-	(DataFormat_Dtype)(0),           // 15: geocube.DataFormat.Dtype
-	(*GroupedRecords)(nil),          // 16: geocube.GroupedRecords
-	(*DatasetMeta)(nil),             // 17: geocube.DatasetMeta
-	(*RecordIdList)(nil),            // 18: geocube.RecordIdList
-	(*RecordFilters)(nil),           // 19: geocube.RecordFilters
-	(*Record)(nil),                  // 20: geocube.Record
-	(*GroupedRecordIdsList)(nil),    // 21: geocube.GroupedRecordIdsList
-	(*GeoTransform)(nil),            // 22: geocube.GeoTransform
-	(*Size)(nil),                    // 23: geocube.Size
-	(Resampling)(0),                 // 24: geocube.Resampling
-	(*DataFormat)(nil),              // 25: geocube.DataFormat
-	(*GroupedRecordIds)(nil),        // 26: geocube.GroupedRecordIds
+func (*TimeSeriesLocation) ProtoMessage() {}
+
+func (x *TimeSeriesLocation) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_catalog_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_pb_catalog_proto_depIdxs = []int32{
-	2,  // 0: geocube.ImageHeader.shape:type_name -> geocube.Shape
-	15, // 1: geocube.ImageHeader.dtype:type_name -> geocube.DataFormat.Dtype
-	0,  // 2: geocube.ImageHeader.order:type_name -> geocube.ByteOrder
-	16, // 3: geocube.ImageHeader.grouped_records:type_name -> geocube.GroupedRecords
-	17, // 4: geocube.ImageHeader.dataset_meta:type_name -> geocube.DatasetMeta
-	18, // 5: geocube.ListDatasetsRequest.records:type_name -> geocube.RecordIdList
-	19, // 6: geocube.ListDatasetsRequest.filters:type_name -> geocube.RecordFilters
-	20, // 7: geocube.ListDatasetsResponse.records:type_name -> geocube.Record
-	17, // 8: geocube.ListDatasetsResponse.dataset_metas:type_name -> geocube.DatasetMeta
-	18, // 9: geocube.GetCubeRequest.records:type_name -> geocube.RecordIdList
-	19, // 10: geocube.GetCubeRequest.filters:type_name -> geocube.RecordFilters
-	21, // 11: geocube.GetCubeRequest.grouped_records:type_name -> geocube.GroupedRecordIdsList
-	22, // 12: geocube.GetCubeRequest.pix_to_crs:type_name -> geocube.GeoTransform
-	23, // 13: geocube.GetCubeRequest.size:type_name -> geocube.Size
-	1,  // 14: geocube.GetCubeRequest.format:type_name -> geocube.FileFormat
-	24, // 15: geocube.GetCubeRequest.resampling_alg:type_name -> geocube.Resampling
-	25, // 16: geocube.GetCubeResponseHeader.ref_dformat:type_name -> geocube.DataFormat
-	24, // 17: geocube.GetCubeResponseHeader.resampling_alg:type_name -> geocube.Resampling
-	22, // 18: geocube.GetCubeResponseHeader.geotransform:type_name -> geocube.GeoTransform
-	9,  // 19: geocube.GetCubeResponse.global_header:type_name -> geocube.GetCubeResponseHeader
-	3,  // 20: geocube.GetCubeResponse.header:type_name -> geocube.ImageHeader
-	4,  // 21: geocube.GetCubeResponse.chunk:type_name -> geocube.ImageChunk
-	17, // 22: geocube.GetCubeMetadataRequest.datasets_meta:type_name -> geocube.DatasetMeta
-	16, // 23: geocube.GetCubeMetadataRequest.grouped_records:type_name -> geocube.GroupedRecords
-	25, // 24: geocube.GetCubeMetadataRequest.ref_dformat:type_name -> geocube.DataFormat
-	24, // 25: geocube.GetCubeMetadataRequest.resampling_alg:type_name -> geocube.Resampling
-	22, // 26: geocube.GetCubeMetadataRequest.pix_to_crs:type_name -> geocube.GeoTransform
-	23, // 27: geocube.GetCubeMetadataRequest.size:type_name -> geocube.Size
-	1,  // 28: geocube.GetCubeMetadataRequest.format:type_name -> geocube.FileFormat
-	9,  // 29: geocube.GetCubeMetadataResponse.global_header:type_name -> geocube.GetCubeResponseHeader
-	3,  // 30: geocube.GetCubeMetadataResponse.header:type_name -> geocube.ImageHeader
-	4,  // 31: geocube.GetCubeMetadataResponse.chunk:type_name -> geocube.ImageChunk
-	26, // 32: geocube.GetTileRequest.records:type_name -> geocube.GroupedRecordIds
-	19, // 33: geocube.GetTileRequest.filters:type_name -> geocube.RecordFilters
-	5,  // 34: geocube.GetTileResponse.image:type_name -> geocube.ImageFile
-	35, // [35:35] is the sub-list for method output_type
-	35, // [35:35] is the sub-list for method input_type
-	35, // [35:35] is the sub-list for extension type_name
-	35, // [35:35] is the sub-list for extension extendee
-	0,  // [0:35] is the sub-list for field type_name
+
+// Deprecated: Use TimeSeriesLocation.ProtoReflect.Descriptor instead.
+func (*TimeSeriesLocation) Descriptor() ([]byte, []int) {
+	return file_pb_catalog_proto_rawDescGZIP(), []int{17}
 }
 
-func init() { file_pb_catalog_proto_init() }
-func file_pb_catalog_proto_init() {
-	if File_pb_catalog_proto != nil {
-		return
+func (x *TimeSeriesLocation) GetId() string {
+	if x != nil {
+		return x.Id
 	}
-	file_pb_dataformat_proto_init()
-	file_pb_records_proto_init()
+	return ""
+}
+
+func (x *TimeSeriesLocation) GetX() []float64 {
+	if x != nil {
+		return x.X
+	}
+	return nil
+}
+
+func (x *TimeSeriesLocation) GetY() []float64 {
+	if x != nil {
+		return x.Y
+	}
+	return nil
+}
+
+// *
+// Request the time series of a list of locations, given an instance and records
+type GetTimeSeriesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to RecordsLister:
+	//
+	//	*GetTimeSeriesRequest_Records
+	//	*GetTimeSeriesRequest_Filters
+	RecordsLister isGetTimeSeriesRequest_RecordsLister `protobuf_oneof:"records_lister"`
+	InstanceId    string                               `protobuf:"bytes,3,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	Locations     []*TimeSeriesLocation                `protobuf:"bytes,4,rep,name=locations,proto3" json:"locations,omitempty"` // At least one
+	Crs           string                               `protobuf:"bytes,5,opt,name=crs,proto3" json:"crs,omitempty"`             // Coordinates Reference System of the locations (default: EPSG:4326, in lon/lat order)
+}
+
+func (x *GetTimeSeriesRequest) Reset() {
+	*x = GetTimeSeriesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_catalog_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTimeSeriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTimeSeriesRequest) ProtoMessage() {}
+
+func (x *GetTimeSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_catalog_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTimeSeriesRequest.ProtoReflect.Descriptor instead.
+func (*GetTimeSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_pb_catalog_proto_rawDescGZIP(), []int{18}
+}
+
+func (m *GetTimeSeriesRequest) GetRecordsLister() isGetTimeSeriesRequest_RecordsLister {
+	if m != nil {
+		return m.RecordsLister
+	}
+	return nil
+}
+
+func (x *GetTimeSeriesRequest) GetRecords() *RecordIdList {
+	if x, ok := x.GetRecordsLister().(*GetTimeSeriesRequest_Records); ok {
+		return x.Records
+	}
+	return nil
+}
+
+func (x *GetTimeSeriesRequest) GetFilters() *RecordFilters {
+	if x, ok := x.GetRecordsLister().(*GetTimeSeriesRequest_Filters); ok {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *GetTimeSeriesRequest) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *GetTimeSeriesRequest) GetLocations() []*TimeSeriesLocation {
+	if x != nil {
+		return x.Locations
+	}
+	return nil
+}
+
+func (x *GetTimeSeriesRequest) GetCrs() string {
+	if x != nil {
+		return x.Crs
+	}
+	return ""
+}
+
+type isGetTimeSeriesRequest_RecordsLister interface {
+	isGetTimeSeriesRequest_RecordsLister()
+}
+
+type GetTimeSeriesRequest_Records struct {
+	Records *RecordIdList `protobuf:"bytes,1,opt,name=records,proto3,oneof"` // List of record ids. At least one
+}
+
+type GetTimeSeriesRequest_Filters struct {
+	Filters *RecordFilters `protobuf:"bytes,2,opt,name=filters,proto3,oneof"` // All the datasets whose records have RecordTags and time between from_time and to_time
+}
+
+func (*GetTimeSeriesRequest_Records) isGetTimeSeriesRequest_RecordsLister() {}
+
+func (*GetTimeSeriesRequest_Filters) isGetTimeSeriesRequest_RecordsLister() {}
+
+// *
+// Value of a location at the datetime of a record
+type TimeSeriesValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Datetime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=datetime,proto3" json:"datetime,omitempty"` // Datetime of the record
+	RecordId string                 `protobuf:"bytes,2,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+	Values   []float64              `protobuf:"fixed64,3,rep,packed,name=values,proto3" json:"values,omitempty"`                    // One value per band of the variable (NaN if nodata). For a polygon, the mean of the valid pixels
+	NbPixels []int32                `protobuf:"varint,4,rep,packed,name=nb_pixels,json=nbPixels,proto3" json:"nb_pixels,omitempty"` // Number of valid pixels of each band
+}
+
+func (x *TimeSeriesValue) Reset() {
+	*x = TimeSeriesValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_catalog_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimeSeriesValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeSeriesValue) ProtoMessage() {}
+
+func (x *TimeSeriesValue) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_catalog_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeSeriesValue.ProtoReflect.Descriptor instead.
+func (*TimeSeriesValue) Descriptor() ([]byte, []int) {
+	return file_pb_catalog_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *TimeSeriesValue) GetDatetime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Datetime
+	}
+	return nil
+}
+
+func (x *TimeSeriesValue) GetRecordId() string {
+	if x != nil {
+		return x.RecordId
+	}
+	return ""
+}
+
+func (x *TimeSeriesValue) GetValues() []float64 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *TimeSeriesValue) GetNbPixels() []int32 {
+	if x != nil {
+		return x.NbPixels
+	}
+	return nil
+}
+
+// *
+// Time series of a location
+type GetTimeSeriesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LocationIndex int32              `protobuf:"varint,1,opt,name=location_index,json=locationIndex,proto3" json:"location_index,omitempty"` // Index of the location in the request
+	LocationId    string             `protobuf:"bytes,2,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
+	Values        []*TimeSeriesValue `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"` // Sorted by datetime. The records whose datasets do not cover the location are omitted
+	Error         string             `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`   // Error of the location (e.g. too many pixels), if any. The values are then empty
+}
+
+func (x *GetTimeSeriesResponse) Reset() {
+	*x = GetTimeSeriesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_catalog_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTimeSeriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTimeSeriesResponse) ProtoMessage() {}
+
+func (x *GetTimeSeriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_catalog_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTimeSeriesResponse.ProtoReflect.Descriptor instead.
+func (*GetTimeSeriesResponse) Descriptor() ([]byte, []int) {
+	return file_pb_catalog_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetTimeSeriesResponse) GetLocationIndex() int32 {
+	if x != nil {
+		return x.LocationIndex
+	}
+	return 0
+}
+
+func (x *GetTimeSeriesResponse) GetLocationId() string {
+	if x != nil {
+		return x.LocationId
+	}
+	return ""
+}
+
+func (x *GetTimeSeriesResponse) GetValues() []*TimeSeriesValue {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *GetTimeSeriesResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// *
+// Bins of the histogram of the zonal statistics
+type HistogramBins struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NbBins int32   `protobuf:"varint,1,opt,name=nb_bins,json=nbBins,proto3" json:"nb_bins,omitempty"` // Number of bins of equal width
+	Min    float64 `protobuf:"fixed64,2,opt,name=min,proto3" json:"min,omitempty"`                    // Lower bound of the first bin (if min=max=0: the real range of the variable)
+	Max    float64 `protobuf:"fixed64,3,opt,name=max,proto3" json:"max,omitempty"`                    // Upper bound of the last bin (included)
+}
+
+func (x *HistogramBins) Reset() {
+	*x = HistogramBins{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_catalog_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HistogramBins) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistogramBins) ProtoMessage() {}
+
+func (x *HistogramBins) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_catalog_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistogramBins.ProtoReflect.Descriptor instead.
+func (*HistogramBins) Descriptor() ([]byte, []int) {
+	return file_pb_catalog_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *HistogramBins) GetNbBins() int32 {
+	if x != nil {
+		return x.NbBins
+	}
+	return 0
+}
+
+func (x *HistogramBins) GetMin() float64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *HistogramBins) GetMax() float64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+// *
+// Request the statistics of the values of an instance inside zones, for each record
+type GetZonalStatisticsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to RecordsLister:
+	//
+	//	*GetZonalStatisticsRequest_Records
+	//	*GetZonalStatisticsRequest_Filters
+	RecordsLister isGetZonalStatisticsRequest_RecordsLister `protobuf_oneof:"records_lister"`
+	InstanceId    string                                    `protobuf:"bytes,3,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	Zones         []byte                                    `protobuf:"bytes,4,opt,name=zones,proto3" json:"zones,omitempty"`                                                                    // GeoJSON FeatureCollection (or Feature) of Polygons and MultiPolygons in lon/lat (EPSG:4326)
+	IdProperty    string                                    `protobuf:"bytes,5,opt,name=id_property,json=idProperty,proto3" json:"id_property,omitempty"`                                        // Property of the features used as the id of the zones (default: the id of the features)
+	Statistics    []GetZonalStatisticsRequest_Statistic     `protobuf:"varint,6,rep,packed,name=statistics,proto3,enum=geocube.GetZonalStatisticsRequest_Statistic" json:"statistics,omitempty"` // Statistics to compute (default: MEAN)
+	Percentiles   []float64                                 `protobuf:"fixed64,7,rep,packed,name=percentiles,proto3" json:"percentiles,omitempty"`                                               // Percentiles to compute, in [0, 100]
+	Histogram     *HistogramBins                            `protobuf:"bytes,8,opt,name=histogram,proto3" json:"histogram,omitempty"`                                                            // Histogram to compute (optional)
+	AllTouched    bool                                      `protobuf:"varint,9,opt,name=all_touched,json=allTouched,proto3" json:"all_touched,omitempty"`                                       // All the pixels touched by a zone are part of the zone (by default, only the pixels whose center is inside)
+	Weighted      bool                                      `protobuf:"varint,10,opt,name=weighted,proto3" json:"weighted,omitempty"`                                                            // Each pixel is weighted by the fraction of its area covered by the zone
+}
+
+func (x *GetZonalStatisticsRequest) Reset() {
+	*x = GetZonalStatisticsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_catalog_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetZonalStatisticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetZonalStatisticsRequest) ProtoMessage() {}
+
+func (x *GetZonalStatisticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_catalog_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetZonalStatisticsRequest.ProtoReflect.Descriptor instead.
+func (*GetZonalStatisticsRequest) Descriptor() ([]byte, []int) {
+	return file_pb_catalog_proto_rawDescGZIP(), []int{22}
+}
+
+func (m *GetZonalStatisticsRequest) GetRecordsLister() isGetZonalStatisticsRequest_RecordsLister {
+	if m != nil {
+		return m.RecordsLister
+	}
+	return nil
+}
+
+func (x *GetZonalStatisticsRequest) GetRecords() *RecordIdList {
+	if x, ok := x.GetRecordsLister().(*GetZonalStatisticsRequest_Records); ok {
+		return x.Records
+	}
+	return nil
+}
+
+func (x *GetZonalStatisticsRequest) GetFilters() *RecordFilters {
+	if x, ok := x.GetRecordsLister().(*GetZonalStatisticsRequest_Filters); ok {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *GetZonalStatisticsRequest) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *GetZonalStatisticsRequest) GetZones() []byte {
+	if x != nil {
+		return x.Zones
+	}
+	return nil
+}
+
+func (x *GetZonalStatisticsRequest) GetIdProperty() string {
+	if x != nil {
+		return x.IdProperty
+	}
+	return ""
+}
+
+func (x *GetZonalStatisticsRequest) GetStatistics() []GetZonalStatisticsRequest_Statistic {
+	if x != nil {
+		return x.Statistics
+	}
+	return nil
+}
+
+func (x *GetZonalStatisticsRequest) GetPercentiles() []float64 {
+	if x != nil {
+		return x.Percentiles
+	}
+	return nil
+}
+
+func (x *GetZonalStatisticsRequest) GetHistogram() *HistogramBins {
+	if x != nil {
+		return x.Histogram
+	}
+	return nil
+}
+
+func (x *GetZonalStatisticsRequest) GetAllTouched() bool {
+	if x != nil {
+		return x.AllTouched
+	}
+	return false
+}
+
+func (x *GetZonalStatisticsRequest) GetWeighted() bool {
+	if x != nil {
+		return x.Weighted
+	}
+	return false
+}
+
+type isGetZonalStatisticsRequest_RecordsLister interface {
+	isGetZonalStatisticsRequest_RecordsLister()
+}
+
+type GetZonalStatisticsRequest_Records struct {
+	Records *RecordIdList `protobuf:"bytes,1,opt,name=records,proto3,oneof"` // List of record ids. At least one
+}
+
+type GetZonalStatisticsRequest_Filters struct {
+	Filters *RecordFilters `protobuf:"bytes,2,opt,name=filters,proto3,oneof"` // All the datasets whose records have RecordTags and time between from_time and to_time
+}
+
+func (*GetZonalStatisticsRequest_Records) isGetZonalStatisticsRequest_RecordsLister() {}
+
+func (*GetZonalStatisticsRequest_Filters) isGetZonalStatisticsRequest_RecordsLister() {}
+
+// *
+// Statistics of a band inside a zone
+type ZonalStatistics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values      []float64 `protobuf:"fixed64,1,rep,packed,name=values,proto3" json:"values,omitempty"`             // One value per requested statistic (NaN if the zone has no valid pixel, except SUM and COUNT)
+	Percentiles []float64 `protobuf:"fixed64,2,rep,packed,name=percentiles,proto3" json:"percentiles,omitempty"`   // One value per requested percentile
+	Histogram   []float64 `protobuf:"fixed64,3,rep,packed,name=histogram,proto3" json:"histogram,omitempty"`       // Sum of the weights of the valid pixels in each bin
+	NbPixels    int32     `protobuf:"varint,4,opt,name=nb_pixels,json=nbPixels,proto3" json:"nb_pixels,omitempty"` // Number of valid pixels
+}
+
+func (x *ZonalStatistics) Reset() {
+	*x = ZonalStatistics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_catalog_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ZonalStatistics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZonalStatistics) ProtoMessage() {}
+
+func (x *ZonalStatistics) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_catalog_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZonalStatistics.ProtoReflect.Descriptor instead.
+func (*ZonalStatistics) Descriptor() ([]byte, []int) {
+	return file_pb_catalog_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ZonalStatistics) GetValues() []float64 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *ZonalStatistics) GetPercentiles() []float64 {
+	if x != nil {
+		return x.Percentiles
+	}
+	return nil
+}
+
+func (x *ZonalStatistics) GetHistogram() []float64 {
+	if x != nil {
+		return x.Histogram
+	}
+	return nil
+}
+
+func (x *ZonalStatistics) GetNbPixels() int32 {
+	if x != nil {
+		return x.NbPixels
+	}
+	return 0
+}
+
+// *
+// Statistics of a zone at the datetime of a record
+type GetZonalStatisticsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ZoneIndex int32                  `protobuf:"varint,1,opt,name=zone_index,json=zoneIndex,proto3" json:"zone_index,omitempty"` // Index of the zone in the feature collection
+	ZoneId    string                 `protobuf:"bytes,2,opt,name=zone_id,json=zoneId,proto3" json:"zone_id,omitempty"`
+	Datetime  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=datetime,proto3" json:"datetime,omitempty"` // Datetime of the record
+	RecordId  string                 `protobuf:"bytes,4,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+	Bands     []*ZonalStatistics     `protobuf:"bytes,5,rep,name=bands,proto3" json:"bands,omitempty"` // One per band of the variable
+	Error     string                 `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"` // Error of the zone for the record (e.g. the zone is too large), if any. The bands are then empty. Without record_id, the error concerns the whole zone
+}
+
+func (x *GetZonalStatisticsResponse) Reset() {
+	*x = GetZonalStatisticsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_catalog_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetZonalStatisticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetZonalStatisticsResponse) ProtoMessage() {}
+
+func (x *GetZonalStatisticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_catalog_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetZonalStatisticsResponse.ProtoReflect.Descriptor instead.
+func (*GetZonalStatisticsResponse) Descriptor() ([]byte, []int) {
+	return file_pb_catalog_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetZonalStatisticsResponse) GetZoneIndex() int32 {
+	if x != nil {
+		return x.ZoneIndex
+	}
+	return 0
+}
+
+func (x *GetZonalStatisticsResponse) GetZoneId() string {
+	if x != nil {
+		return x.ZoneId
+	}
+	return ""
+}
+
+func (x *GetZonalStatisticsResponse) GetDatetime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Datetime
+	}
+	return nil
+}
+
+func (x *GetZonalStatisticsResponse) GetRecordId() string {
+	if x != nil {
+		return x.RecordId
+	}
+	return ""
+}
+
+func (x *GetZonalStatisticsResponse) GetBands() []*ZonalStatistics {
+	if x != nil {
+		return x.Bands
+	}
+	return nil
+}
+
+func (x *GetZonalStatisticsResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+var File_pb_catalog_proto protoreflect.FileDescriptor
+
+var file_pb_catalog_proto_rawDesc = []byte{
+	0x0a, 0x10, 0x70, 0x62, 0x2f, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x07, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x1a, 0x13, 0x70, 0x62, 0x2f,
+	0x64, 0x61, 0x74, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x10, 0x70, 0x62, 0x2f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x10, 0x70, 0x62, 0x2f, 0x6c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x70, 0x62, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74,
+	0x4d, 0x65, 0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x70, 0x62, 0x2f, 0x76,
+	0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x43, 0x0a, 0x05, 0x53, 0x68, 0x61, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x69, 0x6d, 0x31,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x64, 0x69, 0x6d, 0x31, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x69, 0x6d, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x64, 0x69, 0x6d, 0x32,
+	0x12, 0x12, 0x0a, 0x04, 0x64, 0x69, 0x6d, 0x33, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x64, 0x69, 0x6d, 0x33, 0x22, 0xb9, 0x03, 0x0a, 0x0b, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x05, 0x73, 0x68, 0x61, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x53, 0x68,
+	0x61, 0x70, 0x65, 0x52, 0x05, 0x73, 0x68, 0x61, 0x70, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x64, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x67, 0x65, 0x6f, 0x63,
+	0x75, 0x62, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x2e, 0x44,
+	0x74, 0x79, 0x70, 0x65, 0x52, 0x05, 0x64, 0x74, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6e,
+	0x62, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6e,
+	0x62, 0x50, 0x61, 0x72, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x28,
+	0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e,
+	0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x63,
+	0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x0f, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x65, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x0e, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x65, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x37, 0x0a, 0x0c,
+	0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x44, 0x61, 0x74,
+	0x61, 0x73, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65,
+	0x74, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x33, 0x0a, 0x0b, 0x62,
+	0x61, 0x6e, 0x64, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x42, 0x61, 0x6e, 0x64, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x52, 0x0a, 0x62, 0x61, 0x6e, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x22, 0xa8, 0x02, 0x0a, 0x09, 0x42, 0x61, 0x6e, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1f,
+	0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12,
+	0x2d, 0x0a, 0x07, 0x64, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x46,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x07, 0x64, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x19,
+	0x0a, 0x08, 0x6e, 0x62, 0x5f, 0x62, 0x61, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x07, 0x6e, 0x62, 0x42, 0x61, 0x6e, 0x64, 0x73, 0x12, 0x3a, 0x0a, 0x0e, 0x72, 0x65, 0x73,
+	0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x6c, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x13, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x61,
+	0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69,
+	0x6e, 0x67, 0x41, 0x6c, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x61, 0x72,
+	0x69, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x61, 0x72,
+	0x69, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x61, 0x6e, 0x64, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x05, 0x62, 0x61, 0x6e, 0x64, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x22, 0x34, 0x0a, 0x0a, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x72,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x72, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x22, 0x1f, 0x0a, 0x09, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x22, 0xe4, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x73,
+	0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x07, 0x72,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67,
+	0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x4c,
+	0x69, 0x73, 0x74, 0x48, 0x00, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x32,
+	0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x48, 0x00, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x10, 0x0a, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x73, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x72, 0x22, 0xa4, 0x01, 0x0a, 0x14, 0x4c, 0x69,
+	0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x39, 0x0a,
+	0x0d, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x44,
+	0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x61,
+	0x73, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74,
+	0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x22, 0xc2, 0x05, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x75, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x00, 0x52, 0x07, 0x72,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x32, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62,
+	0x65, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x48,
+	0x00, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x48, 0x0a, 0x0f, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x65, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x73, 0x4c, 0x69,
+	0x73, 0x74, 0x48, 0x00, 0x52, 0x0e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x64, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x73, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x73, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x72, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x72, 0x73, 0x12, 0x33, 0x0a, 0x0a, 0x70, 0x69, 0x78,
+	0x5f, 0x74, 0x6f, 0x5f, 0x63, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x47, 0x65, 0x6f, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x6f, 0x72, 0x6d, 0x52, 0x08, 0x70, 0x69, 0x78, 0x54, 0x6f, 0x43, 0x72, 0x73, 0x12, 0x21,
+	0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67,
+	0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x53, 0x69, 0x7a, 0x65, 0x52, 0x04, 0x73, 0x69, 0x7a,
+	0x65, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x63, 0x6f,
+	0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x21,
+	0x0a, 0x0c, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x4f, 0x6e, 0x6c,
+	0x79, 0x12, 0x2b, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x13, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x65,
+	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x3a,
+	0x0a, 0x0e, 0x72, 0x65, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x6c, 0x67,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65,
+	0x2e, 0x52, 0x65, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x72, 0x65, 0x73,
+	0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x41, 0x6c, 0x67, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x76, 0x31, 0x31, 0x78, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x56, 0x31, 0x31, 0x78, 0x12,
+	0x27, 0x0a, 0x0f, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x73, 0x6b, 0x69, 0x70, 0x49, 0x6e,
+	0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x36, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6e, 0x67, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6e, 0x67,
+	0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x69, 0x78, 0x65, 0x64, 0x5f, 0x64, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6d, 0x69, 0x78, 0x65, 0x64, 0x44, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x42, 0x10, 0x0a, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x5f, 0x6c,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x22, 0x79, 0x0a, 0x09, 0x44, 0x61, 0x74, 0x65, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x12, 0x37, 0x0a, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x74,
+	0x6f, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x74, 0x6f, 0x54, 0x69, 0x6d, 0x65,
+	0x22, 0x38, 0x0a, 0x0a, 0x44, 0x61, 0x74, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x2a,
+	0x0a, 0x06, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x06, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0xb9, 0x03, 0x0a, 0x0b, 0x43,
+	0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x36, 0x0a, 0x07, 0x72, 0x65,
+	0x64, 0x75, 0x63, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x67, 0x65,
+	0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6e,
+	0x67, 0x2e, 0x52, 0x65, 0x64, 0x75, 0x63, 0x65, 0x72, 0x52, 0x07, 0x72, 0x65, 0x64, 0x75, 0x63,
+	0x65, 0x72, 0x12, 0x21, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x64, 0x61, 0x79,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x44, 0x61, 0x79, 0x73, 0x12, 0x25, 0x0a, 0x0d, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f,
+	0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x0c,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x12, 0x36, 0x0a, 0x0b,
+	0x64, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x65,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x65, 0x52, 0x61,
+	0x6e, 0x67, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x2e, 0x0a, 0x13, 0x71, 0x75, 0x61, 0x6c,
+	0x69, 0x74, 0x79, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x33, 0x0a, 0x16, 0x71, 0x75, 0x61, 0x6c,
+	0x69, 0x74, 0x79, 0x5f, 0x6c, 0x6f, 0x77, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x73, 0x5f, 0x62, 0x65,
+	0x73, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x74,
+	0x79, 0x4c, 0x6f, 0x77, 0x65, 0x73, 0x74, 0x49, 0x73, 0x42, 0x65, 0x73, 0x74, 0x22, 0x4c, 0x0a,
+	0x07, 0x52, 0x65, 0x64, 0x75, 0x63, 0x65, 0x72, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x45, 0x44, 0x49,
+	0x41, 0x4e, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x4d, 0x45, 0x41, 0x4e, 0x10, 0x01, 0x12, 0x07,
+	0x0a, 0x03, 0x4d, 0x49, 0x4e, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x4d, 0x41, 0x58, 0x10, 0x03,
+	0x12, 0x09, 0x0a, 0x05, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x04, 0x12, 0x0e, 0x0a, 0x0a, 0x42,
+	0x45, 0x53, 0x54, 0x5f, 0x50, 0x49, 0x58, 0x45, 0x4c, 0x10, 0x05, 0x42, 0x09, 0x0a, 0x07, 0x62,
+	0x69, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x22, 0xc2, 0x02, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x75,
+	0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x62, 0x5f, 0x64, 0x61, 0x74,
+	0x61, 0x73, 0x65, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e, 0x62, 0x44,
+	0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x73, 0x12, 0x34, 0x0a, 0x0b, 0x72, 0x65, 0x66, 0x5f, 0x64,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67,
+	0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x46, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x52, 0x0a, 0x72, 0x65, 0x66, 0x44, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x3a, 0x0a,
+	0x0e, 0x72, 0x65, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x6c, 0x67, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e,
+	0x52, 0x65, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x61,
+	0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x41, 0x6c, 0x67, 0x12, 0x39, 0x0a, 0x0c, 0x67, 0x65, 0x6f,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x47, 0x65, 0x6f, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x0c, 0x67, 0x65, 0x6f, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x6f, 0x72, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x63, 0x72, 0x73, 0x12, 0x33, 0x0a, 0x0b, 0x62, 0x61, 0x6e, 0x64, 0x5f, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x65,
+	0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x42, 0x61, 0x6e, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
+	0x0a, 0x62, 0x61, 0x6e, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0xc1, 0x01, 0x0a, 0x0f,
+	0x47, 0x65, 0x74, 0x43, 0x75, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x45, 0x0a, 0x0d, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65,
+	0x2e, 0x47, 0x65, 0x74, 0x43, 0x75, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0c, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65,
+	0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x48, 0x00, 0x52, 0x06,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x05, 0x63, 0x68,
+	0x75, 0x6e, 0x6b, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x9e, 0x05, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x43, 0x75, 0x62, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x0d, 0x64, 0x61,
+	0x74, 0x61, 0x73, 0x65, 0x74, 0x73, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61,
+	0x73, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74,
+	0x73, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x40, 0x0a, 0x0f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x64,
+	0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x64,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x0e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x64,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x34, 0x0a, 0x0b, 0x72, 0x65, 0x66, 0x5f, 0x64,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67,
+	0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x46, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x52, 0x0a, 0x72, 0x65, 0x66, 0x44, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x3a, 0x0a,
+	0x0e, 0x72, 0x65, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x6c, 0x67, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e,
+	0x52, 0x65, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x61,
+	0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x41, 0x6c, 0x67, 0x12, 0x33, 0x0a, 0x0a, 0x70, 0x69, 0x78,
+	0x5f, 0x74, 0x6f, 0x5f, 0x63, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x47, 0x65, 0x6f, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x6f, 0x72, 0x6d, 0x52, 0x08, 0x70, 0x69, 0x78, 0x54, 0x6f, 0x43, 0x72, 0x73, 0x12, 0x10,
+	0x0a, 0x03, 0x63, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x72, 0x73,
+	0x12, 0x21, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d,
+	0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x53, 0x69, 0x7a, 0x65, 0x52, 0x04, 0x73,
+	0x69, 0x7a, 0x65, 0x12, 0x2b, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x46, 0x69,
+	0x6c, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
+	0x61, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x76,
+	0x31, 0x31, 0x78, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x56, 0x31, 0x31, 0x78, 0x12, 0x33, 0x0a, 0x0b, 0x62, 0x61, 0x6e, 0x64, 0x5f,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67,
+	0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x42, 0x61, 0x6e, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x52, 0x0a, 0x62, 0x61, 0x6e, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x27, 0x0a, 0x0f,
+	0x73, 0x6b, 0x69, 0x70, 0x5f, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x73, 0x6b, 0x69, 0x70, 0x49, 0x6e, 0x63, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x36, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6e, 0x67, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x65, 0x6f,
+	0x63, 0x75, 0x62, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6e, 0x67,
+	0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x21, 0x0a,
+	0x0c, 0x6d, 0x69, 0x78, 0x65, 0x64, 0x5f, 0x64, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0b, 0x6d, 0x69, 0x78, 0x65, 0x64, 0x44, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x22, 0xc9, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x43, 0x75, 0x62, 0x65, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0d,
+	0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x47, 0x65,
+	0x74, 0x43, 0x75, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0c, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x48, 0x00, 0x52, 0x06, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b,
+	0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xfc, 0x01, 0x0a,
+	0x0e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64,
+	0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x78, 0x12, 0x0c,
+	0x0a, 0x01, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x79, 0x12, 0x0c, 0x0a, 0x01,
+	0x7a, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x7a, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69,
+	0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x02, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03,
+	0x6d, 0x61, 0x78, 0x18, 0x09, 0x20, 0x01, 0x28, 0x02, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x12, 0x35,
+	0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x65,
+	0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x73, 0x48, 0x00, 0x52, 0x07, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x32, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65,
+	0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x48, 0x00,
+	0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x42, 0x10, 0x0a, 0x0e, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x73, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x72, 0x22, 0x3b, 0x0a, 0x0f, 0x47,
+	0x65, 0x74, 0x54, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28,
+	0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x46, 0x69, 0x6c,
+	0x65, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x22, 0x40, 0x0a, 0x12, 0x54, 0x69, 0x6d, 0x65,
+	0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x0c,
+	0x0a, 0x01, 0x78, 0x18, 0x02, 0x20, 0x03, 0x28, 0x01, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01,
+	0x79, 0x18, 0x03, 0x20, 0x03, 0x28, 0x01, 0x52, 0x01, 0x79, 0x22, 0xfd, 0x01, 0x0a, 0x14, 0x47,
+	0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x00, 0x52, 0x07, 0x72,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x32, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62,
+	0x65, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x48,
+	0x00, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x09, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72,
+	0x69, 0x65, 0x73, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x72, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x72, 0x73, 0x42, 0x10, 0x0a, 0x0e, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x73, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x72, 0x22, 0x9b, 0x01, 0x0a, 0x0f, 0x54,
+	0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x36,
+	0x0a, 0x08, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x64, 0x61,
+	0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x01, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6e,
+	0x62, 0x5f, 0x70, 0x69, 0x78, 0x65, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x05, 0x52, 0x08,
+	0x6e, 0x62, 0x50, 0x69, 0x78, 0x65, 0x6c, 0x73, 0x22, 0xa7, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x06, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x65, 0x6f,
+	0x63, 0x75, 0x62, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x22, 0x4c, 0x0a, 0x0d, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x42,
+	0x69, 0x6e, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x62, 0x5f, 0x62, 0x69, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6e, 0x62, 0x42, 0x69, 0x6e, 0x73, 0x12, 0x10, 0x0a, 0x03,
+	0x6d, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x10,
+	0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6d, 0x61, 0x78,
+	0x22, 0xa4, 0x04, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x5a, 0x6f, 0x6e, 0x61, 0x6c, 0x53, 0x74, 0x61,
+	0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31,
+	0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x49, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x00, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x73, 0x12, 0x32, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x48, 0x00, 0x52, 0x07, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x7a, 0x6f, 0x6e, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x7a, 0x6f, 0x6e, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x69, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x69, 0x64, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x12, 0x4c, 0x0a,
+	0x0a, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0e, 0x32, 0x2c, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x5a,
+	0x6f, 0x6e, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x52,
+	0x0a, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x70,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x01,
+	0x52, 0x0b, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x34, 0x0a,
+	0x09, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x67, 0x72, 0x61, 0x6d, 0x42, 0x69, 0x6e, 0x73, 0x52, 0x09, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x67,
+	0x72, 0x61, 0x6d, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x6c, 0x6c, 0x5f, 0x74, 0x6f, 0x75, 0x63, 0x68,
+	0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x6c, 0x6c, 0x54, 0x6f, 0x75,
+	0x63, 0x68, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64,
+	0x22, 0x53, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x12, 0x08, 0x0a,
+	0x04, 0x4d, 0x45, 0x41, 0x4e, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x4d, 0x49, 0x4e, 0x10, 0x01,
+	0x12, 0x07, 0x0a, 0x03, 0x4d, 0x41, 0x58, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x54, 0x44,
+	0x44, 0x45, 0x56, 0x10, 0x03, 0x12, 0x07, 0x0a, 0x03, 0x53, 0x55, 0x4d, 0x10, 0x04, 0x12, 0x09,
+	0x0a, 0x05, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x05, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x45, 0x44,
+	0x49, 0x41, 0x4e, 0x10, 0x06, 0x42, 0x10, 0x0a, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73,
+	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x72, 0x22, 0x86, 0x01, 0x0a, 0x0f, 0x5a, 0x6f, 0x6e, 0x61,
+	0x6c, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x01, 0x52, 0x06, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x01, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72,
+	0x61, 0x6d, 0x18, 0x03, 0x20, 0x03, 0x28, 0x01, 0x52, 0x09, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x67,
+	0x72, 0x61, 0x6d, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x62, 0x5f, 0x70, 0x69, 0x78, 0x65, 0x6c, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6e, 0x62, 0x50, 0x69, 0x78, 0x65, 0x6c, 0x73,
+	0x22, 0xef, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x5a, 0x6f, 0x6e, 0x61, 0x6c, 0x53, 0x74, 0x61,
+	0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x7a, 0x6f, 0x6e, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x7a, 0x6f, 0x6e, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x17,
+	0x0a, 0x07, 0x7a, 0x6f, 0x6e, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x7a, 0x6f, 0x6e, 0x65, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x65, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x05,
+	0x62, 0x61, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x65,
+	0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x5a, 0x6f, 0x6e, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x69,
+	0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x05, 0x62, 0x61, 0x6e, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x2a, 0x2c, 0x0a, 0x09, 0x42, 0x79, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12,
+	0x10, 0x0a, 0x0c, 0x4c, 0x69, 0x74, 0x74, 0x6c, 0x65, 0x45, 0x6e, 0x64, 0x69, 0x61, 0x6e, 0x10,
+	0x00, 0x12, 0x0d, 0x0a, 0x09, 0x42, 0x69, 0x67, 0x45, 0x6e, 0x64, 0x69, 0x61, 0x6e, 0x10, 0x01,
+	0x2a, 0x45, 0x0a, 0x0a, 0x46, 0x69, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x07,
+	0x0a, 0x03, 0x52, 0x61, 0x77, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x47, 0x54, 0x69, 0x66, 0x66,
+	0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x4e, 0x65, 0x74, 0x43, 0x44, 0x46, 0x34, 0x10, 0x02, 0x12,
+	0x0a, 0x0a, 0x06, 0x5a, 0x61, 0x72, 0x72, 0x56, 0x32, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x5a,
+	0x61, 0x72, 0x72, 0x56, 0x33, 0x10, 0x04, 0x42, 0x0e, 0x5a, 0x0c, 0x2e, 0x2f, 0x70, 0x62, 0x3b,
+	0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pb_catalog_proto_rawDescOnce sync.Once
+	file_pb_catalog_proto_rawDescData = file_pb_catalog_proto_rawDesc
+)
+
+func file_pb_catalog_proto_rawDescGZIP() []byte {
+	file_pb_catalog_proto_rawDescOnce.Do(func() {
+		file_pb_catalog_proto_rawDescData = protoimpl.X.CompressGZIP(file_pb_catalog_proto_rawDescData)
+	})
+	return file_pb_catalog_proto_rawDescData
+}
+
+var file_pb_catalog_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_pb_catalog_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
+var file_pb_catalog_proto_goTypes = []interface{}{
+	(ByteOrder)(0),                           // 0: geocube.ByteOrder
+	(FileFormat)(0),                          // 1: geocube.FileFormat
+	(Compositing_Reducer)(0),                 // 2: geocube.Compositing.Reducer
+	(GetZonalStatisticsRequest_Statistic)(0), // 3: geocube.GetZonalStatisticsRequest.Statistic
+	(*Shape)(nil),                            // 4: geocube.Shape
+	(*ImageHeader)(nil),                      // 5: geocube.ImageHeader
+	(*BandGroup)(nil),                        // 6: geocube.BandGroup
+	(*ImageChunk)(nil),                       // 7: geocube.ImageChunk
+	(*ImageFile)(nil),                        // 8: geocube.ImageFile
+	(*ListDatasetsRequest)(nil),              // 9: geocube.ListDatasetsRequest
+	(*ListDatasetsResponse)(nil),             // 10: geocube.ListDatasetsResponse
+	(*GetCubeRequest)(nil),                   // 11: geocube.GetCubeRequest
+	(*DateRange)(nil),                        // 12: geocube.DateRange
+	(*DateRanges)(nil),                       // 13: geocube.DateRanges
+	(*Compositing)(nil),                      // 14: geocube.Compositing
+	(*GetCubeResponseHeader)(nil),            // 15: geocube.GetCubeResponseHeader
+	(*GetCubeResponse)(nil),                  // 16: geocube.GetCubeResponse
+	(*GetCubeMetadataRequest)(nil),           // 17: geocube.GetCubeMetadataRequest
+	(*GetCubeMetadataResponse)(nil),          // 18: geocube.GetCubeMetadataResponse
+	(*GetTileRequest)(nil),                   // 19: geocube.GetTileRequest
+	(*GetTileResponse)(nil),                  // 20: geocube.GetTileResponse
+	(*TimeSeriesLocation)(nil),               // 21: geocube.TimeSeriesLocation
+	(*GetTimeSeriesRequest)(nil),             // 22: geocube.GetTimeSeriesRequest
+	(*TimeSeriesValue)(nil),                  // 23: geocube.TimeSeriesValue
+	(*GetTimeSeriesResponse)(nil),            // 24: geocube.GetTimeSeriesResponse
+	(*HistogramBins)(nil),                    // 25: geocube.HistogramBins
+	(*GetZonalStatisticsRequest)(nil),        // 26: geocube.GetZonalStatisticsRequest
+	(*ZonalStatistics)(nil),                  // 27: geocube.ZonalStatistics
+	(*GetZonalStatisticsResponse)(nil),       // 28: geocube.GetZonalStatisticsResponse
+	(DataFormat_Dtype)(0),                    // 29: geocube.DataFormat.Dtype
+	(*GroupedRecords)(nil),                   // 30: geocube.GroupedRecords
+	(*DatasetMeta)(nil),                      // 31: geocube.DatasetMeta
+	(*DataFormat)(nil),                       // 32: geocube.DataFormat
+	(Resampling)(0),                          // 33: geocube.Resampling
+	(*RecordIdList)(nil),                     // 34: geocube.RecordIdList
+	(*RecordFilters)(nil),                    // 35: geocube.RecordFilters
+	(*Record)(nil),                           // 36: geocube.Record
+	(*GroupedRecordIdsList)(nil),             // 37: geocube.GroupedRecordIdsList
+	(*GeoTransform)(nil),                     // 38: geocube.GeoTransform
+	(*Size)(nil),                             // 39: geocube.Size
+	(*timestamppb.Timestamp)(nil),            // 40: google.protobuf.Timestamp
+	(*GroupedRecordIds)(nil),                 // 41: geocube.GroupedRecordIds
+}
+var file_pb_catalog_proto_depIdxs = []int32{
+	4,  // 0: geocube.ImageHeader.shape:type_name -> geocube.Shape
+	29, // 1: geocube.ImageHeader.dtype:type_name -> geocube.DataFormat.Dtype
+	0,  // 2: geocube.ImageHeader.order:type_name -> geocube.ByteOrder
+	30, // 3: geocube.ImageHeader.grouped_records:type_name -> geocube.GroupedRecords
+	31, // 4: geocube.ImageHeader.dataset_meta:type_name -> geocube.DatasetMeta
+	6,  // 5: geocube.ImageHeader.band_groups:type_name -> geocube.BandGroup
+	32, // 6: geocube.BandGroup.dformat:type_name -> geocube.DataFormat
+	33, // 7: geocube.BandGroup.resampling_alg:type_name -> geocube.Resampling
+	34, // 8: geocube.ListDatasetsRequest.records:type_name -> geocube.RecordIdList
+	35, // 9: geocube.ListDatasetsRequest.filters:type_name -> geocube.RecordFilters
+	36, // 10: geocube.ListDatasetsResponse.records:type_name -> geocube.Record
+	31, // 11: geocube.ListDatasetsResponse.dataset_metas:type_name -> geocube.DatasetMeta
+	34, // 12: geocube.GetCubeRequest.records:type_name -> geocube.RecordIdList
+	35, // 13: geocube.GetCubeRequest.filters:type_name -> geocube.RecordFilters
+	37, // 14: geocube.GetCubeRequest.grouped_records:type_name -> geocube.GroupedRecordIdsList
+	38, // 15: geocube.GetCubeRequest.pix_to_crs:type_name -> geocube.GeoTransform
+	39, // 16: geocube.GetCubeRequest.size:type_name -> geocube.Size
+	1,  // 17: geocube.GetCubeRequest.format:type_name -> geocube.FileFormat
+	33, // 18: geocube.GetCubeRequest.resampling_alg:type_name -> geocube.Resampling
+	14, // 19: geocube.GetCubeRequest.compositing:type_name -> geocube.Compositing
+	40, // 20: geocube.DateRange.from_time:type_name -> google.protobuf.Timestamp
+	40, // 21: geocube.DateRange.to_time:type_name -> google.protobuf.Timestamp
+	12, // 22: geocube.DateRanges.ranges:type_name -> geocube.DateRange
+	2,  // 23: geocube.Compositing.reducer:type_name -> geocube.Compositing.Reducer
+	13, // 24: geocube.Compositing.date_ranges:type_name -> geocube.DateRanges
+	40, // 25: geocube.Compositing.origin:type_name -> google.protobuf.Timestamp
+	32, // 26: geocube.GetCubeResponseHeader.ref_dformat:type_name -> geocube.DataFormat
+	33, // 27: geocube.GetCubeResponseHeader.resampling_alg:type_name -> geocube.Resampling
+	38, // 28: geocube.GetCubeResponseHeader.geotransform:type_name -> geocube.GeoTransform
+	6,  // 29: geocube.GetCubeResponseHeader.band_groups:type_name -> geocube.BandGroup
+	15, // 30: geocube.GetCubeResponse.global_header:type_name -> geocube.GetCubeResponseHeader
+	5,  // 31: geocube.GetCubeResponse.header:type_name -> geocube.ImageHeader
+	7,  // 32: geocube.GetCubeResponse.chunk:type_name -> geocube.ImageChunk
+	31, // 33: geocube.GetCubeMetadataRequest.datasets_meta:type_name -> geocube.DatasetMeta
+	30, // 34: geocube.GetCubeMetadataRequest.grouped_records:type_name -> geocube.GroupedRecords
+	32, // 35: geocube.GetCubeMetadataRequest.ref_dformat:type_name -> geocube.DataFormat
+	33, // 36: geocube.GetCubeMetadataRequest.resampling_alg:type_name -> geocube.Resampling
+	38, // 37: geocube.GetCubeMetadataRequest.pix_to_crs:type_name -> geocube.GeoTransform
+	39, // 38: geocube.GetCubeMetadataRequest.size:type_name -> geocube.Size
+	1,  // 39: geocube.GetCubeMetadataRequest.format:type_name -> geocube.FileFormat
+	6,  // 40: geocube.GetCubeMetadataRequest.band_groups:type_name -> geocube.BandGroup
+	14, // 41: geocube.GetCubeMetadataRequest.compositing:type_name -> geocube.Compositing
+	15, // 42: geocube.GetCubeMetadataResponse.global_header:type_name -> geocube.GetCubeResponseHeader
+	5,  // 43: geocube.GetCubeMetadataResponse.header:type_name -> geocube.ImageHeader
+	7,  // 44: geocube.GetCubeMetadataResponse.chunk:type_name -> geocube.ImageChunk
+	41, // 45: geocube.GetTileRequest.records:type_name -> geocube.GroupedRecordIds
+	35, // 46: geocube.GetTileRequest.filters:type_name -> geocube.RecordFilters
+	8,  // 47: geocube.GetTileResponse.image:type_name -> geocube.ImageFile
+	34, // 48: geocube.GetTimeSeriesRequest.records:type_name -> geocube.RecordIdList
+	35, // 49: geocube.GetTimeSeriesRequest.filters:type_name -> geocube.RecordFilters
+	21, // 50: geocube.GetTimeSeriesRequest.locations:type_name -> geocube.TimeSeriesLocation
+	40, // 51: geocube.TimeSeriesValue.datetime:type_name -> google.protobuf.Timestamp
+	23, // 52: geocube.GetTimeSeriesResponse.values:type_name -> geocube.TimeSeriesValue
+	34, // 53: geocube.GetZonalStatisticsRequest.records:type_name -> geocube.RecordIdList
+	35, // 54: geocube.GetZonalStatisticsRequest.filters:type_name -> geocube.RecordFilters
+	3,  // 55: geocube.GetZonalStatisticsRequest.statistics:type_name -> geocube.GetZonalStatisticsRequest.Statistic
+	25, // 56: geocube.GetZonalStatisticsRequest.histogram:type_name -> geocube.HistogramBins
+	40, // 57: geocube.GetZonalStatisticsResponse.datetime:type_name -> google.protobuf.Timestamp
+	27, // 58: geocube.GetZonalStatisticsResponse.bands:type_name -> geocube.ZonalStatistics
+	59, // [59:59] is the sub-list for method output_type
+	59, // [59:59] is the sub-list for method input_type
+	59, // [59:59] is the sub-list for extension type_name
+	59, // [59:59] is the sub-list for extension extendee
+	0,  // [0:59] is the sub-list for field type_name
+}
+
+func init() { file_pb_catalog_proto_init() }
+func file_pb_catalog_proto_init() {
+	if File_pb_catalog_proto != nil {
+		return
+	}
+	file_pb_dataformat_proto_init()
+	file_pb_records_proto_init()
 	file_pb_layouts_proto_init()
 	file_pb_datasetMeta_proto_init()
 	file_pb_variables_proto_init()
@@ -1637,7 +3139,7 @@ func file_pb_catalog_proto_init() {
 			}
 		}
 		file_pb_catalog_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ImageChunk); i {
+			switch v := v.(*BandGroup); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1649,7 +3151,7 @@ func file_pb_catalog_proto_init() {
 			}
 		}
 		file_pb_catalog_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ImageFile); i {
+			switch v := v.(*ImageChunk); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1661,7 +3163,7 @@ func file_pb_catalog_proto_init() {
 			}
 		}
 		file_pb_catalog_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListDatasetsRequest); i {
+			switch v := v.(*ImageFile); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1673,7 +3175,7 @@ func file_pb_catalog_proto_init() {
 			}
 		}
 		file_pb_catalog_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListDatasetsResponse); i {
+			switch v := v.(*ListDatasetsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1685,7 +3187,7 @@ func file_pb_catalog_proto_init() {
 			}
 		}
 		file_pb_catalog_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCubeRequest); i {
+			switch v := v.(*ListDatasetsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1697,7 +3199,7 @@ func file_pb_catalog_proto_init() {
 			}
 		}
 		file_pb_catalog_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCubeResponseHeader); i {
+			switch v := v.(*GetCubeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1709,7 +3211,7 @@ func file_pb_catalog_proto_init() {
 			}
 		}
 		file_pb_catalog_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCubeResponse); i {
+			switch v := v.(*DateRange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1721,7 +3223,7 @@ func file_pb_catalog_proto_init() {
 			}
 		}
 		file_pb_catalog_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCubeMetadataRequest); i {
+			switch v := v.(*DateRanges); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1733,7 +3235,7 @@ func file_pb_catalog_proto_init() {
 			}
 		}
 		file_pb_catalog_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCubeMetadataResponse); i {
+			switch v := v.(*Compositing); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1745,7 +3247,7 @@ func file_pb_catalog_proto_init() {
 			}
 		}
 		file_pb_catalog_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTileRequest); i {
+			switch v := v.(*GetCubeResponseHeader); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1757,6 +3259,54 @@ func file_pb_catalog_proto_init() {
 			}
 		}
 		file_pb_catalog_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCubeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_catalog_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCubeMetadataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_catalog_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCubeMetadataResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_catalog_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_catalog_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetTileResponse); i {
 			case 0:
 				return &v.state
@@ -1768,37 +3318,146 @@ func file_pb_catalog_proto_init() {
 				return nil
 			}
 		}
+		file_pb_catalog_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimeSeriesLocation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_catalog_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTimeSeriesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_catalog_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimeSeriesValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_catalog_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTimeSeriesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_catalog_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HistogramBins); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_catalog_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetZonalStatisticsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_catalog_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ZonalStatistics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_catalog_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetZonalStatisticsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_pb_catalog_proto_msgTypes[4].OneofWrappers = []interface{}{
+	file_pb_catalog_proto_msgTypes[5].OneofWrappers = []interface{}{
 		(*ListDatasetsRequest_Records)(nil),
 		(*ListDatasetsRequest_Filters)(nil),
 	}
-	file_pb_catalog_proto_msgTypes[6].OneofWrappers = []interface{}{
+	file_pb_catalog_proto_msgTypes[7].OneofWrappers = []interface{}{
 		(*GetCubeRequest_Records)(nil),
 		(*GetCubeRequest_Filters)(nil),
 		(*GetCubeRequest_GroupedRecords)(nil),
 	}
-	file_pb_catalog_proto_msgTypes[8].OneofWrappers = []interface{}{
+	file_pb_catalog_proto_msgTypes[10].OneofWrappers = []interface{}{
+		(*Compositing_PeriodDays)(nil),
+		(*Compositing_PeriodMonths)(nil),
+		(*Compositing_DateRanges)(nil),
+	}
+	file_pb_catalog_proto_msgTypes[12].OneofWrappers = []interface{}{
 		(*GetCubeResponse_GlobalHeader)(nil),
 		(*GetCubeResponse_Header)(nil),
 		(*GetCubeResponse_Chunk)(nil),
 	}
-	file_pb_catalog_proto_msgTypes[10].OneofWrappers = []interface{}{
+	file_pb_catalog_proto_msgTypes[14].OneofWrappers = []interface{}{
 		(*GetCubeMetadataResponse_GlobalHeader)(nil),
 		(*GetCubeMetadataResponse_Header)(nil),
 		(*GetCubeMetadataResponse_Chunk)(nil),
 	}
-	file_pb_catalog_proto_msgTypes[11].OneofWrappers = []interface{}{
+	file_pb_catalog_proto_msgTypes[15].OneofWrappers = []interface{}{
 		(*GetTileRequest_Records)(nil),
 		(*GetTileRequest_Filters)(nil),
 	}
+	file_pb_catalog_proto_msgTypes[18].OneofWrappers = []interface{}{
+		(*GetTimeSeriesRequest_Records)(nil),
+		(*GetTimeSeriesRequest_Filters)(nil),
+	}
+	file_pb_catalog_proto_msgTypes[22].OneofWrappers = []interface{}{
+		(*GetZonalStatisticsRequest_Records)(nil),
+		(*GetZonalStatisticsRequest_Filters)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pb_catalog_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   13,
+			NumEnums:      4,
+			NumMessages:   25,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,1277 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.12
+// source: pb/events.proto
+
+package geocube
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TaskEvent_Status int32
+
+const (
+	TaskEvent_TaskSuccessful TaskEvent_Status = 0
+	TaskEvent_TaskFailed     TaskEvent_Status = 1
+	TaskEvent_TaskIgnored    TaskEvent_Status = 2 // Nothing to perform
+	TaskEvent_TaskCancelled  TaskEvent_Status = 3 // The task has been cancelled externally (nothing has been done)
+	TaskEvent_TaskSent       TaskEvent_Status = 4
+)
+
+// Enum value maps for TaskEvent_Status.
+var (
+	TaskEvent_Status_name = map[int32]string{
+		0: "TaskSuccessful",
+		1: "TaskFailed",
+		2: "TaskIgnored",
+		3: "TaskCancelled",
+		4: "TaskSent",
+	}
+	TaskEvent_Status_value = map[string]int32{
+		"TaskSuccessful": 0,
+		"TaskFailed":     1,
+		"TaskIgnored":    2,
+		"TaskCancelled":  3,
+		"TaskSent":       4,
+	}
+)
+
+func (x TaskEvent_Status) Enum() *TaskEvent_Status {
+	p := new(TaskEvent_Status)
+	*p = x
+	return p
+}
+
+func (x TaskEvent_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TaskEvent_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_pb_events_proto_enumTypes[0].Descriptor()
+}
+
+func (TaskEvent_Status) Type() protoreflect.EnumType {
+	return &file_pb_events_proto_enumTypes[0]
+}
+
+func (x TaskEvent_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TaskEvent_Status.Descriptor instead.
+func (TaskEvent_Status) EnumDescriptor() ([]byte, []int) {
+	return file_pb_events_proto_rawDescGZIP(), []int{1, 0}
+}
+
+type JobEvent_Status int32
+
+const (
+	JobEvent_JobCreated                  JobEvent_Status = 0
+	JobEvent_OrdersPrepared              JobEvent_Status = 1
+	JobEvent_PrepareOrdersFailed         JobEvent_Status = 2
+	JobEvent_SendOrdersFailed            JobEvent_Status = 3
+	JobEvent_ConsolidationDone           JobEvent_Status = 4
+	JobEvent_ConsolidationFailed         JobEvent_Status = 5
+	JobEvent_ConsolidationRetryFailed    JobEvent_Status = 6
+	JobEvent_ConsolidationIndexed        JobEvent_Status = 7
+	JobEvent_ConsolidationIndexingFailed JobEvent_Status = 8
+	JobEvent_DatasetsSwapped             JobEvent_Status = 9
+	JobEvent_SwapDatasetsFailed          JobEvent_Status = 10
+	JobEvent_DeletionStarted             JobEvent_Status = 11
+	JobEvent_StartDeletionFailed         JobEvent_Status = 12
+	JobEvent_DeletionReady               JobEvent_Status = 13
+	JobEvent_DeletionNotReady            JobEvent_Status = 14
+	JobEvent_RemovalDone                 JobEvent_Status = 15
+	JobEvent_DeletionDone                JobEvent_Status = 16
+	JobEvent_RemovalFailed               JobEvent_Status = 17
+	JobEvent_DeletionFailed              JobEvent_Status = 18
+	JobEvent_CancelledByUser             JobEvent_Status = 19
+	JobEvent_CancelledByUserForced       JobEvent_Status = 20
+	JobEvent_CancellationFailed          JobEvent_Status = 21
+	JobEvent_CancellationDone            JobEvent_Status = 22
+	JobEvent_RollbackFailed              JobEvent_Status = 23
+	JobEvent_RollbackDone                JobEvent_Status = 24
+	JobEvent_Retried                     JobEvent_Status = 25
+	JobEvent_RetryForced                 JobEvent_Status = 26
+	JobEvent_Continue                    JobEvent_Status = 27
+)
+
+// Enum value maps for JobEvent_Status.
+var (
+	JobEvent_Status_name = map[int32]string{
+		0:  "JobCreated",
+		1:  "OrdersPrepared",
+		2:  "PrepareOrdersFailed",
+		3:  "SendOrdersFailed",
+		4:  "ConsolidationDone",
+		5:  "ConsolidationFailed",
+		6:  "ConsolidationRetryFailed",
+		7:  "ConsolidationIndexed",
+		8:  "ConsolidationIndexingFailed",
+		9:  "DatasetsSwapped",
+		10: "SwapDatasetsFailed",
+		11: "DeletionStarted",
+		12: "StartDeletionFailed",
+		13: "DeletionReady",
+		14: "DeletionNotReady",
+		15: "RemovalDone",
+		16: "DeletionDone",
+		17: "RemovalFailed",
+		18: "DeletionFailed",
+		19: "CancelledByUser",
+		20: "CancelledByUserForced",
+		21: "CancellationFailed",
+		22: "CancellationDone",
+		23: "RollbackFailed",
+		24: "RollbackDone",
+		25: "Retried",
+		26: "RetryForced",
+		27: "Continue",
+	}
+	JobEvent_Status_value = map[string]int32{
+		"JobCreated":                  0,
+		"OrdersPrepared":              1,
+		"PrepareOrdersFailed":         2,
+		"SendOrdersFailed":            3,
+		"ConsolidationDone":           4,
+		"ConsolidationFailed":         5,
+		"ConsolidationRetryFailed":    6,
+		"ConsolidationIndexed":        7,
+		"ConsolidationIndexingFailed": 8,
+		"DatasetsSwapped":             9,
+		"SwapDatasetsFailed":          10,
+		"DeletionStarted":             11,
+		"StartDeletionFailed":         12,
+		"DeletionReady":               13,
+		"DeletionNotReady":            14,
+		"RemovalDone":                 15,
+		"DeletionDone":                16,
+		"RemovalFailed":               17,
+		"DeletionFailed":              18,
+		"CancelledByUser":             19,
+		"CancelledByUserForced":       20,
+		"CancellationFailed":          21,
+		"CancellationDone":            22,
+		"RollbackFailed":              23,
+		"RollbackDone":                24,
+		"Retried":                     25,
+		"RetryForced":                 26,
+		"Continue":                    27,
+	}
+)
+
+func (x JobEvent_Status) Enum() *JobEvent_Status {
+	p := new(JobEvent_Status)
+	*p = x
+	return p
+}
+
+func (x JobEvent_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JobEvent_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_pb_events_proto_enumTypes[1].Descriptor()
+}
+
+func (JobEvent_Status) Type() protoreflect.EnumType {
+	return &file_pb_events_proto_enumTypes[1]
+}
+
+func (x JobEvent_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JobEvent_Status.Descriptor instead.
+func (JobEvent_Status) EnumDescriptor() ([]byte, []int) {
+	return file_pb_events_proto_rawDescGZIP(), []int{2, 0}
+}
+
+// *
+// Envelope of the messages exchanged between the Geocube server and the consolidation workers.
+// The schema_version is incremented each time an incompatible change is made to the events.
+// Consumers must ignore the unknown fields and reject the events with an unsupported schema_version.
+type Event struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SchemaVersion uint32 `protobuf:"varint,1,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"` // Version of the schema of the event (current: 1)
+	// Types that are assignable to Payload:
+	//
+	//	*Event_Task
+	//	*Event_Job
+	//	*Event_Consolidation
+	Payload isEvent_Payload `protobuf_oneof:"payload"`
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_events_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_events_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_pb_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Event) GetSchemaVersion() uint32 {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return 0
+}
+
+func (m *Event) GetPayload() isEvent_Payload {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (x *Event) GetTask() *TaskEvent {
+	if x, ok := x.GetPayload().(*Event_Task); ok {
+		return x.Task
+	}
+	return nil
+}
+
+func (x *Event) GetJob() *JobEvent {
+	if x, ok := x.GetPayload().(*Event_Job); ok {
+		return x.Job
+	}
+	return nil
+}
+
+func (x *Event) GetConsolidation() *ConsolidationEvent {
+	if x, ok := x.GetPayload().(*Event_Consolidation); ok {
+		return x.Consolidation
+	}
+	return nil
+}
+
+type isEvent_Payload interface {
+	isEvent_Payload()
+}
+
+type Event_Task struct {
+	Task *TaskEvent `protobuf:"bytes,2,opt,name=task,proto3,oneof"` // Sent by the consolidater when a consolidation task is finished
+}
+
+type Event_Job struct {
+	Job *JobEvent `protobuf:"bytes,3,opt,name=job,proto3,oneof"` // Sent by the server when a step of a job is finished
+}
+
+type Event_Consolidation struct {
+	Consolidation *ConsolidationEvent `protobuf:"bytes,4,opt,name=consolidation,proto3,oneof"` // Sent by the server to start a consolidation task
+}
+
+func (*Event_Task) isEvent_Payload() {}
+
+func (*Event_Job) isEvent_Payload() {}
+
+func (*Event_Consolidation) isEvent_Payload() {}
+
+// *
+// Event sent by the consolidater when a consolidation task is finished
+type TaskEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobId    string           `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	TaskId   string           `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Status   TaskEvent_Status `protobuf:"varint,3,opt,name=status,proto3,enum=geocube.TaskEvent_Status" json:"status,omitempty"`
+	Error    string           `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	Size     int64            `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`        // Size of the file produced by a successful consolidation task
+	Checksum string           `protobuf:"bytes,6,opt,name=checksum,proto3" json:"checksum,omitempty"` // Checksums of the file produced by a successful consolidation task
+}
+
+func (x *TaskEvent) Reset() {
+	*x = TaskEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_events_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaskEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskEvent) ProtoMessage() {}
+
+func (x *TaskEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_events_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskEvent.ProtoReflect.Descriptor instead.
+func (*TaskEvent) Descriptor() ([]byte, []int) {
+	return file_pb_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TaskEvent) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *TaskEvent) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *TaskEvent) GetStatus() TaskEvent_Status {
+	if x != nil {
+		return x.Status
+	}
+	return TaskEvent_TaskSuccessful
+}
+
+func (x *TaskEvent) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *TaskEvent) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *TaskEvent) GetChecksum() string {
+	if x != nil {
+		return x.Checksum
+	}
+	return ""
+}
+
+// *
+// Event sent during the job when one of the job steps is finished
+type JobEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobId  string          `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Status JobEvent_Status `protobuf:"varint,2,opt,name=status,proto3,enum=geocube.JobEvent_Status" json:"status,omitempty"`
+	Error  string          `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	State  string          `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"` // State of the job when the event was sent (optional). The event is ignored if the job is no longer in this state (duplicate or outdated event)
+}
+
+func (x *JobEvent) Reset() {
+	*x = JobEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_events_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JobEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobEvent) ProtoMessage() {}
+
+func (x *JobEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_events_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobEvent.ProtoReflect.Descriptor instead.
+func (*JobEvent) Descriptor() ([]byte, []int) {
+	return file_pb_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *JobEvent) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *JobEvent) GetStatus() JobEvent_Status {
+	if x != nil {
+		return x.Status
+	}
+	return JobEvent_JobCreated
+}
+
+func (x *JobEvent) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *JobEvent) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+// *
+// Event sent to the consolidater to start a consolidation task
+type ConsolidationEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobId     string                  `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	TaskId    string                  `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Records   []*ConsolidationRecord  `protobuf:"bytes,3,rep,name=records,proto3" json:"records,omitempty"`
+	Container *ConsolidationContainer `protobuf:"bytes,4,opt,name=container,proto3" json:"container,omitempty"`
+}
+
+func (x *ConsolidationEvent) Reset() {
+	*x = ConsolidationEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_events_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConsolidationEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsolidationEvent) ProtoMessage() {}
+
+func (x *ConsolidationEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_events_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsolidationEvent.ProtoReflect.Descriptor instead.
+func (*ConsolidationEvent) Descriptor() ([]byte, []int) {
+	return file_pb_events_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ConsolidationEvent) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *ConsolidationEvent) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *ConsolidationEvent) GetRecords() []*ConsolidationRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+func (x *ConsolidationEvent) GetContainer() *ConsolidationContainer {
+	if x != nil {
+		return x.Container
+	}
+	return nil
+}
+
+// *
+// Date and list of datasets to consolidate
+type ConsolidationRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Datetime   string                  `protobuf:"bytes,2,opt,name=datetime,proto3" json:"datetime,omitempty"` // "2018-01-01 12:00:00"
+	Datasets   []*ConsolidationDataset `protobuf:"bytes,3,rep,name=datasets,proto3" json:"datasets,omitempty"`
+	ValidShape []byte                  `protobuf:"bytes,4,opt,name=valid_shape,json=validShape,proto3" json:"valid_shape,omitempty"` // Optional multipolygon (hex-encoded EWKB with its SRID)
+}
+
+func (x *ConsolidationRecord) Reset() {
+	*x = ConsolidationRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_events_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConsolidationRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsolidationRecord) ProtoMessage() {}
+
+func (x *ConsolidationRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_events_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsolidationRecord.ProtoReflect.Descriptor instead.
+func (*ConsolidationRecord) Descriptor() ([]byte, []int) {
+	return file_pb_events_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ConsolidationRecord) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ConsolidationRecord) GetDatetime() string {
+	if x != nil {
+		return x.Datetime
+	}
+	return ""
+}
+
+func (x *ConsolidationRecord) GetDatasets() []*ConsolidationDataset {
+	if x != nil {
+		return x.Datasets
+	}
+	return nil
+}
+
+func (x *ConsolidationRecord) GetValidShape() []byte {
+	if x != nil {
+		return x.ValidShape
+	}
+	return nil
+}
+
+// *
+// Information on a dataset to consolidate it
+type ConsolidationDataset struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri          string      `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`                                           // "gs://...."
+	Subdir       string      `protobuf:"bytes,2,opt,name=subdir,proto3" json:"subdir,omitempty"`                                     // "GTIFF_DIR:1"
+	Bands        []int64     `protobuf:"varint,3,rep,packed,name=bands,proto3" json:"bands,omitempty"`                               // [1, 2, 3]
+	Overviews    bool        `protobuf:"varint,4,opt,name=overviews,proto3" json:"overviews,omitempty"`                              // True if the dataset has overviews
+	Dformat      *DataFormat `protobuf:"bytes,5,opt,name=dformat,proto3" json:"dformat,omitempty"`                                   // Internal data format
+	RealMinValue float64     `protobuf:"fixed64,6,opt,name=real_min_value,json=realMinValue,proto3" json:"real_min_value,omitempty"` // Real min value (dformat.min_value maps to real_min_value)
+	RealMaxValue float64     `protobuf:"fixed64,7,opt,name=real_max_value,json=realMaxValue,proto3" json:"real_max_value,omitempty"` // Real max value (dformat.max_value maps to real_max_value)
+	Exponent     float64     `protobuf:"fixed64,8,opt,name=exponent,proto3" json:"exponent,omitempty"`                               // 1: linear scaling (RealMax - RealMin) * pow( (Value - Min) / (Max - Min), Exponent) + RealMin
+}
+
+func (x *ConsolidationDataset) Reset() {
+	*x = ConsolidationDataset{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_events_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConsolidationDataset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsolidationDataset) ProtoMessage() {}
+
+func (x *ConsolidationDataset) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_events_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsolidationDataset.ProtoReflect.Descriptor instead.
+func (*ConsolidationDataset) Descriptor() ([]byte, []int) {
+	return file_pb_events_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ConsolidationDataset) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *ConsolidationDataset) GetSubdir() string {
+	if x != nil {
+		return x.Subdir
+	}
+	return ""
+}
+
+func (x *ConsolidationDataset) GetBands() []int64 {
+	if x != nil {
+		return x.Bands
+	}
+	return nil
+}
+
+func (x *ConsolidationDataset) GetOverviews() bool {
+	if x != nil {
+		return x.Overviews
+	}
+	return false
+}
+
+func (x *ConsolidationDataset) GetDformat() *DataFormat {
+	if x != nil {
+		return x.Dformat
+	}
+	return nil
+}
+
+func (x *ConsolidationDataset) GetRealMinValue() float64 {
+	if x != nil {
+		return x.RealMinValue
+	}
+	return 0
+}
+
+func (x *ConsolidationDataset) GetRealMaxValue() float64 {
+	if x != nil {
+		return x.RealMaxValue
+	}
+	return 0
+}
+
+func (x *ConsolidationDataset) GetExponent() float64 {
+	if x != nil {
+		return x.Exponent
+	}
+	return 0
+}
+
+// *
+// Information to create the output of the consolidation
+type ConsolidationContainer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri                string            `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`                                           // "gs://bucket/mucog/random_name.TIF"
+	Dformat            *DataFormat       `protobuf:"bytes,2,opt,name=dformat,proto3" json:"dformat,omitempty"`                                   // Internal data format
+	RealMinValue       float64           `protobuf:"fixed64,3,opt,name=real_min_value,json=realMinValue,proto3" json:"real_min_value,omitempty"` // Real min value (dformat.min_value maps to real_min_value)
+	RealMaxValue       float64           `protobuf:"fixed64,4,opt,name=real_max_value,json=realMaxValue,proto3" json:"real_max_value,omitempty"` // Real max value (dformat.max_value maps to real_max_value)
+	Exponent           float64           `protobuf:"fixed64,5,opt,name=exponent,proto3" json:"exponent,omitempty"`                               // 1: linear scaling (RealMax - RealMin) * pow( (Value - Min) / (Max - Min), Exponent) + RealMin
+	Crs                string            `protobuf:"bytes,6,opt,name=crs,proto3" json:"crs,omitempty"`                                           // "+init=epsg:XXXX" or WKT
+	Transform          []float64         `protobuf:"fixed64,7,rep,packed,name=transform,proto3" json:"transform,omitempty"`                      // [x0, 10, 0, y_0, 0, -10] Pixels of the image to coordinates in the CRS
+	Width              int32             `protobuf:"varint,8,opt,name=width,proto3" json:"width,omitempty"`
+	Height             int32             `protobuf:"varint,9,opt,name=height,proto3" json:"height,omitempty"`
+	Cutline            string            `protobuf:"bytes,10,opt,name=cutline,proto3" json:"cutline,omitempty"` // POLYGON(coords)
+	BandsCount         int32             `protobuf:"varint,11,opt,name=bands_count,json=bandsCount,proto3" json:"bands_count,omitempty"`
+	BlockXSize         int32             `protobuf:"varint,12,opt,name=block_x_size,json=blockXSize,proto3" json:"block_x_size,omitempty"`
+	BlockYSize         int32             `protobuf:"varint,13,opt,name=block_y_size,json=blockYSize,proto3" json:"block_y_size,omitempty"`
+	InterlacingPattern string            `protobuf:"bytes,14,opt,name=interlacing_pattern,json=interlacingPattern,proto3" json:"interlacing_pattern,omitempty"` // L=0>T>I>P;I>L=1:>T>P (see github.com/airbusgeo/mucog)
+	OverviewsMinSize   int32             `protobuf:"varint,15,opt,name=overviews_min_size,json=overviewsMinSize,proto3" json:"overviews_min_size,omitempty"`    // Maximum width or height of the smallest overview level. 0: no overview, -1: default (=256)
+	ResamplingAlg      Resampling        `protobuf:"varint,16,opt,name=resampling_alg,json=resamplingAlg,proto3,enum=geocube.Resampling" json:"resampling_alg,omitempty"`
+	OvrResamplingAlg   Resampling        `protobuf:"varint,17,opt,name=ovr_resampling_alg,json=ovrResamplingAlg,proto3,enum=geocube.Resampling" json:"ovr_resampling_alg,omitempty"`
+	OptimizeExtent     bool              `protobuf:"varint,18,opt,name=optimize_extent,json=optimizeExtent,proto3" json:"optimize_extent,omitempty"`                                                                                        // True to crop the dataset to valid pixels
+	CreationParams     map[string]string `protobuf:"bytes,19,rep,name=creation_params,json=creationParams,proto3" json:"creation_params,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // Some of GDAL Creation Options
+	StorageClass       StorageClass      `protobuf:"varint,20,opt,name=storage_class,json=storageClass,proto3,enum=geocube.StorageClass" json:"storage_class,omitempty"`
+}
+
+func (x *ConsolidationContainer) Reset() {
+	*x = ConsolidationContainer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_events_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConsolidationContainer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsolidationContainer) ProtoMessage() {}
+
+func (x *ConsolidationContainer) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_events_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsolidationContainer.ProtoReflect.Descriptor instead.
+func (*ConsolidationContainer) Descriptor() ([]byte, []int) {
+	return file_pb_events_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ConsolidationContainer) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *ConsolidationContainer) GetDformat() *DataFormat {
+	if x != nil {
+		return x.Dformat
+	}
+	return nil
+}
+
+func (x *ConsolidationContainer) GetRealMinValue() float64 {
+	if x != nil {
+		return x.RealMinValue
+	}
+	return 0
+}
+
+func (x *ConsolidationContainer) GetRealMaxValue() float64 {
+	if x != nil {
+		return x.RealMaxValue
+	}
+	return 0
+}
+
+func (x *ConsolidationContainer) GetExponent() float64 {
+	if x != nil {
+		return x.Exponent
+	}
+	return 0
+}
+
+func (x *ConsolidationContainer) GetCrs() string {
+	if x != nil {
+		return x.Crs
+	}
+	return ""
+}
+
+func (x *ConsolidationContainer) GetTransform() []float64 {
+	if x != nil {
+		return x.Transform
+	}
+	return nil
+}
+
+func (x *ConsolidationContainer) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *ConsolidationContainer) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ConsolidationContainer) GetCutline() string {
+	if x != nil {
+		return x.Cutline
+	}
+	return ""
+}
+
+func (x *ConsolidationContainer) GetBandsCount() int32 {
+	if x != nil {
+		return x.BandsCount
+	}
+	return 0
+}
+
+func (x *ConsolidationContainer) GetBlockXSize() int32 {
+	if x != nil {
+		return x.BlockXSize
+	}
+	return 0
+}
+
+func (x *ConsolidationContainer) GetBlockYSize() int32 {
+	if x != nil {
+		return x.BlockYSize
+	}
+	return 0
+}
+
+func (x *ConsolidationContainer) GetInterlacingPattern() string {
+	if x != nil {
+		return x.InterlacingPattern
+	}
+	return ""
+}
+
+func (x *ConsolidationContainer) GetOverviewsMinSize() int32 {
+	if x != nil {
+		return x.OverviewsMinSize
+	}
+	return 0
+}
+
+func (x *ConsolidationContainer) GetResamplingAlg() Resampling {
+	if x != nil {
+		return x.ResamplingAlg
+	}
+	return Resampling_UNDEFINED
+}
+
+func (x *ConsolidationContainer) GetOvrResamplingAlg() Resampling {
+	if x != nil {
+		return x.OvrResamplingAlg
+	}
+	return Resampling_UNDEFINED
+}
+
+func (x *ConsolidationContainer) GetOptimizeExtent() bool {
+	if x != nil {
+		return x.OptimizeExtent
+	}
+	return false
+}
+
+func (x *ConsolidationContainer) GetCreationParams() map[string]string {
+	if x != nil {
+		return x.CreationParams
+	}
+	return nil
+}
+
+func (x *ConsolidationContainer) GetStorageClass() StorageClass {
+	if x != nil {
+		return x.StorageClass
+	}
+	return StorageClass_STANDARD
+}
+
+var File_pb_events_proto protoreflect.FileDescriptor
+
+var file_pb_events_proto_rawDesc = []byte{
+	0x0a, 0x0f, 0x70, 0x62, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x07, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x1a, 0x13, 0x70, 0x62, 0x2f, 0x64,
+	0x61, 0x74, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x12, 0x70, 0x62, 0x2f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x13, 0x70, 0x62, 0x2f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xcf, 0x01, 0x0a, 0x05, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x73, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x04, 0x74, 0x61, 0x73,
+	0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62,
+	0x65, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x04, 0x74,
+	0x61, 0x73, 0x6b, 0x12, 0x25, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x4a, 0x6f, 0x62, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x12, 0x43, 0x0a, 0x0d, 0x63, 0x6f,
+	0x6e, 0x73, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x73,
+	0x6f, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00,
+	0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x09, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x94, 0x02, 0x0a, 0x09, 0x54,
+	0x61, 0x73, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12,
+	0x17, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75,
+	0x62, 0x65, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75,
+	0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75,
+	0x6d, 0x22, 0x5e, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x0e, 0x54,
+	0x61, 0x73, 0x6b, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x10, 0x00, 0x12,
+	0x0e, 0x0a, 0x0a, 0x54, 0x61, 0x73, 0x6b, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x01, 0x12,
+	0x0f, 0x0a, 0x0b, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x64, 0x10, 0x02,
+	0x12, 0x11, 0x0a, 0x0d, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65,
+	0x64, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x65, 0x6e, 0x74, 0x10,
+	0x04, 0x22, 0xe4, 0x05, 0x0a, 0x08, 0x4a, 0x6f, 0x62, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x15,
+	0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e,
+	0x4a, 0x6f, 0x62, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x22, 0xe2, 0x04, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0e,
+	0x0a, 0x0a, 0x4a, 0x6f, 0x62, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x10, 0x00, 0x12, 0x12,
+	0x0a, 0x0e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x64,
+	0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x73, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x53,
+	0x65, 0x6e, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10,
+	0x03, 0x12, 0x15, 0x0a, 0x11, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x44, 0x6f, 0x6e, 0x65, 0x10, 0x04, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x6f, 0x6e, 0x73,
+	0x6f, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10,
+	0x05, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x06, 0x12,
+	0x18, 0x0a, 0x14, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x10, 0x07, 0x12, 0x1f, 0x0a, 0x1b, 0x43, 0x6f, 0x6e,
+	0x73, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x69,
+	0x6e, 0x67, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x08, 0x12, 0x13, 0x0a, 0x0f, 0x44, 0x61,
+	0x74, 0x61, 0x73, 0x65, 0x74, 0x73, 0x53, 0x77, 0x61, 0x70, 0x70, 0x65, 0x64, 0x10, 0x09, 0x12,
+	0x16, 0x0a, 0x12, 0x53, 0x77, 0x61, 0x70, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x73, 0x46,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x0a, 0x12, 0x13, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x10, 0x0b, 0x12, 0x17, 0x0a, 0x13,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x10, 0x0c, 0x12, 0x11, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x61, 0x64, 0x79, 0x10, 0x0d, 0x12, 0x14, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x52, 0x65, 0x61, 0x64, 0x79, 0x10, 0x0e, 0x12, 0x0f,
+	0x0a, 0x0b, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x44, 0x6f, 0x6e, 0x65, 0x10, 0x0f, 0x12,
+	0x10, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x6f, 0x6e, 0x65, 0x10,
+	0x10, 0x12, 0x11, 0x0a, 0x0d, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x46, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x10, 0x11, 0x12, 0x12, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e,
+	0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x12, 0x12, 0x13, 0x0a, 0x0f, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x6c, 0x65, 0x64, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x10, 0x13, 0x12, 0x19, 0x0a,
+	0x15, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72,
+	0x46, 0x6f, 0x72, 0x63, 0x65, 0x64, 0x10, 0x14, 0x12, 0x16, 0x0a, 0x12, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x15,
+	0x12, 0x14, 0x0a, 0x10, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x44, 0x6f, 0x6e, 0x65, 0x10, 0x16, 0x12, 0x12, 0x0a, 0x0e, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61,
+	0x63, 0x6b, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x17, 0x12, 0x10, 0x0a, 0x0c, 0x52, 0x6f,
+	0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x44, 0x6f, 0x6e, 0x65, 0x10, 0x18, 0x12, 0x0b, 0x0a, 0x07,
+	0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x64, 0x10, 0x19, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x65, 0x74,
+	0x72, 0x79, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x64, 0x10, 0x1a, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x6f,
+	0x6e, 0x74, 0x69, 0x6e, 0x75, 0x65, 0x10, 0x1b, 0x22, 0xbb, 0x01, 0x0a, 0x12, 0x43, 0x6f, 0x6e,
+	0x73, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12,
+	0x36, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x6f,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x3d, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x67, 0x65, 0x6f,
+	0x63, 0x75, 0x62, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x09, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x22, 0x9d, 0x01, 0x0a, 0x13, 0x43, 0x6f, 0x6e, 0x73, 0x6f,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x39, 0x0a, 0x08, 0x64, 0x61,
+	0x74, 0x61, 0x73, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x67,
+	0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x52, 0x08, 0x64, 0x61, 0x74,
+	0x61, 0x73, 0x65, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x73,
+	0x68, 0x61, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x53, 0x68, 0x61, 0x70, 0x65, 0x22, 0x8b, 0x02, 0x0a, 0x14, 0x43, 0x6f, 0x6e, 0x73, 0x6f,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72,
+	0x69, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x75, 0x62, 0x64, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x75, 0x62, 0x64, 0x69, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x61, 0x6e,
+	0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03, 0x52, 0x05, 0x62, 0x61, 0x6e, 0x64, 0x73, 0x12,
+	0x1c, 0x0a, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x73, 0x12, 0x2d, 0x0a,
+	0x07, 0x64, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x46, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x52, 0x07, 0x64, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x24, 0x0a, 0x0e,
+	0x72, 0x65, 0x61, 0x6c, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x72, 0x65, 0x61, 0x6c, 0x4d, 0x69, 0x6e, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x72, 0x65, 0x61, 0x6c, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x72, 0x65, 0x61, 0x6c,
+	0x4d, 0x61, 0x78, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x65, 0x78, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x22, 0x82, 0x07, 0x0a, 0x16, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12,
+	0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72,
+	0x69, 0x12, 0x2d, 0x0a, 0x07, 0x64, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x44, 0x61, 0x74,
+	0x61, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x07, 0x64, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x12, 0x24, 0x0a, 0x0e, 0x72, 0x65, 0x61, 0x6c, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x72, 0x65, 0x61, 0x6c, 0x4d, 0x69,
+	0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x72, 0x65, 0x61, 0x6c, 0x5f, 0x6d,
+	0x61, 0x78, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c,
+	0x72, 0x65, 0x61, 0x6c, 0x4d, 0x61, 0x78, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x65, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08,
+	0x65, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x72, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x07, 0x20, 0x03, 0x28, 0x01, 0x52, 0x09, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74,
+	0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16,
+	0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x74, 0x6c, 0x69, 0x6e,
+	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61, 0x6e, 0x64, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x62, 0x61, 0x6e, 0x64, 0x73, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x20, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x78, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x58, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x79, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x59, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x2f, 0x0a, 0x13, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6c, 0x61,
+	0x63, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x12, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6c, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x50,
+	0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x2c, 0x0a, 0x12, 0x6f, 0x76, 0x65, 0x72, 0x76, 0x69,
+	0x65, 0x77, 0x73, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x10, 0x6f, 0x76, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x73, 0x4d, 0x69, 0x6e,
+	0x53, 0x69, 0x7a, 0x65, 0x12, 0x3a, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69,
+	0x6e, 0x67, 0x5f, 0x61, 0x6c, 0x67, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x67,
+	0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e,
+	0x67, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x41, 0x6c, 0x67,
+	0x12, 0x41, 0x0a, 0x12, 0x6f, 0x76, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69,
+	0x6e, 0x67, 0x5f, 0x61, 0x6c, 0x67, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x67,
+	0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e,
+	0x67, 0x52, 0x10, 0x6f, 0x76, 0x72, 0x52, 0x65, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67,
+	0x41, 0x6c, 0x67, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x65, 0x5f,
+	0x65, 0x78, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x6f, 0x70,
+	0x74, 0x69, 0x6d, 0x69, 0x7a, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x5c, 0x0a, 0x0f,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18,
+	0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e,
+	0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x3a, 0x0a, 0x0d, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x15, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x53, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x52, 0x0c, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x1a, 0x41, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0e, 0x5a, 0x0c, 0x2e, 0x2f, 0x70,
+	0x62, 0x3b, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_pb_events_proto_rawDescOnce sync.Once
+	file_pb_events_proto_rawDescData = file_pb_events_proto_rawDesc
+)
+
+func file_pb_events_proto_rawDescGZIP() []byte {
+	file_pb_events_proto_rawDescOnce.Do(func() {
+		file_pb_events_proto_rawDescData = protoimpl.X.CompressGZIP(file_pb_events_proto_rawDescData)
+	})
+	return file_pb_events_proto_rawDescData
+}
+
+var file_pb_events_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_pb_events_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_pb_events_proto_goTypes = []interface{}{
+	(TaskEvent_Status)(0),          // 0: geocube.TaskEvent.Status
+	(JobEvent_Status)(0),           // 1: geocube.JobEvent.Status
+	(*Event)(nil),                  // 2: geocube.Event
+	(*TaskEvent)(nil),              // 3: geocube.TaskEvent
+	(*JobEvent)(nil),               // 4: geocube.JobEvent
+	(*ConsolidationEvent)(nil),     // 5: geocube.ConsolidationEvent
+	(*ConsolidationRecord)(nil),    // 6: geocube.ConsolidationRecord
+	(*ConsolidationDataset)(nil),   // 7: geocube.ConsolidationDataset
+	(*ConsolidationContainer)(nil), // 8: geocube.ConsolidationContainer
+	nil,                            // 9: geocube.ConsolidationContainer.CreationParamsEntry
+	(*DataFormat)(nil),             // 10: geocube.DataFormat
+	(Resampling)(0),                // 11: geocube.Resampling
+	(StorageClass)(0),              // 12: geocube.StorageClass
+}
+var file_pb_events_proto_depIdxs = []int32{
+	3,  // 0: geocube.Event.task:type_name -> geocube.TaskEvent
+	4,  // 1: geocube.Event.job:type_name -> geocube.JobEvent
+	5,  // 2: geocube.Event.consolidation:type_name -> geocube.ConsolidationEvent
+	0,  // 3: geocube.TaskEvent.status:type_name -> geocube.TaskEvent.Status
+	1,  // 4: geocube.JobEvent.status:type_name -> geocube.JobEvent.Status
+	6,  // 5: geocube.ConsolidationEvent.records:type_name -> geocube.ConsolidationRecord
+	8,  // 6: geocube.ConsolidationEvent.container:type_name -> geocube.ConsolidationContainer
+	7,  // 7: geocube.ConsolidationRecord.datasets:type_name -> geocube.ConsolidationDataset
+	10, // 8: geocube.ConsolidationDataset.dformat:type_name -> geocube.DataFormat
+	10, // 9: geocube.ConsolidationContainer.dformat:type_name -> geocube.DataFormat
+	11, // 10: geocube.ConsolidationContainer.resampling_alg:type_name -> geocube.Resampling
+	11, // 11: geocube.ConsolidationContainer.ovr_resampling_alg:type_name -> geocube.Resampling
+	9,  // 12: geocube.ConsolidationContainer.creation_params:type_name -> geocube.ConsolidationContainer.CreationParamsEntry
+	12, // 13: geocube.ConsolidationContainer.storage_class:type_name -> geocube.StorageClass
+	14, // [14:14] is the sub-list for method output_type
+	14, // [14:14] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
+}
+
+func init() { file_pb_events_proto_init() }
+func file_pb_events_proto_init() {
+	if File_pb_events_proto != nil {
+		return
+	}
+	file_pb_dataformat_proto_init()
+	file_pb_variables_proto_init()
+	file_pb_operations_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_pb_events_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_events_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TaskEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_events_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JobEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_events_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConsolidationEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_events_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConsolidationRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_events_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConsolidationDataset); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_events_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConsolidationContainer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_pb_events_proto_msgTypes[0].OneofWrappers = []interface{}{
+		(*Event_Task)(nil),
+		(*Event_Job)(nil),
+		(*Event_Consolidation)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_pb_events_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_pb_events_proto_goTypes,
+		DependencyIndexes: file_pb_events_proto_depIdxs,
+		EnumInfos:         file_pb_events_proto_enumTypes,
+		MessageInfos:      file_pb_events_proto_msgTypes,
+	}.Build()
+	File_pb_events_proto = out.File
+	file_pb_events_proto_rawDesc = nil
+	file_pb_events_proto_goTypes = nil
+	file_pb_events_proto_depIdxs = nil
+}
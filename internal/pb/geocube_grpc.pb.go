@@ -58,6 +58,8 @@ type GeocubeClient interface {
 	IndexDatasets(ctx context.Context, in *IndexDatasetsRequest, opts ...grpc.CallOption) (*IndexDatasetsResponse, error)
 	// List datasets from the Geocube
 	ListDatasets(ctx context.Context, in *ListDatasetsRequest, opts ...grpc.CallOption) (*ListDatasetsResponse, error)
+	// Import STAC Items as records and datasets
+	ImportSTAC(ctx context.Context, in *ImportSTACRequest, opts ...grpc.CallOption) (*ImportSTACResponse, error)
 	// Delete datasets using records, instances and/or filepath
 	DeleteDatasets(ctx context.Context, in *DeleteDatasetsRequest, opts ...grpc.CallOption) (*DeleteDatasetsResponse, error)
 	// Configurate a consolidation process
@@ -82,6 +84,10 @@ type GeocubeClient interface {
 	GetCube(ctx context.Context, in *GetCubeRequest, opts ...grpc.CallOption) (Geocube_GetCubeClient, error)
 	// Get a XYZTile (can be used with a TileServer, provided a GRPCGateway is up)
 	GetXYZTile(ctx context.Context, in *GetTileRequest, opts ...grpc.CallOption) (*GetTileResponse, error)
+	// Get the time series of a list of points or small polygons
+	GetTimeSeries(ctx context.Context, in *GetTimeSeriesRequest, opts ...grpc.CallOption) (Geocube_GetTimeSeriesClient, error)
+	// Get the statistics of the values of zones for each record
+	GetZonalStatistics(ctx context.Context, in *GetZonalStatisticsRequest, opts ...grpc.CallOption) (Geocube_GetZonalStatisticsClient, error)
 	// Create a layout to be used for tiling or consolidation
 	CreateLayout(ctx context.Context, in *CreateLayoutRequest, opts ...grpc.CallOption) (*CreateLayoutResponse, error)
 	// Delete a layout given its name
@@ -359,6 +365,15 @@ func (c *geocubeClient) ListDatasets(ctx context.Context, in *ListDatasetsReques
 	return out, nil
 }
 
+func (c *geocubeClient) ImportSTAC(ctx context.Context, in *ImportSTACRequest, opts ...grpc.CallOption) (*ImportSTACResponse, error) {
+	out := new(ImportSTACResponse)
+	err := c.cc.Invoke(ctx, "/geocube.Geocube/ImportSTAC", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *geocubeClient) DeleteDatasets(ctx context.Context, in *DeleteDatasetsRequest, opts ...grpc.CallOption) (*DeleteDatasetsResponse, error) {
 	out := new(DeleteDatasetsResponse)
 	err := c.cc.Invoke(ctx, "/geocube.Geocube/DeleteDatasets", in, out, opts...)
@@ -490,6 +505,70 @@ func (c *geocubeClient) GetXYZTile(ctx context.Context, in *GetTileRequest, opts
 	return out, nil
 }
 
+func (c *geocubeClient) GetTimeSeries(ctx context.Context, in *GetTimeSeriesRequest, opts ...grpc.CallOption) (Geocube_GetTimeSeriesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Geocube_ServiceDesc.Streams[4], "/geocube.Geocube/GetTimeSeries", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &geocubeGetTimeSeriesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Geocube_GetTimeSeriesClient interface {
+	Recv() (*GetTimeSeriesResponse, error)
+	grpc.ClientStream
+}
+
+type geocubeGetTimeSeriesClient struct {
+	grpc.ClientStream
+}
+
+func (x *geocubeGetTimeSeriesClient) Recv() (*GetTimeSeriesResponse, error) {
+	m := new(GetTimeSeriesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *geocubeClient) GetZonalStatistics(ctx context.Context, in *GetZonalStatisticsRequest, opts ...grpc.CallOption) (Geocube_GetZonalStatisticsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Geocube_ServiceDesc.Streams[5], "/geocube.Geocube/GetZonalStatistics", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &geocubeGetZonalStatisticsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Geocube_GetZonalStatisticsClient interface {
+	Recv() (*GetZonalStatisticsResponse, error)
+	grpc.ClientStream
+}
+
+type geocubeGetZonalStatisticsClient struct {
+	grpc.ClientStream
+}
+
+func (x *geocubeGetZonalStatisticsClient) Recv() (*GetZonalStatisticsResponse, error) {
+	m := new(GetZonalStatisticsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *geocubeClient) CreateLayout(ctx context.Context, in *CreateLayoutRequest, opts ...grpc.CallOption) (*CreateLayoutResponse, error) {
 	out := new(CreateLayoutResponse)
 	err := c.cc.Invoke(ctx, "/geocube.Geocube/CreateLayout", in, out, opts...)
@@ -518,7 +597,7 @@ func (c *geocubeClient) ListLayouts(ctx context.Context, in *ListLayoutsRequest,
 }
 
 func (c *geocubeClient) FindContainerLayouts(ctx context.Context, in *FindContainerLayoutsRequest, opts ...grpc.CallOption) (Geocube_FindContainerLayoutsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Geocube_ServiceDesc.Streams[4], "/geocube.Geocube/FindContainerLayouts", opts...)
+	stream, err := c.cc.NewStream(ctx, &Geocube_ServiceDesc.Streams[6], "/geocube.Geocube/FindContainerLayouts", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -550,7 +629,7 @@ func (x *geocubeFindContainerLayoutsClient) Recv() (*FindContainerLayoutsRespons
 }
 
 func (c *geocubeClient) TileAOI(ctx context.Context, in *TileAOIRequest, opts ...grpc.CallOption) (Geocube_TileAOIClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Geocube_ServiceDesc.Streams[5], "/geocube.Geocube/TileAOI", opts...)
+	stream, err := c.cc.NewStream(ctx, &Geocube_ServiceDesc.Streams[7], "/geocube.Geocube/TileAOI", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -582,7 +661,7 @@ func (x *geocubeTileAOIClient) Recv() (*TileAOIResponse, error) {
 }
 
 func (c *geocubeClient) CreateGrid(ctx context.Context, opts ...grpc.CallOption) (Geocube_CreateGridClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Geocube_ServiceDesc.Streams[6], "/geocube.Geocube/CreateGrid", opts...)
+	stream, err := c.cc.NewStream(ctx, &Geocube_ServiceDesc.Streams[8], "/geocube.Geocube/CreateGrid", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -686,6 +765,8 @@ type GeocubeServer interface {
 	IndexDatasets(context.Context, *IndexDatasetsRequest) (*IndexDatasetsResponse, error)
 	// List datasets from the Geocube
 	ListDatasets(context.Context, *ListDatasetsRequest) (*ListDatasetsResponse, error)
+	// Import STAC Items as records and datasets
+	ImportSTAC(context.Context, *ImportSTACRequest) (*ImportSTACResponse, error)
 	// Delete datasets using records, instances and/or filepath
 	DeleteDatasets(context.Context, *DeleteDatasetsRequest) (*DeleteDatasetsResponse, error)
 	// Configurate a consolidation process
@@ -710,6 +791,10 @@ type GeocubeServer interface {
 	GetCube(*GetCubeRequest, Geocube_GetCubeServer) error
 	// Get a XYZTile (can be used with a TileServer, provided a GRPCGateway is up)
 	GetXYZTile(context.Context, *GetTileRequest) (*GetTileResponse, error)
+	// Get the time series of a list of points or small polygons
+	GetTimeSeries(*GetTimeSeriesRequest, Geocube_GetTimeSeriesServer) error
+	// Get the statistics of the values of zones for each record
+	GetZonalStatistics(*GetZonalStatisticsRequest, Geocube_GetZonalStatisticsServer) error
 	// Create a layout to be used for tiling or consolidation
 	CreateLayout(context.Context, *CreateLayoutRequest) (*CreateLayoutResponse, error)
 	// Delete a layout given its name
@@ -795,6 +880,9 @@ func (UnimplementedGeocubeServer) IndexDatasets(context.Context, *IndexDatasetsR
 func (UnimplementedGeocubeServer) ListDatasets(context.Context, *ListDatasetsRequest) (*ListDatasetsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListDatasets not implemented")
 }
+func (UnimplementedGeocubeServer) ImportSTAC(context.Context, *ImportSTACRequest) (*ImportSTACResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportSTAC not implemented")
+}
 func (UnimplementedGeocubeServer) DeleteDatasets(context.Context, *DeleteDatasetsRequest) (*DeleteDatasetsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteDatasets not implemented")
 }
@@ -831,6 +919,12 @@ func (UnimplementedGeocubeServer) GetCube(*GetCubeRequest, Geocube_GetCubeServer
 func (UnimplementedGeocubeServer) GetXYZTile(context.Context, *GetTileRequest) (*GetTileResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetXYZTile not implemented")
 }
+func (UnimplementedGeocubeServer) GetTimeSeries(*GetTimeSeriesRequest, Geocube_GetTimeSeriesServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetTimeSeries not implemented")
+}
+func (UnimplementedGeocubeServer) GetZonalStatistics(*GetZonalStatisticsRequest, Geocube_GetZonalStatisticsServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetZonalStatistics not implemented")
+}
 func (UnimplementedGeocubeServer) CreateLayout(context.Context, *CreateLayoutRequest) (*CreateLayoutResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateLayout not implemented")
 }
@@ -1240,6 +1334,24 @@ func _Geocube_ListDatasets_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Geocube_ImportSTAC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportSTACRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeocubeServer).ImportSTAC(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/geocube.Geocube/ImportSTAC",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeocubeServer).ImportSTAC(ctx, req.(*ImportSTACRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Geocube_DeleteDatasets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteDatasetsRequest)
 	if err := dec(in); err != nil {
@@ -1459,6 +1571,48 @@ func _Geocube_GetXYZTile_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Geocube_GetTimeSeries_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetTimeSeriesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GeocubeServer).GetTimeSeries(m, &geocubeGetTimeSeriesServer{stream})
+}
+
+type Geocube_GetTimeSeriesServer interface {
+	Send(*GetTimeSeriesResponse) error
+	grpc.ServerStream
+}
+
+type geocubeGetTimeSeriesServer struct {
+	grpc.ServerStream
+}
+
+func (x *geocubeGetTimeSeriesServer) Send(m *GetTimeSeriesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Geocube_GetZonalStatistics_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetZonalStatisticsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GeocubeServer).GetZonalStatistics(m, &geocubeGetZonalStatisticsServer{stream})
+}
+
+type Geocube_GetZonalStatisticsServer interface {
+	Send(*GetZonalStatisticsResponse) error
+	grpc.ServerStream
+}
+
+type geocubeGetZonalStatisticsServer struct {
+	grpc.ServerStream
+}
+
+func (x *geocubeGetZonalStatisticsServer) Send(m *GetZonalStatisticsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Geocube_CreateLayout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateLayoutRequest)
 	if err := dec(in); err != nil {
@@ -1710,6 +1864,10 @@ var Geocube_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListDatasets",
 			Handler:    _Geocube_ListDatasets_Handler,
 		},
+		{
+			MethodName: "ImportSTAC",
+			Handler:    _Geocube_ImportSTAC_Handler,
+		},
 		{
 			MethodName: "DeleteDatasets",
 			Handler:    _Geocube_DeleteDatasets_Handler,
@@ -1800,6 +1958,16 @@ var Geocube_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Geocube_GetCube_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetTimeSeries",
+			Handler:       _Geocube_GetTimeSeries_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetZonalStatistics",
+			Handler:       _Geocube_GetZonalStatistics_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "FindContainerLayouts",
 			Handler:       _Geocube_FindContainerLayouts_Handler,
@@ -181,7 +181,7 @@ func (x ConsolidationParams_Compression) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ConsolidationParams_Compression.Descriptor instead.
 func (ConsolidationParams_Compression) EnumDescriptor() ([]byte, []int) {
-	return file_pb_operations_proto_rawDescGZIP(), []int{7, 0}
+	return file_pb_operations_proto_rawDescGZIP(), []int{8, 0}
 }
 
 // *
@@ -601,6 +601,10 @@ type IndexDatasetsRequest struct {
 
 	// TODO Index several containers: repeated ?
 	Container *Container `protobuf:"bytes,1,opt,name=container,proto3" json:"container,omitempty"`
+	// Compute the shape of the datasets from their valid pixels (nodata, alpha or mask band) instead of their extent (slower, but more accurate for images that are partially nodata)
+	ValidShape bool `protobuf:"varint,2,opt,name=valid_shape,json=validShape,proto3" json:"valid_shape,omitempty"`
+	// Read the GDAL metadata of the files (optional)
+	Metadata *MetadataMapping `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
 }
 
 func (x *IndexDatasetsRequest) Reset() {
@@ -642,17 +646,99 @@ func (x *IndexDatasetsRequest) GetContainer() *Container {
 	return nil
 }
 
+func (x *IndexDatasetsRequest) GetValidShape() bool {
+	if x != nil {
+		return x.ValidShape
+	}
+	return false
+}
+
+func (x *IndexDatasetsRequest) GetMetadata() *MetadataMapping {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
 // *
+// Define how the GDAL metadata of the files are used during the indexation
+type MetadataMapping struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Domains     []string          `protobuf:"bytes,1,rep,name=domains,proto3" json:"domains,omitempty"`                                                                                   // Metadata domains to read (default domain if empty)
+	Tags        map[string]string `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // Metadata key -> record tag. The keys of the metadata of the bands are prefixed with "band<i>:" (i starting at 1), the description of a band is "band<i>:DESCRIPTION"
+	DataMapping bool              `protobuf:"varint,3,opt,name=data_mapping,json=dataMapping,proto3" json:"data_mapping,omitempty"`                                                       // Deduce real_min_value and real_max_value of the datasets from the scale and offset of the bands, if they are not defined (real_min_value=real_max_value=exponent=0). Otherwise, they are required
+}
+
+func (x *MetadataMapping) Reset() {
+	*x = MetadataMapping{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_operations_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MetadataMapping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetadataMapping) ProtoMessage() {}
+
+func (x *MetadataMapping) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_operations_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetadataMapping.ProtoReflect.Descriptor instead.
+func (*MetadataMapping) Descriptor() ([]byte, []int) {
+	return file_pb_operations_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MetadataMapping) GetDomains() []string {
+	if x != nil {
+		return x.Domains
+	}
+	return nil
+}
+
+func (x *MetadataMapping) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *MetadataMapping) GetDataMapping() bool {
+	if x != nil {
+		return x.DataMapping
+	}
+	return false
+}
+
+// *
+// Return the warnings raised during the indexation (e.g. dtype or nodata mismatches between the files and the dataformats)
 type IndexDatasetsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Warnings []string `protobuf:"bytes,1,rep,name=warnings,proto3" json:"warnings,omitempty"`
 }
 
 func (x *IndexDatasetsResponse) Reset() {
 	*x = IndexDatasetsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_operations_proto_msgTypes[6]
+		mi := &file_pb_operations_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -665,7 +751,7 @@ func (x *IndexDatasetsResponse) String() string {
 func (*IndexDatasetsResponse) ProtoMessage() {}
 
 func (x *IndexDatasetsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_operations_proto_msgTypes[6]
+	mi := &file_pb_operations_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -678,7 +764,14 @@ func (x *IndexDatasetsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IndexDatasetsResponse.ProtoReflect.Descriptor instead.
 func (*IndexDatasetsResponse) Descriptor() ([]byte, []int) {
-	return file_pb_operations_proto_rawDescGZIP(), []int{6}
+	return file_pb_operations_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *IndexDatasetsResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
 }
 
 // *
@@ -707,7 +800,7 @@ type ConsolidationParams struct {
 func (x *ConsolidationParams) Reset() {
 	*x = ConsolidationParams{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_operations_proto_msgTypes[7]
+		mi := &file_pb_operations_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -720,7 +813,7 @@ func (x *ConsolidationParams) String() string {
 func (*ConsolidationParams) ProtoMessage() {}
 
 func (x *ConsolidationParams) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_operations_proto_msgTypes[7]
+	mi := &file_pb_operations_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -733,7 +826,7 @@ func (x *ConsolidationParams) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConsolidationParams.ProtoReflect.Descriptor instead.
 func (*ConsolidationParams) Descriptor() ([]byte, []int) {
-	return file_pb_operations_proto_rawDescGZIP(), []int{7}
+	return file_pb_operations_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ConsolidationParams) GetDformat() *DataFormat {
@@ -808,7 +901,7 @@ type ConfigConsolidationRequest struct {
 func (x *ConfigConsolidationRequest) Reset() {
 	*x = ConfigConsolidationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_operations_proto_msgTypes[8]
+		mi := &file_pb_operations_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -821,7 +914,7 @@ func (x *ConfigConsolidationRequest) String() string {
 func (*ConfigConsolidationRequest) ProtoMessage() {}
 
 func (x *ConfigConsolidationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_operations_proto_msgTypes[8]
+	mi := &file_pb_operations_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -834,7 +927,7 @@ func (x *ConfigConsolidationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConfigConsolidationRequest.ProtoReflect.Descriptor instead.
 func (*ConfigConsolidationRequest) Descriptor() ([]byte, []int) {
-	return file_pb_operations_proto_rawDescGZIP(), []int{8}
+	return file_pb_operations_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ConfigConsolidationRequest) GetVariableId() string {
@@ -861,7 +954,7 @@ type ConfigConsolidationResponse struct {
 func (x *ConfigConsolidationResponse) Reset() {
 	*x = ConfigConsolidationResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_operations_proto_msgTypes[9]
+		mi := &file_pb_operations_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -874,7 +967,7 @@ func (x *ConfigConsolidationResponse) String() string {
 func (*ConfigConsolidationResponse) ProtoMessage() {}
 
 func (x *ConfigConsolidationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_operations_proto_msgTypes[9]
+	mi := &file_pb_operations_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -887,7 +980,7 @@ func (x *ConfigConsolidationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConfigConsolidationResponse.ProtoReflect.Descriptor instead.
 func (*ConfigConsolidationResponse) Descriptor() ([]byte, []int) {
-	return file_pb_operations_proto_rawDescGZIP(), []int{9}
+	return file_pb_operations_proto_rawDescGZIP(), []int{10}
 }
 
 // *
@@ -903,7 +996,7 @@ type GetConsolidationParamsRequest struct {
 func (x *GetConsolidationParamsRequest) Reset() {
 	*x = GetConsolidationParamsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_operations_proto_msgTypes[10]
+		mi := &file_pb_operations_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -916,7 +1009,7 @@ func (x *GetConsolidationParamsRequest) String() string {
 func (*GetConsolidationParamsRequest) ProtoMessage() {}
 
 func (x *GetConsolidationParamsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_operations_proto_msgTypes[10]
+	mi := &file_pb_operations_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -929,7 +1022,7 @@ func (x *GetConsolidationParamsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetConsolidationParamsRequest.ProtoReflect.Descriptor instead.
 func (*GetConsolidationParamsRequest) Descriptor() ([]byte, []int) {
-	return file_pb_operations_proto_rawDescGZIP(), []int{10}
+	return file_pb_operations_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetConsolidationParamsRequest) GetVariableId() string {
@@ -952,7 +1045,7 @@ type GetConsolidationParamsResponse struct {
 func (x *GetConsolidationParamsResponse) Reset() {
 	*x = GetConsolidationParamsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_operations_proto_msgTypes[11]
+		mi := &file_pb_operations_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -965,7 +1058,7 @@ func (x *GetConsolidationParamsResponse) String() string {
 func (*GetConsolidationParamsResponse) ProtoMessage() {}
 
 func (x *GetConsolidationParamsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_operations_proto_msgTypes[11]
+	mi := &file_pb_operations_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -978,7 +1071,7 @@ func (x *GetConsolidationParamsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetConsolidationParamsResponse.ProtoReflect.Descriptor instead.
 func (*GetConsolidationParamsResponse) Descriptor() ([]byte, []int) {
-	return file_pb_operations_proto_rawDescGZIP(), []int{11}
+	return file_pb_operations_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *GetConsolidationParamsResponse) GetConsolidationParams() *ConsolidationParams {
@@ -1014,7 +1107,7 @@ type ConsolidateRequest struct {
 func (x *ConsolidateRequest) Reset() {
 	*x = ConsolidateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_operations_proto_msgTypes[12]
+		mi := &file_pb_operations_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1027,7 +1120,7 @@ func (x *ConsolidateRequest) String() string {
 func (*ConsolidateRequest) ProtoMessage() {}
 
 func (x *ConsolidateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_operations_proto_msgTypes[12]
+	mi := &file_pb_operations_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1040,7 +1133,7 @@ func (x *ConsolidateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConsolidateRequest.ProtoReflect.Descriptor instead.
 func (*ConsolidateRequest) Descriptor() ([]byte, []int) {
-	return file_pb_operations_proto_rawDescGZIP(), []int{12}
+	return file_pb_operations_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ConsolidateRequest) GetJobName() string {
@@ -1128,7 +1221,7 @@ type ConsolidateResponse struct {
 func (x *ConsolidateResponse) Reset() {
 	*x = ConsolidateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_operations_proto_msgTypes[13]
+		mi := &file_pb_operations_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1141,7 +1234,7 @@ func (x *ConsolidateResponse) String() string {
 func (*ConsolidateResponse) ProtoMessage() {}
 
 func (x *ConsolidateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_operations_proto_msgTypes[13]
+	mi := &file_pb_operations_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1154,7 +1247,7 @@ func (x *ConsolidateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConsolidateResponse.ProtoReflect.Descriptor instead.
 func (*ConsolidateResponse) Descriptor() ([]byte, []int) {
-	return file_pb_operations_proto_rawDescGZIP(), []int{13}
+	return file_pb_operations_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ConsolidateResponse) GetJobId() string {
@@ -1171,15 +1264,16 @@ type ListJobsRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NameLike string `protobuf:"bytes,1,opt,name=name_like,json=nameLike,proto3" json:"name_like,omitempty"`
-	Page     int32  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	Limit    int32  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	NameLike  string `protobuf:"bytes,1,opt,name=name_like,json=nameLike,proto3" json:"name_like,omitempty"`
+	Page      int32  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit     int32  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	PageToken string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // Continuation token returned by the previous page (ListJobsResponse.next_page_token). Jobs are sorted by id.
 }
 
 func (x *ListJobsRequest) Reset() {
 	*x = ListJobsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_operations_proto_msgTypes[14]
+		mi := &file_pb_operations_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1192,7 +1286,7 @@ func (x *ListJobsRequest) String() string {
 func (*ListJobsRequest) ProtoMessage() {}
 
 func (x *ListJobsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_operations_proto_msgTypes[14]
+	mi := &file_pb_operations_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1205,7 +1299,7 @@ func (x *ListJobsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListJobsRequest.ProtoReflect.Descriptor instead.
 func (*ListJobsRequest) Descriptor() ([]byte, []int) {
-	return file_pb_operations_proto_rawDescGZIP(), []int{14}
+	return file_pb_operations_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ListJobsRequest) GetNameLike() string {
@@ -1229,6 +1323,13 @@ func (x *ListJobsRequest) GetLimit() int32 {
 	return 0
 }
 
+func (x *ListJobsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
 // *
 // Return a list of the job whose name matchs the pattern
 type ListJobsResponse struct {
@@ -1236,13 +1337,14 @@ type ListJobsResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Jobs []*Job `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	Jobs          []*Job `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Token to get the next page (empty if it is the last page)
 }
 
 func (x *ListJobsResponse) Reset() {
 	*x = ListJobsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_operations_proto_msgTypes[15]
+		mi := &file_pb_operations_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1255,7 +1357,7 @@ func (x *ListJobsResponse) String() string {
 func (*ListJobsResponse) ProtoMessage() {}
 
 func (x *ListJobsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_operations_proto_msgTypes[15]
+	mi := &file_pb_operations_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1268,7 +1370,7 @@ func (x *ListJobsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListJobsResponse.ProtoReflect.Descriptor instead.
 func (*ListJobsResponse) Descriptor() ([]byte, []int) {
-	return file_pb_operations_proto_rawDescGZIP(), []int{15}
+	return file_pb_operations_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ListJobsResponse) GetJobs() []*Job {
@@ -1278,6 +1380,13 @@ func (x *ListJobsResponse) GetJobs() []*Job {
 	return nil
 }
 
+func (x *ListJobsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 // *
 // Retrieve a job given its id
 type GetJobRequest struct {
@@ -1293,7 +1402,7 @@ type GetJobRequest struct {
 func (x *GetJobRequest) Reset() {
 	*x = GetJobRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_operations_proto_msgTypes[16]
+		mi := &file_pb_operations_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1306,7 +1415,7 @@ func (x *GetJobRequest) String() string {
 func (*GetJobRequest) ProtoMessage() {}
 
 func (x *GetJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_operations_proto_msgTypes[16]
+	mi := &file_pb_operations_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1319,7 +1428,7 @@ func (x *GetJobRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetJobRequest.ProtoReflect.Descriptor instead.
 func (*GetJobRequest) Descriptor() ([]byte, []int) {
-	return file_pb_operations_proto_rawDescGZIP(), []int{16}
+	return file_pb_operations_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *GetJobRequest) GetId() string {
@@ -1356,7 +1465,7 @@ type GetJobResponse struct {
 func (x *GetJobResponse) Reset() {
 	*x = GetJobResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_operations_proto_msgTypes[17]
+		mi := &file_pb_operations_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1369,7 +1478,7 @@ func (x *GetJobResponse) String() string {
 func (*GetJobResponse) ProtoMessage() {}
 
 func (x *GetJobResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_operations_proto_msgTypes[17]
+	mi := &file_pb_operations_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1382,7 +1491,7 @@ func (x *GetJobResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetJobResponse.ProtoReflect.Descriptor instead.
 func (*GetJobResponse) Descriptor() ([]byte, []int) {
-	return file_pb_operations_proto_rawDescGZIP(), []int{17}
+	return file_pb_operations_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *GetJobResponse) GetJob() *Job {
@@ -1405,7 +1514,7 @@ type CleanJobsRequest struct {
 func (x *CleanJobsRequest) Reset() {
 	*x = CleanJobsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_operations_proto_msgTypes[18]
+		mi := &file_pb_operations_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1418,7 +1527,7 @@ func (x *CleanJobsRequest) String() string {
 func (*CleanJobsRequest) ProtoMessage() {}
 
 func (x *CleanJobsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_operations_proto_msgTypes[18]
+	mi := &file_pb_operations_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1431,7 +1540,7 @@ func (x *CleanJobsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CleanJobsRequest.ProtoReflect.Descriptor instead.
 func (*CleanJobsRequest) Descriptor() ([]byte, []int) {
-	return file_pb_operations_proto_rawDescGZIP(), []int{18}
+	return file_pb_operations_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *CleanJobsRequest) GetNameLike() string {
@@ -1461,7 +1570,7 @@ type CleanJobsResponse struct {
 func (x *CleanJobsResponse) Reset() {
 	*x = CleanJobsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_operations_proto_msgTypes[19]
+		mi := &file_pb_operations_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1474,7 +1583,7 @@ func (x *CleanJobsResponse) String() string {
 func (*CleanJobsResponse) ProtoMessage() {}
 
 func (x *CleanJobsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_operations_proto_msgTypes[19]
+	mi := &file_pb_operations_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1487,7 +1596,7 @@ func (x *CleanJobsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CleanJobsResponse.ProtoReflect.Descriptor instead.
 func (*CleanJobsResponse) Descriptor() ([]byte, []int) {
-	return file_pb_operations_proto_rawDescGZIP(), []int{19}
+	return file_pb_operations_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *CleanJobsResponse) GetCount() int32 {
@@ -1511,7 +1620,7 @@ type CancelJobRequest struct {
 func (x *CancelJobRequest) Reset() {
 	*x = CancelJobRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_operations_proto_msgTypes[20]
+		mi := &file_pb_operations_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1524,7 +1633,7 @@ func (x *CancelJobRequest) String() string {
 func (*CancelJobRequest) ProtoMessage() {}
 
 func (x *CancelJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_operations_proto_msgTypes[20]
+	mi := &file_pb_operations_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1537,7 +1646,7 @@ func (x *CancelJobRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelJobRequest.ProtoReflect.Descriptor instead.
 func (*CancelJobRequest) Descriptor() ([]byte, []int) {
-	return file_pb_operations_proto_rawDescGZIP(), []int{20}
+	return file_pb_operations_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *CancelJobRequest) GetId() string {
@@ -1564,7 +1673,7 @@ type CancelJobResponse struct {
 func (x *CancelJobResponse) Reset() {
 	*x = CancelJobResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_operations_proto_msgTypes[21]
+		mi := &file_pb_operations_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1577,7 +1686,7 @@ func (x *CancelJobResponse) String() string {
 func (*CancelJobResponse) ProtoMessage() {}
 
 func (x *CancelJobResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_operations_proto_msgTypes[21]
+	mi := &file_pb_operations_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1590,7 +1699,7 @@ func (x *CancelJobResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelJobResponse.ProtoReflect.Descriptor instead.
 func (*CancelJobResponse) Descriptor() ([]byte, []int) {
-	return file_pb_operations_proto_rawDescGZIP(), []int{21}
+	return file_pb_operations_proto_rawDescGZIP(), []int{22}
 }
 
 // *
@@ -1607,7 +1716,7 @@ type RetryJobRequest struct {
 func (x *RetryJobRequest) Reset() {
 	*x = RetryJobRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_operations_proto_msgTypes[22]
+		mi := &file_pb_operations_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1620,7 +1729,7 @@ func (x *RetryJobRequest) String() string {
 func (*RetryJobRequest) ProtoMessage() {}
 
 func (x *RetryJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_operations_proto_msgTypes[22]
+	mi := &file_pb_operations_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1633,7 +1742,7 @@ func (x *RetryJobRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RetryJobRequest.ProtoReflect.Descriptor instead.
 func (*RetryJobRequest) Descriptor() ([]byte, []int) {
-	return file_pb_operations_proto_rawDescGZIP(), []int{22}
+	return file_pb_operations_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *RetryJobRequest) GetId() string {
@@ -1660,7 +1769,7 @@ type RetryJobResponse struct {
 func (x *RetryJobResponse) Reset() {
 	*x = RetryJobResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_operations_proto_msgTypes[23]
+		mi := &file_pb_operations_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1673,7 +1782,7 @@ func (x *RetryJobResponse) String() string {
 func (*RetryJobResponse) ProtoMessage() {}
 
 func (x *RetryJobResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_operations_proto_msgTypes[23]
+	mi := &file_pb_operations_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1686,7 +1795,7 @@ func (x *RetryJobResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RetryJobResponse.ProtoReflect.Descriptor instead.
 func (*RetryJobResponse) Descriptor() ([]byte, []int) {
-	return file_pb_operations_proto_rawDescGZIP(), []int{23}
+	return file_pb_operations_proto_rawDescGZIP(), []int{24}
 }
 
 // *
@@ -1702,7 +1811,7 @@ type ContinueJobRequest struct {
 func (x *ContinueJobRequest) Reset() {
 	*x = ContinueJobRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_operations_proto_msgTypes[24]
+		mi := &file_pb_operations_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1715,7 +1824,7 @@ func (x *ContinueJobRequest) String() string {
 func (*ContinueJobRequest) ProtoMessage() {}
 
 func (x *ContinueJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_operations_proto_msgTypes[24]
+	mi := &file_pb_operations_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1728,7 +1837,7 @@ func (x *ContinueJobRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ContinueJobRequest.ProtoReflect.Descriptor instead.
 func (*ContinueJobRequest) Descriptor() ([]byte, []int) {
-	return file_pb_operations_proto_rawDescGZIP(), []int{24}
+	return file_pb_operations_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *ContinueJobRequest) GetId() string {
@@ -1748,7 +1857,7 @@ type ContinueJobResponse struct {
 func (x *ContinueJobResponse) Reset() {
 	*x = ContinueJobResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_operations_proto_msgTypes[25]
+		mi := &file_pb_operations_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1761,7 +1870,7 @@ func (x *ContinueJobResponse) String() string {
 func (*ContinueJobResponse) ProtoMessage() {}
 
 func (x *ContinueJobResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_operations_proto_msgTypes[25]
+	mi := &file_pb_operations_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1774,7 +1883,7 @@ func (x *ContinueJobResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ContinueJobResponse.ProtoReflect.Descriptor instead.
 func (*ContinueJobResponse) Descriptor() ([]byte, []int) {
-	return file_pb_operations_proto_rawDescGZIP(), []int{25}
+	return file_pb_operations_proto_rawDescGZIP(), []int{26}
 }
 
 // *
@@ -1795,7 +1904,7 @@ type DeleteDatasetsRequest struct {
 func (x *DeleteDatasetsRequest) Reset() {
 	*x = DeleteDatasetsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_operations_proto_msgTypes[26]
+		mi := &file_pb_operations_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1808,7 +1917,7 @@ func (x *DeleteDatasetsRequest) String() string {
 func (*DeleteDatasetsRequest) ProtoMessage() {}
 
 func (x *DeleteDatasetsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_operations_proto_msgTypes[26]
+	mi := &file_pb_operations_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1821,7 +1930,7 @@ func (x *DeleteDatasetsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteDatasetsRequest.ProtoReflect.Descriptor instead.
 func (*DeleteDatasetsRequest) Descriptor() ([]byte, []int) {
-	return file_pb_operations_proto_rawDescGZIP(), []int{26}
+	return file_pb_operations_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *DeleteDatasetsRequest) GetRecordIds() []string {
@@ -1872,7 +1981,7 @@ type DeleteDatasetsResponse struct {
 func (x *DeleteDatasetsResponse) Reset() {
 	*x = DeleteDatasetsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_operations_proto_msgTypes[27]
+		mi := &file_pb_operations_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1885,7 +1994,7 @@ func (x *DeleteDatasetsResponse) String() string {
 func (*DeleteDatasetsResponse) ProtoMessage() {}
 
 func (x *DeleteDatasetsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_operations_proto_msgTypes[27]
+	mi := &file_pb_operations_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1898,7 +2007,7 @@ func (x *DeleteDatasetsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteDatasetsResponse.ProtoReflect.Descriptor instead.
 func (*DeleteDatasetsResponse) Descriptor() ([]byte, []int) {
-	return file_pb_operations_proto_rawDescGZIP(), []int{27}
+	return file_pb_operations_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *DeleteDatasetsResponse) GetJob() *Job {
@@ -1976,171 +2085,195 @@ var file_pb_operations_proto_rawDesc = []byte{
 	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
 	0x12, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
 	0x6e, 0x65, 0x72, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x22,
-	0x48, 0x0a, 0x14, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61,
-	0x69, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x65, 0x6f,
-	0x63, 0x75, 0x62, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x09,
-	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x22, 0x17, 0x0a, 0x15, 0x49, 0x6e, 0x64,
-	0x65, 0x78, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0xdc, 0x04, 0x0a, 0x13, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x2d, 0x0a, 0x07, 0x64, 0x66,
-	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x65,
-	0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74,
-	0x52, 0x07, 0x64, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x70,
-	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x65, 0x78, 0x70,
-	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x10, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f,
-	0x6f, 0x76, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42,
-	0x02, 0x18, 0x01, 0x52, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x76,
-	0x69, 0x65, 0x77, 0x73, 0x12, 0x3a, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69,
-	0x6e, 0x67, 0x5f, 0x61, 0x6c, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x67,
-	0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e,
-	0x67, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x41, 0x6c, 0x67,
-	0x12, 0x4a, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e,
+	0x9f, 0x01, 0x0a, 0x14, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x65,
+	0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52,
+	0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x5f, 0x73, 0x68, 0x61, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x53, 0x68, 0x61, 0x70, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x22, 0xbf, 0x01, 0x0a, 0x0f, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x4d, 0x61,
+	0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x12,
+	0x36, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x5f,
+	0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x64,
+	0x61, 0x74, 0x61, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x1a, 0x37, 0x0a, 0x09, 0x54, 0x61,
+	0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0x33, 0x0a, 0x15, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x44, 0x61, 0x74, 0x61,
+	0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08,
+	0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x22, 0xdc, 0x04, 0x0a, 0x13, 0x43, 0x6f, 0x6e,
+	0x73, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x12, 0x2d, 0x0a, 0x07, 0x64, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61,
+	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x07, 0x64, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x08, 0x65, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x10, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x4f, 0x76, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x73, 0x12, 0x3a, 0x0a, 0x0e, 0x72, 0x65,
+	0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x6c, 0x67, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x13, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x52, 0x65, 0x73,
+	0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x61, 0x6d, 0x70, 0x6c,
+	0x69, 0x6e, 0x67, 0x41, 0x6c, 0x67, 0x12, 0x4a, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x67, 0x65,
+	0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x59, 0x0a, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x67, 0x65,
+	0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x2d, 0x0a,
+	0x10, 0x62, 0x61, 0x6e, 0x64, 0x73, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6c, 0x65, 0x61, 0x76,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0f, 0x62, 0x61, 0x6e,
+	0x64, 0x73, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x12, 0x3a, 0x0a, 0x0d,
+	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x53, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x52, 0x0c, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x1a, 0x41, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x3a, 0x0a, 0x0b, 0x43,
+	0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x06, 0x0a, 0x02, 0x4e, 0x4f,
+	0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x4c, 0x4f, 0x53, 0x53, 0x4c, 0x45, 0x53, 0x53, 0x10, 0x01,
+	0x12, 0x09, 0x0a, 0x05, 0x4c, 0x4f, 0x53, 0x53, 0x59, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x43,
+	0x55, 0x53, 0x54, 0x4f, 0x4d, 0x10, 0x03, 0x22, 0x8e, 0x01, 0x0a, 0x1a, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x61, 0x72,
+	0x69, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x4f, 0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x73, 0x6f,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e,
 	0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
-	0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x59, 0x0a, 0x0f,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18,
-	0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e,
-	0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x2d, 0x0a, 0x10, 0x62, 0x61, 0x6e, 0x64, 0x73,
-	0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x08, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0f, 0x62, 0x61, 0x6e, 0x64, 0x73, 0x49, 0x6e, 0x74, 0x65,
-	0x72, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x12, 0x3a, 0x0a, 0x0d, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
-	0x65, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e,
-	0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43,
-	0x6c, 0x61, 0x73, 0x73, 0x52, 0x0c, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6c, 0x61,
-	0x73, 0x73, 0x1a, 0x41, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x3a, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x06, 0x0a, 0x02, 0x4e, 0x4f, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08,
-	0x4c, 0x4f, 0x53, 0x53, 0x4c, 0x45, 0x53, 0x53, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x4c, 0x4f,
-	0x53, 0x53, 0x59, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x10,
-	0x03, 0x22, 0x8e, 0x01, 0x0a, 0x1a, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x43, 0x6f, 0x6e, 0x73,
-	0x6f, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x49,
-	0x64, 0x12, 0x4f, 0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1c, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x13, 0x63,
-	0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x22, 0x1d, 0x0a, 0x1b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x43, 0x6f, 0x6e, 0x73,
-	0x6f, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x40, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c,
-	0x65, 0x49, 0x64, 0x22, 0x71, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x43, 0x6f,
+	0x61, 0x6d, 0x73, 0x52, 0x13, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x1d, 0x0a, 0x1b, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x40, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x43, 0x6f,
 	0x6e, 0x73, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x52, 0x13, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0xdf, 0x02, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x73, 0x6f,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a,
-	0x08, 0x6a, 0x6f, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x6a, 0x6f, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x61, 0x79,
-	0x6f, 0x75, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x6c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x40, 0x0a, 0x0f, 0x65, 0x78,
-	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x45, 0x78,
-	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x0e, 0x65, 0x78,
-	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x31, 0x0a, 0x15,
-	0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x5f, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x63, 0x6f,
-	0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x63, 0x6f, 0x6c,
-	0x6c, 0x61, 0x70, 0x73, 0x65, 0x4f, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x12,
-	0x31, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x15, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72,
-	0x64, 0x49, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x00, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72,
-	0x64, 0x73, 0x12, 0x32, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x52, 0x65,
-	0x63, 0x6f, 0x72, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x48, 0x00, 0x52, 0x07, 0x66,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x42, 0x10, 0x0a, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
-	0x73, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x72, 0x22, 0x2c, 0x0a, 0x13, 0x43, 0x6f, 0x6e, 0x73,
-	0x6f, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x22, 0x58, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f,
-	0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x61, 0x6d,
-	0x65, 0x5f, 0x6c, 0x69, 0x6b, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x61,
-	0x6d, 0x65, 0x4c, 0x69, 0x6b, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69,
-	0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
-	0x22, 0x34, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x4a, 0x6f, 0x62,
-	0x52, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x22, 0x57, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x6f, 0x67, 0x5f, 0x70,
-	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6c, 0x6f, 0x67, 0x50, 0x61,
-	0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6c, 0x6f, 0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22,
-	0x30, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x1e, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
-	0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x03, 0x6a, 0x6f,
-	0x62, 0x22, 0x45, 0x0a, 0x10, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x6c, 0x69,
-	0x6b, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x61, 0x6d, 0x65, 0x4c, 0x69,
-	0x6b, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x29, 0x0a, 0x11, 0x43, 0x6c, 0x65, 0x61,
-	0x6e, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x22, 0x4a, 0x0a, 0x10, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4a, 0x6f, 0x62,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x66, 0x6f, 0x72, 0x63, 0x65,
-	0x5f, 0x61, 0x6e, 0x79, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0d, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x41, 0x6e, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x22,
-	0x13, 0x0a, 0x11, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x49, 0x0a, 0x0f, 0x52, 0x65, 0x74, 0x72, 0x79, 0x4a, 0x6f, 0x62,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x66, 0x6f, 0x72, 0x63, 0x65,
-	0x5f, 0x61, 0x6e, 0x79, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0d, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x41, 0x6e, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x22,
-	0x12, 0x0a, 0x10, 0x52, 0x65, 0x74, 0x72, 0x79, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x24, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x65, 0x4a,
-	0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x15, 0x0a, 0x13, 0x43, 0x6f, 0x6e,
-	0x74, 0x69, 0x6e, 0x75, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0xe1, 0x01, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x73,
-	0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65,
-	0x63, 0x6f, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09,
-	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x73, 0x12, 0x29, 0x0a, 0x10,
-	0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73,
-	0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x50,
-	0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x12, 0x40, 0x0a, 0x0f, 0x65, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x17, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x0e, 0x65, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x6a, 0x6f, 0x62,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6a, 0x6f, 0x62,
-	0x4e, 0x61, 0x6d, 0x65, 0x22, 0x38, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x61,
-	0x74, 0x61, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e,
-	0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x67, 0x65,
-	0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x2a, 0x4a,
-	0x0a, 0x0c, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x0c,
-	0x0a, 0x08, 0x53, 0x54, 0x41, 0x4e, 0x44, 0x41, 0x52, 0x44, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a,
-	0x49, 0x4e, 0x46, 0x52, 0x45, 0x51, 0x55, 0x45, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07,
-	0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x45, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x44, 0x45, 0x45,
-	0x50, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x45, 0x10, 0x03, 0x2a, 0x85, 0x01, 0x0a, 0x0e, 0x45,
-	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x18, 0x0a,
-	0x14, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x79, 0x6e, 0x63, 0x68, 0x72,
-	0x6f, 0x6e, 0x6f, 0x75, 0x73, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x45, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x69, 0x6f, 0x6e, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x68, 0x72, 0x6f, 0x6e, 0x6f, 0x75, 0x73,
-	0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x74, 0x65, 0x70, 0x42, 0x79, 0x53, 0x74, 0x65, 0x70,
-	0x43, 0x72, 0x69, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x74,
-	0x65, 0x70, 0x42, 0x79, 0x53, 0x74, 0x65, 0x70, 0x4d, 0x61, 0x6a, 0x6f, 0x72, 0x10, 0x03, 0x12,
-	0x11, 0x0a, 0x0d, 0x53, 0x74, 0x65, 0x70, 0x42, 0x79, 0x53, 0x74, 0x65, 0x70, 0x41, 0x6c, 0x6c,
-	0x10, 0x04, 0x42, 0x0e, 0x5a, 0x0c, 0x2e, 0x2f, 0x70, 0x62, 0x3b, 0x67, 0x65, 0x6f, 0x63, 0x75,
-	0x62, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x61, 0x72, 0x69,
+	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x76,
+	0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x71, 0x0a, 0x1e, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x14, 0x63,
+	0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x65, 0x6f, 0x63,
+	0x75, 0x62, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x13, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0xdf, 0x02, 0x0a,
+	0x12, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6a, 0x6f, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6a, 0x6f, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x40, 0x0a, 0x0f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x67, 0x65, 0x6f, 0x63,
+	0x75, 0x62, 0x65, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x52, 0x0e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x12, 0x31, 0x0a, 0x15, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x5f, 0x6f,
+	0x6e, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x12, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x4f, 0x6e, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65,
+	0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x00, 0x52,
+	0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x32, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x65, 0x6f, 0x63,
+	0x75, 0x62, 0x65, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x73, 0x48, 0x00, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x42, 0x10, 0x0a, 0x0e,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x72, 0x22, 0x2c,
+	0x0a, 0x13, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x22, 0x77, 0x0a, 0x0f,
+	0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x6c, 0x69, 0x6b, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x6e, 0x61, 0x6d, 0x65, 0x4c, 0x69, 0x6b, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x5c, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x04, 0x6a, 0x6f, 0x62,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62,
+	0x65, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e,
+	0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0x57, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x6f, 0x67, 0x5f, 0x70, 0x61, 0x67, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6c, 0x6f, 0x67, 0x50, 0x61, 0x67, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x6c, 0x6f, 0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x30, 0x0a, 0x0e,
+	0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e,
+	0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x67, 0x65,
+	0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x22, 0x45,
+	0x0a, 0x10, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x6c, 0x69, 0x6b, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x61, 0x6d, 0x65, 0x4c, 0x69, 0x6b, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x29, 0x0a, 0x11, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x4a, 0x6f,
+	0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x4a, 0x0a, 0x10, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x5f, 0x61, 0x6e,
+	0x79, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x66,
+	0x6f, 0x72, 0x63, 0x65, 0x41, 0x6e, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x22, 0x13, 0x0a, 0x11,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x49, 0x0a, 0x0f, 0x52, 0x65, 0x74, 0x72, 0x79, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x5f, 0x61, 0x6e,
+	0x79, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x66,
+	0x6f, 0x72, 0x63, 0x65, 0x41, 0x6e, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x22, 0x12, 0x0a, 0x10,
+	0x52, 0x65, 0x74, 0x72, 0x79, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x24, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x65, 0x4a, 0x6f, 0x62, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x15, 0x0a, 0x13, 0x43, 0x6f, 0x6e, 0x74, 0x69, 0x6e,
+	0x75, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xe1, 0x01,
+	0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x49, 0x64, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x61, 0x74,
+	0x61, 0x73, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x50, 0x61, 0x74, 0x74,
+	0x65, 0x72, 0x6e, 0x73, 0x12, 0x40, 0x0a, 0x0f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e,
+	0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x0e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x6a, 0x6f, 0x62, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6a, 0x6f, 0x62, 0x4e, 0x61, 0x6d,
+	0x65, 0x22, 0x38, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x73,
+	0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x03, 0x6a,
+	0x6f, 0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75,
+	0x62, 0x65, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x2a, 0x4a, 0x0a, 0x0c, 0x53,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x0c, 0x0a, 0x08, 0x53,
+	0x54, 0x41, 0x4e, 0x44, 0x41, 0x52, 0x44, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x49, 0x4e, 0x46,
+	0x52, 0x45, 0x51, 0x55, 0x45, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x41, 0x52, 0x43,
+	0x48, 0x49, 0x56, 0x45, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x44, 0x45, 0x45, 0x50, 0x41, 0x52,
+	0x43, 0x48, 0x49, 0x56, 0x45, 0x10, 0x03, 0x2a, 0x85, 0x01, 0x0a, 0x0e, 0x45, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x18, 0x0a, 0x14, 0x45, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x79, 0x6e, 0x63, 0x68, 0x72, 0x6f, 0x6e, 0x6f,
+	0x75, 0x73, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x68, 0x72, 0x6f, 0x6e, 0x6f, 0x75, 0x73, 0x10, 0x01, 0x12,
+	0x16, 0x0a, 0x12, 0x53, 0x74, 0x65, 0x70, 0x42, 0x79, 0x53, 0x74, 0x65, 0x70, 0x43, 0x72, 0x69,
+	0x74, 0x69, 0x63, 0x61, 0x6c, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x74, 0x65, 0x70, 0x42,
+	0x79, 0x53, 0x74, 0x65, 0x70, 0x4d, 0x61, 0x6a, 0x6f, 0x72, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d,
+	0x53, 0x74, 0x65, 0x70, 0x42, 0x79, 0x53, 0x74, 0x65, 0x70, 0x41, 0x6c, 0x6c, 0x10, 0x04, 0x42,
+	0x0e, 0x5a, 0x0c, 0x2e, 0x2f, 0x70, 0x62, 0x3b, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2156,7 +2289,7 @@ func file_pb_operations_proto_rawDescGZIP() []byte {
 }
 
 var file_pb_operations_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_pb_operations_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
+var file_pb_operations_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
 var file_pb_operations_proto_goTypes = []interface{}{
 	(StorageClass)(0),                      // 0: geocube.StorageClass
 	(ExecutionLevel)(0),                    // 1: geocube.ExecutionLevel
@@ -2167,62 +2300,66 @@ var file_pb_operations_proto_goTypes = []interface{}{
 	(*GetContainersRequest)(nil),           // 6: geocube.GetContainersRequest
 	(*GetContainersResponse)(nil),          // 7: geocube.GetContainersResponse
 	(*IndexDatasetsRequest)(nil),           // 8: geocube.IndexDatasetsRequest
-	(*IndexDatasetsResponse)(nil),          // 9: geocube.IndexDatasetsResponse
-	(*ConsolidationParams)(nil),            // 10: geocube.ConsolidationParams
-	(*ConfigConsolidationRequest)(nil),     // 11: geocube.ConfigConsolidationRequest
-	(*ConfigConsolidationResponse)(nil),    // 12: geocube.ConfigConsolidationResponse
-	(*GetConsolidationParamsRequest)(nil),  // 13: geocube.GetConsolidationParamsRequest
-	(*GetConsolidationParamsResponse)(nil), // 14: geocube.GetConsolidationParamsResponse
-	(*ConsolidateRequest)(nil),             // 15: geocube.ConsolidateRequest
-	(*ConsolidateResponse)(nil),            // 16: geocube.ConsolidateResponse
-	(*ListJobsRequest)(nil),                // 17: geocube.ListJobsRequest
-	(*ListJobsResponse)(nil),               // 18: geocube.ListJobsResponse
-	(*GetJobRequest)(nil),                  // 19: geocube.GetJobRequest
-	(*GetJobResponse)(nil),                 // 20: geocube.GetJobResponse
-	(*CleanJobsRequest)(nil),               // 21: geocube.CleanJobsRequest
-	(*CleanJobsResponse)(nil),              // 22: geocube.CleanJobsResponse
-	(*CancelJobRequest)(nil),               // 23: geocube.CancelJobRequest
-	(*CancelJobResponse)(nil),              // 24: geocube.CancelJobResponse
-	(*RetryJobRequest)(nil),                // 25: geocube.RetryJobRequest
-	(*RetryJobResponse)(nil),               // 26: geocube.RetryJobResponse
-	(*ContinueJobRequest)(nil),             // 27: geocube.ContinueJobRequest
-	(*ContinueJobResponse)(nil),            // 28: geocube.ContinueJobResponse
-	(*DeleteDatasetsRequest)(nil),          // 29: geocube.DeleteDatasetsRequest
-	(*DeleteDatasetsResponse)(nil),         // 30: geocube.DeleteDatasetsResponse
-	nil,                                    // 31: geocube.ConsolidationParams.CreationParamsEntry
-	(*DataFormat)(nil),                     // 32: geocube.DataFormat
-	(*timestamppb.Timestamp)(nil),          // 33: google.protobuf.Timestamp
-	(Resampling)(0),                        // 34: geocube.Resampling
-	(*RecordIdList)(nil),                   // 35: geocube.RecordIdList
-	(*RecordFilters)(nil),                  // 36: geocube.RecordFilters
+	(*MetadataMapping)(nil),                // 9: geocube.MetadataMapping
+	(*IndexDatasetsResponse)(nil),          // 10: geocube.IndexDatasetsResponse
+	(*ConsolidationParams)(nil),            // 11: geocube.ConsolidationParams
+	(*ConfigConsolidationRequest)(nil),     // 12: geocube.ConfigConsolidationRequest
+	(*ConfigConsolidationResponse)(nil),    // 13: geocube.ConfigConsolidationResponse
+	(*GetConsolidationParamsRequest)(nil),  // 14: geocube.GetConsolidationParamsRequest
+	(*GetConsolidationParamsResponse)(nil), // 15: geocube.GetConsolidationParamsResponse
+	(*ConsolidateRequest)(nil),             // 16: geocube.ConsolidateRequest
+	(*ConsolidateResponse)(nil),            // 17: geocube.ConsolidateResponse
+	(*ListJobsRequest)(nil),                // 18: geocube.ListJobsRequest
+	(*ListJobsResponse)(nil),               // 19: geocube.ListJobsResponse
+	(*GetJobRequest)(nil),                  // 20: geocube.GetJobRequest
+	(*GetJobResponse)(nil),                 // 21: geocube.GetJobResponse
+	(*CleanJobsRequest)(nil),               // 22: geocube.CleanJobsRequest
+	(*CleanJobsResponse)(nil),              // 23: geocube.CleanJobsResponse
+	(*CancelJobRequest)(nil),               // 24: geocube.CancelJobRequest
+	(*CancelJobResponse)(nil),              // 25: geocube.CancelJobResponse
+	(*RetryJobRequest)(nil),                // 26: geocube.RetryJobRequest
+	(*RetryJobResponse)(nil),               // 27: geocube.RetryJobResponse
+	(*ContinueJobRequest)(nil),             // 28: geocube.ContinueJobRequest
+	(*ContinueJobResponse)(nil),            // 29: geocube.ContinueJobResponse
+	(*DeleteDatasetsRequest)(nil),          // 30: geocube.DeleteDatasetsRequest
+	(*DeleteDatasetsResponse)(nil),         // 31: geocube.DeleteDatasetsResponse
+	nil,                                    // 32: geocube.MetadataMapping.TagsEntry
+	nil,                                    // 33: geocube.ConsolidationParams.CreationParamsEntry
+	(*DataFormat)(nil),                     // 34: geocube.DataFormat
+	(*timestamppb.Timestamp)(nil),          // 35: google.protobuf.Timestamp
+	(Resampling)(0),                        // 36: geocube.Resampling
+	(*RecordIdList)(nil),                   // 37: geocube.RecordIdList
+	(*RecordFilters)(nil),                  // 38: geocube.RecordFilters
 }
 var file_pb_operations_proto_depIdxs = []int32{
-	32, // 0: geocube.Dataset.dformat:type_name -> geocube.DataFormat
+	34, // 0: geocube.Dataset.dformat:type_name -> geocube.DataFormat
 	3,  // 1: geocube.Container.datasets:type_name -> geocube.Dataset
-	33, // 2: geocube.Job.creation_time:type_name -> google.protobuf.Timestamp
-	33, // 3: geocube.Job.last_update_time:type_name -> google.protobuf.Timestamp
+	35, // 2: geocube.Job.creation_time:type_name -> google.protobuf.Timestamp
+	35, // 3: geocube.Job.last_update_time:type_name -> google.protobuf.Timestamp
 	1,  // 4: geocube.Job.execution_level:type_name -> geocube.ExecutionLevel
 	4,  // 5: geocube.GetContainersResponse.containers:type_name -> geocube.Container
 	4,  // 6: geocube.IndexDatasetsRequest.container:type_name -> geocube.Container
-	32, // 7: geocube.ConsolidationParams.dformat:type_name -> geocube.DataFormat
-	34, // 8: geocube.ConsolidationParams.resampling_alg:type_name -> geocube.Resampling
-	2,  // 9: geocube.ConsolidationParams.compression:type_name -> geocube.ConsolidationParams.Compression
-	31, // 10: geocube.ConsolidationParams.creation_params:type_name -> geocube.ConsolidationParams.CreationParamsEntry
-	0,  // 11: geocube.ConsolidationParams.storage_class:type_name -> geocube.StorageClass
-	10, // 12: geocube.ConfigConsolidationRequest.consolidation_params:type_name -> geocube.ConsolidationParams
-	10, // 13: geocube.GetConsolidationParamsResponse.consolidation_params:type_name -> geocube.ConsolidationParams
-	1,  // 14: geocube.ConsolidateRequest.execution_level:type_name -> geocube.ExecutionLevel
-	35, // 15: geocube.ConsolidateRequest.records:type_name -> geocube.RecordIdList
-	36, // 16: geocube.ConsolidateRequest.filters:type_name -> geocube.RecordFilters
-	5,  // 17: geocube.ListJobsResponse.jobs:type_name -> geocube.Job
-	5,  // 18: geocube.GetJobResponse.job:type_name -> geocube.Job
-	1,  // 19: geocube.DeleteDatasetsRequest.execution_level:type_name -> geocube.ExecutionLevel
-	5,  // 20: geocube.DeleteDatasetsResponse.job:type_name -> geocube.Job
-	21, // [21:21] is the sub-list for method output_type
-	21, // [21:21] is the sub-list for method input_type
-	21, // [21:21] is the sub-list for extension type_name
-	21, // [21:21] is the sub-list for extension extendee
-	0,  // [0:21] is the sub-list for field type_name
+	9,  // 7: geocube.IndexDatasetsRequest.metadata:type_name -> geocube.MetadataMapping
+	32, // 8: geocube.MetadataMapping.tags:type_name -> geocube.MetadataMapping.TagsEntry
+	34, // 9: geocube.ConsolidationParams.dformat:type_name -> geocube.DataFormat
+	36, // 10: geocube.ConsolidationParams.resampling_alg:type_name -> geocube.Resampling
+	2,  // 11: geocube.ConsolidationParams.compression:type_name -> geocube.ConsolidationParams.Compression
+	33, // 12: geocube.ConsolidationParams.creation_params:type_name -> geocube.ConsolidationParams.CreationParamsEntry
+	0,  // 13: geocube.ConsolidationParams.storage_class:type_name -> geocube.StorageClass
+	11, // 14: geocube.ConfigConsolidationRequest.consolidation_params:type_name -> geocube.ConsolidationParams
+	11, // 15: geocube.GetConsolidationParamsResponse.consolidation_params:type_name -> geocube.ConsolidationParams
+	1,  // 16: geocube.ConsolidateRequest.execution_level:type_name -> geocube.ExecutionLevel
+	37, // 17: geocube.ConsolidateRequest.records:type_name -> geocube.RecordIdList
+	38, // 18: geocube.ConsolidateRequest.filters:type_name -> geocube.RecordFilters
+	5,  // 19: geocube.ListJobsResponse.jobs:type_name -> geocube.Job
+	5,  // 20: geocube.GetJobResponse.job:type_name -> geocube.Job
+	1,  // 21: geocube.DeleteDatasetsRequest.execution_level:type_name -> geocube.ExecutionLevel
+	5,  // 22: geocube.DeleteDatasetsResponse.job:type_name -> geocube.Job
+	23, // [23:23] is the sub-list for method output_type
+	23, // [23:23] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_pb_operations_proto_init() }
@@ -2307,7 +2444,7 @@ func file_pb_operations_proto_init() {
 			}
 		}
 		file_pb_operations_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IndexDatasetsResponse); i {
+			switch v := v.(*MetadataMapping); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2319,7 +2456,7 @@ func file_pb_operations_proto_init() {
 			}
 		}
 		file_pb_operations_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConsolidationParams); i {
+			switch v := v.(*IndexDatasetsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2331,7 +2468,7 @@ func file_pb_operations_proto_init() {
 			}
 		}
 		file_pb_operations_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConfigConsolidationRequest); i {
+			switch v := v.(*ConsolidationParams); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2343,7 +2480,7 @@ func file_pb_operations_proto_init() {
 			}
 		}
 		file_pb_operations_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConfigConsolidationResponse); i {
+			switch v := v.(*ConfigConsolidationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2355,7 +2492,7 @@ func file_pb_operations_proto_init() {
 			}
 		}
 		file_pb_operations_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetConsolidationParamsRequest); i {
+			switch v := v.(*ConfigConsolidationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2367,7 +2504,7 @@ func file_pb_operations_proto_init() {
 			}
 		}
 		file_pb_operations_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetConsolidationParamsResponse); i {
+			switch v := v.(*GetConsolidationParamsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2379,7 +2516,7 @@ func file_pb_operations_proto_init() {
 			}
 		}
 		file_pb_operations_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConsolidateRequest); i {
+			switch v := v.(*GetConsolidationParamsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2391,7 +2528,7 @@ func file_pb_operations_proto_init() {
 			}
 		}
 		file_pb_operations_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConsolidateResponse); i {
+			switch v := v.(*ConsolidateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2403,7 +2540,7 @@ func file_pb_operations_proto_init() {
 			}
 		}
 		file_pb_operations_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListJobsRequest); i {
+			switch v := v.(*ConsolidateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2415,7 +2552,7 @@ func file_pb_operations_proto_init() {
 			}
 		}
 		file_pb_operations_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListJobsResponse); i {
+			switch v := v.(*ListJobsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2427,7 +2564,7 @@ func file_pb_operations_proto_init() {
 			}
 		}
 		file_pb_operations_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetJobRequest); i {
+			switch v := v.(*ListJobsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2439,7 +2576,7 @@ func file_pb_operations_proto_init() {
 			}
 		}
 		file_pb_operations_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetJobResponse); i {
+			switch v := v.(*GetJobRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2451,7 +2588,7 @@ func file_pb_operations_proto_init() {
 			}
 		}
 		file_pb_operations_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CleanJobsRequest); i {
+			switch v := v.(*GetJobResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2463,7 +2600,7 @@ func file_pb_operations_proto_init() {
 			}
 		}
 		file_pb_operations_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CleanJobsResponse); i {
+			switch v := v.(*CleanJobsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2475,7 +2612,7 @@ func file_pb_operations_proto_init() {
 			}
 		}
 		file_pb_operations_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelJobRequest); i {
+			switch v := v.(*CleanJobsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2487,7 +2624,7 @@ func file_pb_operations_proto_init() {
 			}
 		}
 		file_pb_operations_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelJobResponse); i {
+			switch v := v.(*CancelJobRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2499,7 +2636,7 @@ func file_pb_operations_proto_init() {
 			}
 		}
 		file_pb_operations_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetryJobRequest); i {
+			switch v := v.(*CancelJobResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2511,7 +2648,7 @@ func file_pb_operations_proto_init() {
 			}
 		}
 		file_pb_operations_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetryJobResponse); i {
+			switch v := v.(*RetryJobRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2523,7 +2660,7 @@ func file_pb_operations_proto_init() {
 			}
 		}
 		file_pb_operations_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ContinueJobRequest); i {
+			switch v := v.(*RetryJobResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2535,7 +2672,7 @@ func file_pb_operations_proto_init() {
 			}
 		}
 		file_pb_operations_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ContinueJobResponse); i {
+			switch v := v.(*ContinueJobRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2547,7 +2684,7 @@ func file_pb_operations_proto_init() {
 			}
 		}
 		file_pb_operations_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteDatasetsRequest); i {
+			switch v := v.(*ContinueJobResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2559,6 +2696,18 @@ func file_pb_operations_proto_init() {
 			}
 		}
 		file_pb_operations_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteDatasetsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_operations_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteDatasetsResponse); i {
 			case 0:
 				return &v.state
@@ -2571,7 +2720,7 @@ func file_pb_operations_proto_init() {
 			}
 		}
 	}
-	file_pb_operations_proto_msgTypes[12].OneofWrappers = []interface{}{
+	file_pb_operations_proto_msgTypes[13].OneofWrappers = []interface{}{
 		(*ConsolidateRequest_Records)(nil),
 		(*ConsolidateRequest_Filters)(nil),
 	}
@@ -2581,7 +2730,7 @@ func file_pb_operations_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pb_operations_proto_rawDesc,
 			NumEnums:      3,
-			NumMessages:   29,
+			NumMessages:   31,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
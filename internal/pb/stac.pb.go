@@ -0,0 +1,554 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.12
+// source: pb/stac.proto
+
+package geocube
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// *
+// Define how an asset of a STAC Item is indexed as a dataset
+// Several mappings can refer to the same asset (e.g. to index each band in a different instance)
+type STACAssetMapping struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Asset        string      `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`                                       // Key of the asset in the STAC Item (e.g. "B04", "visual")
+	InstanceId   string      `protobuf:"bytes,2,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`           // Instance of the variable in which the asset is indexed
+	Bands        []int64     `protobuf:"varint,3,rep,packed,name=bands,proto3" json:"bands,omitempty"`                               // [Optional] Bands of the asset (starting at 1). Default: all the bands described by the asset, or 1
+	BandNames    []string    `protobuf:"bytes,4,rep,name=band_names,json=bandNames,proto3" json:"band_names,omitempty"`              // [Optional] Names or common names of the bands (eo:bands or bands of the asset), instead of bands
+	Dformat      *DataFormat `protobuf:"bytes,5,opt,name=dformat,proto3" json:"dformat,omitempty"`                                   // [Optional] Internal data format of the asset. Default: from the data_type, nodata, scale and offset of the raster bands of the asset
+	RealMinValue float64     `protobuf:"fixed64,6,opt,name=real_min_value,json=realMinValue,proto3" json:"real_min_value,omitempty"` // [Optional] Real min value (dformat.min_value maps to real_min_value). Default: dformat.min_value
+	RealMaxValue float64     `protobuf:"fixed64,7,opt,name=real_max_value,json=realMaxValue,proto3" json:"real_max_value,omitempty"` // [Optional] Real max value (dformat.max_value maps to real_max_value). Default: dformat.max_value
+	Exponent     float64     `protobuf:"fixed64,8,opt,name=exponent,proto3" json:"exponent,omitempty"`                               // [Optional] 1: linear scaling (RealMax - RealMin) * pow( (Value - Min) / (Max - Min), Exponent) + RealMin. Default: 1
+}
+
+func (x *STACAssetMapping) Reset() {
+	*x = STACAssetMapping{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_stac_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *STACAssetMapping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*STACAssetMapping) ProtoMessage() {}
+
+func (x *STACAssetMapping) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_stac_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use STACAssetMapping.ProtoReflect.Descriptor instead.
+func (*STACAssetMapping) Descriptor() ([]byte, []int) {
+	return file_pb_stac_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *STACAssetMapping) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *STACAssetMapping) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *STACAssetMapping) GetBands() []int64 {
+	if x != nil {
+		return x.Bands
+	}
+	return nil
+}
+
+func (x *STACAssetMapping) GetBandNames() []string {
+	if x != nil {
+		return x.BandNames
+	}
+	return nil
+}
+
+func (x *STACAssetMapping) GetDformat() *DataFormat {
+	if x != nil {
+		return x.Dformat
+	}
+	return nil
+}
+
+func (x *STACAssetMapping) GetRealMinValue() float64 {
+	if x != nil {
+		return x.RealMinValue
+	}
+	return 0
+}
+
+func (x *STACAssetMapping) GetRealMaxValue() float64 {
+	if x != nil {
+		return x.RealMaxValue
+	}
+	return 0
+}
+
+func (x *STACAssetMapping) GetExponent() float64 {
+	if x != nil {
+		return x.Exponent
+	}
+	return 0
+}
+
+// *
+// Define how the STAC Items are imported
+type STACMapping struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Assets []*STACAssetMapping `protobuf:"bytes,1,rep,name=assets,proto3" json:"assets,omitempty"`                                                                                     // Assets to be indexed. The other assets are ignored
+	Tags   map[string]string   `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // [Optional] Tags added to the records (override the properties of the items)
+}
+
+func (x *STACMapping) Reset() {
+	*x = STACMapping{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_stac_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *STACMapping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*STACMapping) ProtoMessage() {}
+
+func (x *STACMapping) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_stac_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use STACMapping.ProtoReflect.Descriptor instead.
+func (*STACMapping) Descriptor() ([]byte, []int) {
+	return file_pb_stac_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *STACMapping) GetAssets() []*STACAssetMapping {
+	if x != nil {
+		return x.Assets
+	}
+	return nil
+}
+
+func (x *STACMapping) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// *
+// Import STAC Items: for each item, create the AOI (or reuse an identical one), the record (or reuse an identical one) and index the assets as datasets.
+// The record is named after the id of the item, with the datetime of the item and its properties as tags.
+// Importing an item twice is idempotent.
+type ImportSTACRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items   []byte       `protobuf:"bytes,1,opt,name=items,proto3" json:"items,omitempty"` // STAC Item or ItemCollection (GeoJSON). The hrefs of the assets must be absolute.
+	Mapping *STACMapping `protobuf:"bytes,2,opt,name=mapping,proto3" json:"mapping,omitempty"`
+}
+
+func (x *ImportSTACRequest) Reset() {
+	*x = ImportSTACRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_stac_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportSTACRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportSTACRequest) ProtoMessage() {}
+
+func (x *ImportSTACRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_stac_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportSTACRequest.ProtoReflect.Descriptor instead.
+func (*ImportSTACRequest) Descriptor() ([]byte, []int) {
+	return file_pb_stac_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ImportSTACRequest) GetItems() []byte {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ImportSTACRequest) GetMapping() *STACMapping {
+	if x != nil {
+		return x.Mapping
+	}
+	return nil
+}
+
+// *
+// Result of the import of a STAC Item
+type STACImportedItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ItemId     string `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`              // Id of the STAC Item
+	RecordId   string `protobuf:"bytes,2,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`        // Id of the record
+	AoiId      string `protobuf:"bytes,3,opt,name=aoi_id,json=aoiId,proto3" json:"aoi_id,omitempty"`                 // Id of the AOI of the record
+	NewRecord  bool   `protobuf:"varint,4,opt,name=new_record,json=newRecord,proto3" json:"new_record,omitempty"`    // False if the record already existed
+	NbDatasets int32  `protobuf:"varint,5,opt,name=nb_datasets,json=nbDatasets,proto3" json:"nb_datasets,omitempty"` // Number of datasets indexed
+}
+
+func (x *STACImportedItem) Reset() {
+	*x = STACImportedItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_stac_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *STACImportedItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*STACImportedItem) ProtoMessage() {}
+
+func (x *STACImportedItem) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_stac_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use STACImportedItem.ProtoReflect.Descriptor instead.
+func (*STACImportedItem) Descriptor() ([]byte, []int) {
+	return file_pb_stac_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *STACImportedItem) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *STACImportedItem) GetRecordId() string {
+	if x != nil {
+		return x.RecordId
+	}
+	return ""
+}
+
+func (x *STACImportedItem) GetAoiId() string {
+	if x != nil {
+		return x.AoiId
+	}
+	return ""
+}
+
+func (x *STACImportedItem) GetNewRecord() bool {
+	if x != nil {
+		return x.NewRecord
+	}
+	return false
+}
+
+func (x *STACImportedItem) GetNbDatasets() int32 {
+	if x != nil {
+		return x.NbDatasets
+	}
+	return 0
+}
+
+// *
+type ImportSTACResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*STACImportedItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *ImportSTACResponse) Reset() {
+	*x = ImportSTACResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_stac_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportSTACResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportSTACResponse) ProtoMessage() {}
+
+func (x *ImportSTACResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_stac_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportSTACResponse.ProtoReflect.Descriptor instead.
+func (*ImportSTACResponse) Descriptor() ([]byte, []int) {
+	return file_pb_stac_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ImportSTACResponse) GetItems() []*STACImportedItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+var File_pb_stac_proto protoreflect.FileDescriptor
+
+var file_pb_stac_proto_rawDesc = []byte{
+	0x0a, 0x0d, 0x70, 0x62, 0x2f, 0x73, 0x74, 0x61, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x07, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x1a, 0x13, 0x70, 0x62, 0x2f, 0x64, 0x61, 0x74,
+	0x61, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x95, 0x02,
+	0x0a, 0x10, 0x53, 0x54, 0x41, 0x43, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4d, 0x61, 0x70, 0x70, 0x69,
+	0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x61, 0x6e,
+	0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03, 0x52, 0x05, 0x62, 0x61, 0x6e, 0x64, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x6e, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61, 0x6e, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x2d,
+	0x0a, 0x07, 0x64, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x46, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x52, 0x07, 0x64, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x24, 0x0a,
+	0x0e, 0x72, 0x65, 0x61, 0x6c, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x72, 0x65, 0x61, 0x6c, 0x4d, 0x69, 0x6e, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x72, 0x65, 0x61, 0x6c, 0x5f, 0x6d, 0x61, 0x78, 0x5f,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x72, 0x65, 0x61,
+	0x6c, 0x4d, 0x61, 0x78, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x70,
+	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x65, 0x78, 0x70,
+	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x22, 0xad, 0x01, 0x0a, 0x0b, 0x53, 0x54, 0x41, 0x43, 0x4d, 0x61,
+	0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x31, 0x0a, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e,
+	0x53, 0x54, 0x41, 0x43, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67,
+	0x52, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x32, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65,
+	0x2e, 0x53, 0x54, 0x41, 0x43, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x61, 0x67,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x1a, 0x37, 0x0a, 0x09,
+	0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x59, 0x0a, 0x11, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53,
+	0x54, 0x41, 0x43, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x12, 0x2e, 0x0a, 0x07, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x53, 0x54, 0x41, 0x43,
+	0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67,
+	0x22, 0x9f, 0x01, 0x0a, 0x10, 0x53, 0x54, 0x41, 0x43, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x64, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x61,
+	0x6f, 0x69, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6f, 0x69,
+	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x77, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6e, 0x65, 0x77, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x62, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6e, 0x62, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65,
+	0x74, 0x73, 0x22, 0x45, 0x0a, 0x12, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x54, 0x41, 0x43,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62,
+	0x65, 0x2e, 0x53, 0x54, 0x41, 0x43, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x49, 0x74,
+	0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x42, 0x0e, 0x5a, 0x0c, 0x2e, 0x2f, 0x70,
+	0x62, 0x3b, 0x67, 0x65, 0x6f, 0x63, 0x75, 0x62, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_pb_stac_proto_rawDescOnce sync.Once
+	file_pb_stac_proto_rawDescData = file_pb_stac_proto_rawDesc
+)
+
+func file_pb_stac_proto_rawDescGZIP() []byte {
+	file_pb_stac_proto_rawDescOnce.Do(func() {
+		file_pb_stac_proto_rawDescData = protoimpl.X.CompressGZIP(file_pb_stac_proto_rawDescData)
+	})
+	return file_pb_stac_proto_rawDescData
+}
+
+var file_pb_stac_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_pb_stac_proto_goTypes = []interface{}{
+	(*STACAssetMapping)(nil),   // 0: geocube.STACAssetMapping
+	(*STACMapping)(nil),        // 1: geocube.STACMapping
+	(*ImportSTACRequest)(nil),  // 2: geocube.ImportSTACRequest
+	(*STACImportedItem)(nil),   // 3: geocube.STACImportedItem
+	(*ImportSTACResponse)(nil), // 4: geocube.ImportSTACResponse
+	nil,                        // 5: geocube.STACMapping.TagsEntry
+	(*DataFormat)(nil),         // 6: geocube.DataFormat
+}
+var file_pb_stac_proto_depIdxs = []int32{
+	6, // 0: geocube.STACAssetMapping.dformat:type_name -> geocube.DataFormat
+	0, // 1: geocube.STACMapping.assets:type_name -> geocube.STACAssetMapping
+	5, // 2: geocube.STACMapping.tags:type_name -> geocube.STACMapping.TagsEntry
+	1, // 3: geocube.ImportSTACRequest.mapping:type_name -> geocube.STACMapping
+	3, // 4: geocube.ImportSTACResponse.items:type_name -> geocube.STACImportedItem
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_pb_stac_proto_init() }
+func file_pb_stac_proto_init() {
+	if File_pb_stac_proto != nil {
+		return
+	}
+	file_pb_dataformat_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_pb_stac_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*STACAssetMapping); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_stac_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*STACMapping); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_stac_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportSTACRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_stac_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*STACImportedItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_stac_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportSTACResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_pb_stac_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_pb_stac_proto_goTypes,
+		DependencyIndexes: file_pb_stac_proto_depIdxs,
+		MessageInfos:      file_pb_stac_proto_msgTypes,
+	}.Build()
+	File_pb_stac_proto = out.File
+	file_pb_stac_proto_rawDesc = nil
+	file_pb_stac_proto_goTypes = nil
+	file_pb_stac_proto_depIdxs = nil
+}
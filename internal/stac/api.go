@@ -0,0 +1,724 @@
+package stac
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/twpayne/go-geom/encoding/geojson"
+	"go.uber.org/zap"
+
+	"github.com/airbusgeo/geocube/internal/geocube"
+	"github.com/airbusgeo/geocube/internal/log"
+	"github.com/airbusgeo/geocube/internal/utils/bitmap"
+)
+
+// Version of the STAC specification served by the API
+const Version = "1.0.0"
+
+const (
+	defaultLimit = 10
+	maxLimit     = 1000
+
+	rasterExtension      = "https://stac-extensions.github.io/raster/v1.1.0/schema.json"
+	webMapLinksExtension = "https://stac-extensions.github.io/web-map-links/v1.2.0/schema.json"
+
+	mediaTypeJSON    = "application/json"
+	mediaTypeGeoJSON = "application/geo+json"
+)
+
+// maxScannedRecords is the maximum number of records scanned by a search request.
+// When it is reached, the page may have less items than requested (or none) and the search continues with the next page.
+var maxScannedRecords = 10000
+
+var conformance = []string{
+	"https://api.stacspec.org/v1.0.0/core",
+	"https://api.stacspec.org/v1.0.0/collections",
+	"https://api.stacspec.org/v1.0.0/ogcapi-features",
+	"https://api.stacspec.org/v1.0.0/item-search",
+	"http://www.opengis.net/spec/ogcapi-features-1/1.0/conf/core",
+	"http://www.opengis.net/spec/ogcapi-features-1/1.0/conf/geojson",
+}
+
+// Catalog gives access to the entities of the Geocube exposed by the STAC API
+type Catalog interface {
+	GetAOI(ctx context.Context, aoiID string) (*geocube.AOI, error)
+	GetRecords(ctx context.Context, ids []string) ([]*geocube.Record, error)
+	ListRecords(ctx context.Context, namelike string, tags geocube.TagsQuery, fromTime, toTime time.Time, aoi *geocube.AOI, page, limit int, after *geocube.Cursor, withAOI bool) ([]*geocube.Record, error)
+	GetVariable(ctx context.Context, variableID, instanceID, variableName string) (*geocube.Variable, error)
+	ListVariables(ctx context.Context, namelike string, page, limit int, after *geocube.Cursor) ([]*geocube.Variable, error)
+	ListRecordsDatasets(ctx context.Context, recordsID, instancesID []string) ([]*geocube.Dataset, error)
+}
+
+// API is a read-only STAC API (https://github.com/radiantearth/stac-api-spec) on the catalog of the Geocube.
+// Each variable is a collection. An item of a collection is a record, whose assets are the datasets of the variable.
+type API struct {
+	catalog     Catalog
+	prefix      string
+	tilesPrefix string
+	mux         *http.ServeMux
+}
+
+// NewAPI returns the STAC API served under the path prefix (e.g. "/v1/stac").
+// The items link to the XYZ tiles served under tilesPrefix (e.g. "/v1/catalog/mosaic").
+func NewAPI(catalog Catalog, prefix, tilesPrefix string) *API {
+	api := &API{
+		catalog:     catalog,
+		prefix:      strings.TrimSuffix(prefix, "/"),
+		tilesPrefix: strings.TrimSuffix(tilesPrefix, "/"),
+		mux:         http.NewServeMux(),
+	}
+	api.mux.HandleFunc("GET "+api.prefix, api.landingPage)
+	api.mux.HandleFunc("GET "+api.prefix+"/{$}", api.landingPage)
+	api.mux.HandleFunc("GET "+api.prefix+"/conformance", api.conformance)
+	api.mux.HandleFunc("GET "+api.prefix+"/collections", api.collections)
+	api.mux.HandleFunc("GET "+api.prefix+"/collections/{collectionId}", api.collection)
+	api.mux.HandleFunc("GET "+api.prefix+"/collections/{collectionId}/items", api.collectionItems)
+	api.mux.HandleFunc("GET "+api.prefix+"/collections/{collectionId}/items/{itemId}", api.item)
+	api.mux.HandleFunc("GET "+api.prefix+"/search", api.search)
+	api.mux.HandleFunc("POST "+api.prefix+"/search", api.search)
+	return api
+}
+
+// ServeHTTP implements http.Handler
+func (api *API) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	api.mux.ServeHTTP(w, r)
+}
+
+// Collection is a STAC Collection
+type Collection struct {
+	Type        string                 `json:"type"`
+	StacVersion string                 `json:"stac_version"`
+	ID          string                 `json:"id"`
+	Title       string                 `json:"title,omitempty"`
+	Description string                 `json:"description"`
+	License     string                 `json:"license"`
+	Extent      interface{}            `json:"extent"`
+	Summaries   map[string]interface{} `json:"summaries,omitempty"`
+	Links       []Link                 `json:"links"`
+}
+
+// ItemCollection is a page of STAC Items
+type ItemCollection struct {
+	Type           string  `json:"type"`
+	Features       []*Item `json:"features"`
+	NumberReturned int     `json:"numberReturned"`
+	Links          []Link  `json:"links"`
+}
+
+// searchParams are the parameters of an item search (GET query or POST body)
+type searchParams struct {
+	Collections []string        `json:"collections,omitempty"`
+	BBox        []float64       `json:"bbox,omitempty"`
+	Intersects  json.RawMessage `json:"intersects,omitempty"`
+	Datetime    string          `json:"datetime,omitempty"`
+	TagsQuery   string          `json:"tags_query,omitempty"` // see geocube.ParseTagsQuery
+	Limit       int             `json:"limit,omitempty"`
+	Token       string          `json:"token,omitempty"`
+	IDs         []string        `json:"ids,omitempty"`
+}
+
+// searchFilter is the validated version of searchParams
+type searchFilter struct {
+	variables        []*geocube.Variable
+	aoi              *geocube.AOI
+	tags             geocube.TagsQuery
+	fromTime, toTime time.Time
+	limit            int
+	after            *geocube.Cursor
+}
+
+func (api *API) landingPage(w http.ResponseWriter, r *http.Request) {
+	root := api.url(r, "")
+	writeJSON(w, mediaTypeJSON, map[string]interface{}{
+		"type":         TypeCatalog,
+		"stac_version": Version,
+		"id":           "geocube",
+		"title":        "Geocube",
+		"description":  "Catalog of the Geocube: one collection per variable",
+		"conformsTo":   conformance,
+		"links": []Link{
+			{Rel: "self", Href: root, Type: mediaTypeJSON},
+			{Rel: "root", Href: root, Type: mediaTypeJSON},
+			{Rel: "conformance", Href: api.url(r, "/conformance"), Type: mediaTypeJSON},
+			{Rel: "data", Href: api.url(r, "/collections"), Type: mediaTypeJSON},
+			{Rel: "search", Href: api.url(r, "/search"), Type: mediaTypeGeoJSON, Method: http.MethodGet},
+			{Rel: "search", Href: api.url(r, "/search"), Type: mediaTypeGeoJSON, Method: http.MethodPost},
+		},
+	})
+}
+
+func (api *API) conformance(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, mediaTypeJSON, map[string]interface{}{"conformsTo": conformance})
+}
+
+func (api *API) collections(w http.ResponseWriter, r *http.Request) {
+	variables, err := api.catalog.ListVariables(r.Context(), "", 0, 0, nil)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	collections := make([]*Collection, len(variables))
+	for i, v := range variables {
+		collections[i] = api.newCollection(r, v)
+	}
+	writeJSON(w, mediaTypeJSON, map[string]interface{}{
+		"collections": collections,
+		"links": []Link{
+			{Rel: "self", Href: api.url(r, "/collections"), Type: mediaTypeJSON},
+			{Rel: "root", Href: api.url(r, ""), Type: mediaTypeJSON},
+		},
+	})
+}
+
+func (api *API) collection(w http.ResponseWriter, r *http.Request) {
+	v, err := api.getVariable(r.Context(), r.PathValue("collectionId"))
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, mediaTypeJSON, api.newCollection(r, v))
+}
+
+func (api *API) collectionItems(w http.ResponseWriter, r *http.Request) {
+	params, err := parseSearchQuery(r.URL.Query())
+	if err == nil && len(params.Collections) > 0 {
+		err = geocube.NewValidationError("collections is not a parameter of the items of a collection")
+	}
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	params.Collections = []string{r.PathValue("collectionId")}
+	api.writeItems(w, r, params, false)
+}
+
+func (api *API) item(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	v, err := api.getVariable(ctx, r.PathValue("collectionId"))
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	itemID := r.PathValue("itemId")
+	notFound := geocube.NewEntityNotFound("Item", "id", itemID, "")
+	if _, err := uuid.Parse(itemID); err != nil {
+		writeError(w, r, notFound)
+		return
+	}
+	records, err := api.catalog.GetRecords(ctx, []string{itemID})
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	if len(records) == 0 {
+		writeError(w, r, notFound)
+		return
+	}
+	record := records[0]
+	aoi, err := api.catalog.GetAOI(ctx, record.AOI.ID)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	record.AOI = *aoi
+	datasets, err := api.catalog.ListRecordsDatasets(ctx, []string{record.ID}, instancesID(v))
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	if len(datasets) == 0 {
+		writeError(w, r, notFound)
+		return
+	}
+	item, err := api.newItem(r, record, v, datasets)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, mediaTypeGeoJSON, item)
+}
+
+func (api *API) search(w http.ResponseWriter, r *http.Request) {
+	var params searchParams
+	var err error
+	if r.Method == http.MethodPost {
+		if err = json.NewDecoder(r.Body).Decode(&params); err != nil {
+			err = geocube.NewValidationError("invalid body: %v", err)
+		}
+	} else {
+		params, err = parseSearchQuery(r.URL.Query())
+	}
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	api.writeItems(w, r, params, r.Method == http.MethodPost)
+}
+
+// writeItems searches the items and writes the page of results with the link to the next page
+func (api *API) writeItems(w http.ResponseWriter, r *http.Request, params searchParams, post bool) {
+	ctx := r.Context()
+	filter, err := api.newSearchFilter(ctx, params)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	records, datasets, next, err := api.searchRecords(ctx, filter)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	res := ItemCollection{Type: TypeItemCollection, Features: []*Item{}}
+	for i, record := range records {
+		for _, v := range filter.variables {
+			if ds := datasets[i][v.ID]; len(ds) > 0 {
+				item, err := api.newItem(r, record, v, ds)
+				if err != nil {
+					writeError(w, r, err)
+					return
+				}
+				res.Features = append(res.Features, item)
+			}
+		}
+	}
+	res.NumberReturned = len(res.Features)
+
+	self := *r.URL
+	self.Scheme, self.Host = baseURL(r).Scheme, baseURL(r).Host
+	res.Links = []Link{
+		{Rel: "self", Href: self.String(), Type: mediaTypeGeoJSON},
+		{Rel: "root", Href: api.url(r, ""), Type: mediaTypeJSON},
+	}
+	if next != nil {
+		if post {
+			params.Token = next.Token()
+			res.Links = append(res.Links, Link{Rel: "next", Href: self.String(), Type: mediaTypeGeoJSON, Method: http.MethodPost, Body: params})
+		} else {
+			query := self.Query()
+			query.Set("token", next.Token())
+			self.RawQuery = query.Encode()
+			res.Links = append(res.Links, Link{Rel: "next", Href: self.String(), Type: mediaTypeGeoJSON, Method: http.MethodGet})
+		}
+	}
+	writeJSON(w, mediaTypeGeoJSON, res)
+}
+
+// parseSearchQuery parses the parameters of an item search from the query of a GET request
+// Only returns ValidationError
+func parseSearchQuery(query url.Values) (searchParams, error) {
+	params := searchParams{
+		Datetime:  query.Get("datetime"),
+		TagsQuery: query.Get("tags_query"),
+		Token:     query.Get("token"),
+	}
+	if s := query.Get("collections"); s != "" {
+		params.Collections = strings.Split(s, ",")
+	}
+	if s := query.Get("ids"); s != "" {
+		params.IDs = strings.Split(s, ",")
+	}
+	if s := query.Get("bbox"); s != "" {
+		for _, v := range strings.Split(s, ",") {
+			f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+			if err != nil {
+				return params, geocube.NewValidationError("invalid bbox: %s", s)
+			}
+			params.BBox = append(params.BBox, f)
+		}
+	}
+	if s := query.Get("intersects"); s != "" {
+		params.Intersects = json.RawMessage(s)
+	}
+	if s := query.Get("limit"); s != "" {
+		var err error
+		if params.Limit, err = strconv.Atoi(s); err != nil || params.Limit <= 0 {
+			return params, geocube.NewValidationError("invalid limit: %s", s)
+		}
+	}
+	return params, nil
+}
+
+// newSearchFilter validates the parameters of the search
+// Only returns ValidationError, EntityNotFound or an error from the catalog
+func (api *API) newSearchFilter(ctx context.Context, params searchParams) (*searchFilter, error) {
+	var err error
+	filter := searchFilter{limit: params.Limit}
+	if len(params.IDs) > 0 {
+		return nil, geocube.NewValidationError("ids is not supported: use tags_query to filter the records")
+	}
+	if len(params.BBox) > 0 && len(params.Intersects) > 0 {
+		return nil, geocube.NewValidationError("bbox and intersects cannot be both defined")
+	}
+	if len(params.BBox) > 0 {
+		if filter.aoi, err = aoiFromBBox(params.BBox); err != nil {
+			return nil, err
+		}
+	} else if len(params.Intersects) > 0 {
+		if filter.aoi, err = aoiFromGeoJSON(params.Intersects); err != nil {
+			return nil, err
+		}
+	}
+	if filter.fromTime, filter.toTime, err = parseDatetime(params.Datetime); err != nil {
+		return nil, err
+	}
+	if filter.tags, err = geocube.ParseTagsQuery(params.TagsQuery); err != nil {
+		return nil, err
+	}
+	if filter.after, err = geocube.ParseCursor(params.Token); err != nil {
+		return nil, err
+	}
+	switch {
+	case filter.limit < 0:
+		return nil, geocube.NewValidationError("invalid limit: %d", filter.limit)
+	case filter.limit == 0:
+		filter.limit = defaultLimit
+	case filter.limit > maxLimit:
+		filter.limit = maxLimit
+	}
+
+	if len(params.Collections) == 0 {
+		if filter.variables, err = api.catalog.ListVariables(ctx, "", 0, 0, nil); err != nil {
+			return nil, err
+		}
+	}
+	for _, c := range params.Collections {
+		v, err := api.getVariable(ctx, c)
+		if err != nil {
+			return nil, err
+		}
+		filter.variables = append(filter.variables, v)
+	}
+	sort.Slice(filter.variables, func(i, j int) bool { return filter.variables[i].Name < filter.variables[j].Name })
+	return &filter, nil
+}
+
+// parseDatetime parses a datetime or an interval ("start/end", "../end", "start/..")
+// Only returns ValidationError
+func parseDatetime(s string) (fromTime, toTime time.Time, err error) {
+	if s == "" {
+		return
+	}
+	parse := func(v string) (time.Time, error) {
+		if v == "" || v == ".." {
+			return time.Time{}, nil
+		}
+		t, err := time.Parse(time.RFC3339Nano, v)
+		if err != nil {
+			return t, geocube.NewValidationError("invalid datetime: %s", v)
+		}
+		return t, nil
+	}
+	parts := strings.Split(s, "/")
+	switch len(parts) {
+	case 1:
+		if fromTime, err = parse(parts[0]); err == nil && fromTime.IsZero() {
+			err = geocube.NewValidationError("invalid datetime: %s", s)
+		}
+		return fromTime, fromTime, err
+	case 2:
+		if fromTime, err = parse(parts[0]); err != nil {
+			return
+		}
+		if toTime, err = parse(parts[1]); err != nil {
+			return
+		}
+		if fromTime.IsZero() && toTime.IsZero() || !fromTime.IsZero() && !toTime.IsZero() && toTime.Before(fromTime) {
+			err = geocube.NewValidationError("invalid datetime interval: %s", s)
+		}
+		return
+	}
+	return fromTime, toTime, geocube.NewValidationError("invalid datetime: %s", s)
+}
+
+// searchRecords returns the records that fit the filter and have datasets of the variables with, for each record, the datasets by variable.
+// The records are sorted by datetime and id. At least filter.limit items are returned (if any), unless the last record
+// has datasets of several variables, or less if maxScannedRecords records have been scanned without finding them
+// (e.g. the variables have datasets in few records). The cursor of the next page is returned, nil if it is the last page.
+func (api *API) searchRecords(ctx context.Context, filter *searchFilter) ([]*geocube.Record, []map[string][]*geocube.Dataset, *geocube.Cursor, error) {
+	variableOf := map[string]string{}
+	var instances []string
+	for _, v := range filter.variables {
+		for _, id := range instancesID(v) {
+			variableOf[id] = v.ID
+			instances = append(instances, id)
+		}
+	}
+	if len(instances) == 0 {
+		return nil, nil, nil, nil
+	}
+
+	var records []*geocube.Record
+	var datasets []map[string][]*geocube.Dataset
+	nbItems, nbScanned, after := 0, 0, filter.after
+	for {
+		page, err := api.catalog.ListRecords(ctx, "", filter.tags, filter.fromTime, filter.toTime, filter.aoi, 0, filter.limit, after, true)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		if len(page) == 0 {
+			return records, datasets, nil, nil
+		}
+		ids := make([]string, len(page))
+		for i, r := range page {
+			ids[i] = r.ID
+		}
+		pageDatasets, err := api.catalog.ListRecordsDatasets(ctx, ids, instances)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		byRecord := map[string]map[string][]*geocube.Dataset{}
+		for _, d := range pageDatasets {
+			if byRecord[d.RecordID] == nil {
+				byRecord[d.RecordID] = map[string][]*geocube.Dataset{}
+			}
+			byRecord[d.RecordID][variableOf[d.InstanceID]] = append(byRecord[d.RecordID][variableOf[d.InstanceID]], d)
+		}
+
+		for _, r := range page {
+			if nbItems >= filter.limit {
+				return records, datasets, after, nil
+			}
+			if ds, ok := byRecord[r.ID]; ok {
+				records = append(records, r)
+				datasets = append(datasets, ds)
+				nbItems += len(ds)
+			}
+			c := r.Cursor()
+			after = &c
+		}
+		if len(page) < filter.limit {
+			return records, datasets, nil, nil
+		}
+		if nbScanned += len(page); nbItems >= filter.limit || nbScanned >= maxScannedRecords {
+			return records, datasets, after, nil
+		}
+	}
+}
+
+func (api *API) getVariable(ctx context.Context, name string) (*geocube.Variable, error) {
+	v, err := api.catalog.GetVariable(ctx, "", "", name)
+	if err != nil {
+		if geocube.IsError(err, geocube.EntityNotFound) {
+			return nil, geocube.NewEntityNotFound("Collection", "id", name, "")
+		}
+		return nil, err
+	}
+	return v, nil
+}
+
+func (api *API) newCollection(r *http.Request, v *geocube.Variable) *Collection {
+	instances := make([]string, 0, len(v.Instances))
+	for _, instance := range v.Instances {
+		instances = append(instances, instance.Name)
+	}
+	sort.Strings(instances)
+	description := v.Description
+	if description == "" {
+		description = v.Name
+	}
+	self := api.url(r, "/collections/"+url.PathEscape(v.Name))
+	return &Collection{
+		Type:        TypeCollection,
+		StacVersion: Version,
+		ID:          v.Name,
+		Title:       v.Name,
+		Description: description,
+		License:     "proprietary",
+		Extent: map[string]interface{}{
+			"spatial":  map[string]interface{}{"bbox": [][]float64{{-180, -90, 180, 90}}},
+			"temporal": map[string]interface{}{"interval": [][]interface{}{{nil, nil}}},
+		},
+		Summaries: map[string]interface{}{"geocube:instances": instances},
+		Links: []Link{
+			{Rel: "self", Href: self, Type: mediaTypeJSON},
+			{Rel: "root", Href: api.url(r, ""), Type: mediaTypeJSON},
+			{Rel: "parent", Href: api.url(r, ""), Type: mediaTypeJSON},
+			{Rel: "items", Href: self + "/items", Type: mediaTypeGeoJSON},
+		},
+	}
+}
+
+// newItem returns the item of the record in the collection of the variable, with the datasets as assets
+func (api *API) newItem(r *http.Request, record *geocube.Record, v *geocube.Variable, datasets []*geocube.Dataset) (*Item, error) {
+	item := Item{
+		Type:           TypeItem,
+		StacVersion:    Version,
+		StacExtensions: []string{rasterExtension, webMapLinksExtension},
+		ID:             record.ID,
+		Collection:     v.Name,
+		Geometry:       json.RawMessage("null"),
+		Properties:     map[string]interface{}{},
+		Assets:         map[string]*Asset{},
+	}
+
+	if record.AOI.Geometry != nil && record.AOI.Geometry.MultiPolygon != nil {
+		var err error
+		if item.Geometry, err = geojson.Marshal(record.AOI.Geometry.MultiPolygon); err != nil {
+			return nil, fmt.Errorf("newItem: %w", err)
+		}
+		b := record.AOI.Geometry.Bounds()
+		item.BBox = []float64{b.Min(0), b.Min(1), b.Max(0), b.Max(1)}
+	}
+
+	for k, tag := range record.Tags {
+		item.Properties[k] = tag
+	}
+	item.Properties["title"] = string(record.Name)
+	item.Properties["datetime"] = record.Time.UTC().Format(time.RFC3339Nano)
+
+	// Assets
+	sort.Slice(datasets, func(i, j int) bool {
+		ni, nj := instanceName(v, datasets[i].InstanceID), instanceName(v, datasets[j].InstanceID)
+		if ni != nj {
+			return ni < nj
+		}
+		return datasets[i].ContainerURI < datasets[j].ContainerURI
+	})
+	var instances []string
+	for _, d := range datasets {
+		name := instanceName(v, d.InstanceID)
+		key := name
+		for i := 2; item.Assets[key] != nil; i++ {
+			key = fmt.Sprintf("%s_%d", name, i)
+		}
+		if key == name {
+			instances = append(instances, d.InstanceID)
+		}
+		item.Assets[key] = newAsset(d, name)
+	}
+
+	// Links
+	collection := api.url(r, "/collections/"+url.PathEscape(v.Name))
+	item.Links = []Link{
+		{Rel: "self", Href: collection + "/items/" + record.ID, Type: mediaTypeGeoJSON},
+		{Rel: "parent", Href: collection, Type: mediaTypeJSON},
+		{Rel: "collection", Href: collection, Type: mediaTypeJSON},
+		{Rel: "root", Href: api.url(r, ""), Type: mediaTypeJSON},
+	}
+	for _, instanceID := range instances {
+		item.Links = append(item.Links, Link{
+			Rel:   "xyz",
+			Href:  baseURL(r).String() + api.tilesPrefix + "/" + instanceID + "/{x}/{y}/{z}/png?records.ids=" + record.ID,
+			Type:  "image/png",
+			Title: instanceName(v, instanceID),
+		})
+	}
+	return &item, nil
+}
+
+// newAsset returns the asset of the dataset
+func newAsset(d *geocube.Dataset, instanceName string) *Asset {
+	asset := Asset{
+		Href:         d.ContainerURI,
+		Title:        instanceName,
+		Roles:        []string{"data"},
+		InstanceID:   d.InstanceID,
+		DatasetBands: d.Bands,
+		SubDir:       d.ContainerSubDir,
+	}
+	if ext := strings.ToLower(path.Ext(d.ContainerURI)); ext == ".tif" || ext == ".tiff" {
+		asset.Type = "image/tiff; application=geotiff"
+	}
+
+	dm := d.DataMapping
+	band := RasterBand{DataType: strings.ToLower(dm.DType.String()), NoData: dm.NoData}
+	if dm.DType == bitmap.DTypeCOMPLEX64 {
+		band.DataType = "cfloat32"
+	}
+	switch {
+	case math.IsNaN(dm.NoData):
+		band.NoData = "nan"
+	case math.IsInf(dm.NoData, 1):
+		band.NoData = "inf"
+	case math.IsInf(dm.NoData, -1):
+		band.NoData = "-inf"
+	}
+	if dm.Exponent == 1 && dm.Range.Interval() != 0 {
+		// Real value = scale * value + offset
+		scale := dm.RangeExt.Interval() / dm.Range.Interval()
+		offset := dm.RangeExt.Min - dm.Range.Min*scale
+		if scale != 1 || offset != 0 {
+			band.Scale, band.Offset = &scale, &offset
+		}
+	}
+	for range d.Bands {
+		asset.RasterBands = append(asset.RasterBands, band)
+	}
+	return &asset
+}
+
+func instancesID(v *geocube.Variable) []string {
+	ids := make([]string, 0, len(v.Instances))
+	for id := range v.Instances {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func instanceName(v *geocube.Variable, instanceID string) string {
+	if instance, ok := v.Instances[instanceID]; ok {
+		return instance.Name
+	}
+	return instanceID
+}
+
+// baseURL returns the scheme and the host of the request
+func baseURL(r *http.Request) *url.URL {
+	u := url.URL{Scheme: "http", Host: r.Host}
+	if r.TLS != nil {
+		u.Scheme = "https"
+	}
+	if proto := r.Header.Get("X-Forwarded-Proto"); proto != "" {
+		u.Scheme = proto
+	}
+	return &u
+}
+
+// url returns the absolute url of the path of the API
+func (api *API) url(r *http.Request, p string) string {
+	u := baseURL(r)
+	u.Path = api.prefix + p
+	if u.Path == "" {
+		u.Path = "/"
+	}
+	return u.String()
+}
+
+func writeJSON(w http.ResponseWriter, contentType string, v interface{}) {
+	w.Header().Set("Content-Type", contentType)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+func writeError(w http.ResponseWriter, r *http.Request, err error) {
+	status, code := http.StatusInternalServerError, "InternalServerError"
+	var gcerr geocube.GeocubeError
+	if errors.As(err, &gcerr) {
+		switch gcerr.Code() {
+		case geocube.EntityValidationError:
+			status, code = http.StatusBadRequest, "BadRequest"
+		case geocube.EntityNotFound:
+			status, code = http.StatusNotFound, "NotFound"
+		}
+	}
+	if status == http.StatusInternalServerError {
+		log.Logger(r.Context()).Error("STAC API", zap.String("path", r.URL.Path), zap.Error(err))
+	}
+	w.Header().Set("Content-Type", mediaTypeJSON)
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]string{"code": code, "description": err.Error()})
+}
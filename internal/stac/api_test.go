@@ -0,0 +1,383 @@
+package stac
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/twpayne/go-geom"
+
+	"github.com/airbusgeo/geocube/internal/geocube"
+	"github.com/airbusgeo/geocube/internal/utils/bitmap"
+)
+
+// fakeCatalog is an in-memory Catalog
+type fakeCatalog struct {
+	aois      map[string]*geocube.AOI
+	records   []*geocube.Record // sorted by time
+	variables []*geocube.Variable
+	datasets  []*geocube.Dataset
+}
+
+func (c *fakeCatalog) GetAOI(ctx context.Context, aoiID string) (*geocube.AOI, error) {
+	if aoi, ok := c.aois[aoiID]; ok {
+		return aoi, nil
+	}
+	return nil, geocube.NewEntityNotFound("AOI", "id", aoiID, "")
+}
+
+func (c *fakeCatalog) GetRecords(ctx context.Context, ids []string) ([]*geocube.Record, error) {
+	var records []*geocube.Record
+	for _, r := range c.records {
+		for _, id := range ids {
+			if r.ID == id {
+				rc := *r
+				rc.AOI = geocube.AOI{ID: r.AOI.ID}
+				records = append(records, &rc)
+			}
+		}
+	}
+	return records, nil
+}
+
+func (c *fakeCatalog) ListRecords(ctx context.Context, namelike string, tags geocube.TagsQuery, fromTime, toTime time.Time, aoi *geocube.AOI, page, limit int, after *geocube.Cursor, withAOI bool) ([]*geocube.Record, error) {
+	var records []*geocube.Record
+	for _, r := range c.records {
+		switch {
+		case tags != nil && !tags.Match(r.Tags),
+			!fromTime.IsZero() && r.Time.Before(fromTime),
+			!toTime.IsZero() && r.Time.After(toTime),
+			aoi != nil && !aoi.Geometry.Bounds().Overlaps(geom.XY, r.AOI.Geometry.Bounds()),
+			after != nil && (r.Time.Before(after.Time) || r.Time.Equal(after.Time) && r.ID <= after.ID):
+			continue
+		}
+		if limit > 0 && len(records) == limit {
+			break
+		}
+		records = append(records, r)
+	}
+	return records, nil
+}
+
+func (c *fakeCatalog) GetVariable(ctx context.Context, variableID, instanceID, variableName string) (*geocube.Variable, error) {
+	for _, v := range c.variables {
+		if v.Name == variableName {
+			return v, nil
+		}
+	}
+	return nil, geocube.NewEntityNotFound("Variable", "name", variableName, "")
+}
+
+func (c *fakeCatalog) ListVariables(ctx context.Context, namelike string, page, limit int, after *geocube.Cursor) ([]*geocube.Variable, error) {
+	return c.variables, nil
+}
+
+func (c *fakeCatalog) ListRecordsDatasets(ctx context.Context, recordsID, instancesID []string) ([]*geocube.Dataset, error) {
+	var datasets []*geocube.Dataset
+	for _, d := range c.datasets {
+		if contains(recordsID, d.RecordID) && contains(instancesID, d.InstanceID) {
+			datasets = append(datasets, d)
+		}
+	}
+	return datasets, nil
+}
+
+func contains(l []string, s string) bool {
+	for _, e := range l {
+		if e == s {
+			return true
+		}
+	}
+	return false
+}
+
+const (
+	instanceNDVI = "10000000-0000-0000-0000-000000000001"
+	instanceRGB8 = "10000000-0000-0000-0000-000000000002"
+)
+
+func recordUUID(i int) string {
+	return fmt.Sprintf("00000000-0000-0000-0000-%012d", i)
+}
+
+// newFakeCatalog returns a catalog with 5 records (one per day) with ndvi datasets and 2 of them with rgb datasets.
+// The 4 first records are in [0, 1]x[0, 1], the last one in [10, 11]x[10, 11].
+func newFakeCatalog(t *testing.T) *fakeCatalog {
+	c := fakeCatalog{aois: map[string]*geocube.AOI{}}
+	c.variables = []*geocube.Variable{
+		{ID: "20000000-0000-0000-0000-000000000001", Name: "ndvi", Description: "Vegetation index",
+			Instances: map[string]*geocube.VariableInstance{instanceNDVI: {ID: instanceNDVI, Name: "v1"}}},
+		{ID: "20000000-0000-0000-0000-000000000002", Name: "rgb",
+			Instances: map[string]*geocube.VariableInstance{instanceRGB8: {ID: instanceRGB8, Name: "uint8"}}},
+	}
+	for i := 1; i <= 5; i++ {
+		bbox := []float64{0, 0, 1, 1}
+		if i == 5 {
+			bbox = []float64{10, 10, 11, 11}
+		}
+		aoi, err := aoiFromBBox(bbox)
+		if err != nil {
+			t.Fatal(err)
+		}
+		c.aois[aoi.ID] = aoi
+		c.records = append(c.records, &geocube.Record{
+			ID:   recordUUID(i),
+			Name: geocube.URN(fmt.Sprintf("record%d", i)),
+			Time: time.Date(2023, 1, i, 0, 0, 0, 0, time.UTC),
+			Tags: geocube.Metadata{"cloud": fmt.Sprint(10 * i)},
+			AOI:  *aoi,
+		})
+		c.datasets = append(c.datasets, &geocube.Dataset{
+			ID: fmt.Sprintf("30000000-0000-0000-0000-%012d", i), RecordID: recordUUID(i), InstanceID: instanceNDVI,
+			ContainerURI: fmt.Sprintf("gs://bucket/ndvi/%d.tif", i), Bands: []int64{1},
+			DataMapping: geocube.DataMapping{
+				DataFormat: geocube.DataFormat{DType: bitmap.DTypeFLOAT32, NoData: 0, Range: geocube.Range{Min: -1, Max: 1}},
+				RangeExt:   geocube.Range{Min: -1, Max: 1}, Exponent: 1,
+			},
+		})
+		if i%2 == 0 {
+			c.datasets = append(c.datasets, &geocube.Dataset{
+				ID: fmt.Sprintf("40000000-0000-0000-0000-%012d", i), RecordID: recordUUID(i), InstanceID: instanceRGB8,
+				ContainerURI: fmt.Sprintf("gs://bucket/rgb/%d.tif", i), Bands: []int64{1, 2, 3},
+				DataMapping: geocube.DataMapping{
+					DataFormat: geocube.DataFormat{DType: bitmap.DTypeUINT8, NoData: 0, Range: geocube.Range{Min: 1, Max: 255}},
+					RangeExt:   geocube.Range{Min: 0, Max: 1}, Exponent: 1,
+				},
+			})
+		}
+	}
+	return &c
+}
+
+func newTestServer(t *testing.T) *httptest.Server {
+	server := httptest.NewServer(NewAPI(newFakeCatalog(t), "/v1/stac", "/v1/catalog/mosaic"))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// getJSON requests the url and decodes the response
+func getJSON(t *testing.T, method, url string, body interface{}, expectedStatus int, v interface{}) {
+	t.Helper()
+	var resp *http.Response
+	var err error
+	if method == http.MethodPost {
+		b, _ := json.Marshal(body)
+		resp, err = http.Post(url, mediaTypeJSON, bytes.NewReader(b))
+	} else {
+		resp, err = http.Get(url)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != expectedStatus {
+		t.Fatalf("%s %s: status %d, want %d", method, url, resp.StatusCode, expectedStatus)
+	}
+	if v != nil {
+		if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+			t.Fatalf("%s %s: %v", method, url, err)
+		}
+	}
+}
+
+func findLink(links []Link, rel string) *Link {
+	for i := range links {
+		if links[i].Rel == rel {
+			return &links[i]
+		}
+	}
+	return nil
+}
+
+// walkItems follows the next links and returns the "collection/id" of the items
+func walkItems(t *testing.T, method, url string, body interface{}) (ids []string, nbPages int) {
+	t.Helper()
+	for {
+		var page ItemCollection
+		getJSON(t, method, url, body, http.StatusOK, &page)
+		if page.NumberReturned != len(page.Features) {
+			t.Errorf("numberReturned=%d, want %d", page.NumberReturned, len(page.Features))
+		}
+		for _, item := range page.Features {
+			ids = append(ids, item.Collection+"/"+item.ID)
+		}
+		nbPages++
+		next := findLink(page.Links, "next")
+		if next == nil {
+			return ids, nbPages
+		}
+		if next.Method != method {
+			t.Fatalf("next link: method %s, want %s", next.Method, method)
+		}
+		url, body = next.Href, next.Body
+	}
+}
+
+func TestAPILandingPage(t *testing.T) {
+	server := newTestServer(t)
+	for _, p := range []string{"/v1/stac", "/v1/stac/"} {
+		var landing struct {
+			Type       string   `json:"type"`
+			ConformsTo []string `json:"conformsTo"`
+			Links      []Link   `json:"links"`
+		}
+		getJSON(t, http.MethodGet, server.URL+p, nil, http.StatusOK, &landing)
+		if landing.Type != TypeCatalog || len(landing.ConformsTo) != len(conformance) {
+			t.Errorf("GET %s: %+v", p, landing)
+		}
+		if l := findLink(landing.Links, "data"); l == nil || l.Href != server.URL+"/v1/stac/collections" {
+			t.Errorf("GET %s: data link %v", p, l)
+		}
+	}
+	getJSON(t, http.MethodGet, server.URL+"/v1/stac/unknown", nil, http.StatusNotFound, nil)
+}
+
+func TestAPICollections(t *testing.T) {
+	server := newTestServer(t)
+	var collections struct {
+		Collections []Collection `json:"collections"`
+	}
+	getJSON(t, http.MethodGet, server.URL+"/v1/stac/collections", nil, http.StatusOK, &collections)
+	if len(collections.Collections) != 2 || collections.Collections[0].ID != "ndvi" || collections.Collections[1].Description != "rgb" {
+		t.Fatalf("GET /collections: %+v", collections)
+	}
+
+	var collection Collection
+	getJSON(t, http.MethodGet, server.URL+"/v1/stac/collections/ndvi", nil, http.StatusOK, &collection)
+	if collection.Type != TypeCollection || collection.Description != "Vegetation index" {
+		t.Errorf("GET /collections/ndvi: %+v", collection)
+	}
+	if l := findLink(collection.Links, "items"); l == nil || l.Href != server.URL+"/v1/stac/collections/ndvi/items" {
+		t.Errorf("GET /collections/ndvi: items link %v", l)
+	}
+	getJSON(t, http.MethodGet, server.URL+"/v1/stac/collections/unknown", nil, http.StatusNotFound, nil)
+}
+
+func TestAPICollectionItems(t *testing.T) {
+	server := newTestServer(t)
+	ids, nbPages := walkItems(t, http.MethodGet, server.URL+"/v1/stac/collections/ndvi/items?limit=2", nil)
+	expected := []string{"ndvi/" + recordUUID(1), "ndvi/" + recordUUID(2), "ndvi/" + recordUUID(3), "ndvi/" + recordUUID(4), "ndvi/" + recordUUID(5)}
+	if strings.Join(ids, ",") != strings.Join(expected, ",") || nbPages != 3 {
+		t.Errorf("items: %v (%d pages), want %v", ids, nbPages, expected)
+	}
+
+	// Only the records with datasets of the collection
+	ids, nbPages = walkItems(t, http.MethodGet, server.URL+"/v1/stac/collections/rgb/items?limit=1", nil)
+	if len(ids) != 2 || ids[0] != "rgb/"+recordUUID(2) || ids[1] != "rgb/"+recordUUID(4) {
+		t.Errorf("items: %v", ids)
+	}
+	getJSON(t, http.MethodGet, server.URL+"/v1/stac/collections/unknown/items", nil, http.StatusNotFound, nil)
+}
+
+func TestAPICollectionItemsMaxScannedRecords(t *testing.T) {
+	maxScanned := maxScannedRecords
+	maxScannedRecords = 1
+	defer func() { maxScannedRecords = maxScanned }()
+
+	// The records without datasets of the collection are scanned one by one, returning empty pages
+	server := newTestServer(t)
+	ids, nbPages := walkItems(t, http.MethodGet, server.URL+"/v1/stac/collections/rgb/items?limit=1", nil)
+	if len(ids) != 2 || ids[0] != "rgb/"+recordUUID(2) || ids[1] != "rgb/"+recordUUID(4) || nbPages != 6 {
+		t.Errorf("items: %v (%d pages)", ids, nbPages)
+	}
+}
+
+func TestAPIItem(t *testing.T) {
+	server := newTestServer(t)
+	var item Item
+	getJSON(t, http.MethodGet, server.URL+"/v1/stac/collections/rgb/items/"+recordUUID(2), nil, http.StatusOK, &item)
+	if item.ID != recordUUID(2) || item.Collection != "rgb" || item.Properties["title"] != "record2" ||
+		item.Properties["datetime"] != "2023-01-02T00:00:00Z" || item.Properties["cloud"] != "20" {
+		t.Errorf("item: %+v", item)
+	}
+	if len(item.BBox) != 4 || item.BBox[2] != 1 || string(item.Geometry) == "null" {
+		t.Errorf("item: bbox %v, geometry %s", item.BBox, item.Geometry)
+	}
+
+	asset := item.Assets["uint8"]
+	if asset == nil || asset.Href != "gs://bucket/rgb/2.tif" || asset.InstanceID != instanceRGB8 || len(asset.DatasetBands) != 3 || len(asset.RasterBands) != 3 {
+		t.Fatalf("item: assets %+v", item.Assets)
+	}
+	if b := asset.RasterBands[0]; b.DataType != "uint8" || b.NoData != 0. || *b.Scale != 1./254 || *b.Offset != -1./254 {
+		t.Errorf("item: raster band %+v", b)
+	}
+	expected := server.URL + "/v1/catalog/mosaic/" + instanceRGB8 + "/{x}/{y}/{z}/png?records.ids=" + recordUUID(2)
+	if l := findLink(item.Links, "xyz"); l == nil || l.Href != expected || l.Title != "uint8" {
+		t.Errorf("item: xyz link %v, want %s", l, expected)
+	}
+
+	getJSON(t, http.MethodGet, server.URL+"/v1/stac/collections/rgb/items/"+recordUUID(1), nil, http.StatusNotFound, nil)
+	getJSON(t, http.MethodGet, server.URL+"/v1/stac/collections/rgb/items/"+recordUUID(9), nil, http.StatusNotFound, nil)
+	getJSON(t, http.MethodGet, server.URL+"/v1/stac/collections/rgb/items/record2", nil, http.StatusNotFound, nil)
+}
+
+func TestAPISearch(t *testing.T) {
+	server := newTestServer(t)
+	for _, tc := range []struct {
+		query    string
+		expected []string
+	}{
+		{"", []string{"ndvi/1", "ndvi/2", "rgb/2", "ndvi/3", "ndvi/4", "rgb/4", "ndvi/5"}},
+		{"collections=rgb", []string{"rgb/2", "rgb/4"}},
+		{"collections=rgb,ndvi&limit=3", []string{"ndvi/1", "ndvi/2", "rgb/2", "ndvi/3", "ndvi/4", "rgb/4", "ndvi/5"}},
+		{"bbox=9,9,12,12", []string{"ndvi/5"}},
+		{"datetime=2023-01-02T00:00:00Z", []string{"ndvi/2", "rgb/2"}},
+		{"datetime=2023-01-03T00:00:00Z/..&collections=ndvi", []string{"ndvi/3", "ndvi/4", "ndvi/5"}},
+		{"datetime=../2023-01-02T00:00:00Z&collections=ndvi", []string{"ndvi/1", "ndvi/2"}},
+		{"tags_query=cloud>=30 AND cloud<50&limit=1", []string{"ndvi/3", "ndvi/4", "rgb/4"}},
+	} {
+		u, err := http.NewRequest(http.MethodGet, server.URL+"/v1/stac/search", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		u.URL.RawQuery = strings.ReplaceAll(tc.query, " ", "%20")
+		ids, _ := walkItems(t, http.MethodGet, u.URL.String(), nil)
+		for i, id := range ids {
+			ids[i] = strings.Replace(id, "00000000-0000-0000-0000-00000000000", "", 1)
+		}
+		if strings.Join(ids, ",") != strings.Join(tc.expected, ",") {
+			t.Errorf("search?%s: %v, want %v", tc.query, ids, tc.expected)
+		}
+	}
+}
+
+func TestAPISearchPost(t *testing.T) {
+	server := newTestServer(t)
+	ids, nbPages := walkItems(t, http.MethodPost, server.URL+"/v1/stac/search", map[string]interface{}{
+		"collections": []string{"ndvi"},
+		"intersects":  map[string]interface{}{"type": "Polygon", "coordinates": [][][]float64{{{0, 0}, {2, 0}, {2, 2}, {0, 2}, {0, 0}}}},
+		"limit":       3,
+	})
+	sort.Strings(ids)
+	if len(ids) != 4 || ids[0] != "ndvi/"+recordUUID(1) || nbPages != 2 {
+		t.Errorf("POST search: %v (%d pages)", ids, nbPages)
+	}
+}
+
+func TestAPISearchInvalid(t *testing.T) {
+	server := newTestServer(t)
+	for _, query := range []string{
+		"limit=abc",
+		"limit=0",
+		"bbox=1,2,3",
+		"bbox=3,0,1,1",
+		"bbox=0,0,1,1&intersects={}",
+		"datetime=yesterday",
+		"datetime=2023-01-02T00:00:00Z/2023-01-01T00:00:00Z",
+		"datetime=../..",
+		"tags_query=cloud%20%3D",
+		"token=invalid",
+		"ids=1,2",
+	} {
+		getJSON(t, http.MethodGet, server.URL+"/v1/stac/search?"+query, nil, http.StatusBadRequest, nil)
+	}
+	getJSON(t, http.MethodGet, server.URL+"/v1/stac/search?collections=unknown", nil, http.StatusNotFound, nil)
+	getJSON(t, http.MethodPost, server.URL+"/v1/stac/search", "not an object", http.StatusBadRequest, nil)
+}
@@ -0,0 +1,362 @@
+package stac
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/airbusgeo/geocube/internal/geocube"
+	"github.com/airbusgeo/geocube/internal/log"
+	pb "github.com/airbusgeo/geocube/internal/pb"
+	"github.com/airbusgeo/geocube/internal/utils/bitmap"
+	"github.com/google/uuid"
+	"github.com/twpayne/go-geom"
+	"github.com/twpayne/go-geom/encoding/geojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Tags added to the records to identify the STAC Item
+const (
+	TagID         = "stac:id"
+	TagCollection = "stac:collection"
+)
+
+// AssetMapping defines how an asset of an item is indexed as a dataset
+type AssetMapping struct {
+	Asset      string
+	InstanceID string
+	Bands      []int64
+	BandNames  []string
+	// DataMapping of the asset. If nil, it is deduced from the raster bands of the asset
+	DataMapping *geocube.DataMapping
+}
+
+// Mapping defines how the STAC Items are imported
+type Mapping struct {
+	Assets []AssetMapping
+	Tags   geocube.Metadata
+}
+
+// NewMappingFromProtobuf creates a mapping from protobuf
+// Only returns ValidationError
+func NewMappingFromProtobuf(pbm *pb.STACMapping) (*Mapping, error) {
+	if len(pbm.GetAssets()) == 0 {
+		return nil, geocube.NewValidationError("STAC mapping: at least one asset must be mapped")
+	}
+	m := Mapping{Tags: pbm.GetTags()}
+	for _, pba := range pbm.GetAssets() {
+		if pba.GetAsset() == "" {
+			return nil, geocube.NewValidationError("STAC mapping: the key of the asset is missing")
+		}
+		if _, err := uuid.Parse(pba.GetInstanceId()); err != nil {
+			return nil, geocube.NewValidationError("STAC mapping: invalid instance id for asset %s: %s", pba.GetAsset(), pba.GetInstanceId())
+		}
+		if len(pba.GetBands()) > 0 && len(pba.GetBandNames()) > 0 {
+			return nil, geocube.NewValidationError("STAC mapping: bands and band_names cannot be both defined for asset %s", pba.GetAsset())
+		}
+		am := AssetMapping{
+			Asset:      pba.GetAsset(),
+			InstanceID: pba.GetInstanceId(),
+			Bands:      pba.GetBands(),
+			BandNames:  pba.GetBandNames(),
+		}
+		if pba.GetDformat() != nil {
+			dm := geocube.DataMapping{
+				DataFormat: *geocube.NewDataFormatFromProtobuf(pba.GetDformat()),
+				RangeExt:   geocube.Range{Min: pba.GetRealMinValue(), Max: pba.GetRealMaxValue()},
+				Exponent:   pba.GetExponent(),
+			}
+			if dm.RangeExt.Min == 0 && dm.RangeExt.Max == 0 {
+				dm.RangeExt = dm.Range
+			}
+			if dm.Exponent == 0 {
+				dm.Exponent = 1
+			}
+			am.DataMapping = &dm
+		}
+		m.Assets = append(m.Assets, am)
+	}
+	return &m, nil
+}
+
+// AOI returns the AOI of the item, from its geometry or, if the geometry is null, from its bbox
+// Only returns ValidationError
+func (item *Item) AOI() (*geocube.AOI, error) {
+	var aoi *geocube.AOI
+	var err error
+	if len(item.Geometry) > 0 && string(item.Geometry) != "null" {
+		aoi, err = aoiFromGeoJSON(item.Geometry)
+	} else if len(item.BBox) > 0 {
+		aoi, err = aoiFromBBox(item.BBox)
+	} else {
+		err = geocube.NewValidationError("geometry or bbox is missing")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("item %s: %w", item.ID, err)
+	}
+	return aoi, nil
+}
+
+// aoiFromGeoJSON returns an AOI from a GeoJSON Polygon or MultiPolygon
+// Only returns ValidationError
+func aoiFromGeoJSON(geometry []byte) (*geocube.AOI, error) {
+	var g geom.T
+	if err := geojson.Unmarshal(geometry, &g); err != nil {
+		return nil, geocube.NewValidationError("invalid geometry: %v", err)
+	}
+	mp := geom.NewMultiPolygon(geom.XY)
+	switch g := g.(type) {
+	case *geom.Polygon:
+		if err := mp.Push(geom.NewPolygonFlat(geom.XY, g.FlatCoords(), g.Ends())); err != nil {
+			return nil, geocube.NewValidationError("invalid geometry: %v", err)
+		}
+	case *geom.MultiPolygon:
+		mp = geom.NewMultiPolygonFlat(geom.XY, g.FlatCoords(), g.Endss())
+	default:
+		return nil, geocube.NewValidationError("geometry must be a Polygon or a MultiPolygon")
+	}
+	return geocube.NewAOIFromMultiPolygon(*mp)
+}
+
+// aoiFromBBox returns an AOI from a bbox (minx, miny, maxx, maxy) or (minx, miny, minz, maxx, maxy, maxz)
+// Only returns ValidationError
+func aoiFromBBox(bbox []float64) (*geocube.AOI, error) {
+	var minx, miny, maxx, maxy float64
+	switch len(bbox) {
+	case 4:
+		minx, miny, maxx, maxy = bbox[0], bbox[1], bbox[2], bbox[3]
+	case 6:
+		minx, miny, maxx, maxy = bbox[0], bbox[1], bbox[3], bbox[4]
+	default:
+		return nil, geocube.NewValidationError("bbox must have 4 or 6 values")
+	}
+	if minx > maxx || miny > maxy {
+		return nil, geocube.NewValidationError("invalid bbox (crossing the antimeridian is not supported): %v", bbox)
+	}
+	mp := geom.NewMultiPolygonFlat(geom.XY, []float64{minx, miny, maxx, miny, maxx, maxy, minx, maxy, minx, miny}, [][]int{{10}})
+	return geocube.NewAOIFromMultiPolygon(*mp)
+}
+
+// Time returns the datetime of the item (or its start_datetime if datetime is null)
+// Only returns ValidationError
+func (item *Item) Time() (time.Time, error) {
+	for _, key := range []string{"datetime", "start_datetime"} {
+		if s, ok := item.Properties[key].(string); ok {
+			t, err := time.Parse(time.RFC3339Nano, s)
+			if err != nil {
+				return time.Time{}, geocube.NewValidationError("item %s: invalid %s: %v", item.ID, key, err)
+			}
+			return t, nil
+		}
+	}
+	return time.Time{}, geocube.NewValidationError("item %s: datetime is missing", item.ID)
+}
+
+var invalidURNChars = regexp.MustCompile("[^a-zA-Z0-9-:_/]+")
+
+// recordName returns a valid URN from the id of the item, replacing the invalid characters by "_"
+func recordName(id string) string {
+	var parts []string
+	for _, p := range strings.Split(invalidURNChars.ReplaceAllString(id, "_"), "/") {
+		if p != "" {
+			parts = append(parts, p)
+		}
+	}
+	return strings.Join(parts, "/")
+}
+
+// Record returns a new record named after the id of the item, with the datetime of the item and its properties as tags.
+// Only returns ValidationError
+func (m Mapping) Record(ctx context.Context, item *Item, aoiID string) (*geocube.Record, error) {
+	t, err := item.Time()
+	if err != nil {
+		return nil, err
+	}
+	return geocube.NewRecordFromProtobuf(&pb.NewRecord{
+		Name:  recordName(item.ID),
+		Time:  timestamppb.New(t.Truncate(time.Microsecond)), // precision of the database
+		Tags:  m.tags(ctx, item),
+		AoiId: aoiID,
+	})
+}
+
+// tags returns the tags of the record of the item.
+// Properties whose key or value contains * or ? are not supported by the tags: they are ignored and logged.
+func (m Mapping) tags(ctx context.Context, item *Item) geocube.Metadata {
+	tags := geocube.Metadata{}
+	add := func(k, v string) {
+		if strings.ContainsAny(k, "*?") || strings.ContainsAny(v, "*?") {
+			log.Logger(ctx).Sugar().Warnf("STAC Item %s: property %s=%s is ignored (* and ? are not supported in the tags)", item.ID, k, v)
+			return
+		}
+		tags[k] = v
+	}
+	for k, v := range item.Properties {
+		if k == "datetime" || v == nil {
+			continue
+		}
+		switch v := v.(type) {
+		case string:
+			add(k, v)
+		case float64:
+			add(k, strconv.FormatFloat(v, 'f', -1, 64))
+		case bool:
+			add(k, strconv.FormatBool(v))
+		default:
+			if b, err := json.Marshal(v); err == nil {
+				add(k, string(b))
+			}
+		}
+	}
+	add(TagID, item.ID)
+	if item.Collection != "" {
+		add(TagCollection, item.Collection)
+	}
+	for k, v := range m.Tags {
+		add(k, v)
+	}
+	return tags
+}
+
+// Containers returns the containers of the mapped assets of the item and, for each container, the datasets to be indexed.
+// The assets that are not in the item are ignored.
+// Only returns ValidationError
+func (m Mapping) Containers(item *Item, recordID string) ([]*geocube.Container, [][]*geocube.Dataset, error) {
+	var containers []*geocube.Container
+	var datasets [][]*geocube.Dataset
+	idx := map[string]int{}
+
+	for _, am := range m.Assets {
+		asset, ok := item.Assets[am.Asset]
+		if !ok {
+			continue
+		}
+		bands, err := am.bands(asset)
+		if err != nil {
+			return nil, nil, fmt.Errorf("item %s: %w", item.ID, err)
+		}
+		dm := am.DataMapping
+		if dm == nil {
+			if dm, err = dataMappingFromBands(asset, bands); err != nil {
+				return nil, nil, fmt.Errorf("item %s: asset %s: %w", item.ID, am.Asset, err)
+			}
+		}
+
+		i, ok := idx[asset.Href]
+		if !ok {
+			c, err := geocube.NewContainerFromProtobuf(&pb.Container{Uri: asset.Href})
+			if err != nil {
+				return nil, nil, fmt.Errorf("item %s: asset %s: %w", item.ID, am.Asset, err)
+			}
+			i = len(containers)
+			idx[asset.Href] = i
+			containers = append(containers, c)
+			datasets = append(datasets, nil)
+		}
+
+		d, err := geocube.NewDatasetFromProtobuf(&pb.Dataset{
+			RecordId:     recordID,
+			InstanceId:   am.InstanceID,
+			Bands:        bands,
+			Dformat:      dm.DataFormat.ToProtobuf(),
+			RealMinValue: dm.RangeExt.Min,
+			RealMaxValue: dm.RangeExt.Max,
+			Exponent:     dm.Exponent,
+		}, asset.Href, false)
+		if err != nil {
+			return nil, nil, fmt.Errorf("item %s: asset %s: %w", item.ID, am.Asset, err)
+		}
+		datasets[i] = append(datasets[i], d)
+	}
+	return containers, datasets, nil
+}
+
+// bands returns the bands of the asset to be indexed
+func (am AssetMapping) bands(asset *Asset) ([]int64, error) {
+	if len(am.Bands) > 0 {
+		return am.Bands, nil
+	}
+	info := asset.BandsInfo()
+	if len(am.BandNames) > 0 {
+		bands := make([]int64, len(am.BandNames))
+	names:
+		for i, name := range am.BandNames {
+			for j, b := range info {
+				if strings.EqualFold(b.Name, name) || strings.EqualFold(b.CommonName, name) {
+					bands[i] = int64(j + 1)
+					continue names
+				}
+			}
+			return nil, geocube.NewValidationError("asset %s: band not found: %s", am.Asset, name)
+		}
+		return bands, nil
+	}
+	if len(info) == 0 {
+		return []int64{1}, nil
+	}
+	bands := make([]int64, len(info))
+	for i := range bands {
+		bands[i] = int64(i + 1)
+	}
+	return bands, nil
+}
+
+// dataMappingFromBands deduces the data mapping from the data_type, nodata, scale, offset and statistics of the bands
+func dataMappingFromBands(asset *Asset, bands []int64) (*geocube.DataMapping, error) {
+	info := asset.BandsInfo()
+	var ref *Band
+	for _, b := range bands {
+		if b < 1 || int(b) > len(info) || info[b-1].DataType == "" {
+			return nil, geocube.NewValidationError("the data type of the band %d is not described: define the dformat in the mapping", b)
+		}
+		band := info[b-1]
+		if ref == nil {
+			ref = &band
+		} else if band.DataType != ref.DataType || fmt.Sprint(band.NoData) != fmt.Sprint(ref.NoData) ||
+			!equalPtr(band.Scale, ref.Scale) || !equalPtr(band.Offset, ref.Offset) {
+			return nil, geocube.NewValidationError("the bands have different data formats: define the dformat in the mapping")
+		}
+	}
+
+	dtype, err := bitmap.DTypeString(strings.ToUpper(ref.DataType))
+	if err != nil || dtype == bitmap.DTypeUNDEFINED || dtype == bitmap.DTypeCOMPLEX64 {
+		return nil, geocube.NewValidationError("unsupported data type: %s", ref.DataType)
+	}
+	nodata, err := ref.noData()
+	if err != nil {
+		return nil, geocube.NewValidationError("%v", err)
+	}
+
+	dm := geocube.DataMapping{
+		DataFormat: geocube.DataFormat{DType: dtype, NoData: nodata, Range: geocube.Range{Min: dtype.MinValue(), Max: dtype.MaxValue()}},
+		Exponent:   1,
+	}
+	if dtype.IsFloatingPointFormat() {
+		if ref.Statistics == nil || ref.Statistics.Minimum == nil || ref.Statistics.Maximum == nil {
+			return nil, geocube.NewValidationError("the range of values of a %s band must be defined by its statistics or by the dformat of the mapping", ref.DataType)
+		}
+		dm.Range = geocube.Range{Min: *ref.Statistics.Minimum, Max: *ref.Statistics.Maximum}
+	}
+
+	// Real value = scale * value + offset
+	scale, offset := 1.0, 0.0
+	if ref.Scale != nil {
+		scale = *ref.Scale
+	}
+	if ref.Offset != nil {
+		offset = *ref.Offset
+	}
+	if scale <= 0 {
+		return nil, geocube.NewValidationError("scale must be strictly positive (found %f)", scale)
+	}
+	dm.RangeExt = geocube.Range{Min: dm.Range.Min*scale + offset, Max: dm.Range.Max*scale + offset}
+	return &dm, nil
+}
+
+func equalPtr(a, b *float64) bool {
+	return (a == nil && b == nil) || (a != nil && b != nil && *a == *b)
+}
@@ -0,0 +1,210 @@
+package stac
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/airbusgeo/geocube/internal/geocube"
+	pb "github.com/airbusgeo/geocube/internal/pb"
+	"github.com/airbusgeo/geocube/internal/utils/bitmap"
+)
+
+const (
+	instanceRed = "11111111-1111-1111-1111-111111111111"
+	instanceRGB = "22222222-2222-2222-2222-222222222222"
+	instanceLST = "33333333-3333-3333-3333-333333333333"
+	aoiID       = "44444444-4444-4444-4444-444444444444"
+	recordID    = "55555555-5555-5555-5555-555555555555"
+)
+
+func TestNewMappingFromProtobuf(t *testing.T) {
+	m, err := NewMappingFromProtobuf(&pb.STACMapping{
+		Assets: []*pb.STACAssetMapping{
+			{Asset: "B04", InstanceId: instanceRed},
+			{Asset: "visual", InstanceId: instanceRGB, Dformat: &pb.DataFormat{Dtype: pb.DataFormat_UInt8, MinValue: 1, MaxValue: 255}},
+		},
+		Tags: map[string]string{"source": "stac"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(m.Assets) != 2 || m.Assets[0].DataMapping != nil || m.Tags["source"] != "stac" {
+		t.Fatalf("NewMappingFromProtobuf() = %+v", m)
+	}
+	if dm := m.Assets[1].DataMapping; dm.DType != bitmap.DTypeUINT8 || dm.RangeExt != dm.Range || dm.Exponent != 1 {
+		t.Errorf("NewMappingFromProtobuf(): DataMapping = %+v", dm)
+	}
+
+	for _, pbm := range []*pb.STACMapping{
+		{},
+		{Assets: []*pb.STACAssetMapping{{InstanceId: instanceRed}}},
+		{Assets: []*pb.STACAssetMapping{{Asset: "B04", InstanceId: "red"}}},
+		{Assets: []*pb.STACAssetMapping{{Asset: "B04", InstanceId: instanceRed, Bands: []int64{1}, BandNames: []string{"red"}}}},
+	} {
+		if _, err := NewMappingFromProtobuf(pbm); !geocube.IsError(err, geocube.EntityValidationError) {
+			t.Errorf("NewMappingFromProtobuf(%v): want a validation error, got %v", pbm, err)
+		}
+	}
+}
+
+func TestItemAOI(t *testing.T) {
+	items := append(readItems(t, "item.json"), readItems(t, "collection.json")...)
+	for _, item := range items {
+		aoi, err := item.AOI()
+		if err != nil {
+			t.Fatalf("AOI(%s): %v", item.ID, err)
+		}
+		if aoi.Geometry.NumPolygons() != 1 {
+			t.Errorf("AOI(%s): %d polygons", item.ID, aoi.Geometry.NumPolygons())
+		}
+	}
+	// Null geometry: AOI from the bbox
+	aoi1, _ := items[1].AOI()
+	aoi2, _ := items[2].AOI()
+	if aoi1.Geometry.Bounds().Min(0) != 10 || aoi1.Geometry.Bounds().Max(1) != aoi2.Geometry.Bounds().Max(1) {
+		t.Errorf("AOI(): %v, %v", aoi1.Geometry.Bounds(), aoi2.Geometry.Bounds())
+	}
+
+	for _, item := range []*Item{
+		{ID: "point", Geometry: []byte(`{"type": "Point", "coordinates": [1, 2]}`)},
+		{ID: "invalid", Geometry: []byte(`{"type": "Polygon"`)},
+		{ID: "missing", Geometry: []byte(`null`)},
+	} {
+		if _, err := item.AOI(); !geocube.IsError(err, geocube.EntityValidationError) {
+			t.Errorf("AOI(%s): want a validation error, got %v", item.ID, err)
+		}
+	}
+}
+
+func TestMappingRecord(t *testing.T) {
+	m := Mapping{Tags: geocube.Metadata{"source": "stac", "platform": "S2A"}}
+	item := readItems(t, "item.json")[0]
+	record, err := m.Record(context.Background(), item, aoiID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if record.Name != "S2A_31TCJ_20230102_0_L2A" || record.AOI.ID != aoiID {
+		t.Errorf("Record(): %v", record)
+	}
+	if expected := time.Date(2023, 1, 2, 10, 47, 11, 123456000, time.UTC); !record.Time.Equal(expected) {
+		t.Errorf("Record(): time %v, want %v", record.Time, expected)
+	}
+	expectedTags := geocube.Metadata{
+		"platform":               "S2A",
+		"eo:cloud_cover":         "12.5",
+		"s2:processing_baseline": "05.09",
+		"instruments":            `["msi"]`,
+		TagID:                    "S2A_31TCJ_20230102_0_L2A",
+		TagCollection:            "sentinel-2-l2a",
+		"source":                 "stac",
+	}
+	if len(record.Tags) != len(expectedTags) {
+		t.Errorf("Record(): tags %v, want %v", record.Tags, expectedTags)
+	}
+	for k, v := range expectedTags {
+		if record.Tags[k] != v {
+			t.Errorf("Record(): tag %s=%s, want %s", k, record.Tags[k], v)
+		}
+	}
+
+	// Name sanitizing and start_datetime
+	item = readItems(t, "collection.json")[0]
+	if record, err = m.Record(context.Background(), item, aoiID); err != nil {
+		t.Fatal(err)
+	}
+	if record.Name != "LST/2023-06-01" || !record.Time.Equal(time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Record(): %v", record)
+	}
+	item.ID = "/LST 2023.06.01//day"
+	if record, err = m.Record(context.Background(), item, aoiID); err != nil || record.Name != "LST_2023_06_01/day" {
+		t.Errorf("Record(): %v, %v", record, err)
+	}
+
+	delete(item.Properties, "start_datetime")
+	if _, err = m.Record(context.Background(), item, aoiID); !geocube.IsError(err, geocube.EntityValidationError) {
+		t.Errorf("Record(no datetime): want a validation error, got %v", err)
+	}
+}
+
+func TestMappingContainers(t *testing.T) {
+	dformat := geocube.DataFormat{DType: bitmap.DTypeUINT8, NoData: 0, Range: geocube.Range{Min: 1, Max: 255}}
+	m := Mapping{Assets: []AssetMapping{
+		{Asset: "B04", InstanceID: instanceRed},
+		{Asset: "visual", InstanceID: instanceRed, BandNames: []string{"RED"}, DataMapping: &geocube.DataMapping{DataFormat: dformat, RangeExt: geocube.Range{Min: 0, Max: 1}, Exponent: 1}},
+		{Asset: "visual", InstanceID: instanceRGB, BandNames: []string{"B04", "green", "Blue"}},
+		{Asset: "B08", InstanceID: instanceRed},
+	}}
+	item := readItems(t, "item.json")[0]
+	item.resolveHrefs("/data/S2A_31TCJ_20230102/item.json")
+
+	containers, datasets, err := m.Containers(item, recordID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(containers) != 2 || len(datasets) != 2 {
+		t.Fatalf("Containers(): %d containers, %d datasets", len(containers), len(datasets))
+	}
+	if containers[0].URI != "/data/S2A_31TCJ_20230102/B04.tif" || len(datasets[0]) != 1 {
+		t.Errorf("Containers(): %s, %d datasets", containers[0].URI, len(datasets[0]))
+	}
+	if containers[1].URI != "https://example.com/S2A_31TCJ_20230102/TCI.tif" || len(datasets[1]) != 2 {
+		t.Errorf("Containers(): %s, %d datasets", containers[1].URI, len(datasets[1]))
+	}
+
+	// Data mapping from the raster bands (scale & offset)
+	d := datasets[0][0]
+	if d.RecordID != recordID || d.InstanceID != instanceRed || len(d.Bands) != 1 || d.Bands[0] != 1 {
+		t.Errorf("Containers(): %+v", d)
+	}
+	dm := d.DataMapping
+	if dm.DType != bitmap.DTypeUINT16 || dm.NoData != 0 || dm.Range.Min != 0 || dm.Range.Max != 65535 ||
+		math.Abs(dm.RangeExt.Min+0.1) > 1e-9 || math.Abs(dm.RangeExt.Max-6.4535) > 1e-9 || dm.Exponent != 1 {
+		t.Errorf("Containers(): data mapping %+v", dm)
+	}
+
+	// Band names & explicit data mapping
+	if d = datasets[1][0]; len(d.Bands) != 1 || d.Bands[0] != 1 || d.DataMapping.Range.Min != 1 || d.DataMapping.RangeExt.Max != 1 {
+		t.Errorf("Containers(): %+v", d)
+	}
+	if d = datasets[1][1]; len(d.Bands) != 3 || d.Bands[0] != 1 || d.Bands[2] != 3 || d.DataMapping.DType != bitmap.DTypeUINT8 || d.DataMapping.RangeExt.Max != 255 {
+		t.Errorf("Containers(): %+v", d)
+	}
+}
+
+func TestMappingContainersFloat(t *testing.T) {
+	m := Mapping{Assets: []AssetMapping{{Asset: "lst", InstanceID: instanceLST}}}
+	items := readItems(t, "collection.json")
+
+	_, datasets, err := m.Containers(items[0], recordID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := datasets[0][0]
+	if len(d.Bands) != 2 || d.DataMapping.DType != bitmap.DTypeFLOAT32 || !math.IsNaN(d.DataMapping.NoData) ||
+		d.DataMapping.Range.Min != 200 || d.DataMapping.RangeExt.Max != 350 {
+		t.Errorf("Containers(): %+v", d)
+	}
+
+	// No statistics
+	if _, _, err = m.Containers(items[1], recordID); !geocube.IsError(err, geocube.EntityValidationError) {
+		t.Errorf("Containers(no statistics): want a validation error, got %v", err)
+	}
+}
+
+func TestMappingContainersInvalid(t *testing.T) {
+	item := readItems(t, "item.json")[0]
+	item.Assets["visual"].RasterBands[1].NoData = 255.
+	for name, am := range map[string]AssetMapping{
+		"unknown band name": {Asset: "visual", InstanceID: instanceRGB, BandNames: []string{"nir"}},
+		"different formats": {Asset: "visual", InstanceID: instanceRGB, Bands: []int64{1, 2}},
+		"no data type":      {Asset: "thumbnail", InstanceID: instanceRGB},
+		"band out of range": {Asset: "B04", InstanceID: instanceRed, Bands: []int64{2}},
+	} {
+		m := Mapping{Assets: []AssetMapping{am}}
+		if _, _, err := m.Containers(item, recordID); !geocube.IsError(err, geocube.EntityValidationError) {
+			t.Errorf("Containers(%s): want a validation error, got %v", name, err)
+		}
+	}
+}
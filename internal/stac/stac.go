@@ -0,0 +1,265 @@
+// Package stac reads STAC Items, ItemCollections and static Catalogs (https://stacspec.org)
+// and maps the items to the records and datasets of the Geocube.
+package stac
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/airbusgeo/geocube/internal/geocube"
+)
+
+// STAC object types
+const (
+	TypeItem           = "Feature"
+	TypeItemCollection = "FeatureCollection"
+	TypeCatalog        = "Catalog"
+	TypeCollection     = "Collection"
+)
+
+// Item is a STAC Item
+type Item struct {
+	Type           string                 `json:"type"`
+	StacVersion    string                 `json:"stac_version,omitempty"`
+	StacExtensions []string               `json:"stac_extensions,omitempty"`
+	ID             string                 `json:"id"`
+	Collection     string                 `json:"collection,omitempty"`
+	Geometry       json.RawMessage        `json:"geometry"`
+	BBox           []float64              `json:"bbox,omitempty"`
+	Properties     map[string]interface{} `json:"properties"`
+	Assets         map[string]*Asset      `json:"assets"`
+	Links          []Link                 `json:"links,omitempty"`
+}
+
+// Asset is a file referenced by a STAC Item
+type Asset struct {
+	Href        string       `json:"href"`
+	Title       string       `json:"title,omitempty"`
+	Type        string       `json:"type,omitempty"`
+	Roles       []string     `json:"roles,omitempty"`
+	Bands       []Band       `json:"bands,omitempty"`        // STAC 1.1
+	EOBands     []EOBand     `json:"eo:bands,omitempty"`     // STAC 1.0, eo extension
+	RasterBands []RasterBand `json:"raster:bands,omitempty"` // STAC 1.0, raster extension
+
+	// Dataset of the Geocube (STAC API)
+	InstanceID   string  `json:"geocube:instance_id,omitempty"`
+	DatasetBands []int64 `json:"geocube:bands,omitempty"`
+	SubDir       string  `json:"geocube:subdir,omitempty"`
+}
+
+// Band describes a band of an asset (STAC 1.1)
+type Band struct {
+	Name       string      `json:"name,omitempty"`
+	CommonName string      `json:"eo:common_name,omitempty"`
+	DataType   string      `json:"data_type,omitempty"`
+	NoData     interface{} `json:"nodata,omitempty"`
+	Scale      *float64    `json:"raster:scale,omitempty"`
+	Offset     *float64    `json:"raster:offset,omitempty"`
+	Statistics *Statistics `json:"statistics,omitempty"`
+}
+
+// EOBand describes a band of an asset (eo extension)
+type EOBand struct {
+	Name       string `json:"name,omitempty"`
+	CommonName string `json:"common_name,omitempty"`
+}
+
+// RasterBand describes a band of an asset (raster extension)
+type RasterBand struct {
+	DataType   string      `json:"data_type,omitempty"`
+	NoData     interface{} `json:"nodata,omitempty"`
+	Scale      *float64    `json:"scale,omitempty"`
+	Offset     *float64    `json:"offset,omitempty"`
+	Statistics *Statistics `json:"statistics,omitempty"`
+}
+
+// Statistics of the values of a band
+type Statistics struct {
+	Minimum *float64 `json:"minimum,omitempty"`
+	Maximum *float64 `json:"maximum,omitempty"`
+}
+
+// Link to another STAC object
+type Link struct {
+	Href   string      `json:"href"`
+	Rel    string      `json:"rel"`
+	Type   string      `json:"type,omitempty"`
+	Title  string      `json:"title,omitempty"`
+	Method string      `json:"method,omitempty"`
+	Body   interface{} `json:"body,omitempty"`
+}
+
+type object struct {
+	Type     string  `json:"type"`
+	Features []*Item `json:"features"`
+	Links    []Link  `json:"links"`
+}
+
+// BandsInfo returns the description of the bands of the asset, merging the eo and raster extensions of STAC 1.0
+func (a *Asset) BandsInfo() []Band {
+	if len(a.Bands) > 0 {
+		return a.Bands
+	}
+	n := len(a.EOBands)
+	if len(a.RasterBands) > n {
+		n = len(a.RasterBands)
+	}
+	bands := make([]Band, n)
+	for i, b := range a.EOBands {
+		bands[i].Name, bands[i].CommonName = b.Name, b.CommonName
+	}
+	for i, b := range a.RasterBands {
+		bands[i].DataType, bands[i].NoData, bands[i].Scale, bands[i].Offset, bands[i].Statistics = b.DataType, b.NoData, b.Scale, b.Offset, b.Statistics
+	}
+	return bands
+}
+
+// noData returns the nodata value of the band (NaN if it is not defined)
+func (b Band) noData() (float64, error) {
+	switch v := b.NoData.(type) {
+	case nil:
+		return math.NaN(), nil
+	case float64:
+		return v, nil
+	case string:
+		switch strings.ToLower(v) {
+		case "nan":
+			return math.NaN(), nil
+		case "inf":
+			return math.Inf(1), nil
+		case "-inf":
+			return math.Inf(-1), nil
+		}
+	}
+	return 0, fmt.Errorf("invalid nodata: %v", b.NoData)
+}
+
+// ParseItems parses a STAC Item or ItemCollection
+// Only returns ValidationError
+func ParseItems(data []byte) ([]*Item, error) {
+	var obj object
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return nil, geocube.NewValidationError("invalid STAC document: %v", err)
+	}
+	switch obj.Type {
+	case TypeItem:
+		var item Item
+		if err := json.Unmarshal(data, &item); err != nil {
+			return nil, geocube.NewValidationError("invalid STAC Item: %v", err)
+		}
+		return []*Item{&item}, nil
+	case TypeItemCollection:
+		return obj.Features, nil
+	}
+	return nil, geocube.NewValidationError("a STAC Item or ItemCollection is expected (found type: '%s')", obj.Type)
+}
+
+// Walk reads the STAC document at the given location (local path or http(s) url) and calls fn for each item.
+// Catalogs and Collections are walked recursively, following their "child" and "item" links.
+// The relative hrefs of the assets are resolved against the location of the document.
+func Walk(ctx context.Context, location string, fn func(*Item) error) error {
+	return walk(ctx, location, fn, map[string]struct{}{})
+}
+
+func walk(ctx context.Context, location string, fn func(*Item) error, visited map[string]struct{}) error {
+	if _, ok := visited[location]; ok {
+		return nil
+	}
+	visited[location] = struct{}{}
+
+	data, err := read(ctx, location)
+	if err != nil {
+		return fmt.Errorf("walk: %w", err)
+	}
+	var obj object
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return fmt.Errorf("walk[%s]: %w", location, err)
+	}
+
+	switch obj.Type {
+	case TypeItem, TypeItemCollection:
+		items, err := ParseItems(data)
+		if err != nil {
+			return fmt.Errorf("walk[%s]: %w", location, err)
+		}
+		for _, item := range items {
+			item.resolveHrefs(location)
+			if err := fn(item); err != nil {
+				return err
+			}
+		}
+	case TypeCatalog, TypeCollection:
+		for _, link := range obj.Links {
+			if link.Rel == "child" || link.Rel == "item" {
+				if err := walk(ctx, resolve(location, link.Href), fn, visited); err != nil {
+					return err
+				}
+			}
+		}
+	default:
+		return fmt.Errorf("walk[%s]: unknown STAC type: '%s'", location, obj.Type)
+	}
+	return nil
+}
+
+// resolveHrefs resolves the relative hrefs of the assets against the location of the item.
+// If the item has a "self" link, it is used as the location of the item.
+func (item *Item) resolveHrefs(location string) {
+	for _, link := range item.Links {
+		if link.Rel == "self" && isAbsolute(link.Href) {
+			location = link.Href
+		}
+	}
+	for _, asset := range item.Assets {
+		asset.Href = resolve(location, asset.Href)
+	}
+}
+
+func isAbsolute(href string) bool {
+	u, err := url.Parse(href)
+	return (err == nil && u.Scheme != "" && len(u.Scheme) > 1) || filepath.IsAbs(href)
+}
+
+// resolve returns the location of href relative to base
+func resolve(base, href string) string {
+	if isAbsolute(href) {
+		return href
+	}
+	if u, err := url.Parse(base); err == nil && u.Scheme != "" && len(u.Scheme) > 1 {
+		ref, err := url.Parse(href)
+		if err != nil {
+			return href
+		}
+		return u.ResolveReference(ref).String()
+	}
+	return path.Join(filepath.ToSlash(filepath.Dir(base)), filepath.ToSlash(href))
+}
+
+// read reads a local file or a http(s) url
+func read(ctx context.Context, location string) ([]byte, error) {
+	if !strings.HasPrefix(location, "http://") && !strings.HasPrefix(location, "https://") {
+		return os.ReadFile(strings.TrimPrefix(location, "file://"))
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, location, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %s: %s", location, resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
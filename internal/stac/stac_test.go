@@ -0,0 +1,137 @@
+package stac
+
+import (
+	"context"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/airbusgeo/geocube/internal/geocube"
+)
+
+func readItems(t *testing.T, file string) []*Item {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("testdata", file))
+	if err != nil {
+		t.Fatal(err)
+	}
+	items, err := ParseItems(data)
+	if err != nil {
+		t.Fatalf("ParseItems(%s): %v", file, err)
+	}
+	return items
+}
+
+func TestParseItems(t *testing.T) {
+	items := readItems(t, "item.json")
+	if len(items) != 1 || items[0].ID != "S2A_31TCJ_20230102_0_L2A" || len(items[0].Assets) != 3 {
+		t.Fatalf("ParseItems(item.json) = %v", items)
+	}
+
+	items = readItems(t, "collection.json")
+	if len(items) != 2 || items[0].ID != "LST/2023-06-01" || items[1].ID != "LST/2023-06-02" {
+		t.Fatalf("ParseItems(collection.json) = %v", items)
+	}
+
+	for _, data := range []string{
+		`not json`,
+		`{"type": "Catalog", "links": []}`,
+		`{"type": "Feature", "properties": "not an object"}`,
+	} {
+		if _, err := ParseItems([]byte(data)); !geocube.IsError(err, geocube.EntityValidationError) {
+			t.Errorf("ParseItems(%s): want a validation error, got %v", data, err)
+		}
+	}
+}
+
+func TestBandsInfo(t *testing.T) {
+	asset := readItems(t, "item.json")[0].Assets["B04"]
+	bands := asset.BandsInfo()
+	if len(bands) != 1 {
+		t.Fatalf("BandsInfo() = %v", bands)
+	}
+	b := bands[0]
+	if b.Name != "B04" || b.CommonName != "red" || b.DataType != "uint16" || *b.Scale != 0.0001 || *b.Offset != -0.1 {
+		t.Errorf("BandsInfo() = %+v", b)
+	}
+	if nodata, err := b.noData(); err != nil || nodata != 0 {
+		t.Errorf("noData() = %f, %v", nodata, err)
+	}
+
+	asset = readItems(t, "collection.json")[0].Assets["lst"]
+	bands = asset.BandsInfo()
+	if len(bands) != 2 || bands[1].Name != "night" || bands[1].DataType != "float32" {
+		t.Fatalf("BandsInfo() = %v", bands)
+	}
+	if nodata, err := bands[1].noData(); err != nil || !math.IsNaN(nodata) {
+		t.Errorf("noData() = %f, %v", nodata, err)
+	}
+	if _, err := (Band{NoData: "none"}).noData(); err == nil {
+		t.Errorf("noData(none): want an error")
+	}
+}
+
+func TestWalk(t *testing.T) {
+	hrefs := map[string]string{}
+	err := Walk(context.Background(), filepath.Join("testdata", "catalog", "catalog.json"), func(item *Item) error {
+		for key, asset := range item.Assets {
+			hrefs[item.ID+"/"+key] = asset.Href
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]string{
+		"item-1/data":   "testdata/catalog/data/item-1.tif",
+		"item-2/data":   "https://example.com/catalog/sub/item-2/item-2.tif",
+		"item-2/remote": "s3://bucket/item-2.tif",
+	}
+	if len(hrefs) != len(expected) {
+		t.Errorf("Walk: %v, want %v", hrefs, expected)
+	}
+	for k, v := range expected {
+		if hrefs[k] != v {
+			t.Errorf("Walk: %s = %s, want %s", k, hrefs[k], v)
+		}
+	}
+}
+
+func TestWalkItems(t *testing.T) {
+	var ids []string
+	for _, file := range []string{"item.json", "collection.json"} {
+		if err := Walk(context.Background(), filepath.Join("testdata", file), func(item *Item) error {
+			ids = append(ids, item.ID)
+			return nil
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	sort.Strings(ids)
+	if len(ids) != 3 || ids[0] != "LST/2023-06-01" || ids[2] != "S2A_31TCJ_20230102_0_L2A" {
+		t.Errorf("Walk: %v", ids)
+	}
+
+	if err := Walk(context.Background(), filepath.Join("testdata", "missing.json"), func(*Item) error { return nil }); err == nil {
+		t.Errorf("Walk(missing.json): want an error")
+	}
+}
+
+func TestResolve(t *testing.T) {
+	for _, tc := range []struct{ base, href, expected string }{
+		{"/data/catalog.json", "./sub/item.json", "/data/sub/item.json"},
+		{"/data/sub/item.json", "../item.tif", "/data/item.tif"},
+		{"catalog.json", "item.tif", "item.tif"},
+		{"/data/catalog.json", "/other/item.tif", "/other/item.tif"},
+		{"/data/catalog.json", "gs://bucket/item.tif", "gs://bucket/item.tif"},
+		{"https://example.com/stac/catalog.json", "./sub/item.json", "https://example.com/stac/sub/item.json"},
+		{"https://example.com/stac/sub/item.json", "../item.tif", "https://example.com/stac/item.tif"},
+	} {
+		if got := resolve(tc.base, tc.href); got != tc.expected {
+			t.Errorf("resolve(%s, %s) = %s, want %s", tc.base, tc.href, got, tc.expected)
+		}
+	}
+}
@@ -4,14 +4,22 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/airbusgeo/geocube/interface/database"
+	"github.com/airbusgeo/geocube/interface/messaging"
+	"github.com/airbusgeo/geocube/interface/storage/cache"
 
 	"github.com/airbusgeo/geocube/internal/geocube"
+	"github.com/airbusgeo/geocube/internal/image"
+	"github.com/airbusgeo/geocube/internal/log"
+	"github.com/airbusgeo/godal"
 )
 
 var errSimulationEnded = errors.New("simulation ended")
 
+var errDeadLetterQueueNotConfigured = geocube.NewValidationError("dead-letter queue is not configured (pgqueue messaging only)")
+
 // TidyPending implements ServiceAdmin
 func (svc *Service) TidyPending(ctx context.Context, aois, records, variables, instances, containers, params bool, simulate bool) ([]int64, error) {
 	var nbs [6]int64
@@ -92,3 +100,113 @@ func (svc *Service) UpdateDatasets(ctx context.Context, simulate bool, instanceI
 
 	return results, nil
 }
+
+// computeValidShapesBatchSize is the number of datasets loaded and updated per transaction by ComputeValidShapes
+var computeValidShapesBatchSize = 500
+
+// ComputeValidShapes implements ServiceAdmin
+// It recomputes the shapes of the active datasets from their valid pixels (see image.ComputeValidShape).
+// The datasets are processed by batches, each batch being committed in its own transaction:
+// if an error occurs or the context is cancelled, the previous batches are kept.
+// Returns the number of datasets updated and the error per dataset that failed.
+func (svc *Service) ComputeValidShapes(ctx context.Context, simulate bool, instanceID string, recordIds []string) (int64, map[string]string, error) {
+	if instanceID == "" && len(recordIds) == 0 {
+		return 0, nil, geocube.NewValidationError("at least an instance id or a record id must be provided")
+	}
+	var instancesID []string
+	if instanceID != "" {
+		instancesID = []string{instanceID}
+	}
+
+	var nbUpdated int64
+	failures := map[string]string{}
+	for page := 0; ; page++ {
+		if err := ctx.Err(); err != nil {
+			return nbUpdated, failures, fmt.Errorf("ComputeValidShapes: %w", err)
+		}
+		// The paginated results are ordered by id and the update does not change the filtered fields
+		datasets, err := svc.db.FindDatasets(ctx, geocube.DatasetStatusACTIVE, nil, "", instancesID, recordIds, nil, time.Time{}, time.Time{}, nil, nil, page, computeValidShapesBatchSize, nil, false)
+		if err != nil {
+			return nbUpdated, failures, fmt.Errorf("ComputeValidShapes.%w", err)
+		}
+
+		var updated []*geocube.Dataset
+		for _, dataset := range datasets {
+			if err := computeValidShape(dataset); err != nil {
+				failures[dataset.ID] = err.Error()
+				continue
+			}
+			updated = append(updated, dataset)
+		}
+
+		if !simulate && len(updated) > 0 {
+			if err := svc.unitOfWork(ctx, func(txn database.GeocubeTxBackend) error {
+				return txn.UpdateDatasetsShape(ctx, updated)
+			}); err != nil {
+				return nbUpdated, failures, fmt.Errorf("ComputeValidShapes.%w", err)
+			}
+		}
+		nbUpdated += int64(len(updated))
+		log.Logger(ctx).Sugar().Debugf("ComputeValidShapes: %d datasets updated, %d failures", nbUpdated, len(failures))
+
+		if len(datasets) < computeValidShapesBatchSize {
+			return nbUpdated, failures, nil
+		}
+	}
+}
+
+// computeValidShape opens the dataset and sets its shape from its valid pixels
+func computeValidShape(dataset *geocube.Dataset) error {
+	ds, err := godal.Open(dataset.GDALURI(), image.ErrLogger)
+	if err != nil {
+		return fmt.Errorf("%s cannot be opened: %w", dataset.GDALURI(), err)
+	}
+	defer ds.Close()
+	mp, err := image.ComputeValidShape(ds, dataset.Bands, dataset.DataMapping.NoData)
+	if err != nil {
+		return err
+	}
+	return dataset.SetShape(mp, ds.Projection())
+}
+
+// ListDeadLetters implements ServiceAdmin
+func (svc *Service) ListDeadLetters(ctx context.Context, queue string, page, limit int) ([]*messaging.DeadLetter, error) {
+	if svc.deadLetterQueue == nil {
+		return nil, errDeadLetterQueueNotConfigured
+	}
+	return svc.deadLetterQueue.ListDeadLetters(ctx, queue, page, limit)
+}
+
+// GetDeadLetter implements ServiceAdmin
+func (svc *Service) GetDeadLetter(ctx context.Context, id int64) (*messaging.DeadLetter, error) {
+	if svc.deadLetterQueue == nil {
+		return nil, errDeadLetterQueueNotConfigured
+	}
+	deadLetter, err := svc.deadLetterQueue.ReadDeadLetter(ctx, id)
+	if errors.Is(err, messaging.ErrDeadLetterNotFound) {
+		return nil, geocube.NewEntityNotFound("DeadLetter", "id", fmt.Sprint(id), "")
+	}
+	return deadLetter, err
+}
+
+// RequeueDeadLetters implements ServiceAdmin
+// The dead letters of consolidation tasks have already been notified as failed: use RetryJob instead
+func (svc *Service) RequeueDeadLetters(ctx context.Context, ids []int64) (int64, error) {
+	if svc.deadLetterQueue == nil {
+		return 0, errDeadLetterQueueNotConfigured
+	}
+	return svc.deadLetterQueue.RequeueDeadLetters(ctx, ids)
+}
+
+// PurgeDeadLetters implements ServiceAdmin
+func (svc *Service) PurgeDeadLetters(ctx context.Context, queue string, ids []int64) (int64, error) {
+	if svc.deadLetterQueue == nil {
+		return 0, errDeadLetterQueueNotConfigured
+	}
+	return svc.deadLetterQueue.PurgeDeadLetters(ctx, queue, ids)
+}
+
+// StorageCacheStats implements GeocubeServiceAdmin
+func (svc *Service) StorageCacheStats(ctx context.Context) *cache.Stats {
+	return cache.DefaultStats()
+}
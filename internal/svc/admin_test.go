@@ -0,0 +1,113 @@
+package svc_test
+
+import (
+	"context"
+	"fmt"
+
+	mocksDB "github.com/airbusgeo/geocube/interface/database/mocks"
+	"github.com/airbusgeo/geocube/internal/geocube"
+	"github.com/airbusgeo/geocube/internal/svc"
+	"github.com/airbusgeo/godal"
+	"github.com/stretchr/testify/mock"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ComputeValidShapes", func() {
+
+	var (
+		ctx = context.Background()
+
+		mockDatabase *mocksDB.GeocubeBackend
+		service      *svc.Service
+
+		instanceIDToUse string
+		datasetsToUse   []*geocube.Dataset
+		batchSize       = *svc.ComputeValidShapesBatchSize
+
+		returnedNb       int64
+		returnedFailures map[string]string
+		returnedError    error
+	)
+
+	BeforeEach(func() {
+		godal.RegisterAll()
+		mockDatabase = new(mocksDB.GeocubeBackend)
+		var err error
+		service, err = svc.New(ctx, mockDatabase, nil, nil, "", "", 1)
+		if err != nil {
+			panic(err)
+		}
+		*svc.ComputeValidShapesBatchSize = 2
+		instanceIDToUse = "instance"
+	})
+
+	AfterEach(func() {
+		*svc.ComputeValidShapesBatchSize = batchSize
+	})
+
+	JustBeforeEach(func() {
+		// The datasets cannot be opened: they all fail
+		for page := 0; page*2 <= len(datasetsToUse); page++ {
+			end := (page + 1) * 2
+			if end > len(datasetsToUse) {
+				end = len(datasetsToUse)
+			}
+			mockDatabase.On("FindDatasets", ctx, geocube.DatasetStatusACTIVE, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+				mock.Anything, mock.Anything, mock.Anything, mock.Anything, page, 2, mock.Anything, false).Return(datasetsToUse[page*2:end], nil).Once()
+		}
+		returnedNb, returnedFailures, returnedError = service.ComputeValidShapes(ctx, false, instanceIDToUse, nil)
+	})
+
+	var (
+		itShouldReadNBatches = func(n int) {
+			It("it should read the datasets by batches", func() {
+				mockDatabase.AssertNumberOfCalls(GinkgoT(), "FindDatasets", n)
+			})
+		}
+		itShouldReturnAFailurePerDataset = func() {
+			It("it should return a failure per dataset", func() {
+				Expect(returnedError).To(BeNil())
+				Expect(returnedNb).To(Equal(int64(0)))
+				Expect(returnedFailures).To(HaveLen(len(datasetsToUse)))
+				mockDatabase.AssertNumberOfCalls(GinkgoT(), "StartTransaction", 0)
+			})
+		}
+	)
+
+	newDatasets := func(n int) []*geocube.Dataset {
+		datasets := make([]*geocube.Dataset, n)
+		for i := range datasets {
+			datasets[i] = &geocube.Dataset{ID: fmt.Sprint(i), ContainerURI: fmt.Sprintf("/nonexistent/%d.tif", i), Bands: []int64{1}}
+		}
+		return datasets
+	}
+
+	Context("when the last batch is incomplete", func() {
+		BeforeEach(func() {
+			datasetsToUse = newDatasets(5)
+		})
+		itShouldReadNBatches(3)
+		itShouldReturnAFailurePerDataset()
+	})
+
+	Context("when the last batch is complete", func() {
+		BeforeEach(func() {
+			datasetsToUse = newDatasets(4)
+		})
+		itShouldReadNBatches(3)
+		itShouldReturnAFailurePerDataset()
+	})
+
+	Context("without instance nor record", func() {
+		BeforeEach(func() {
+			datasetsToUse = nil
+			instanceIDToUse = ""
+		})
+		It("it should return a validation error", func() {
+			Expect(returnedError).To(HaveOccurred())
+			mockDatabase.AssertNumberOfCalls(GinkgoT(), "FindDatasets", 0)
+		})
+	})
+})
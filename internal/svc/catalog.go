@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"image"
 	"math"
+	"slices"
 	"strconv"
 	"time"
 
@@ -24,7 +25,10 @@ type GetCubeOptions struct {
 	HeadersOnly          bool
 	Resampling           geocube.Resampling
 	Predownload          bool
-	FilterPartialImagePc int // Filter images that have less than % of valid pixels (-1 to deactivate)
+	FilterPartialImagePc int                 // Filter images that have less than % of valid pixels (-1 to deactivate)
+	SkipIncomplete       bool                // With several band groups, skip the images that do not have all the groups (otherwise, the missing groups are filled with nodata)
+	MixedDTypes          bool                // With several band groups, allow groups of different data types, each group keeping its own data type (always allowed with the cube file formats)
+	Compositing          *CompositingOptions // Optional temporal compositing of the records of each image
 }
 
 // CubeSlice is a slice of a cube, an image corresponding to a group of record
@@ -34,6 +38,26 @@ type CubeSlice struct {
 	Records      []*geocube.Record
 	Metadata     map[string]string
 	DatasetsMeta SliceMeta
+	BandGroups   []BandGroup // Groups of bands of the image (Image is the concatenation of the groups)
+}
+
+// BandGroup describes the bands of the images of a cube corresponding to one instance
+type BandGroup struct {
+	InstanceID string // Empty if the cube has only one group, merging all the datasets
+	Bands      int
+	DataFormat geocube.DataFormat
+	Resampling geocube.Resampling
+	Size       int // Size of the group in the bytes of an image (CubeSlice only)
+	Variable   string
+	Instance   string
+	BandNames  []string
+	Unit       string
+}
+
+// cubeBandGroup is a BandGroup with the description of its output
+type cubeBandGroup struct {
+	BandGroup
+	outDesc internalImage.GdalDatasetDescriptor
 }
 
 // SliceMeta info to provide direct access to raw images
@@ -47,6 +71,37 @@ type CubeInfo struct {
 	NbDatasets    int
 	Resampling    geocube.Resampling
 	RefDataFormat geocube.DataFormat
+	BandGroups    []BandGroup
+}
+
+// ToProtobuf
+func (g *BandGroup) ToProtobuf() *pb.BandGroup {
+	return &pb.BandGroup{
+		InstanceId:    g.InstanceID,
+		Dformat:       g.DataFormat.ToProtobuf(),
+		NbBands:       int32(g.Bands),
+		ResamplingAlg: pb.Resampling(g.Resampling),
+		Size:          int64(g.Size),
+		Variable:      g.Variable,
+		Instance:      g.Instance,
+		Bands:         g.BandNames,
+		Unit:          g.Unit,
+	}
+}
+
+// NewBandGroupFromProtobuf
+func NewBandGroupFromProtobuf(pbg *pb.BandGroup) *BandGroup {
+	return &BandGroup{
+		InstanceID: pbg.GetInstanceId(),
+		Bands:      int(pbg.GetNbBands()),
+		DataFormat: *geocube.NewDataFormatFromProtobuf(pbg.GetDformat()),
+		Resampling: geocube.Resampling(pbg.GetResamplingAlg()),
+		Size:       int(pbg.GetSize()),
+		Variable:   pbg.GetVariable(),
+		Instance:   pbg.GetInstance(),
+		BandNames:  pbg.GetBands(),
+		Unit:       pbg.GetUnit(),
+	}
 }
 
 // ToProtobuf
@@ -65,6 +120,8 @@ func (s *SliceMeta) ToProtobuf() *pb.DatasetMeta {
 			RangeMin:        d.DataMapping.RangeExt.Min,
 			RangeMax:        d.DataMapping.RangeExt.Max,
 			Exponent:        d.DataMapping.Exponent,
+			InstanceId:      d.InstanceID,
+			RecordId:        d.RecordID,
 		}
 	}
 	return datasetMeta
@@ -86,59 +143,98 @@ func NewSliceMetaFromProtobuf(pbmeta *pb.DatasetMeta) *SliceMeta {
 				RangeExt:   geocube.Range{Min: meta.RangeMin, Max: meta.RangeMax},
 				Exponent:   meta.Exponent,
 			},
+			InstanceID: meta.InstanceId,
+			RecordID:   meta.RecordId,
 		}
 	}
 	return s
 }
 
 // ListDatasets implements GeocubeService
-func (svc *Service) ListDatasets(ctx context.Context, instanceID string, recordsID []string, recordTags geocube.Metadata, fromTime, toTime time.Time) ([]SliceMeta, []*geocube.Record, error) {
+// If limit > 0 or after is defined, the records are paginated by (datetime, id) and the cursor of the next page is returned (nil if it's the last page).
+// All the datasets of a record are returned in the same page, so a page may have more than limit datasets.
+func (svc *Service) ListDatasets(ctx context.Context, instanceID string, recordsID []string, recordTags geocube.TagsQuery, fromTime, toTime time.Time, limit int, after *geocube.Cursor) ([]SliceMeta, []*geocube.Record, *geocube.Cursor, error) {
 	// Find the datasets that fit
-	datasets, err := svc.db.FindDatasets(ctx, geocube.DatasetStatusACTIVE, nil, "", []string{instanceID}, recordsID, recordTags, fromTime, toTime, nil, nil, 0, 0, true)
+	datasets, err := svc.db.FindDatasets(ctx, geocube.DatasetStatusACTIVE, nil, "", []string{instanceID}, recordsID, recordTags, fromTime, toTime, nil, nil, 0, limit, after, true)
 	if err != nil {
-		return nil, nil, fmt.Errorf("GetCubeFromRecords.%w", err)
+		return nil, nil, nil, fmt.Errorf("ListDatasets.%w", err)
 	}
 
-	// Group datasets by record
+	lastPage := limit <= 0 || len(datasets) < limit
+	if !lastPage {
+		// The datasets of the last record may be truncated by the limit
+		lastRecordID := datasets[len(datasets)-1].RecordID
+		lastDatasets, err := svc.db.FindDatasets(ctx, geocube.DatasetStatusACTIVE, nil, "", []string{instanceID}, []string{lastRecordID}, nil, time.Time{}, time.Time{}, nil, nil, 0, 0, nil, false)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("ListDatasets.%w", err)
+		}
+		i := len(datasets)
+		for i > 0 && datasets[i-1].RecordID == lastRecordID {
+			i--
+		}
+		datasets = append(datasets[:i], lastDatasets...)
+	}
+
+	// Group datasets by record (datasets are sorted by record)
 	datasetsByRecord, records, err := svc.groupDatasetsByRecord(ctx, datasets)
 	if err != nil {
-		return nil, nil, fmt.Errorf("GetCubeFromRecords.%w", err)
+		return nil, nil, nil, fmt.Errorf("ListDatasets.%w", err)
+	}
+
+	var next *geocube.Cursor
+	if !lastPage {
+		r := records[len(records)-1]
+		next = &geocube.Cursor{Time: r.Time, ID: r.ID}
+	}
+	return datasetsByRecord, records, next, nil
+}
+
+// ListRecordsDatasets returns the active datasets of the given records and, optionally, of the given instances
+func (svc *Service) ListRecordsDatasets(ctx context.Context, recordsID, instancesID []string) ([]*geocube.Dataset, error) {
+	if len(recordsID) == 0 {
+		return nil, nil
+	}
+	datasets, err := svc.db.FindDatasets(ctx, geocube.DatasetStatusACTIVE, nil, "", instancesID, recordsID, nil, time.Time{}, time.Time{}, nil, nil, 0, 0, nil, false)
+	if err != nil {
+		return nil, fmt.Errorf("ListRecordsDatasets.%w", err)
 	}
-	return datasetsByRecord, records, nil
+	return datasets, nil
 }
 
-// GetCubeFromDatasets implements GeocubeDownloaderService
-// panics if instancesID is empty
+// GetCubeFromMetadatas implements GeocubeDownloaderService
+// If there are several groups, the datasets are grouped by instance
+// panics if groups is empty
 func (svc *Service) GetCubeFromMetadatas(ctx context.Context, metadatas []SliceMeta, grecords [][]*geocube.Record,
-	refDf geocube.DataFormat, crs *godal.SpatialRef, pixToCRS *affine.Affine, width, height int, options GetCubeOptions) (CubeInfo, <-chan CubeSlice, error) {
-	var err error
-	var nbDs int
-	for _, element := range metadatas {
-		nbDs += len(element.Datasets)
-	}
-	outDesc := internalImage.GdalDatasetDescriptor{
-		PixToCRS:   pixToCRS,
-		Width:      width,
-		Height:     height,
-		Bands:      len(metadatas[0].Datasets[0].Bands),
-		Resampling: options.Resampling,
-		DataMapping: geocube.DataMapping{
-			DataFormat: refDf,
-			RangeExt:   refDf.Range,
-			Exponent:   1,
-		},
-		ValidPixPc: options.FilterPartialImagePc,
-		Format:     options.Format,
-	}
-	outDesc.WktCRS, err = crs.WKT()
+	groups []BandGroup, crs *godal.SpatialRef, pixToCRS *affine.Affine, width, height int, options GetCubeOptions) (CubeInfo, <-chan CubeSlice, error) {
+	wktCRS, err := crs.WKT()
 	if err != nil {
 		return CubeInfo{}, nil, fmt.Errorf("getCubeFromMetadatas.ToWKT: %w", err)
 	}
-	stream, err := svc.getCubeStream(ctx, metadatas, grecords, outDesc, options)
-	if err != nil {
-		return CubeInfo{}, nil, err
+	cubeGroups := make([]cubeBandGroup, len(groups))
+	for i, group := range groups {
+		cubeGroups[i] = cubeBandGroup{
+			BandGroup: group,
+			outDesc: internalImage.GdalDatasetDescriptor{
+				WktCRS:     wktCRS,
+				PixToCRS:   pixToCRS,
+				Width:      width,
+				Height:     height,
+				Bands:      group.Bands,
+				Resampling: group.Resampling,
+				DataMapping: geocube.DataMapping{
+					DataFormat: group.DataFormat,
+					RangeExt:   group.DataFormat.Range,
+					Exponent:   1,
+				},
+				ValidPixPc: options.FilterPartialImagePc,
+				Format:     options.Format,
+			},
+		}
 	}
-	return CubeInfo{NbImages: len(metadatas), NbDatasets: nbDs}, stream, nil
+	if err := checkBandGroupsDType(cubeGroups, options); err != nil {
+		return CubeInfo{}, nil, fmt.Errorf("getCubeFromMetadatas.%w", err)
+	}
+	return svc.getCubeStream(ctx, metadatas, grecords, cubeGroups, options)
 }
 
 // GetCubeFromRecords implements GeocubeService
@@ -146,7 +242,7 @@ func (svc *Service) GetCubeFromMetadatas(ctx context.Context, metadatas []SliceM
 func (svc *Service) GetCubeFromRecords(ctx context.Context, grecordsID [][]string, instancesID []string, crs *godal.SpatialRef, pixToCRS *affine.Affine,
 	width, height int, options GetCubeOptions) (CubeInfo, <-chan CubeSlice, error) {
 	// Prepare the request
-	outDesc, geogExtent, err := svc.getCubePrepare(ctx, instancesID, crs, pixToCRS, width, height, options)
+	groups, geogExtent, err := svc.getCubePrepare(ctx, instancesID, crs, pixToCRS, width, height, options)
 	if err != nil {
 		return CubeInfo{}, nil, err
 	}
@@ -158,7 +254,7 @@ func (svc *Service) GetCubeFromRecords(ctx context.Context, grecordsID [][]strin
 	}
 
 	// Find the datasets that fit
-	datasets, err := svc.db.FindDatasets(ctx, geocube.DatasetStatusACTIVE, nil, "", instancesID, recordsID, geocube.Metadata{}, time.Time{}, time.Time{}, geogExtent, nil, 0, 0, true)
+	datasets, err := svc.db.FindDatasets(ctx, geocube.DatasetStatusACTIVE, nil, "", instancesID, recordsID, nil, time.Time{}, time.Time{}, geogExtent, nil, 0, 0, nil, true)
 	if err != nil {
 		return CubeInfo{}, nil, fmt.Errorf("GetCubeFromRecords.%w", err)
 	}
@@ -177,27 +273,27 @@ func (svc *Service) GetCubeFromRecords(ctx context.Context, grecordsID [][]strin
 	var grecords [][]*geocube.Record
 	datasetsByRecord, grecords = groupDatasetsByRecordsGroup(datasetsByRecord, records, recordIdx, grecordsID)
 
+	// Group the records by period
+	if options.Compositing != nil {
+		datasetsByRecord, grecords = options.Compositing.binRecords(datasetsByRecord, grecords)
+	}
+
 	// GetCube
-	stream, err := svc.getCubeStream(ctx, datasetsByRecord, grecords, outDesc, options)
-	return CubeInfo{NbImages: len(datasetsByRecord),
-		NbDatasets:    len(datasets),
-		Resampling:    outDesc.Resampling,
-		RefDataFormat: outDesc.DataMapping.DataFormat,
-	}, stream, err
+	return svc.getCubeStream(ctx, datasetsByRecord, grecords, groups, options)
 }
 
 // GetCubeFromFilters implements GeocubeService
 // panics if instancesID is empty
-func (svc *Service) GetCubeFromFilters(ctx context.Context, recordTags geocube.Metadata, fromTime, toTime time.Time, instancesID []string, crs *godal.SpatialRef, pixToCRS *affine.Affine,
+func (svc *Service) GetCubeFromFilters(ctx context.Context, recordTags geocube.TagsQuery, fromTime, toTime time.Time, instancesID []string, crs *godal.SpatialRef, pixToCRS *affine.Affine,
 	width, height int, options GetCubeOptions) (CubeInfo, <-chan CubeSlice, error) {
 	// Prepare the request
-	outDesc, geogExtent, err := svc.getCubePrepare(ctx, instancesID, crs, pixToCRS, width, height, options)
+	groups, geogExtent, err := svc.getCubePrepare(ctx, instancesID, crs, pixToCRS, width, height, options)
 	if err != nil {
 		return CubeInfo{}, nil, err
 	}
 
 	// Find the datasets that fit
-	datasets, err := svc.db.FindDatasets(ctx, geocube.DatasetStatusACTIVE, nil, "", instancesID, nil, recordTags, fromTime, toTime, geogExtent, nil, 0, 0, true)
+	datasets, err := svc.db.FindDatasets(ctx, geocube.DatasetStatusACTIVE, nil, "", instancesID, nil, recordTags, fromTime, toTime, geogExtent, nil, 0, 0, nil, true)
 	if err != nil {
 		return CubeInfo{}, nil, fmt.Errorf("GetCubeFromFilters.%w", err)
 	}
@@ -214,63 +310,157 @@ func (svc *Service) GetCubeFromFilters(ctx context.Context, recordTags geocube.M
 		grecords[i] = []*geocube.Record{r}
 	}
 
+	// Group the records by period (starting from fromTime by default)
+	if c := options.Compositing; c != nil {
+		if c.Origin.IsZero() && !fromTime.IsZero() {
+			withOrigin := *c
+			withOrigin.Origin = fromTime
+			c = &withOrigin
+		}
+		datasetsByRecord, grecords = c.binRecords(datasetsByRecord, grecords)
+	}
+
 	// GetCube
-	stream, err := svc.getCubeStream(ctx, datasetsByRecord, grecords, outDesc, options)
-	return CubeInfo{NbImages: len(datasetsByRecord),
-		NbDatasets:    len(datasets),
-		Resampling:    outDesc.Resampling,
-		RefDataFormat: outDesc.DataMapping.DataFormat,
-	}, stream, err
+	return svc.getCubeStream(ctx, datasetsByRecord, grecords, groups, options)
 }
 
-func (svc *Service) getCubePrepare(ctx context.Context, instancesID []string, crs *godal.SpatialRef, pixToCRS *affine.Affine, width, height int, options GetCubeOptions) (internalImage.GdalDatasetDescriptor, *proj.GeographicRing, error) {
-	// Validate the input
-	variable, err := svc.db.ReadVariableFromInstanceID(ctx, instancesID[0])
-	if err != nil {
-		return internalImage.GdalDatasetDescriptor{}, nil, fmt.Errorf("getCubePrepare.%w", err)
+// newCubeInfo returns the CubeInfo of a cube made of the slices
+// NbImages is an upper bound, as the images without valid pixels are skipped during the merge (except with HeadersOnly).
+// If the cube is returned as a single file, it counts as one image.
+func newCubeInfo(datasetsByRecord []SliceMeta, groups []cubeBandGroup, options GetCubeOptions) CubeInfo {
+	nbImages, nbDatasets := len(datasetsByRecord), 0
+	for _, s := range datasetsByRecord {
+		nbDatasets += len(s.Datasets)
 	}
-	for _, instanceID := range instancesID {
-		if err := variable.CheckInstanceExists(instanceID); err != nil {
-			return internalImage.GdalDatasetDescriptor{}, nil, fmt.Errorf("getCubePrepare.%w", err)
-		}
+	if IsCubeFileFormat(options.Format) && !options.HeadersOnly && nbImages > 0 {
+		nbImages = 1
 	}
-	if options.Resampling == geocube.Resampling(pb.Resampling_UNDEFINED) {
-		options.Resampling = variable.Resampling
+	info := CubeInfo{
+		NbImages:      nbImages,
+		NbDatasets:    nbDatasets,
+		Resampling:    groups[0].Resampling,
+		RefDataFormat: groups[0].DataFormat,
+		BandGroups:    make([]BandGroup, len(groups)),
 	}
-
-	// Describe the output
-	outDesc := internalImage.GdalDatasetDescriptor{
-		PixToCRS:   pixToCRS,
-		Width:      width,
-		Height:     height,
-		Bands:      len(variable.Bands),
-		Resampling: options.Resampling,
-		DataMapping: geocube.DataMapping{
-			DataFormat: variable.DFormat,
-			RangeExt:   variable.DFormat.Range,
-			Exponent:   1,
-		},
-		ValidPixPc: options.FilterPartialImagePc,
-		Format:     options.Format,
+	for i, group := range groups {
+		info.BandGroups[i] = group.BandGroup
 	}
-	outDesc.WktCRS, err = crs.WKT()
+	return info
+}
+
+// getCubePrepare returns the band groups of the cube (one per instance) and its geographic extent
+func (svc *Service) getCubePrepare(ctx context.Context, instancesID []string, crs *godal.SpatialRef, pixToCRS *affine.Affine, width, height int, options GetCubeOptions) ([]cubeBandGroup, *proj.GeographicRing, error) {
+	wktCRS, err := crs.WKT()
 	if err != nil {
-		return internalImage.GdalDatasetDescriptor{}, nil, fmt.Errorf("getCubePrepare.ToWKT: %w", err)
+		return nil, nil, fmt.Errorf("getCubePrepare.ToWKT: %w", err)
 	}
 
-	if variable.Palette != "" {
-		if outDesc.Palette, err = svc.db.ReadPalette(ctx, variable.Palette); err != nil {
-			return internalImage.GdalDatasetDescriptor{}, nil, fmt.Errorf("getCubePrepare.%w", err)
+	if c := options.Compositing; c != nil {
+		if err := c.validate(); err != nil {
+			return nil, nil, fmt.Errorf("getCubePrepare: %w", err)
+		}
+		if c.Reducer == internalImage.ReducerBestPixel && !slices.Contains(instancesID, c.QualityInstanceID) {
+			return nil, nil, fmt.Errorf("getCubePrepare: %w", geocube.NewValidationError("Compositing: the quality instance %s must be one of the instances of the cube", c.QualityInstanceID))
 		}
 	}
 
+	// Describe the output of each instance
+	groups := make([]cubeBandGroup, len(instancesID))
+	for i, instanceID := range instancesID {
+		if slices.Contains(instancesID[:i], instanceID) {
+			return nil, nil, fmt.Errorf("getCubePrepare: %w", geocube.NewValidationError("instance %s is requested twice", instanceID))
+		}
+		variable, err := svc.db.ReadVariableFromInstanceID(ctx, instanceID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("getCubePrepare.%w", err)
+		}
+		if err := variable.CheckInstanceExists(instanceID); err != nil {
+			return nil, nil, fmt.Errorf("getCubePrepare.%w", err)
+		}
+		resampling := options.Resampling
+		if resampling == geocube.Resampling(pb.Resampling_UNDEFINED) {
+			resampling = variable.Resampling
+		}
+
+		groups[i] = cubeBandGroup{
+			BandGroup: BandGroup{
+				Bands:      len(variable.Bands),
+				DataFormat: variable.DFormat,
+				Resampling: resampling,
+				Variable:   variable.Name,
+				Instance:   variable.Instances[instanceID].Name,
+				BandNames:  variable.Bands,
+				Unit:       variable.Unit,
+			},
+			outDesc: internalImage.GdalDatasetDescriptor{
+				WktCRS:     wktCRS,
+				PixToCRS:   pixToCRS,
+				Width:      width,
+				Height:     height,
+				Bands:      len(variable.Bands),
+				Resampling: resampling,
+				DataMapping: geocube.DataMapping{
+					DataFormat: variable.DFormat,
+					RangeExt:   variable.DFormat.Range,
+					Exponent:   1,
+				},
+				ValidPixPc: options.FilterPartialImagePc,
+				Format:     options.Format,
+			},
+		}
+		if len(instancesID) > 1 {
+			groups[i].InstanceID = instanceID
+		}
+
+		if variable.Palette != "" {
+			if groups[i].outDesc.Palette, err = svc.db.ReadPalette(ctx, variable.Palette); err != nil {
+				return nil, nil, fmt.Errorf("getCubePrepare.%w", err)
+			}
+		}
+	}
+
+	if err := checkBandGroupsDType(groups, options); err != nil {
+		return nil, nil, fmt.Errorf("getCubePrepare.%w", err)
+	}
+
 	// Get the extent
 	geogExtent, err := proj.NewGeographicRingFromExtent(pixToCRS, width, height, crs)
 	if err != nil {
-		return internalImage.GdalDatasetDescriptor{}, nil, fmt.Errorf("getCubePrepare.%w", err)
+		return nil, nil, fmt.Errorf("getCubePrepare.%w", err)
 	}
 
-	return outDesc, &geogExtent, nil
+	return groups, &geogExtent, nil
+}
+
+// checkBandGroupsDType returns a ValidationError if the band groups have different data types, unless options.MixedDTypes
+// or the cube is returned as a single file (with one variable per group).
+func checkBandGroupsDType(groups []cubeBandGroup, options GetCubeOptions) error {
+	if options.MixedDTypes || IsCubeFileFormat(options.Format) {
+		return nil
+	}
+	for _, group := range groups[1:] {
+		if group.DataFormat.DType != groups[0].DataFormat.DType {
+			return geocube.NewValidationError("the band groups have different data types (%s and %s): set mixed_dtypes to get the concatenation of the groups, each in its own data type (see BandGroup.dformat)",
+				groups[0].DataFormat.DType.String(), group.DataFormat.DType.String())
+		}
+	}
+	return nil
+}
+
+// skipIncompleteSlices removes the slices that do not have datasets for each band group
+func skipIncompleteSlices(datasetsByRecord []SliceMeta, grecords [][]*geocube.Record, groups []cubeBandGroup) ([]SliceMeta, [][]*geocube.Record) {
+	if len(groups) == 1 {
+		return datasetsByRecord, grecords
+	}
+	var completeDatasets []SliceMeta
+	var completeRecords [][]*geocube.Record
+	for i, s := range datasetsByRecord {
+		if !slices.ContainsFunc(s.groupDatasets(groups), func(datasets []*internalImage.Dataset) bool { return len(datasets) == 0 }) {
+			completeDatasets = append(completeDatasets, s)
+			completeRecords = append(completeRecords, grecords[i])
+		}
+	}
+	return completeDatasets, completeRecords
 }
 
 // getCubeGroupByRecordsGroup groups datasets and records according to the original recordGroups
@@ -307,6 +497,8 @@ func (svc *Service) groupDatasetsByRecord(ctx context.Context, datasets []*geocu
 				SubDir:      datasets[i].ContainerSubDir,
 				Bands:       datasets[i].Bands,
 				DataMapping: datasets[i].DataMapping,
+				InstanceID:  datasets[i].InstanceID,
+				RecordID:    recordID,
 			})
 		}
 		datasetsByRecord = append(datasetsByRecord, ds)
@@ -322,21 +514,79 @@ func getNumberOfWorkers(memoryUsageBytes int) int {
 	return utils.MinI(10, utils.MaxI(1, ramSize/memoryUsageBytes))
 }
 
-func (svc *Service) getCubeStream(ctx context.Context, datasetsByRecord []SliceMeta, grecords [][]*geocube.Record, outDesc internalImage.GdalDatasetDescriptor, options GetCubeOptions) (<-chan CubeSlice, error) {
+// groupDatasets returns the datasets of the slice by band group
+func (s SliceMeta) groupDatasets(groups []cubeBandGroup) [][]*internalImage.Dataset {
+	if len(groups) == 1 {
+		return [][]*internalImage.Dataset{s.Datasets}
+	}
+	datasets := make([][]*internalImage.Dataset, len(groups))
+	for _, d := range s.Datasets {
+		for i, group := range groups {
+			if d.InstanceID == group.InstanceID {
+				datasets[i] = append(datasets[i], d)
+				break
+			}
+		}
+	}
+	return datasets
+}
+
+// groupDatasetsByObservation returns the datasets of the slice by record (in the order of the records) and by band group
+// The datasets of an unknown record are grouped in additional observations.
+func (s SliceMeta) groupDatasetsByObservation(records []*geocube.Record, groups []cubeBandGroup) [][][]*internalImage.Dataset {
+	recordIdx := make(map[string]int, len(records))
+	byRecord := make([]SliceMeta, len(records))
+	for i, r := range records {
+		recordIdx[r.ID] = i
+	}
+	for _, d := range s.Datasets {
+		idx, ok := recordIdx[d.RecordID]
+		if !ok {
+			idx = len(byRecord)
+			recordIdx[d.RecordID] = idx
+			byRecord = append(byRecord, SliceMeta{})
+		}
+		byRecord[idx].Datasets = append(byRecord[idx].Datasets, d)
+	}
+	observations := make([][][]*internalImage.Dataset, 0, len(byRecord))
+	for _, datasets := range byRecord {
+		if len(datasets.Datasets) > 0 {
+			observations = append(observations, datasets.groupDatasets(groups))
+		}
+	}
+	return observations
+}
+
+// getCubeStream returns the CubeInfo and the stream of the slices of the cube
+// With options.SkipIncomplete, the slices that do not have datasets for each band group are removed beforehand.
+func (svc *Service) getCubeStream(ctx context.Context, datasetsByRecord []SliceMeta, grecords [][]*geocube.Record, groups []cubeBandGroup, options GetCubeOptions) (CubeInfo, <-chan CubeSlice, error) {
+	if options.SkipIncomplete {
+		datasetsByRecord, grecords = skipIncompleteSlices(datasetsByRecord, grecords, groups)
+	}
+	info := newCubeInfo(datasetsByRecord, groups, options)
+
 	if options.HeadersOnly {
 		// Push the headers into a channel
 		headersOut := make(chan CubeSlice, len(grecords))
+		width, height := groups[0].outDesc.Width, groups[0].outDesc.Height
 		for i, records := range grecords {
+			bandGroups := make([]BandGroup, len(groups))
+			nbBands := 0
+			for j := range groups {
+				bandGroups[j] = groups[j].BandGroup
+				nbBands += groups[j].Bands
+			}
 			headersOut <- CubeSlice{
-				Image:        bitmap.NewBitmapHeader(image.Rect(0, 0, outDesc.Width, outDesc.Height), outDesc.DataMapping.DType, outDesc.Bands),
+				Image:        bitmap.NewBitmapHeader(image.Rect(0, 0, width, height), groups[0].DataFormat.DType, nbBands),
 				Err:          nil,
 				Records:      records,
 				Metadata:     map[string]string{},
-				DatasetsMeta: datasetsByRecord[i]}
+				DatasetsMeta: datasetsByRecord[i],
+				BandGroups:   bandGroups}
 		}
 		close(headersOut)
 
-		return headersOut, nil
+		return info, headersOut, nil
 	}
 
 	// Predownload datasets if required
@@ -345,6 +595,15 @@ func (svc *Service) getCubeStream(ctx context.Context, datasetsByRecord []SliceM
 		PredownloadRemoteDatasets(ctx, datasetsByRecord, datasetsAvailability)
 	}
 
+	// Quality band of a best-pixel composite
+	var quality *internalImage.CompositeQuality
+	if options.Compositing != nil {
+		var err error
+		if quality, err = options.Compositing.quality(groups); err != nil {
+			return CubeInfo{}, nil, fmt.Errorf("getCubeStream.%w", err)
+		}
+	}
+
 	// Create a job for each batch of datasets with the same record id and a result channel
 	var jobs []mergeDatasetJob
 	var unorderedSlices []<-chan CubeSlice
@@ -353,7 +612,10 @@ func (svc *Service) getCubeStream(ctx context.Context, datasetsByRecord []SliceM
 		jobs = append(jobs, mergeDatasetJob{
 			ID:    len(jobs),
 			Slice: datasets, Records: grecords[i],
-			OutDesc:           &outDesc,
+			Groups:            groups,
+			SkipIncomplete:    options.SkipIncomplete,
+			Compositing:       options.Compositing,
+			Quality:           quality,
 			AvailabilityChans: datasetsAvailability[i],
 			ResultChan:        ackChan,
 		})
@@ -369,7 +631,11 @@ func (svc *Service) getCubeStream(ctx context.Context, datasetsByRecord []SliceM
 	// Start workers
 	{
 		jobChan := make(chan mergeDatasetJob, len(jobs))
-		nbWorkers := utils.MinI(len(jobs), utils.MinI(svc.cubeWorkers, getNumberOfWorkers(outDesc.Height*outDesc.Width*outDesc.DataMapping.DType.Size()*10)))
+		imageSize := 0
+		for _, group := range groups {
+			imageSize += group.outDesc.Height * group.outDesc.Width * group.outDesc.DataMapping.DType.Size()
+		}
+		nbWorkers := utils.MinI(len(jobs), utils.MinI(svc.cubeWorkers, getNumberOfWorkers(imageSize*10)))
 		for i := 0; i < nbWorkers; i++ {
 			go mergeDatasetsWorker(ctx, jobChan)
 		}
@@ -380,7 +646,10 @@ func (svc *Service) getCubeStream(ctx context.Context, datasetsByRecord []SliceM
 		close(jobChan)
 	}
 
-	return orderedSlices, nil
+	if IsCubeFileFormat(options.Format) {
+		return info, stackCubeSlices(ctx, orderedSlices, groups, options.Format, svc.workDir), nil
+	}
+	return info, orderedSlices, nil
 }
 
 func (svc *Service) infoFromTile(a, b, z int) (*proj.GeographicRing, internalImage.GdalDatasetDescriptor, error) {
@@ -419,7 +688,7 @@ func (svc *Service) GetXYZTile(ctx context.Context, instanceID string, recordsID
 	}
 
 	// Get an image from these filters
-	ds, err := svc.getMosaic(ctx, []string{instanceID}, recordsID, geocube.Metadata{}, time.Time{}, time.Time{}, *geogExtent, &outDesc)
+	ds, err := svc.getMosaic(ctx, []string{instanceID}, recordsID, nil, time.Time{}, time.Time{}, *geogExtent, &outDesc)
 	if err != nil {
 		return nil, fmt.Errorf("GetXYZTile.%w", err)
 	}
@@ -432,7 +701,7 @@ func (svc *Service) GetXYZTile(ctx context.Context, instanceID string, recordsID
 }
 
 // GetXYZTileFromFilters implements GeocubeService
-func (svc *Service) GetXYZTileFromFilters(ctx context.Context, instanceID string, recordTags geocube.Metadata, fromTime, toTime time.Time, a, b, z int, min, max float64) ([]byte, error) {
+func (svc *Service) GetXYZTileFromFilters(ctx context.Context, instanceID string, recordTags geocube.TagsQuery, fromTime, toTime time.Time, a, b, z int, min, max float64) ([]byte, error) {
 	geogExtent, outDesc, err := svc.infoFromTile(a, b, z)
 	if err != nil {
 		return nil, fmt.Errorf("GetXYZTileFromFilters.%w", err)
@@ -532,7 +801,10 @@ type mergeDatasetJob struct {
 	ID                int
 	Slice             SliceMeta
 	Records           []*geocube.Record
-	OutDesc           *internalImage.GdalDatasetDescriptor
+	Groups            []cubeBandGroup
+	SkipIncomplete    bool
+	Compositing       *CompositingOptions
+	Quality           *internalImage.CompositeQuality
 	AvailabilityChans DatasetsAvailability
 	ResultChan        chan<- CubeSlice
 }
@@ -580,32 +852,15 @@ func mergeDatasetsWorker(ctx context.Context, jobs <-chan mergeDatasetJob) {
 				metadata[fmt.Sprintf("WaitDownload %d", len(job.AvailabilityChans))] = fmt.Sprintf("%v", time.Since(start))
 			}
 
-			// Run mergeDatasets
+			// Merge the datasets of each group
 			start := time.Now()
-			var bmp *bitmap.Bitmap
-			ds, err := internalImage.MergeDatasets(ctx, job.Slice.Datasets, job.OutDesc)
+			bmp, bandGroups, err := mergeBandGroups(ctx, job)
 			// Acq downloaded images
 			for _, ack := range acks {
 				if !utils.IsCancelled(ack.Ctx) {
 					ack.AckChan <- struct{}{}
 				}
 			}
-			if err == nil {
-				// Convert to image
-				switch job.OutDesc.Format {
-				case "GTiff":
-					tags := mergeTags(job.Records)
-					bmp = bitmap.NewBitmapHeader(image.Rect(0, 0, job.OutDesc.Width, job.OutDesc.Height), job.OutDesc.DataMapping.DType, job.OutDesc.Bands)
-					var bytes []byte
-					bytes, err = internalImage.DatasetToTiffAsBytes(ds, job.OutDesc.DataMapping, tags, nil)
-					bmp.Chunks = &bitmap.ByteArray{Bytes: bytes}
-					ds.Close()
-
-				default:
-					bmp, err = bitmap.NewStreamableBitmapFromDataset(ds)
-					// ds is closed by "bmp"
-				}
-			}
 
 			metadata[fmt.Sprintf("Merge %d", len(job.Slice.Datasets))] = fmt.Sprintf("%v", time.Since(start))
 
@@ -618,15 +873,146 @@ func mergeDatasetsWorker(ctx context.Context, jobs <-chan mergeDatasetJob) {
 				Err:          err,
 				Records:      job.Records,
 				Metadata:     metadata,
-				DatasetsMeta: job.Slice}:
+				DatasetsMeta: job.Slice,
+				BandGroups:   bandGroups}:
 			}
 		}()
 	}
 }
 
+// mergeBandGroups merges the datasets of each group of the job and returns the concatenation of the groups.
+// With several groups, a group without datasets (or without valid pixels) is filled with nodata, or the slice is skipped (nil bitmap and nil error) if job.SkipIncomplete.
+func mergeBandGroups(ctx context.Context, job mergeDatasetJob) (*bitmap.Bitmap, []BandGroup, error) {
+	if job.Compositing != nil {
+		return compositeBandGroups(ctx, job)
+	}
+	if len(job.Groups) == 1 {
+		bmp, err := mergeBandGroup(ctx, job.Slice.Datasets, &job.Groups[0].outDesc, job.Records)
+		if err != nil {
+			return nil, nil, err
+		}
+		group := job.Groups[0].BandGroup
+		group.Size = bmp.Len()
+		return bmp, []BandGroup{group}, nil
+	}
+
+	var firstErr error
+	bmps := make([]*bitmap.Bitmap, len(job.Groups))
+	for i, datasets := range job.Slice.groupDatasets(job.Groups) {
+		if len(datasets) > 0 {
+			var err error
+			if bmps[i], err = mergeBandGroup(ctx, datasets, &job.Groups[i].outDesc, job.Records); err == nil {
+				continue
+			} else if !geocube.IsError(err, geocube.EntityNotFound) {
+				return nil, nil, err
+			} else if firstErr == nil {
+				firstErr = err
+			}
+		}
+		if job.SkipIncomplete {
+			return nil, nil, nil
+		}
+	}
+	if firstErr != nil && slices.IndexFunc(bmps, func(b *bitmap.Bitmap) bool { return b != nil }) == -1 {
+		// No valid pixels at all
+		return nil, nil, firstErr
+	}
+	return concatBandGroups(job, bmps)
+}
+
+// compositeBandGroups computes the temporal composite of the records of the job for each group and returns the concatenation of the groups.
+// As in mergeBandGroups, the missing groups are filled with nodata or the slice is skipped.
+func compositeBandGroups(ctx context.Context, job mergeDatasetJob) (*bitmap.Bitmap, []BandGroup, error) {
+	outDescs := make([]*internalImage.GdalDatasetDescriptor, len(job.Groups))
+	for i := range job.Groups {
+		outDescs[i] = &job.Groups[i].outDesc
+	}
+	datasets, err := internalImage.CompositeDatasets(ctx, job.Slice.groupDatasetsByObservation(job.Records, job.Groups), outDescs, job.Compositing.Reducer, job.Quality)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	bmps := make([]*bitmap.Bitmap, len(job.Groups))
+	complete := true
+	for i, ds := range datasets {
+		if ds == nil {
+			complete = false
+		} else if bmps[i], err = datasetToBitmap(ds, outDescs[i], job.Records); err != nil {
+			for _, ds := range datasets[i+1:] {
+				if ds != nil {
+					ds.Close()
+				}
+			}
+			return nil, nil, err
+		}
+	}
+	if slices.IndexFunc(bmps, func(b *bitmap.Bitmap) bool { return b != nil }) == -1 {
+		return nil, nil, geocube.NewEntityNotFound("", "", "", "No observation (skipped)")
+	}
+	if !complete && job.SkipIncomplete {
+		return nil, nil, nil
+	}
+	return concatBandGroups(job, bmps)
+}
+
+// concatBandGroups fills the missing groups with nodata and returns the concatenation of the groups
+func concatBandGroups(job mergeDatasetJob, bmps []*bitmap.Bitmap) (*bitmap.Bitmap, []BandGroup, error) {
+	readers := make([]bitmap.ChunkReader, len(job.Groups))
+	bandGroups := make([]BandGroup, len(job.Groups))
+	nbBands := 0
+	for i := range job.Groups {
+		if bmps[i] == nil {
+			ds, err := internalImage.NewNoDataDataset(&job.Groups[i].outDesc)
+			if err != nil {
+				return nil, nil, err
+			}
+			if bmps[i], err = datasetToBitmap(ds, &job.Groups[i].outDesc, job.Records); err != nil {
+				return nil, nil, err
+			}
+		}
+		readers[i] = bmps[i].Chunks
+		bandGroups[i] = job.Groups[i].BandGroup
+		bandGroups[i].Size = bmps[i].Len()
+		nbBands += bmps[i].Bands
+	}
+	if len(bmps) == 1 {
+		return bmps[0], bandGroups, nil
+	}
+	bmp := bitmap.NewBitmapHeader(bmps[0].Rect, bmps[0].DType, nbBands)
+	bmp.ByteOrder = bmps[0].ByteOrder
+	bmp.Chunks = &bitmap.MultiChunkReader{Readers: readers}
+	return bmp, bandGroups, nil
+}
+
+// mergeBandGroup merges the datasets and converts the result to a bitmap in the output format
+func mergeBandGroup(ctx context.Context, datasets []*internalImage.Dataset, outDesc *internalImage.GdalDatasetDescriptor, records []*geocube.Record) (*bitmap.Bitmap, error) {
+	ds, err := internalImage.MergeDatasets(ctx, datasets, outDesc)
+	if err != nil {
+		return nil, err
+	}
+	return datasetToBitmap(ds, outDesc, records)
+}
+
+// datasetToBitmap converts the dataset to a bitmap in the output format. ds is closed by the function or by the bitmap.
+func datasetToBitmap(ds *godal.Dataset, outDesc *internalImage.GdalDatasetDescriptor, records []*geocube.Record) (*bitmap.Bitmap, error) {
+	switch outDesc.Format {
+	case "GTiff":
+		defer ds.Close()
+		tags := mergeTags(records)
+		bmp := bitmap.NewBitmapHeader(image.Rect(0, 0, outDesc.Width, outDesc.Height), outDesc.DataMapping.DType, outDesc.Bands)
+		bytes, err := internalImage.DatasetToTiffAsBytes(ds, outDesc.DataMapping, tags, nil)
+		bmp.Chunks = &bitmap.ByteArray{Bytes: bytes}
+		return bmp, err
+
+	default:
+		// ds is closed by "bmp"
+		return bitmap.NewStreamableBitmapFromDataset(ds)
+	}
+}
+
 // getMosaic returns a mosaic given recordsID and instancesID (both not empty)
 // The caller is responsible to close the output dataset
-func (svc *Service) getMosaic(ctx context.Context, instancesID, recordsID []string, recordTags geocube.Metadata, fromTime, toTime time.Time, geogExtent proj.GeographicRing, outDesc *internalImage.GdalDatasetDescriptor) (*godal.Dataset, error) {
+func (svc *Service) getMosaic(ctx context.Context, instancesID, recordsID []string, recordTags geocube.TagsQuery, fromTime, toTime time.Time, geogExtent proj.GeographicRing, outDesc *internalImage.GdalDatasetDescriptor) (*godal.Dataset, error) {
 	// Read Variable
 	variable, err := svc.db.ReadVariableFromInstanceID(ctx, instancesID[0])
 	if err != nil {
@@ -639,7 +1025,7 @@ func (svc *Service) getMosaic(ctx context.Context, instancesID, recordsID []stri
 	}
 
 	// Retrieve datasets
-	datasets, err := svc.db.FindDatasets(ctx, geocube.DatasetStatusACTIVE, nil, "", instancesID, recordsID, recordTags, fromTime, toTime, &geogExtent, nil, 0, 0, true)
+	datasets, err := svc.db.FindDatasets(ctx, geocube.DatasetStatusACTIVE, nil, "", instancesID, recordsID, recordTags, fromTime, toTime, &geogExtent, nil, 0, 0, nil, true)
 	if err != nil {
 		return nil, fmt.Errorf("GetMosaic.%w", err)
 	}
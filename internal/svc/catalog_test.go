@@ -0,0 +1,99 @@
+package svc_test
+
+import (
+	"context"
+	"image"
+
+	"github.com/airbusgeo/geocube/internal/geocube"
+	internalImage "github.com/airbusgeo/geocube/internal/image"
+	"github.com/airbusgeo/geocube/internal/svc"
+	"github.com/airbusgeo/geocube/internal/utils/bitmap"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("BandGroups", func() {
+
+	var (
+		ctx = context.Background()
+
+		uint8Group = svc.BandGroup{
+			InstanceID: "instanceA",
+			Bands:      1,
+			DataFormat: geocube.DataFormat{DType: bitmap.DTypeUINT8, NoData: 0, Range: geocube.Range{Min: 1, Max: 255}},
+		}
+		float32Group = svc.BandGroup{
+			InstanceID: "instanceB",
+			Bands:      2,
+			DataFormat: geocube.DataFormat{DType: bitmap.DTypeFLOAT32, NoData: -1, Range: geocube.Range{Min: 0, Max: 1}},
+		}
+	)
+
+	newBitmap := func(dtype bitmap.DType, bands int, value byte) *bitmap.Bitmap {
+		bmp := bitmap.NewBitmapHeader(image.Rect(0, 0, 2, 3), dtype, bands)
+		bytes := make([]byte, 2*3*bands*dtype.Size())
+		for i := range bytes {
+			bytes[i] = value
+		}
+		bmp.Chunks = &bitmap.ByteArray{Bytes: bytes}
+		return bmp
+	}
+
+	Context("concatBandGroups", func() {
+		It("should concatenate groups of different data types", func() {
+			bmp, bandGroups, err := svc.ConcatBandGroups([]svc.BandGroup{uint8Group, float32Group},
+				[]*bitmap.Bitmap{newBitmap(bitmap.DTypeUINT8, 1, 1), newBitmap(bitmap.DTypeFLOAT32, 2, 2)})
+			Expect(err).To(BeNil())
+			Expect(bmp.Bands).To(Equal(3))
+			Expect(bmp.DType).To(Equal(bitmap.DTypeUINT8)) // type of the first group
+			Expect(bandGroups).To(HaveLen(2))
+			Expect(bandGroups[0].DataFormat.DType).To(Equal(bitmap.DTypeUINT8))
+			Expect(bandGroups[0].Size).To(Equal(2 * 3))
+			Expect(bandGroups[1].DataFormat.DType).To(Equal(bitmap.DTypeFLOAT32))
+			Expect(bandGroups[1].Size).To(Equal(2 * 3 * 2 * 4))
+
+			bytes, err := bmp.ReadAllBytes()
+			Expect(err).To(BeNil())
+			Expect(bytes).To(HaveLen(bandGroups[0].Size + bandGroups[1].Size))
+			Expect(bytes[:bandGroups[0].Size]).To(HaveEach(byte(1)))
+			Expect(bytes[bandGroups[0].Size:]).To(HaveEach(byte(2)))
+		})
+	})
+
+	Context("mergeBandGroups", func() {
+		It("should skip an incomplete slice", func() {
+			slice := svc.SliceMeta{Datasets: []*internalImage.Dataset{{URI: "gs://bucket/b.tif", InstanceID: "instanceB"}}}
+			bmp, bandGroups, err := svc.MergeBandGroups(ctx, []svc.BandGroup{uint8Group, float32Group}, slice, true)
+			Expect(err).To(BeNil())
+			Expect(bmp).To(BeNil())
+			Expect(bandGroups).To(BeNil())
+		})
+	})
+
+	Context("skipIncompleteSlices", func() {
+		It("should remove the slices without datasets for each group", func() {
+			datasetA := &internalImage.Dataset{URI: "gs://bucket/a.tif", InstanceID: "instanceA"}
+			datasetB := &internalImage.Dataset{URI: "gs://bucket/b.tif", InstanceID: "instanceB"}
+			records := [][]*geocube.Record{{{ID: "record1"}}, {{ID: "record2"}}, {{ID: "record3"}}}
+			slices, grecords := svc.SkipIncompleteSlices([]svc.SliceMeta{
+				{Datasets: []*internalImage.Dataset{datasetA, datasetB}},
+				{Datasets: []*internalImage.Dataset{datasetB}},
+				{Datasets: []*internalImage.Dataset{datasetB, datasetA}},
+			}, records, []svc.BandGroup{uint8Group, float32Group})
+			Expect(slices).To(HaveLen(2))
+			Expect(grecords).To(Equal([][]*geocube.Record{records[0], records[2]}))
+		})
+	})
+
+	Context("checkBandGroupsDType", func() {
+		It("should refuse mixed data types unless allowed", func() {
+			groups := []svc.BandGroup{uint8Group, float32Group}
+			err := svc.CheckBandGroupsDType(groups, svc.GetCubeOptions{Format: "Raw"})
+			Expect(geocube.IsError(err, geocube.EntityValidationError)).To(BeTrue())
+			Expect(svc.CheckBandGroupsDType(groups, svc.GetCubeOptions{Format: "Raw", MixedDTypes: true})).To(BeNil())
+			Expect(svc.CheckBandGroupsDType(groups, svc.GetCubeOptions{Format: "NetCDF4"})).To(BeNil())
+			Expect(svc.CheckBandGroupsDType([]svc.BandGroup{float32Group, float32Group}, svc.GetCubeOptions{Format: "Raw"})).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,132 @@
+package svc
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/airbusgeo/geocube/internal/geocube"
+	internalImage "github.com/airbusgeo/geocube/internal/image"
+)
+
+// CompositingOptions defines a per-pixel temporal compositing of the records of a cube, binned by period
+type CompositingOptions struct {
+	Reducer internalImage.Reducer
+	// Binning: PeriodDays, PeriodMonths or DateRanges (none if the records are already grouped by period)
+	PeriodDays   int
+	PeriodMonths int
+	DateRanges   [][2]time.Time // [from, to[
+	Origin       time.Time      // Start of the first period (default: the day or the month of the first record)
+	// Best pixel
+	QualityInstanceID   string // Instance whose first band is the quality of the observations
+	QualityLowestIsBest bool
+}
+
+// validate checks the options
+func (c *CompositingOptions) validate() error {
+	if c.PeriodDays < 0 || c.PeriodMonths < 0 {
+		return geocube.NewValidationError("Compositing: the period must be positive")
+	}
+	nbRules := 0
+	for _, defined := range []bool{c.PeriodDays > 0, c.PeriodMonths > 0, len(c.DateRanges) > 0} {
+		if defined {
+			nbRules++
+		}
+	}
+	if nbRules > 1 {
+		return geocube.NewValidationError("Compositing: only one binning rule can be defined")
+	}
+	for _, r := range c.DateRanges {
+		if !r[0].Before(r[1]) {
+			return geocube.NewValidationError("Compositing: invalid date range [%v, %v[", r[0], r[1])
+		}
+	}
+	if c.Reducer == internalImage.ReducerBestPixel && c.QualityInstanceID == "" {
+		return geocube.NewValidationError("Compositing: the quality instance must be defined for a best-pixel composite")
+	}
+	return nil
+}
+
+// quality returns the quality band of a best-pixel composite (nil otherwise) given the band groups of the cube
+func (c *CompositingOptions) quality(groups []cubeBandGroup) (*internalImage.CompositeQuality, error) {
+	if c.Reducer != internalImage.ReducerBestPixel {
+		return nil, nil
+	}
+	for i, group := range groups {
+		// With only one group, the quality instance is the instance of the cube
+		if len(groups) == 1 || group.InstanceID == c.QualityInstanceID {
+			return &internalImage.CompositeQuality{Output: i, Band: 0, LowestIsBest: c.QualityLowestIsBest}, nil
+		}
+	}
+	return nil, geocube.NewValidationError("Compositing: the quality instance %s must be one of the instances of the cube", c.QualityInstanceID)
+}
+
+// binRecords groups the slices of the cube by period. The time of a slice is the time of its first record.
+// The periods are sorted by time (except the date ranges, returned in the given order) and the empty periods are removed.
+// Without binning rule, the slices are returned as they are.
+func (c *CompositingOptions) binRecords(datasetsByRecord []SliceMeta, grecords [][]*geocube.Record) ([]SliceMeta, [][]*geocube.Record) {
+	if c.PeriodDays == 0 && c.PeriodMonths == 0 && len(c.DateRanges) == 0 || len(grecords) == 0 {
+		return datasetsByRecord, grecords
+	}
+
+	origin := c.Origin
+	if origin.IsZero() {
+		origin = grecords[0][0].Time
+		for _, records := range grecords {
+			if records[0].Time.Before(origin) {
+				origin = records[0].Time
+			}
+		}
+		origin = origin.UTC().Truncate(24 * time.Hour)
+	}
+
+	// Index of the periods of each slice
+	binsOfSlice := make([][]int, len(grecords))
+	for i, records := range grecords {
+		t := records[0].Time
+		switch {
+		case c.PeriodDays > 0:
+			binsOfSlice[i] = []int{floorDiv(int(math.Floor(t.Sub(origin).Hours()/24)), c.PeriodDays)}
+		case c.PeriodMonths > 0:
+			t, o := t.UTC(), origin.UTC()
+			binsOfSlice[i] = []int{floorDiv((t.Year()-o.Year())*12+int(t.Month())-int(o.Month()), c.PeriodMonths)}
+		default:
+			for r, dateRange := range c.DateRanges {
+				if !t.Before(dateRange[0]) && t.Before(dateRange[1]) {
+					binsOfSlice[i] = append(binsOfSlice[i], r)
+				}
+			}
+		}
+	}
+
+	// Group the slices by period
+	var bins []int
+	binSlices := map[int][]int{}
+	for i, bs := range binsOfSlice {
+		for _, b := range bs {
+			if _, ok := binSlices[b]; !ok {
+				bins = append(bins, b)
+			}
+			binSlices[b] = append(binSlices[b], i)
+		}
+	}
+	sort.Ints(bins)
+
+	newDatasetsByRecord := make([]SliceMeta, len(bins))
+	newGrecords := make([][]*geocube.Record, len(bins))
+	for i, b := range bins {
+		for _, s := range binSlices[b] {
+			newDatasetsByRecord[i].Datasets = append(newDatasetsByRecord[i].Datasets, datasetsByRecord[s].Datasets...)
+			newGrecords[i] = append(newGrecords[i], grecords[s]...)
+		}
+	}
+	return newDatasetsByRecord, newGrecords
+}
+
+// floorDiv returns the floor of a/b (b>0)
+func floorDiv(a, b int) int {
+	if a < 0 {
+		return -((-a + b - 1) / b)
+	}
+	return a / b
+}
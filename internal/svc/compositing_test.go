@@ -0,0 +1,99 @@
+package svc_test
+
+import (
+	"time"
+
+	"github.com/airbusgeo/geocube/internal/geocube"
+	"github.com/airbusgeo/geocube/internal/image"
+	"github.com/airbusgeo/geocube/internal/svc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("binRecords", func() {
+
+	var (
+		options          svc.CompositingOptions
+		datasetsByRecord []svc.SliceMeta
+		grecords         [][]*geocube.Record
+
+		returnedDatasets []svc.SliceMeta
+		returnedRecords  [][]*geocube.Record
+	)
+
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 10, 0, 0, 0, time.UTC)
+	}
+	recordIDs := func(records [][]*geocube.Record) [][]string {
+		ids := make([][]string, len(records))
+		for i, rs := range records {
+			for _, r := range rs {
+				ids[i] = append(ids[i], r.ID)
+			}
+		}
+		return ids
+	}
+
+	BeforeEach(func() {
+		options = svc.CompositingOptions{}
+		datasetsByRecord, grecords = nil, nil
+		for i, t := range []time.Time{date(2022, 1, 30), date(2022, 1, 2), date(2022, 2, 3), date(2022, 1, 11)} {
+			id := string(rune('a' + i))
+			datasetsByRecord = append(datasetsByRecord, svc.SliceMeta{Datasets: []*image.Dataset{{URI: id}}})
+			grecords = append(grecords, []*geocube.Record{{ID: id, Time: t}})
+		}
+	})
+
+	JustBeforeEach(func() {
+		returnedDatasets, returnedRecords = svc.BinRecords(&options, datasetsByRecord, grecords)
+	})
+
+	Context("without binning rule", func() {
+		It("should return the slices as they are", func() {
+			Expect(recordIDs(returnedRecords)).To(Equal([][]string{{"a"}, {"b"}, {"c"}, {"d"}}))
+		})
+	})
+
+	Context("by period of days", func() {
+		BeforeEach(func() {
+			options.PeriodDays = 10
+		})
+		It("should group the records from the day of the first record", func() {
+			Expect(recordIDs(returnedRecords)).To(Equal([][]string{{"b", "d"}, {"a"}, {"c"}}))
+			Expect(returnedDatasets).To(HaveLen(3))
+		})
+
+		Context("with an origin", func() {
+			BeforeEach(func() {
+				options.Origin = date(2022, 1, 1)
+				options.PeriodDays = 31
+			})
+			It("should group the records from the origin", func() {
+				Expect(recordIDs(returnedRecords)).To(Equal([][]string{{"a", "b", "d"}, {"c"}}))
+				Expect(returnedDatasets[0].Datasets).To(HaveLen(3))
+			})
+		})
+	})
+
+	Context("by period of months", func() {
+		BeforeEach(func() {
+			options.PeriodMonths = 1
+		})
+		It("should group the records by calendar month", func() {
+			Expect(recordIDs(returnedRecords)).To(Equal([][]string{{"a", "b", "d"}, {"c"}}))
+		})
+	})
+
+	Context("by date ranges", func() {
+		BeforeEach(func() {
+			options.DateRanges = [][2]time.Time{
+				{date(2022, 1, 10), date(2022, 2, 10)},
+				{date(2022, 1, 1), date(2022, 1, 10)},
+			}
+		})
+		It("should group the records by range in the given order and ignore the others", func() {
+			Expect(recordIDs(returnedRecords)).To(Equal([][]string{{"a", "c", "d"}, {"b"}}))
+		})
+	})
+})
@@ -5,10 +5,11 @@ import (
 	"fmt"
 	"sort"
 	"strconv"
-	"sync"
 	"time"
 
 	"github.com/airbusgeo/geocube/interface/database"
+	"github.com/airbusgeo/geocube/interface/messaging"
+	"github.com/airbusgeo/geocube/interface/storage"
 	"github.com/airbusgeo/geocube/interface/storage/uri"
 	"github.com/airbusgeo/geocube/internal/geocube"
 	"github.com/airbusgeo/geocube/internal/log"
@@ -18,40 +19,41 @@ import (
 
 // csldOnEnterNewState should only returns publishing error
 // All the other errors must be handle by the state machine
+// On success, the actions publish their event in the same transaction as the job (see publishEvent)
 func (svc *Service) csldOnEnterNewState(ctx context.Context, j *geocube.Job) error {
 	switch j.State {
 	case geocube.JobStateNEW:
-		return svc.publishEvent(ctx, geocube.JobCreated, j, "")
+		return svc.publishEvent(ctx, nil, geocube.JobCreated, j, "")
 
 	case geocube.JobStateCREATED:
 		if err := svc.csldPrepareOrders(ctx, j); err != nil {
-			return svc.publishEvent(ctx, geocube.PrepareOrdersFailed, j, err.Error())
+			return svc.publishFailure(ctx, geocube.PrepareOrdersFailed, j, err)
 		}
-		return svc.publishEvent(ctx, geocube.OrdersPrepared, j, "")
+		return nil
 
 	case geocube.JobStateCONSOLIDATIONINPROGRESS:
 		if err := svc.csldSendOrders(ctx, j); err != nil {
-			return svc.publishEvent(ctx, geocube.SendOrdersFailed, j, err.Error())
+			return svc.publishFailure(ctx, geocube.SendOrdersFailed, j, err)
 		}
 		return nil
 
 	case geocube.JobStateCONSOLIDATIONDONE:
 		if err := svc.csldIndex(ctx, j); err != nil {
-			return svc.publishEvent(ctx, geocube.ConsolidationIndexingFailed, j, err.Error())
+			return svc.publishFailure(ctx, geocube.ConsolidationIndexingFailed, j, err)
 		}
-		return svc.publishEvent(ctx, geocube.ConsolidationIndexed, j, "")
+		return nil
 
 	case geocube.JobStateCONSOLIDATIONINDEXED:
 		if err := svc.csldSwapDatasets(ctx, j); err != nil {
-			return svc.publishEvent(ctx, geocube.SwapDatasetsFailed, j, err.Error())
+			return svc.publishFailure(ctx, geocube.SwapDatasetsFailed, j, err)
 		}
-		return svc.publishEvent(ctx, geocube.DatasetsSwapped, j, "")
+		return nil
 
 	case geocube.JobStateCONSOLIDATIONEFFECTIVE:
 		if err := svc.csldDeleteDatasets(ctx, j); err != nil {
-			return svc.publishEvent(ctx, geocube.StartDeletionFailed, j, err.Error())
+			return svc.publishFailure(ctx, geocube.StartDeletionFailed, j, err)
 		}
-		return svc.publishEvent(ctx, geocube.DeletionStarted, j, "")
+		return nil
 
 	case geocube.JobStateDONE:
 		// Finished !
@@ -63,9 +65,9 @@ func (svc *Service) csldOnEnterNewState(ctx context.Context, j *geocube.Job) err
 
 	case geocube.JobStateCONSOLIDATIONCANCELLING:
 		if err := svc.csldCancel(ctx, j); err != nil {
-			return svc.publishEvent(ctx, geocube.CancellationFailed, j, err.Error())
+			return svc.publishFailure(ctx, geocube.CancellationFailed, j, err)
 		}
-		return svc.publishEvent(ctx, geocube.CancellationDone, j, "")
+		return nil
 
 	case geocube.JobStateCONSOLIDATIONFAILED, geocube.JobStateINITIALISATIONFAILED, geocube.JobStateCANCELLATIONFAILED:
 		j.LogErr("Consolidation failed")
@@ -74,21 +76,21 @@ func (svc *Service) csldOnEnterNewState(ctx context.Context, j *geocube.Job) err
 
 	case geocube.JobStateCONSOLIDATIONRETRYING:
 		if err := svc.csldConsolidationRetry(ctx, j); err != nil {
-			return svc.publishEvent(ctx, geocube.ConsolidationRetryFailed, j, err.Error())
+			return svc.publishFailure(ctx, geocube.ConsolidationRetryFailed, j, err)
 		}
-		return svc.publishEvent(ctx, geocube.OrdersPrepared, j, "")
+		return nil
 
 	case geocube.JobStateCONSOLIDATIONFORCERETRYING:
 		if err := svc.csldConsolidationForceRetry(ctx, j); err != nil {
-			return svc.publishEvent(ctx, geocube.ConsolidationRetryFailed, j, err.Error())
+			return svc.publishFailure(ctx, geocube.ConsolidationRetryFailed, j, err)
 		}
-		return svc.publishEvent(ctx, geocube.OrdersPrepared, j, "")
+		return nil
 
 	case geocube.JobStateABORTED:
 		if err := svc.csldRollback(ctx, j); err != nil {
-			return svc.publishEvent(ctx, geocube.RollbackFailed, j, err.Error())
+			return svc.publishFailure(ctx, geocube.RollbackFailed, j, err)
 		}
-		return svc.publishEvent(ctx, geocube.RollbackDone, j, "")
+		return nil
 
 	case geocube.JobStateROLLBACKFAILED:
 		j.LogErr("Rollback failed")
@@ -137,16 +139,12 @@ func (svc Service) csldInit(ctx context.Context, job *geocube.Job, datasetsID []
 		}
 		log.Logger(ctx).Sugar().Debugf("SaveJob: %v\n", time.Since(start))
 
-		return nil
+		// Start the job
+		return svc.publishEvent(ctx, txn, geocube.JobCreated, job, "")
 	}); err != nil {
 		return fmt.Errorf("csldInit.%w", err)
 	}
-
-	// Start the job
 	log.Logger(ctx).Sugar().Debug("new consolidation job started")
-	if err := svc.csldOnEnterNewState(ctx, job); err != nil {
-		return fmt.Errorf("csldInit.%w", err)
-	}
 	return nil
 }
 
@@ -172,7 +170,7 @@ func (svc *Service) csldPrepareOrders(ctx context.Context, job *geocube.Job) err
 		// Get all the records id and datetime of the job
 		recordsTime := make(map[string]string)
 		{
-			records, err := txn.FindRecords(ctx, "", nil, time.Time{}, time.Time{}, job.ID, nil, 0, 0, false, false)
+			records, err := txn.FindRecords(ctx, "", nil, time.Time{}, time.Time{}, job.ID, nil, 0, 0, nil, false, false)
 			if err != nil {
 				return fmt.Errorf("csldPrepareOrders.%w", err)
 			}
@@ -250,8 +248,8 @@ func (svc *Service) csldPrepareOrders(ctx context.Context, job *geocube.Job) err
 			uniqueDatasetsID := utils.StringSet{}
 			{
 				// Retrieve all the datasets covering the cell
-				ds, err := txn.FindDatasets(ctx, geocube.DatasetStatusACTIVE, nil, job.ID, nil, nil, geocube.Metadata{},
-					time.Time{}, time.Time{}, &cell.GeographicRing, &cell.Ring, 0, 0, true)
+				ds, err := txn.FindDatasets(ctx, geocube.DatasetStatusACTIVE, nil, job.ID, nil, nil, nil,
+					time.Time{}, time.Time{}, &cell.GeographicRing, &cell.Ring, 0, 0, nil, true)
 				if err != nil {
 					return fmt.Errorf("csldPrepareOrders.%w", err)
 				}
@@ -402,7 +400,10 @@ func (svc *Service) csldPrepareOrders(ctx context.Context, job *geocube.Job) err
 		job.LogMsgf(geocube.INFO, "Consolidation orders prepared (%d task(s))", len(job.Tasks))
 
 		// Save job
-		return svc.saveJob(ctx, txn, job)
+		if err := svc.saveJob(ctx, txn, job); err != nil {
+			return err
+		}
+		return svc.publishEvent(ctx, txn, geocube.OrdersPrepared, job, "")
 	})
 }
 
@@ -528,10 +529,12 @@ func (svc *Service) csldSendOrders(ctx context.Context, job *geocube.Job) error
 	}
 
 	var consolidationOrders [][]byte
+	var consolidationAttributes []map[string]string
 	allTasksDone := true
 	for _, task := range job.Tasks {
 		if task.State == geocube.TaskStateNEW {
 			consolidationOrders = append(consolidationOrders, task.Payload)
+			consolidationAttributes = append(consolidationAttributes, geocube.ConsolidationEventAttributes(job.ID, task.ID))
 			if err := job.UpdateTask(*geocube.NewTaskEvent(job.ID, task.ID, geocube.TaskSent, nil)); err != nil {
 				return err
 			}
@@ -541,19 +544,29 @@ func (svc *Service) csldSendOrders(ctx context.Context, job *geocube.Job) error
 		}
 	}
 
-	// Save job
-	if err := svc.saveJob(ctx, nil, job); err != nil {
-		return err
-	}
-
 	// If all the tasks are done, consolidation is done
 	if allTasksDone {
 		job.LogMsg(geocube.INFO, "No consolidation orders found !")
-		return svc.publishEvent(ctx, geocube.ConsolidationDone, job, "")
+		return svc.saveJobAndPublishEvent(ctx, geocube.ConsolidationDone, job)
+	}
+
+	// Save job and orders in the same transaction
+	if svc.outboxEnabled() {
+		return svc.unitOfWork(ctx, func(txn database.GeocubeTxBackend) error {
+			if err := svc.saveJob(ctx, txn, job); err != nil {
+				return err
+			}
+			return svc.storeInOutbox(ctx, txn, outboxConsolidationsQueue, consolidationOrders)
+		})
+	}
+
+	// Save job
+	if err := svc.saveJob(ctx, nil, job); err != nil {
+		return err
 	}
 
 	// Publish
-	return svc.consolidationPublisher.Publish(ctx, consolidationOrders...)
+	return messaging.PublishWithAttributes(ctx, svc.consolidationPublisher, consolidationAttributes, consolidationOrders...)
 }
 
 func (svc *Service) csldIndex(ctx context.Context, job *geocube.Job) (err error) {
@@ -563,15 +576,18 @@ func (svc *Service) csldIndex(ctx context.Context, job *geocube.Job) (err error)
 	if job.Tasks, err = svc.db.ReadTasks(ctx, job.ID, []geocube.TaskState{geocube.TaskStateDONE}); err != nil {
 		return err
 	}
+	if len(job.Tasks) == 0 {
+		return svc.publishEvent(ctx, nil, geocube.ConsolidationIndexed, job, "")
+	}
 
-	// Create new datasets
+	// Create new datasets (the event is published with the last one)
 	for len(job.Tasks) > 0 {
 		err := svc.unitOfWork(ctx, func(txn database.GeocubeTxBackend) error {
 			container, records, err := job.Tasks[0].ConsolidationOutput()
 			if err != nil {
 				return fmt.Errorf("csldIndex.%w", err)
 			}
-			newContainer, err := geocube.NewContainerFromConsolidation(container)
+			newContainer, err := geocube.NewContainerFromConsolidation(container, job.Tasks[0].Checksum)
 			if err != nil {
 				return fmt.Errorf("csldIndex.%w", err)
 			}
@@ -632,7 +648,13 @@ func (svc *Service) csldIndex(ctx context.Context, job *geocube.Job) (err error)
 			job.LogMsg(geocube.DEBUG, "Datasets indexed")
 
 			// Save job
-			return svc.saveJob(ctx, txn, job)
+			if err := svc.saveJob(ctx, txn, job); err != nil {
+				return err
+			}
+			if len(job.Tasks) == 0 {
+				return svc.publishEvent(ctx, txn, geocube.ConsolidationIndexed, job, "")
+			}
+			return nil
 		})
 		if err != nil {
 			return err
@@ -641,6 +663,46 @@ func (svc *Service) csldIndex(ctx context.Context, job *geocube.Job) (err error)
 	return nil
 }
 
+// csldVerifyOutput checks that the size and the checksums of the container uploaded by the consolidation task
+// are the ones computed by the consolidater. Only the size can be verified if the storage does not provide any checksum.
+func (svc *Service) csldVerifyOutput(ctx context.Context, task *geocube.Task, evt geocube.TaskEvent) error {
+	if evt.Checksum == "" {
+		// Consolidater of a previous version
+		return nil
+	}
+	expected, err := storage.ParseChecksums(evt.Checksum)
+	if err != nil {
+		return fmt.Errorf("csldVerifyOutput.%w", err)
+	}
+	container, _, err := task.ConsolidationOutput()
+	if err != nil {
+		return fmt.Errorf("csldVerifyOutput.%w", err)
+	}
+	containerURI, err := uri.ParseUri(container.URI)
+	if err != nil {
+		return fmt.Errorf("csldVerifyOutput[%s]: %w", container.URI, err)
+	}
+
+	attrs, err := containerURI.GetAttrs(ctx)
+	if err != nil {
+		if utils.Temporary(err) {
+			return err
+		}
+		return fmt.Errorf("csldVerifyOutput[%s]: %w", container.URI, err)
+	}
+	if attrs.Size != evt.Size {
+		return fmt.Errorf("csldVerifyOutput[%s]: %w: expected size %d, found %d", container.URI, storage.ErrChecksumMismatch, evt.Size, attrs.Size)
+	}
+	verified, err := expected.Verify(attrs.Checksums)
+	if err != nil {
+		return fmt.Errorf("csldVerifyOutput[%s]: %w", container.URI, err)
+	}
+	if !verified {
+		log.Logger(ctx).Sugar().Debugf("%s: no checksum provided by the storage, only the size has been verified", container.URI)
+	}
+	return nil
+}
+
 func (svc *Service) csldSwapDatasets(ctx context.Context, job *geocube.Job) error {
 	job.LogMsg(geocube.INFO, "Swap datasets...")
 
@@ -662,22 +724,23 @@ func (svc *Service) csldSwapDatasets(ctx context.Context, job *geocube.Job) erro
 		job.ReleaseDatasets(geocube.LockFlagNEW)
 		job.LogMsg(geocube.INFO, "Datasets swapped")
 		// Persist changes in db
-		return svc.saveJob(ctx, txn, job)
+		if err := svc.saveJob(ctx, txn, job); err != nil {
+			return err
+		}
+		return svc.publishEvent(ctx, txn, geocube.DatasetsSwapped, job, "")
 	})
 }
 
 func (svc *Service) csldDeleteDatasets(ctx context.Context, job *geocube.Job) error {
-	var deletionJob *geocube.Job
-
 	// Persist the jobs
 	if err := svc.unitOfWork(ctx, func(txn database.GeocubeTxBackend) error {
 		// Get Dataset to delete
-		datasets, err := txn.FindDatasets(ctx, geocube.DatasetStatusTODELETE, nil, job.ID, nil, nil, geocube.Metadata{}, time.Time{}, time.Time{}, nil, nil, 0, 0, true)
+		datasets, err := txn.FindDatasets(ctx, geocube.DatasetStatusTODELETE, nil, job.ID, nil, nil, nil, time.Time{}, time.Time{}, nil, nil, 0, 0, nil, true)
 		if err != nil {
 			return err
 		}
 		if len(datasets) == 0 {
-			return nil
+			return svc.publishEvent(ctx, txn, geocube.DeletionStarted, job, "")
 		}
 		ids := make([]string, len(datasets))
 		for i, dataset := range datasets {
@@ -685,7 +748,7 @@ func (svc *Service) csldDeleteDatasets(ctx context.Context, job *geocube.Job) er
 		}
 
 		// Create a deletion job
-		deletionJob = geocube.NewDeletionJob(job.Name+"_deletion_"+uuid.New().String(), geocube.ExecutionAsynchronous)
+		deletionJob := geocube.NewDeletionJob(job.Name+"_deletion_"+uuid.New().String(), geocube.ExecutionAsynchronous)
 
 		// Lock datasets for deletion
 		deletionJob.LockDatasets(ids, geocube.LockFlagTODELETE)
@@ -701,15 +764,15 @@ func (svc *Service) csldDeleteDatasets(ctx context.Context, job *geocube.Job) er
 		if err := svc.saveJob(ctx, txn, deletionJob); err != nil {
 			return err
 		}
-		return nil
+
+		// Start the deletion job
+		if err := svc.publishEvent(ctx, txn, geocube.JobCreated, deletionJob, ""); err != nil {
+			return err
+		}
+		return svc.publishEvent(ctx, txn, geocube.DeletionStarted, job, "")
 	}); err != nil {
 		return fmt.Errorf("csldDeleteDatasets.%w", err)
 	}
-	if deletionJob != nil {
-		if err := svc.delOnEnterNewState(ctx, deletionJob); err != nil {
-			return fmt.Errorf("csldDeleteDatasets.%w", err)
-		}
-	}
 	return nil
 }
 
@@ -750,7 +813,7 @@ func (svc *Service) csldCancel(ctx context.Context, job *geocube.Job) error {
 	job.LogMsg(geocube.INFO, "Cancel all tasks...")
 
 	if job.ActiveTasks == 0 {
-		return nil
+		return svc.publishEvent(ctx, nil, geocube.CancellationDone, job, "")
 	}
 
 	var err error
@@ -787,10 +850,7 @@ func (svc *Service) csldCancel(ctx context.Context, job *geocube.Job) error {
 	}
 
 	job.LogMsg(geocube.INFO, "Job and associated tasks are cancelled")
-	if err = svc.saveJob(ctx, nil, job); err != nil {
-		return err
-	}
-	return nil
+	return svc.saveJobAndPublishEvent(ctx, geocube.CancellationDone, job)
 }
 
 // csldConsolidationRetry retries failed tasks
@@ -804,7 +864,7 @@ func (svc *Service) csldConsolidationRetry(ctx context.Context, job *geocube.Job
 	}
 	// Reset and save task status
 	job.ResetTasks([]geocube.TaskState{geocube.TaskStateFAILED})
-	return svc.saveJob(ctx, nil, job)
+	return svc.saveJobAndPublishEvent(ctx, geocube.OrdersPrepared, job)
 }
 
 // csldConsolidationForceRetry retries FAILED, NEW and PENDING tasks
@@ -820,7 +880,7 @@ func (svc *Service) csldConsolidationForceRetry(ctx context.Context, job *geocub
 	active := job.ActiveTasks
 	job.ResetTasks([]geocube.TaskState{geocube.TaskStateNEW, geocube.TaskStateFAILED, geocube.TaskStatePENDING})
 	job.LogMsgf(geocube.DEBUG, "Set %d tasks to retry", job.ActiveTasks-active)
-	return svc.saveJob(ctx, nil, job)
+	return svc.saveJobAndPublishEvent(ctx, geocube.OrdersPrepared, job)
 }
 
 func (svc *Service) csldRollback(ctx context.Context, job *geocube.Job) error {
@@ -860,7 +920,7 @@ func (svc *Service) csldRollback(ctx context.Context, job *geocube.Job) error {
 	job.ReleaseDatasets(geocube.LockFlagINIT)
 
 	// Persist DeleteTasks and ReleaseDatasets
-	if err = svc.saveJob(ctx, nil, job); err != nil {
+	if err = svc.saveJobAndPublishEvent(ctx, geocube.RollbackDone, job); err != nil {
 		return fmt.Errorf("Rollback.%w", err)
 	}
 
@@ -868,25 +928,10 @@ func (svc *Service) csldRollback(ctx context.Context, job *geocube.Job) error {
 }
 
 // csldSubFncDeleteContainers deletes containers, ignoring FileNotFoundError
+// Other errors are logged but not returned
 func (svc *Service) csldSubFncDeleteContainers(ctx context.Context, containersURI []string) error {
-	// Delete containers
-	workers := 20
-	tasks := make(chan string)
-	wg := sync.WaitGroup{}
-
-	wg.Add(workers)
-	for w := 0; w < workers; w++ {
-		go func() {
-			defer wg.Done()
-			for task := range tasks {
-				svc.opSubFncDeleteContainer(ctx, task)
-			}
-		}()
-	}
-	for _, task := range containersURI {
-		tasks <- task
+	if err := svc.opSubFncDeleteContainers(ctx, containersURI, func(string, error) {}); err != nil {
+		log.Logger(ctx).Sugar().Warnf("csldSubFncDeleteContainers: %v", err)
 	}
-	close(tasks)
-	wg.Wait()
 	return nil
 }
@@ -1,3 +1,51 @@
 package svc
 
+import (
+	"context"
+
+	"github.com/airbusgeo/geocube/internal/geocube"
+	internalImage "github.com/airbusgeo/geocube/internal/image"
+	"github.com/airbusgeo/geocube/internal/utils/bitmap"
+)
+
 var CsldPrepareOrdersNeedReconsolidation = csldPrepareOrdersNeedReconsolidation
+
+var RelayOutboxMessages = (*Service).relayOutboxMessages
+
+var BinRecords = (*CompositingOptions).binRecords
+
+var FindIdenticalRecord = (*Service).findIdenticalRecord
+
+func cubeBandGroups(groups []BandGroup) []cubeBandGroup {
+	cubeGroups := make([]cubeBandGroup, len(groups))
+	for i, group := range groups {
+		cubeGroups[i] = cubeBandGroup{BandGroup: group}
+	}
+	return cubeGroups
+}
+
+func ConcatBandGroups(groups []BandGroup, bmps []*bitmap.Bitmap) (*bitmap.Bitmap, []BandGroup, error) {
+	return concatBandGroups(mergeDatasetJob{Groups: cubeBandGroups(groups)}, bmps)
+}
+
+func MergeBandGroups(ctx context.Context, groups []BandGroup, slice SliceMeta, skipIncomplete bool) (*bitmap.Bitmap, []BandGroup, error) {
+	return mergeBandGroups(ctx, mergeDatasetJob{Groups: cubeBandGroups(groups), Slice: slice, SkipIncomplete: skipIncomplete})
+}
+
+func SkipIncompleteSlices(datasetsByRecord []SliceMeta, grecords [][]*geocube.Record, groups []BandGroup) ([]SliceMeta, [][]*geocube.Record) {
+	return skipIncompleteSlices(datasetsByRecord, grecords, cubeBandGroups(groups))
+}
+
+func CheckBandGroupsDType(groups []BandGroup, options GetCubeOptions) error {
+	return checkBandGroupsDType(cubeBandGroups(groups), options)
+}
+
+func StackCubeSlices(ctx context.Context, slices <-chan CubeSlice, groups []BandGroup, outDesc internalImage.GdalDatasetDescriptor, format string) <-chan CubeSlice {
+	cubeGroups := cubeBandGroups(groups)
+	for i := range cubeGroups {
+		cubeGroups[i].outDesc = outDesc
+	}
+	return stackCubeSlices(ctx, slices, cubeGroups, format, "")
+}
+
+var ComputeValidShapesBatchSize = &computeValidShapesBatchSize
@@ -0,0 +1,220 @@
+package svc_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/airbusgeo/godal"
+	"github.com/airbusgeo/mucog"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/airbusgeo/geocube/cmd"
+	"github.com/airbusgeo/geocube/interface/database/memdb"
+	"github.com/airbusgeo/geocube/interface/messaging"
+	"github.com/airbusgeo/geocube/interface/messaging/memqueue"
+	geocubeStorage "github.com/airbusgeo/geocube/interface/storage"
+	"github.com/airbusgeo/geocube/interface/storage/mem"
+	"github.com/airbusgeo/geocube/internal/geocube"
+	"github.com/airbusgeo/geocube/internal/image"
+	pb "github.com/airbusgeo/geocube/internal/pb"
+	"github.com/airbusgeo/geocube/internal/svc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// Whole consolidation run, as in the all-in-one mode: the server and the consolidater share in-memory queues,
+// the database is in memory and the files are stored in mem://
+var _ = Describe("Consolidation in memory", func() {
+
+	const (
+		inputURI      = "mem://inputs/image.tif"
+		ingestionPath = "mem://geocube/ingestion"
+		cancelledPath = "mem://geocube/cancelled"
+		width, height = 256, 256
+	)
+
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+
+		memStrategy geocubeStorage.Strategy
+		service     *svc.Service
+
+		recordToUse   *geocube.Record
+		instanceToUse *geocube.VariableInstance
+		jobToUse      *geocube.Job
+
+		returnedError error
+	)
+
+	// pull calls the callback on the messages of the consumer until the context is done
+	pull := func(consumer messaging.Consumer, cb messaging.Callback) {
+		for ctx.Err() == nil {
+			if err := consumer.Pull(ctx, cb); err != nil {
+				return
+			}
+		}
+	}
+
+	BeforeEach(func() {
+		godal.RegisterAll()
+		ctx, cancel = context.WithCancel(context.Background())
+
+		var err error
+		memStrategy, err = mem.NewMemStrategy(ctx)
+		Expect(err).To(BeNil())
+		Expect(uploadGeotiff(ctx, memStrategy, inputURI, width, height, [6]float64{2, 0.001, 0, 44, 0, -0.001})).To(Succeed())
+
+		// Server
+		eventsQueue, consolidationsQueue := memqueue.NewQueue("events"), memqueue.NewQueue("consolidations")
+		eventPublisher := memqueue.NewPublisher(eventsQueue)
+		service, err = svc.New(ctx, memdb.New(), eventPublisher, memqueue.NewPublisher(consolidationsQueue), ingestionPath, cancelledPath, 1)
+		Expect(err).To(BeNil())
+		go pull(memqueue.NewConsumer(eventsQueue), func(ctx context.Context, m *messaging.Message) error {
+			evt, err := geocube.UnmarshalEvent(bytes.NewReader(m.Data))
+			if err != nil {
+				return err
+			}
+			return service.HandleEvent(ctx, evt)
+		})
+
+		// Consolidater
+		handlerConsolidation := image.NewHandleConsolidation(image.NewCogGenerator(), image.NewMucogGenerator(), cancelledPath, 1, math.MaxInt64)
+		worker := cmd.NewConsolidationWorker(handlerConsolidation, eventPublisher, os.TempDir(), memqueue.DefaultMaxTries-1)
+		go pull(memqueue.NewConsumer(consolidationsQueue, memqueue.WithDeadLetterHandler(worker.NotifyDeadLetter)), worker.Process)
+
+		// Catalog
+		dformat := &pb.DataFormat{Dtype: pb.DataFormat_UInt8, NoData: 0, MinValue: 0, MaxValue: 255}
+		aoi, err := geocube.NewAOIFromProtobuf([]*pb.Polygon{{Linearrings: []*pb.LinearRing{{Points: []*pb.Coord{
+			{Lon: 2, Lat: 44}, {Lon: 2.256, Lat: 44}, {Lon: 2.256, Lat: 43.744}, {Lon: 2, Lat: 43.744}, {Lon: 2, Lat: 44},
+		}}}}}, false)
+		Expect(err).To(BeNil())
+		Expect(service.CreateAOI(ctx, aoi)).To(Succeed())
+
+		recordToUse, err = geocube.NewRecordFromProtobuf(&pb.NewRecord{Name: "inmemory", Time: timestamppb.New(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)), AoiId: aoi.ID})
+		Expect(err).To(BeNil())
+		Expect(service.CreateRecords(ctx, []*geocube.Record{recordToUse})).To(Succeed())
+
+		variable, err := geocube.NewVariableFromProtobuf(&pb.Variable{Name: "test/inmemory", Dformat: dformat, Bands: []string{""}, ResamplingAlg: pb.Resampling_NEAR})
+		Expect(err).To(BeNil())
+		Expect(service.CreateVariable(ctx, variable)).To(Succeed())
+		instanceToUse, err = geocube.NewInstance("default", nil)
+		Expect(err).To(BeNil())
+		Expect(service.InstantiateVariable(ctx, variable.ID, instanceToUse)).To(Succeed())
+		params, err := geocube.NewConsolidationParamsFromProtobuf(&pb.ConsolidationParams{Dformat: dformat, Exponent: 1, ResamplingAlg: pb.Resampling_NEAR, Compression: pb.ConsolidationParams_LOSSLESS})
+		Expect(err).To(BeNil())
+		Expect(service.ConfigConsolidation(ctx, variable.ID, *params)).To(Succeed())
+
+		layout, err := geocube.NewLayoutFromProtobuf(&pb.Layout{
+			Name:               "inmemory",
+			GridParameters:     map[string]string{"grid": "singlecell", "proj": "epsg", "crs": "4326", "resolution": "0.001"},
+			BlockXSize:         256,
+			BlockYSize:         256,
+			MaxRecords:         10,
+			InterlacingPattern: mucog.MUCOGPattern,
+		}, false)
+		Expect(err).To(BeNil())
+		Expect(service.CreateLayout(ctx, layout)).To(Succeed())
+
+		// Indexation
+		container, err := geocube.NewContainerFromProtobuf(&pb.Container{Uri: inputURI})
+		Expect(err).To(BeNil())
+		dataset, err := geocube.NewDatasetFromProtobuf(&pb.Dataset{RecordId: recordToUse.ID, InstanceId: instanceToUse.ID, Bands: []int64{1}, Dformat: dformat}, inputURI, true)
+		Expect(err).To(BeNil())
+		_, err = service.IndexExternalDatasets(ctx, container, []*geocube.Dataset{dataset}, svc.IndexationOptions{})
+		Expect(err).To(BeNil())
+
+		jobToUse, err = geocube.NewConsolidationJob("inmemory", layout.Name, instanceToUse.ID, "", geocube.ExecutionAsynchronous)
+		Expect(err).To(BeNil())
+	})
+
+	JustBeforeEach(func() {
+		returnedError = service.ConsolidateFromRecords(ctx, jobToUse, []string{recordToUse.ID})
+	})
+
+	AfterEach(func() {
+		cancel()
+		mem.DefaultStore().Reset()
+	})
+
+	It("it should consolidate the dataset into a new container", func() {
+		Expect(returnedError).To(BeNil())
+		Eventually(func() geocube.JobState {
+			job, err := service.GetJob(ctx, jobToUse.ID)
+			Expect(err).To(BeNil())
+			return job.State
+		}, "60s", "100ms").Should(Equal(geocube.JobStateDONE))
+
+		datasets, err := service.ListRecordsDatasets(ctx, []string{recordToUse.ID}, []string{instanceToUse.ID})
+		Expect(err).To(BeNil())
+		Expect(datasets).To(HaveLen(1))
+		Expect(strings.HasPrefix(datasets[0].ContainerURI, ingestionPath+"/")).To(BeTrue())
+
+		exist, err := memStrategy.Exist(ctx, datasets[0].ContainerURI)
+		Expect(err).To(BeNil())
+		Expect(exist).To(BeTrue())
+		ds, err := godal.Open(datasets[0].ContainerURI)
+		Expect(err).To(BeNil())
+		defer ds.Close()
+		Expect(ds.Structure().SizeX).To(Equal(width))
+		Expect(ds.Structure().SizeY).To(Equal(height))
+	})
+})
+
+// uploadGeotiff creates a one-band Byte GeoTIFF in EPSG:4326 and uploads it
+func uploadGeotiff(ctx context.Context, strategy geocubeStorage.Strategy, uri string, width, height int, transform [6]float64) error {
+	vsiPath := path.Join("/vsimem", path.Base(uri))
+	ds, err := godal.Create(godal.GTiff, vsiPath, 1, godal.Byte, width, height)
+	if err != nil {
+		return err
+	}
+	sr, err := godal.NewSpatialRefFromEPSG(4326)
+	if err != nil {
+		ds.Close()
+		return err
+	}
+	defer sr.Close()
+	buf := make([]byte, width*height)
+	for i := range buf {
+		buf[i] = byte(1 + i%200)
+	}
+	band := ds.Bands()[0]
+	if err := ds.SetSpatialRef(sr); err != nil {
+		ds.Close()
+		return err
+	}
+	if err := ds.SetGeoTransform(transform); err != nil {
+		ds.Close()
+		return err
+	}
+	if err := band.SetNoData(0); err != nil {
+		ds.Close()
+		return err
+	}
+	if err := band.Write(0, 0, buf, width, height); err != nil {
+		ds.Close()
+		return err
+	}
+	if err := ds.Close(); err != nil {
+		return err
+	}
+	defer godal.VSIUnlink(vsiPath)
+
+	f, err := godal.VSIOpen(vsiPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	data, err := io.ReadAll(f)
+	if err != nil {
+		return err
+	}
+	return strategy.Upload(ctx, uri, data)
+}
@@ -314,7 +314,7 @@ var _ = Describe("Consolidater", func() {
 				}
 				ctx := log.WithFields(ctx, zap.String("job", jobToUse.ID))
 				multipolygon := geom.NewMultiPolygonFlat(inputFeature.Geometry.Layout(), inputFeature.Geometry.FlatCoords(), inputFeature.Geometry.Endss())
-				geocubeTxBackendReturned.On("FindRecords", ctx, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(findRecordReturned, findRecordErrorReturned)
+				geocubeTxBackendReturned.On("FindRecords", ctx, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(findRecordReturned, findRecordErrorReturned)
 				geocubeTxBackendReturned.On("GetDatasetsGeometryUnion", ctx, mock.Anything).Return(multipolygon, nil)
 				geocubeTxBackendReturned.On("ReadLayout", ctx, mock.Anything).Return(&geocube.Layout{
 					Name:      "myLayout",
@@ -331,7 +331,7 @@ var _ = Describe("Consolidater", func() {
 					OverviewsMinSize:   geocube.NO_OVERVIEW,
 					InterlacingPattern: mucog.MUCOGPattern,
 				}, nil)
-				geocubeTxBackendReturned.On("FindDatasets", ctx, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]*geocube.Dataset{}, nil)
+				geocubeTxBackendReturned.On("FindDatasets", ctx, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]*geocube.Dataset{}, nil)
 				geocubeTxBackendReturned.On("ReleaseDatasets", ctx, mock.Anything, mock.Anything).Return(nil)
 				geocubeTxBackendReturned.On("UpdateJob", ctx, mock.Anything).Return(nil)
 			})
@@ -0,0 +1,204 @@
+package svc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"image"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/airbusgeo/geocube/internal/geocube"
+	internalImage "github.com/airbusgeo/geocube/internal/image"
+	pb "github.com/airbusgeo/geocube/internal/pb"
+	"github.com/airbusgeo/geocube/internal/utils"
+	"github.com/airbusgeo/geocube/internal/utils/bitmap"
+)
+
+// RecordsAttribute is the global attribute of a cube file listing the records of each time (as JSON)
+const RecordsAttribute = "geocube_records"
+
+// IsCubeFileFormat returns true if the format returns the whole cube as a single file
+func IsCubeFileFormat(format string) bool {
+	switch format {
+	case pb.FileFormat_NetCDF4.String(), pb.FileFormat_ZarrV2.String(), pb.FileFormat_ZarrV3.String():
+		return true
+	}
+	return false
+}
+
+// newCubeFileWriter returns the writer of a cube file in the given format, using workDir as scratch directory
+func newCubeFileWriter(format string, groups []cubeBandGroup, workDir string) (internalImage.CubeFileWriter, error) {
+	outDesc := groups[0].outDesc
+	desc := internalImage.CubeFileDescriptor{
+		WktCRS:    outDesc.WktCRS,
+		PixToCRS:  outDesc.PixToCRS,
+		Width:     outDesc.Width,
+		Height:    outDesc.Height,
+		Variables: make([]internalImage.CubeFileVariable, len(groups)),
+	}
+
+	// The variables are named after the instance if a variable is requested several times (or if its name is unknown)
+	nbVariables := map[string]int{}
+	for _, group := range groups {
+		nbVariables[group.Variable]++
+	}
+	for i, group := range groups {
+		v := internalImage.CubeFileVariable{
+			Name:        group.Variable,
+			Bands:       group.BandNames,
+			Unit:        group.Unit,
+			DataMapping: group.outDesc.DataMapping,
+		}
+		if nbVariables[group.Variable] > 1 || v.Name == "" {
+			instance := group.Instance
+			if instance == "" {
+				instance = group.InstanceID
+			}
+			v.Name = strings.Trim(v.Name+"_"+instance, "_")
+		}
+		if len(v.Bands) != group.Bands {
+			v.Bands = make([]string, group.Bands)
+			for b := range v.Bands {
+				v.Bands[b] = strconv.Itoa(b + 1)
+			}
+		}
+		desc.Variables[i] = v
+	}
+
+	switch format {
+	case pb.FileFormat_NetCDF4.String():
+		return internalImage.NewNetCDFWriter(desc, workDir)
+	case pb.FileFormat_ZarrV2.String():
+		return internalImage.NewZarrWriter(desc, 2, workDir)
+	case pb.FileFormat_ZarrV3.String():
+		return internalImage.NewZarrWriter(desc, 3, workDir)
+	}
+	return nil, fmt.Errorf("unsupported cube file format: %s", format)
+}
+
+// cubeFileRecords is the description of the records of a time of a cube file (see RecordsAttribute)
+type cubeFileRecords struct {
+	Datetime time.Time        `json:"datetime"`
+	Records  []cubeFileRecord `json:"records"`
+}
+
+type cubeFileRecord struct {
+	ID   string            `json:"id"`
+	Name string            `json:"name"`
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// stackCubeSlices writes the slices in a single cube file and returns it as a unique slice.
+// The time of a slice is the datetime of its first record. The global attributes are the tags shared by all the records
+// and the description of the records of each time (see RecordsAttribute).
+// The slices without enough valid pixels (EntityNotFound) are skipped. The other errors are forwarded and stop the stacking.
+// The cube file is built in workDir (the temporary directory if empty) and streamed: its resources are released when it has been read or when the context is cancelled.
+func stackCubeSlices(ctx context.Context, slices <-chan CubeSlice, groups []cubeBandGroup, format, workDir string) <-chan CubeSlice {
+	out := make(chan CubeSlice)
+	go func() {
+		defer close(out)
+		send := func(slice CubeSlice) {
+			select {
+			case out <- slice:
+			case <-ctx.Done():
+			}
+		}
+
+		writer, err := newCubeFileWriter(format, groups, workDir)
+		if err != nil {
+			send(CubeSlice{Err: fmt.Errorf("stackCubeSlices.%w", err), Metadata: map[string]string{}})
+			return
+		}
+		defer writer.Close()
+
+		var records []*geocube.Record
+		var timeRecords []cubeFileRecords
+		start := time.Now()
+		for slice := range slices {
+			if slice.Err != nil {
+				if geocube.IsError(slice.Err, geocube.EntityNotFound) {
+					// The image has not enough valid pixels: it is skipped, as with the other formats
+					continue
+				}
+				send(slice)
+				return
+			}
+			bytes, err := slice.Image.ReadAllBytes()
+			if err != nil {
+				send(CubeSlice{Err: fmt.Errorf("stackCubeSlices.%w", err), Records: slice.Records, Metadata: map[string]string{}})
+				return
+			}
+			data := make([][]byte, len(slice.BandGroups))
+			for i, group := range slice.BandGroups {
+				if group.Size > len(bytes) {
+					send(CubeSlice{Err: fmt.Errorf("stackCubeSlices: inconsistent size of the band groups"), Records: slice.Records, Metadata: map[string]string{}})
+					return
+				}
+				data[i], bytes = bytes[:group.Size], bytes[group.Size:]
+			}
+			if err := writer.WriteSlice(slice.Records[0].Time, data, slice.Image.ByteOrder); err != nil {
+				send(CubeSlice{Err: fmt.Errorf("stackCubeSlices.%w", err), Records: slice.Records, Metadata: map[string]string{}})
+				return
+			}
+
+			records = append(records, slice.Records...)
+			tr := cubeFileRecords{Datetime: slice.Records[0].Time}
+			for _, r := range slice.Records {
+				tr.Records = append(tr.Records, cubeFileRecord{ID: r.ID, Name: string(r.Name), Tags: r.Tags})
+			}
+			timeRecords = append(timeRecords, tr)
+		}
+		if utils.IsCancelled(ctx) {
+			return
+		}
+		if len(records) == 0 {
+			send(CubeSlice{Err: fmt.Errorf("stackCubeSlices: no image in the cube"), Metadata: map[string]string{}})
+			return
+		}
+
+		// Global attributes
+		attributes := map[string]string{}
+		for key, value := range records[0].Tags {
+			attributes[key] = value
+			for _, r := range records[1:] {
+				if v, ok := r.Tags[key]; !ok || v != value {
+					delete(attributes, key)
+					break
+				}
+			}
+		}
+		recordsJSON, err := json.Marshal(timeRecords)
+		if err != nil {
+			send(CubeSlice{Err: fmt.Errorf("stackCubeSlices.Marshal: %w", err), Metadata: map[string]string{}})
+			return
+		}
+		attributes[RecordsAttribute] = string(recordsJSON)
+
+		file, err := writer.Finalize(attributes)
+		if err != nil {
+			send(CubeSlice{Err: fmt.Errorf("stackCubeSlices.%w", err), Records: records, Metadata: map[string]string{}})
+			return
+		}
+
+		nbBands := 0
+		for _, group := range groups {
+			nbBands += group.Bands
+		}
+		bmp := bitmap.NewBitmapHeader(image.Rect(0, 0, groups[0].outDesc.Width, groups[0].outDesc.Height), groups[0].DataFormat.DType, nbBands)
+		bmp.Chunks = file
+		send(CubeSlice{
+			Image:    bmp,
+			Records:  records,
+			Metadata: map[string]string{fmt.Sprintf("Stack %d", len(timeRecords)): fmt.Sprintf("%v", time.Since(start))},
+		})
+
+		// Keep the writer open until the file has been streamed
+		select {
+		case <-file.Done():
+		case <-ctx.Done():
+		}
+	}()
+	return out
+}
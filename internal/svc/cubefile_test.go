@@ -0,0 +1,135 @@
+package svc_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"image"
+	"io"
+	"time"
+
+	"github.com/airbusgeo/geocube/internal/geocube"
+	internalImage "github.com/airbusgeo/geocube/internal/image"
+	"github.com/airbusgeo/geocube/internal/svc"
+	"github.com/airbusgeo/geocube/internal/utils/affine"
+	"github.com/airbusgeo/geocube/internal/utils/bitmap"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("StackCubeSlices", func() {
+
+	var (
+		ctx     = context.Background()
+		groups  = []svc.BandGroup{{Variable: "ndvi", Bands: 1, DataFormat: geocube.DataFormat{DType: bitmap.DTypeUINT8, NoData: 0, Range: geocube.Range{Min: 1, Max: 255}}}}
+		outDesc = internalImage.GdalDatasetDescriptor{
+			WktCRS:   "EPSG:4326",
+			PixToCRS: affine.NewAffine(10, 1, 0, 20, 0, -1),
+			Width:    2,
+			Height:   3,
+			Bands:    1,
+			DataMapping: geocube.DataMapping{
+				DataFormat: groups[0].DataFormat,
+				RangeExt:   geocube.Range{Min: 0, Max: 1},
+				Exponent:   1,
+			},
+		}
+
+		inputSlices    []svc.CubeSlice
+		returnedSlices []svc.CubeSlice
+		returnedFile   []byte
+	)
+
+	newSlice := func(i int) svc.CubeSlice {
+		bmp := bitmap.NewBitmapHeader(image.Rect(0, 0, 2, 3), bitmap.DTypeUINT8, 1)
+		bmp.Chunks = &bitmap.ByteArray{Bytes: []byte{1, 2, 3, 4, 5, 6}}
+		group := groups[0]
+		group.Size = 6
+		return svc.CubeSlice{
+			Image:      bmp,
+			Records:    []*geocube.Record{{ID: fmt.Sprintf("record%d", i), Time: time.Unix(int64(1000*i), 0)}},
+			Metadata:   map[string]string{},
+			BandGroups: []svc.BandGroup{group},
+		}
+	}
+
+	JustBeforeEach(func() {
+		slices := make(chan svc.CubeSlice, len(inputSlices))
+		for _, slice := range inputSlices {
+			slices <- slice
+		}
+		close(slices)
+		returnedSlices, returnedFile = nil, nil
+		for slice := range svc.StackCubeSlices(ctx, slices, groups, outDesc, "ZarrV2") {
+			if slice.Err == nil {
+				// The file must be read to release the writer
+				var err error
+				returnedFile, err = slice.Image.ReadAllBytes()
+				Expect(err).To(BeNil())
+			}
+			returnedSlices = append(returnedSlices, slice)
+		}
+	})
+
+	Context("with an empty record", func() {
+		BeforeEach(func() {
+			empty := newSlice(2)
+			empty.Image, empty.Err = nil, geocube.NewEntityNotFound("", "", "", "Not enough valid pixels (skipped)")
+			inputSlices = []svc.CubeSlice{newSlice(1), empty, newSlice(3)}
+		})
+
+		It("should skip the record", func() {
+			Expect(returnedSlices).To(HaveLen(1))
+			Expect(returnedSlices[0].Err).To(BeNil())
+			Expect(returnedSlices[0].Records).To(HaveLen(2))
+			Expect(returnedSlices[0].Records[1].ID).To(Equal("record3"))
+
+			var timeRecords []map[string]interface{}
+			Expect(json.Unmarshal([]byte(readZarrAttributes(returnedFile)[svc.RecordsAttribute].(string)), &timeRecords)).To(Succeed())
+			Expect(timeRecords).To(HaveLen(2))
+		})
+	})
+
+	Context("with a record in error", func() {
+		BeforeEach(func() {
+			failed := newSlice(2)
+			failed.Image, failed.Err = nil, fmt.Errorf("unable to read the dataset")
+			inputSlices = []svc.CubeSlice{newSlice(1), failed, newSlice(3)}
+		})
+
+		It("should forward the error", func() {
+			Expect(returnedSlices).To(HaveLen(1))
+			Expect(returnedSlices[0].Err).To(MatchError("unable to read the dataset"))
+		})
+	})
+
+	Context("with only empty records", func() {
+		BeforeEach(func() {
+			empty := newSlice(1)
+			empty.Image, empty.Err = nil, geocube.NewEntityNotFound("", "", "", "Not enough valid pixels (skipped)")
+			inputSlices = []svc.CubeSlice{empty}
+		})
+
+		It("should return an error", func() {
+			Expect(returnedSlices).To(HaveLen(1))
+			Expect(returnedSlices[0].Err).NotTo(BeNil())
+		})
+	})
+})
+
+// readZarrAttributes returns the global attributes of a Zarr v2 store in a zip archive
+func readZarrAttributes(file []byte) map[string]interface{} {
+	zr, err := zip.NewReader(bytes.NewReader(file), int64(len(file)))
+	Expect(err).To(BeNil())
+	f, err := zr.Open(".zattrs")
+	Expect(err).To(BeNil())
+	defer f.Close()
+	data, err := io.ReadAll(f)
+	Expect(err).To(BeNil())
+	attrs := map[string]interface{}{}
+	Expect(json.Unmarshal(data, &attrs)).To(BeNil())
+	return attrs
+}
@@ -2,7 +2,9 @@ package svc
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -42,6 +44,12 @@ func (svc *Service) handleJobEvt(ctx context.Context, evt geocube.JobEvent) erro
 	ctx = log.With(ctx, "job", job.Name)
 	log.Logger(ctx).Sugar().Debugf("JobEvt got (id:%s, err:%s)", evt.JobID, evt.Error)
 
+	// Events are delivered at least once: ignore the event if the job is no longer in the state it was sent from
+	if evt.State != nil && *evt.State != job.State {
+		log.Logger(ctx).Sugar().Warnf("JobEvt %s ignored: sent in state %s, but the job is in state %s (duplicate or outdated event)", evt.Status.String(), evt.State.String(), job.State.String())
+		return nil
+	}
+
 	// Trigger the event
 	if err = job.Trigger(evt); err != nil {
 		return fmt.Errorf("handleJobEvt.%w", err)
@@ -84,6 +92,16 @@ func (svc *Service) handleTaskEvt(ctx context.Context, evt geocube.TaskEvent) er
 	if job.State == geocube.JobStateFAILED {
 		return nil
 	}
+	if job.Type == geocube.JobTypeCONSOLIDATION && evt.Status == geocube.TaskSuccessful {
+		if err := svc.csldVerifyOutput(ctx, job.Tasks[0], evt); err != nil {
+			if utils.Temporary(err) {
+				return fmt.Errorf("handleTaskEvt(%s).%w", evt.TaskID, err)
+			}
+			// The task is failed, so that it can be retried
+			log.Logger(ctx).Sugar().Errorf("consolidation output: %v", err)
+			evt.Status, evt.Error = geocube.TaskFailed, err.Error()
+		}
+	}
 	job.Clean(true)
 
 	if err = job.UpdateTask(evt); err != nil {
@@ -92,53 +110,60 @@ func (svc *Service) handleTaskEvt(ctx context.Context, evt geocube.TaskEvent) er
 
 	job.LogMsgf(geocube.DEBUG, "TaskEvt received with status %s (id:%s, err:%s)", evt.Status.String(), evt.TaskID, evt.Error)
 
-	if err = svc.saveJob(ctx, nil, job); err != nil {
-		return fmt.Errorf("handleTaskEvt(%s).%w", evt.TaskID, err)
-	}
-	log.Logger(ctx).Sugar().Debugf("evt %s processed in %v", evt.Status.String(), time.Since(start))
-
-	if job.ActiveTasks == 0 {
+	// Save the job and publish its event in the same transaction
+	// A task event already applied does not change the job, but the event of the job is published again if it is still expected
+	if err = svc.unitOfWork(ctx, func(txn database.GeocubeTxBackend) error {
+		if err := svc.saveJob(ctx, txn, job); err != nil {
+			return err
+		}
+		if job.ActiveTasks > 0 {
+			return nil
+		}
 		switch job.State {
 		case geocube.JobStateCONSOLIDATIONCANCELLING:
 			job.LogMsg(geocube.INFO, "Job has been canceled")
-			return svc.publishEvent(ctx, geocube.CancellationDone, job, "")
-		case geocube.JobStateCANCELLATIONFAILED, geocube.JobStateABORTED, geocube.JobStateROLLBACKFAILED, geocube.JobStateFAILED:
-			return nil
-		}
-		if job.FailedTasks > 0 {
-			return svc.publishEvent(ctx, geocube.ConsolidationFailed, job,
-				fmt.Sprintf("Job failed: %d tasks failed\n", job.FailedTasks))
+			return svc.publishEvent(ctx, txn, geocube.CancellationDone, job, "")
+		case geocube.JobStateCONSOLIDATIONINPROGRESS:
+			if job.FailedTasks > 0 {
+				return svc.publishEvent(ctx, txn, geocube.ConsolidationFailed, job,
+					fmt.Sprintf("Job failed: %d tasks failed\n", job.FailedTasks))
+			}
+			return svc.publishEvent(ctx, txn, geocube.ConsolidationDone, job, "")
 		}
-		return svc.publishEvent(ctx, geocube.ConsolidationDone, job, "")
+		return nil
+	}); err != nil {
+		return fmt.Errorf("handleTaskEvt(%s).%w", evt.TaskID, err)
 	}
+	log.Logger(ctx).Sugar().Debugf("evt %s processed in %v", evt.Status.String(), time.Since(start))
 
 	return nil
 }
 
 // delOnEnterNewState should only returns publishing error
 // All the other errors must be handle by the state machine
+// On success, the actions publish their event in the same transaction as the job (see publishEvent)
 func (svc *Service) delOnEnterNewState(ctx context.Context, job *geocube.Job) error {
 	switch job.State {
 	case geocube.JobStateNEW:
-		return svc.publishEvent(ctx, geocube.JobCreated, job, "")
+		return svc.publishEvent(ctx, nil, geocube.JobCreated, job, "")
 
 	case geocube.JobStateCREATED:
 		if err := svc.delSetToDelete(ctx, job); err != nil {
-			return svc.publishEvent(ctx, geocube.DeletionNotReady, job, err.Error())
+			return svc.publishFailure(ctx, geocube.DeletionNotReady, job, err)
 		}
-		return svc.publishEvent(ctx, geocube.DeletionReady, job, "")
+		return nil
 
 	case geocube.JobStateDELETIONINPROGRESS:
 		if err := svc.delRemoveDatasets(ctx, job); err != nil {
-			return svc.publishEvent(ctx, geocube.RemovalFailed, job, err.Error())
+			return svc.publishFailure(ctx, geocube.RemovalFailed, job, err)
 		}
-		return svc.publishEvent(ctx, geocube.RemovalDone, job, "")
+		return nil
 
 	case geocube.JobStateDELETIONEFFECTIVE:
 		if err := svc.delDeleteContainers(ctx, job); err != nil {
-			return svc.publishEvent(ctx, geocube.DeletionFailed, job, err.Error())
+			return svc.publishFailure(ctx, geocube.DeletionFailed, job, err)
 		}
-		return svc.publishEvent(ctx, geocube.DeletionDone, job, "")
+		return nil
 
 	case geocube.JobStateDONE:
 		// Finished !
@@ -155,9 +180,9 @@ func (svc *Service) delOnEnterNewState(ctx context.Context, job *geocube.Job) er
 
 	case geocube.JobStateABORTED:
 		if err := svc.delRollback(ctx, job); err != nil {
-			return svc.publishEvent(ctx, geocube.RollbackFailed, job, err.Error())
+			return svc.publishFailure(ctx, geocube.RollbackFailed, job, err)
 		}
-		return svc.publishEvent(ctx, geocube.RollbackDone, job, "")
+		return nil
 
 	case geocube.JobStateROLLBACKFAILED:
 		job.LogErr("Rollback failed")
@@ -176,7 +201,7 @@ func (svc *Service) delOnEnterNewState(ctx context.Context, job *geocube.Job) er
 
 func (svc *Service) delInit(ctx context.Context, job *geocube.Job, instanceIDs, recordIDs, datasetPatterns []string) error {
 	if err := svc.unitOfWork(ctx, func(txn database.GeocubeTxBackend) (err error) {
-		datasets, err := txn.FindDatasets(ctx, geocube.DatasetStatusACTIVE, datasetPatterns, "", instanceIDs, recordIDs, geocube.Metadata{}, time.Time{}, time.Time{}, nil, nil, 0, 0, false)
+		datasets, err := txn.FindDatasets(ctx, geocube.DatasetStatusACTIVE, datasetPatterns, "", instanceIDs, recordIDs, nil, time.Time{}, time.Time{}, nil, nil, 0, 0, nil, false)
 		if err != nil {
 			return err
 		}
@@ -199,16 +224,12 @@ func (svc *Service) delInit(ctx context.Context, job *geocube.Job, instanceIDs,
 		}
 		log.Logger(ctx).Sugar().Debugf("SaveJob: %v\n", time.Since(start))
 
-		return nil
+		// Start the job
+		return svc.publishEvent(ctx, txn, geocube.JobCreated, job, "")
 	}); err != nil {
 		return fmt.Errorf("delInit.%w", err)
 	}
-
-	// Start the job
 	log.Logger(ctx).Sugar().Debug("new deletion job started")
-	if err := svc.delOnEnterNewState(ctx, job); err != nil {
-		return fmt.Errorf("delInit.%w", err)
-	}
 	return nil
 }
 
@@ -223,7 +244,10 @@ func (svc *Service) delSetToDelete(ctx context.Context, job *geocube.Job) error
 		}
 
 		// Persist changes in db
-		return svc.saveJob(ctx, txn, job)
+		if err := svc.saveJob(ctx, txn, job); err != nil {
+			return err
+		}
+		return svc.publishEvent(ctx, txn, geocube.DeletionReady, job, "")
 	})
 }
 
@@ -246,7 +270,10 @@ func (svc *Service) delRemoveDatasets(ctx context.Context, job *geocube.Job) err
 		}
 		// Persist changes in db
 		log.Logger(ctx).Debug("Save job")
-		return svc.saveJob(ctx, txn, job)
+		if err := svc.saveJob(ctx, txn, job); err != nil {
+			return err
+		}
+		return svc.publishEvent(ctx, txn, geocube.RemovalDone, job, "")
 	})
 }
 
@@ -262,7 +289,7 @@ func (svc *Service) opSubFncRemoveDatasetsAndContainers(ctx context.Context, txn
 	}
 
 	// Find the datasets of the job with the given status
-	datasets, err := txn.FindDatasets(ctx, datasetStatus, nil, job.ID, nil, nil, geocube.Metadata{}, time.Time{}, time.Time{}, nil, nil, 0, 0, true)
+	datasets, err := txn.FindDatasets(ctx, datasetStatus, nil, job.ID, nil, nil, nil, time.Time{}, time.Time{}, nil, nil, 0, 0, nil, true)
 	if err != nil {
 		return nil, fmt.Errorf("opRemoveJobDatasetsAndContainers.%w", err)
 	}
@@ -342,56 +369,44 @@ func (svc *Service) delDeleteContainers(ctx context.Context, job *geocube.Job) e
 	}
 	if len(job.Tasks) == 0 {
 		job.LogMsg(geocube.DEBUG, "Nothing to delete")
-		return nil
+		return svc.publishEvent(ctx, nil, geocube.DeletionDone, job, "")
 	}
 	job.LogMsgf(geocube.DEBUG, "Start deletion of %d containers...", len(job.Tasks))
 
 	// Delete containers
-	workers := utils.MinI(20, len(job.Tasks))
-	tasks := make(chan *geocube.Task)
-	wg := sync.WaitGroup{}
+	tasks := make(map[string]*geocube.Task, len(job.Tasks))
+	containersURI := make([]string, 0, len(job.Tasks))
+	for _, task := range job.Tasks {
+		containerURI, err := task.DeletionPayload()
+		if err != nil {
+			return err
+		}
+		tasks[containerURI] = task
+		containersURI = append(containersURI, containerURI)
+	}
+
 	mutex := sync.Mutex{}
 	var errs error
 	nbErrors := 0
+	svc.opSubFncDeleteContainers(ctx, containersURI, func(containerURI string, err error) {
+		status := geocube.TaskSuccessful
+		if err != nil {
+			status = geocube.TaskFailed
+		}
+		mutex.Lock()
+		defer mutex.Unlock()
+		job.UpdateTask(*geocube.NewTaskEvent(job.ID, tasks[containerURI].ID, status, err))
 
-	wg.Add(workers)
-	for w := 0; w < workers; w++ {
-		go func() error {
-			defer wg.Done()
-			for task := range tasks {
-				containerURI, err := task.DeletionPayload()
-				if err != nil {
-					return err
-				}
-				status := geocube.TaskSuccessful
-				if err = svc.opSubFncDeleteContainer(ctx, containerURI); err != nil {
-					status = geocube.TaskFailed
-				}
-				mutex.Lock()
-				if err != nil {
-					log.Logger(ctx).Sugar().Debugf("%v", err)
-				}
-				job.UpdateTask(*geocube.NewTaskEvent(job.ID, task.ID, status, err))
-
-				if err != nil {
-					nbErrors++
-					log.Logger(ctx).Sugar().Debugf("%v", err)
-					if nbErrors == 1000 {
-						errs = utils.MergeErrors(true, errs, utils.MakeTemporary(fmt.Errorf("more than 1000 errors")))
-					} else if nbErrors < 1000 {
-						errs = utils.MergeErrors(true, errs, err)
-					}
-				}
-				mutex.Unlock()
+		if err != nil {
+			nbErrors++
+			log.Logger(ctx).Sugar().Debugf("%v", err)
+			if nbErrors == 1000 {
+				errs = utils.MergeErrors(true, errs, utils.MakeTemporary(fmt.Errorf("more than 1000 errors")))
+			} else if nbErrors < 1000 {
+				errs = utils.MergeErrors(true, errs, err)
 			}
-			return nil
-		}()
-	}
-	for _, task := range job.Tasks {
-		tasks <- task
-	}
-	close(tasks)
-	wg.Wait()
+		}
+	})
 
 	job.LogMsgf(geocube.DEBUG, "End deletion of %d containers...", len(job.Tasks))
 
@@ -404,10 +419,12 @@ func (svc *Service) delDeleteContainers(ctx context.Context, job *geocube.Job) e
 
 	if errs != nil {
 		log.Logger(ctx).Sugar().Debugf("Some deletion failed: %v", errs)
+		// Persist job
+		return utils.MergeErrors(true, errs, svc.saveJob(ctx, nil, job))
 	}
 
 	// Persist job
-	return utils.MergeErrors(true, errs, svc.saveJob(ctx, nil, job))
+	return svc.saveJobAndPublishEvent(ctx, geocube.DeletionDone, job)
 }
 
 func (svc *Service) delRollback(ctx context.Context, job *geocube.Job) error {
@@ -424,7 +441,7 @@ func (svc *Service) delRollback(ctx context.Context, job *geocube.Job) error {
 	job.ReleaseDatasets(geocube.LockFlagTODELETE)
 
 	// Persist DeleteTasks and ReleaseDatasets
-	if err := svc.saveJob(ctx, nil, job); err != nil {
+	if err := svc.saveJobAndPublishEvent(ctx, geocube.RollbackDone, job); err != nil {
 		return fmt.Errorf("Rollback.%w", err)
 	}
 
@@ -437,25 +454,82 @@ func (svc *Service) opSubFncDeleteContainer(ctx context.Context, containerURI st
 	if err != nil {
 		return fmt.Errorf("opSubFncDeleteContainer.%w", err)
 	}
+	if URI.ReadOnly() {
+		log.Logger(ctx).Sugar().Warnf("opSubFncDeleteContainer: %s is read-only and will not be deleted", containerURI)
+		return nil
+	}
 	if err := URI.Delete(ctx, storage.IgnoreNotFound()); err != nil {
 		return fmt.Errorf("opSubFncDeleteContainer[%s].%w", containerURI, err)
 	}
 	return nil
 }
 
+// opSubFncDeleteContainers deletes containers in batches (grouped by storage), ignoring FileNotFoundError and read-only containers
+// onDelete is called (concurrently) with the result of the deletion of each container
+func (svc *Service) opSubFncDeleteContainers(ctx context.Context, containersURI []string, onDelete storage.DeleteFunc) error {
+	var errs error
+	batches := map[string][]string{}
+	sources := map[string]string{}
+	for _, containerURI := range containersURI {
+		URI, err := uri.ParseUri(containerURI)
+		if err != nil {
+			err = fmt.Errorf("opSubFncDeleteContainers.%w", err)
+			onDelete(containerURI, err)
+			errs = utils.MergeErrors(true, errs, err)
+			continue
+		}
+		if URI.ReadOnly() {
+			log.Logger(ctx).Sugar().Warnf("opSubFncDeleteContainers: %s is read-only and will not be deleted", containerURI)
+			onDelete(containerURI, nil)
+			continue
+		}
+		protocol := strings.ToLower(URI.Protocol())
+		batches[protocol] = append(batches[protocol], URI.String())
+		sources[URI.String()] = containerURI
+	}
+
+	for _, uris := range batches {
+		URI, _ := uri.ParseUri(uris[0])
+		strategy, err := URI.NewStorageStrategy(ctx)
+		if err != nil {
+			err = fmt.Errorf("opSubFncDeleteContainers.%w", err)
+			for _, u := range uris {
+				onDelete(sources[u], err)
+			}
+			errs = utils.MergeErrors(true, errs, err)
+			continue
+		}
+		err = strategy.BulkDelete(ctx, uris, storage.IgnoreNotFound(), storage.OnDelete(func(u string, err error) {
+			if err != nil {
+				err = fmt.Errorf("opSubFncDeleteContainers[%s].%w", sources[u], err)
+			}
+			onDelete(sources[u], err)
+		}))
+		if err != nil {
+			errs = utils.MergeErrors(true, errs, fmt.Errorf("opSubFncDeleteContainers.%w", err))
+		}
+	}
+	return errs
+}
+
 func (svc *Service) opContactAdmin(_ context.Context, job *geocube.Job) error {
 	job.LogMsg(geocube.WARN, "Contact admin...")
 	//TODO Contact Admin
 	return nil
 }
 
-func (svc *Service) publishEvent(ctx context.Context, status geocube.JobStatus, job *geocube.Job, serr string) error {
+// publishEvent publishes the event of the job. The event records the current state of the job.
+// If txn is not nil, the event is stored in the outbox in the transaction txn (if enabled),
+// otherwise it is published (or handled if the job is synchronous) once txn is committed.
+func (svc *Service) publishEvent(ctx context.Context, txn database.GeocubeTxBackend, status geocube.JobStatus, job *geocube.Job, serr string) error {
 	job.LogMsgf(geocube.DEBUG, "  Event %s %s...", status.String(), serr)
 
 	evt := geocube.NewJobEvent(job.ID, status, serr)
+	state := job.State
+	evt.State = &state
 
 	if job.ExecutionLevel == geocube.ExecutionSynchronous {
-		return svc.handleJobEvt(ctx, *evt)
+		return onCommit(txn, func() error { return svc.handleJobEvt(ctx, *evt) })
 	}
 
 	data, err := geocube.MarshalEvent(evt)
@@ -463,5 +537,41 @@ func (svc *Service) publishEvent(ctx context.Context, status geocube.JobStatus,
 		panic("Unable to marshal event")
 	}
 
-	return svc.eventPublisher.Publish(ctx, data)
+	if svc.outboxEnabled() {
+		if txn == nil {
+			return svc.unitOfWork(ctx, func(txn database.GeocubeTxBackend) error {
+				return svc.publishEventInOutbox(ctx, txn, data, job)
+			})
+		}
+		return svc.publishEventInOutbox(ctx, txn, data, job)
+	}
+
+	return onCommit(txn, func() error { return svc.eventPublisher.Publish(ctx, data) })
+}
+
+func (svc *Service) publishEventInOutbox(ctx context.Context, txn database.GeocubeTxBackend, data []byte, job *geocube.Job) error {
+	if err := svc.storeInOutbox(ctx, txn, outboxEventsQueue, [][]byte{data}); err != nil {
+		return err
+	}
+	return svc.saveJobLogs(ctx, txn, job)
+}
+
+// saveJobAndPublishEvent persists the job and publishes the event in the same transaction
+func (svc *Service) saveJobAndPublishEvent(ctx context.Context, status geocube.JobStatus, job *geocube.Job) error {
+	return svc.unitOfWork(ctx, func(txn database.GeocubeTxBackend) error {
+		if err := svc.saveJob(ctx, txn, job); err != nil {
+			return err
+		}
+		return svc.publishEvent(ctx, txn, status, job, "")
+	})
+}
+
+// publishFailure publishes the event of the failure of an action of the job.
+// If the action succeeded but its event could not be published (committedError), nothing is published
+// and the error is returned.
+func (svc *Service) publishFailure(ctx context.Context, status geocube.JobStatus, job *geocube.Job, err error) error {
+	if errors.As(err, &committedError{}) {
+		return err
+	}
+	return svc.publishEvent(ctx, nil, status, job, err.Error())
 }
@@ -0,0 +1,165 @@
+package svc
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/airbusgeo/geocube/interface/database"
+	"github.com/airbusgeo/geocube/interface/messaging"
+	"github.com/airbusgeo/geocube/internal/geocube"
+	"github.com/airbusgeo/geocube/internal/log"
+)
+
+// Queues of the outbox
+const (
+	outboxEventsQueue         = "events"
+	outboxConsolidationsQueue = "consolidations"
+)
+
+const (
+	outboxBatchSize     = 100
+	outboxRetention     = 24 * time.Hour
+	outboxPurgeInterval = time.Hour
+)
+
+// EnableOutbox enables the transactional outbox: the job events and the consolidation orders are stored in the database,
+// in the same transaction as the job they refer to, then published by the relay (see RunOutboxRelay).
+func (svc *Service) EnableOutbox() {
+	svc.outboxNotify = make(chan struct{}, 1)
+}
+
+func (svc *Service) outboxEnabled() bool {
+	return svc.outboxNotify != nil
+}
+
+// storeInOutbox stores the messages in the outbox in the transaction txn and wakes up the relay once it is committed
+func (svc *Service) storeInOutbox(ctx context.Context, txn database.GeocubeTxBackend, queue string, messages [][]byte) error {
+	if err := txn.CreateOutboxMessages(ctx, queue, messages); err != nil {
+		return err
+	}
+	return onCommit(txn, func() error {
+		select {
+		case svc.outboxNotify <- struct{}{}:
+		default:
+		}
+		return nil
+	})
+}
+
+// RunOutboxRelay publishes the pending messages of the outbox until the context is done.
+// The relay is woken up each time a message is stored in the outbox by this service, or every pollInterval
+// to publish the messages stored by the other instances of the service.
+// Several relays can run concurrently: the messages being published by a relay are locked and skipped by the others.
+// A message is published at least once: it may be published again if the relay fails before marking it as sent.
+// The consumers must be idempotent: a job event is ignored if the job is no longer in the state it was sent from
+// and a task event already applied does not change the job (see HandleEvent).
+func (svc *Service) RunOutboxRelay(ctx context.Context, pollInterval time.Duration) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	var lastPurge time.Time
+	for {
+		for {
+			n, err := svc.relayOutboxMessages(ctx, outboxBatchSize)
+			if err != nil {
+				log.Logger(ctx).Sugar().Errorf("outbox relay: %v", err)
+				break
+			}
+			if n < outboxBatchSize {
+				break
+			}
+		}
+
+		if time.Since(lastPurge) > outboxPurgeInterval {
+			if n, err := svc.db.DeleteSentOutboxMessages(ctx, time.Now().Add(-outboxRetention)); err != nil {
+				log.Logger(ctx).Sugar().Errorf("outbox relay: %v", err)
+			} else if n > 0 {
+				log.Logger(ctx).Sugar().Debugf("outbox relay: %d sent messages purged", n)
+			}
+			lastPurge = time.Now()
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-svc.outboxNotify:
+		}
+	}
+}
+
+// relayOutboxMessages publishes a batch of pending messages of the outbox and marks them as sent
+// If the transaction fails after the publication, the messages are published again by the next call.
+// Returns the number of messages read from the outbox
+func (svc *Service) relayOutboxMessages(ctx context.Context, limit int) (int, error) {
+	var n int
+	var publishErr error
+	err := svc.unitOfWork(ctx, func(txn database.GeocubeTxBackend) error {
+		messages, err := txn.ReadPendingOutboxMessages(ctx, limit)
+		if err != nil {
+			return err
+		}
+		n = len(messages)
+
+		// Publish the consecutive messages of the same queue together, preserving the order
+		var sent []int64
+		for i := 0; i < len(messages); {
+			j := i + 1
+			for j < len(messages) && messages[j].Queue == messages[i].Queue {
+				j++
+			}
+			if publishErr = svc.publishOutboxMessages(ctx, messages[i:j]); publishErr != nil {
+				break
+			}
+			for _, m := range messages[i:j] {
+				sent = append(sent, m.ID)
+			}
+			i = j
+		}
+		// Even if a publication failed, the messages already published are marked as sent
+		return txn.MarkOutboxMessagesSent(ctx, sent)
+	})
+	if err != nil {
+		return n, fmt.Errorf("relayOutboxMessages.%w", err)
+	}
+	if publishErr != nil {
+		return n, fmt.Errorf("relayOutboxMessages: %w", publishErr)
+	}
+	return n, nil
+}
+
+// publishOutboxMessages publishes messages of the same queue
+func (svc *Service) publishOutboxMessages(ctx context.Context, messages []*database.OutboxMessage) error {
+	var publisher messaging.Publisher
+	switch messages[0].Queue {
+	case outboxEventsQueue:
+		publisher = svc.eventPublisher
+	case outboxConsolidationsQueue:
+		publisher = svc.consolidationPublisher
+	}
+	if publisher == nil {
+		return fmt.Errorf("no publisher for the queue '%s'", messages[0].Queue)
+	}
+
+	payloads := make([][]byte, len(messages))
+	for i, m := range messages {
+		payloads[i] = m.Payload
+	}
+	if messages[0].Queue == outboxConsolidationsQueue {
+		return messaging.PublishWithAttributes(ctx, publisher, consolidationOrdersAttributes(payloads), payloads...)
+	}
+	return publisher.Publish(ctx, payloads...)
+}
+
+// consolidationOrdersAttributes returns the attributes of the messages of the consolidation orders (see geocube.ConsolidationEventAttributes)
+// The orders stored in the outbox are the payloads of the tasks, i.e. consolidation events.
+func consolidationOrdersAttributes(payloads [][]byte) []map[string]string {
+	attributes := make([]map[string]string, len(payloads))
+	for i, payload := range payloads {
+		if evt, err := geocube.UnmarshalConsolidationEvent(bytes.NewReader(payload)); err == nil {
+			attributes[i] = geocube.ConsolidationEventAttributes(evt.JobID, evt.TaskID)
+		}
+	}
+	return attributes
+}
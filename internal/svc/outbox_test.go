@@ -0,0 +1,245 @@
+package svc_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+
+	"github.com/airbusgeo/geocube/interface/database"
+	"github.com/airbusgeo/geocube/interface/database/memdb"
+	mocksDB "github.com/airbusgeo/geocube/interface/database/mocks"
+	"github.com/airbusgeo/geocube/interface/messaging"
+	"github.com/airbusgeo/geocube/interface/messaging/memqueue"
+	mocksMessaging "github.com/airbusgeo/geocube/interface/messaging/mocks"
+	"github.com/airbusgeo/geocube/internal/geocube"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/airbusgeo/geocube/internal/svc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("relayOutboxMessages", func() {
+
+	var (
+		ctx = context.Background()
+
+		mockDatabase               *mocksDB.GeocubeBackend
+		mockTx                     *mocksDB.GeocubeTxBackend
+		mockEventPublisher         *mocksMessaging.Publisher
+		mockConsolidationPublisher *mocksMessaging.Publisher
+
+		messagesToUse              []*database.OutboxMessage
+		consolidationErrorReturned error
+		returnedN                  int
+		returnedError              error
+		sentIDs                    []int64
+
+		service *svc.Service
+	)
+
+	BeforeEach(func() {
+		mockDatabase = new(mocksDB.GeocubeBackend)
+		mockTx = new(mocksDB.GeocubeTxBackend)
+		mockEventPublisher = new(mocksMessaging.Publisher)
+		mockConsolidationPublisher = new(mocksMessaging.Publisher)
+
+		var err error
+		service, err = svc.New(ctx, mockDatabase, mockEventPublisher, mockConsolidationPublisher, os.TempDir(), os.TempDir(), 1)
+		if err != nil {
+			panic(err)
+		}
+		service.EnableOutbox()
+
+		messagesToUse = []*database.OutboxMessage{
+			{ID: 1, Queue: "events", Payload: []byte("evt1")},
+			{ID: 2, Queue: "consolidations", Payload: []byte("order1")},
+			{ID: 3, Queue: "consolidations", Payload: []byte("order2")},
+			{ID: 4, Queue: "events", Payload: []byte("evt2")},
+		}
+		consolidationErrorReturned = nil
+		sentIDs = nil
+	})
+
+	JustBeforeEach(func() {
+		mockDatabase.On("StartTransaction", ctx).Return(mockTx, nil)
+		mockTx.On("Rollback").Return(nil)
+		mockTx.On("Commit").Return(nil)
+		mockTx.On("ReadPendingOutboxMessages", ctx, 10).Return(messagesToUse, nil)
+		mockTx.On("MarkOutboxMessagesSent", ctx, mock.Anything).Run(func(args mock.Arguments) {
+			sentIDs = args.Get(1).([]int64)
+		}).Return(nil)
+		mockEventPublisher.On("Publish", ctx, mock.Anything).Return(nil)
+		mockConsolidationPublisher.On("Publish", ctx, mock.Anything).Return(consolidationErrorReturned)
+		returnedN, returnedError = svc.RelayOutboxMessages(service, ctx, 10)
+	})
+
+	Context("default", func() {
+		It("should not return an error", func() {
+			Expect(returnedError).To(BeNil())
+			Expect(returnedN).To(Equal(4))
+		})
+		It("should publish the messages in order, grouped by queue", func() {
+			mockEventPublisher.AssertNumberOfCalls(GinkgoT(), "Publish", 2)
+			mockConsolidationPublisher.AssertCalled(GinkgoT(), "Publish", ctx, [][]byte{[]byte("order1"), []byte("order2")})
+		})
+		It("should mark all the messages as sent", func() {
+			Expect(sentIDs).To(Equal([]int64{1, 2, 3, 4}))
+		})
+	})
+
+	Context("when a publication fails", func() {
+		BeforeEach(func() {
+			consolidationErrorReturned = errors.New("broker unavailable")
+		})
+		It("should return an error", func() {
+			Expect(returnedError).NotTo(BeNil())
+		})
+		It("should only mark the messages published before the failure as sent", func() {
+			Expect(sentIDs).To(Equal([]int64{1}))
+			mockEventPublisher.AssertNumberOfCalls(GinkgoT(), "Publish", 1)
+		})
+	})
+})
+
+var _ = Describe("HandleEvent with the outbox", func() {
+
+	var (
+		ctx = context.Background()
+
+		db      *memdb.BackendDB
+		service *svc.Service
+		job     *geocube.Job
+	)
+
+	// pendingEvents returns the job events waiting in the outbox
+	pendingEvents := func() []geocube.JobEvent {
+		txn, err := db.StartTransaction(ctx)
+		Expect(err).To(BeNil())
+		defer txn.Rollback()
+		messages, err := txn.ReadPendingOutboxMessages(ctx, 100)
+		Expect(err).To(BeNil())
+		var events []geocube.JobEvent
+		for _, m := range messages {
+			Expect(m.Queue).To(Equal("events"))
+			evt, err := geocube.UnmarshalEvent(bytes.NewReader(m.Payload))
+			Expect(err).To(BeNil())
+			events = append(events, evt.(geocube.JobEvent))
+		}
+		return events
+	}
+
+	createJob := func() {
+		Expect(db.CreateJob(ctx, job)).To(BeNil())
+		Expect(db.CreateTasks(ctx, job.ID, job.Tasks)).To(BeNil())
+	}
+
+	BeforeEach(func() {
+		db = memdb.New()
+		var err error
+		service, err = svc.New(ctx, db, new(mocksMessaging.Publisher), new(mocksMessaging.Publisher), os.TempDir(), os.TempDir(), 1)
+		if err != nil {
+			panic(err)
+		}
+		service.EnableOutbox()
+	})
+
+	Context("when a job event is delivered twice", func() {
+		var evt geocube.JobEvent
+
+		BeforeEach(func() {
+			job = geocube.NewDeletionJob("deletion", geocube.ExecutionAsynchronous)
+			job.State = geocube.JobStateDELETIONINPROGRESS
+			createJob()
+			state := job.State
+			evt = geocube.JobEvent{JobID: job.ID, Status: geocube.RemovalDone, State: &state}
+		})
+
+		It("should only handle the first one", func() {
+			Expect(service.HandleEvent(ctx, evt)).To(BeNil())
+			events := pendingEvents()
+			Expect(events).To(HaveLen(1))
+			Expect(events[0].Status).To(Equal(geocube.DeletionDone))
+			Expect(*events[0].State).To(Equal(geocube.JobStateDELETIONEFFECTIVE))
+
+			Expect(service.HandleEvent(ctx, evt)).To(BeNil())
+			Expect(pendingEvents()).To(HaveLen(1))
+			j, err := service.GetJob(ctx, job.ID)
+			Expect(err).To(BeNil())
+			Expect(j.State).To(Equal(geocube.JobStateDELETIONEFFECTIVE))
+		})
+
+		It("should refuse an unexpected event sent without state", func() {
+			Expect(service.HandleEvent(ctx, geocube.JobEvent{JobID: job.ID, Status: geocube.RemovalDone})).To(BeNil())
+			err := service.HandleEvent(ctx, geocube.JobEvent{JobID: job.ID, Status: geocube.RemovalDone})
+			Expect(geocube.IsError(err, geocube.UnhandledEvent)).To(BeTrue())
+		})
+	})
+
+	Context("when the last task event is delivered twice", func() {
+		var evt geocube.TaskEvent
+
+		BeforeEach(func() {
+			job, _ = geocube.NewConsolidationJob("consolidation", "layout", "instance", "", geocube.StepByStepAll)
+			job.State = geocube.JobStateCONSOLIDATIONINPROGRESS
+			Expect(job.CreateConsolidationTask(geocube.ConsolidationEvent{JobID: job.ID})).To(BeNil())
+			Expect(job.UpdateTask(*geocube.NewTaskEvent(job.ID, job.Tasks[0].ID, geocube.TaskSent, nil))).To(BeNil())
+			createJob()
+			evt = *geocube.NewTaskEvent(job.ID, job.Tasks[0].ID, geocube.TaskSuccessful, nil)
+		})
+
+		It("should store the job event with the job and only handle it once", func() {
+			Expect(service.HandleEvent(ctx, evt)).To(BeNil())
+			Expect(service.HandleEvent(ctx, evt)).To(BeNil())
+			j, err := service.GetJob(ctx, job.ID)
+			Expect(err).To(BeNil())
+			Expect(j.ActiveTasks).To(Equal(0))
+
+			events := pendingEvents()
+			Expect(events).To(HaveLen(2))
+			for _, e := range events {
+				Expect(e.Status).To(Equal(geocube.ConsolidationDone))
+				Expect(*e.State).To(Equal(geocube.JobStateCONSOLIDATIONINPROGRESS))
+				Expect(service.HandleEvent(ctx, e)).To(BeNil())
+			}
+			j, err = service.GetJob(ctx, job.ID)
+			Expect(err).To(BeNil())
+			Expect(j.State).To(Equal(geocube.JobStateCONSOLIDATIONDONE))
+			Expect(j.Waiting).To(BeTrue())
+		})
+	})
+})
+
+var _ = Describe("relayOutboxMessages of the consolidation orders", func() {
+
+	var ctx = context.Background()
+
+	It("should publish the orders with the ids of the job and of the task in their attributes", func() {
+		db := memdb.New()
+		queue := memqueue.NewQueue("consolidations")
+		service, err := svc.New(ctx, db, new(mocksMessaging.Publisher), memqueue.NewPublisher(queue), os.TempDir(), os.TempDir(), 1)
+		Expect(err).To(BeNil())
+		service.EnableOutbox()
+
+		job, _ := geocube.NewConsolidationJob("consolidation", "layout", "instance", "", geocube.StepByStepAll)
+		Expect(job.CreateConsolidationTask(geocube.ConsolidationEvent{JobID: job.ID})).To(BeNil())
+		Expect(db.CreateOutboxMessages(ctx, "consolidations", [][]byte{job.Tasks[0].Payload, []byte("unreadable")})).To(BeNil())
+		n, err := svc.RelayOutboxMessages(service, ctx, 10)
+		Expect(err).To(BeNil())
+		Expect(n).To(Equal(2))
+
+		var attributes []map[string]string
+		consumer := memqueue.NewConsumer(queue)
+		for i := 0; i < 2; i++ {
+			Expect(consumer.Pull(ctx, func(ctx context.Context, m *messaging.Message) error {
+				attributes = append(attributes, m.Attributes)
+				return nil
+			})).To(Succeed())
+		}
+		Expect(attributes).To(Equal([]map[string]string{
+			{geocube.ConsolidationJobIDAttribute: job.ID, geocube.ConsolidationTaskIDAttribute: job.Tasks[0].ID},
+			{},
+		}))
+	})
+})
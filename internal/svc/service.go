@@ -5,6 +5,8 @@ import "C"
 import (
 	"context"
 	"fmt"
+	"maps"
+	"math"
 	"strings"
 	"time"
 
@@ -29,9 +31,12 @@ type Service struct {
 	db                         database.GeocubeDBBackend
 	eventPublisher             messaging.Publisher
 	consolidationPublisher     messaging.Publisher
+	deadLetterQueue            messaging.DeadLetterQueue
 	cubeWorkers                int
 	ingestionStoragePath       string
 	cancelledConsolidationPath string
+	workDir                    string        // Scratch directory of the cube files (see SetWorkDir)
+	outboxNotify               chan struct{} // Not nil if the outbox is enabled (see EnableOutbox)
 }
 
 // New returns a new business service
@@ -54,6 +59,16 @@ func New(ctx context.Context, db database.GeocubeDBBackend, eventPublisher messa
 	return &Service{db: db, eventPublisher: eventPublisher, consolidationPublisher: consolidationPublisher, cubeWorkers: cubeWorkers, ingestionStoragePath: ingestionStoragePath, cancelledConsolidationPath: cancelledConsolidationPath}, nil
 }
 
+// SetDeadLetterQueue enables the admin functions on the dead letters of the messaging queues
+func (svc *Service) SetDeadLetterQueue(deadLetterQueue messaging.DeadLetterQueue) {
+	svc.deadLetterQueue = deadLetterQueue
+}
+
+// SetWorkDir sets the scratch directory of the cube files (NetCDF4, Zarr). By default, the temporary directory is used.
+func (svc *Service) SetWorkDir(workDir string) {
+	svc.workDir = workDir
+}
+
 // CreateAOI implements GeocubeService
 func (svc *Service) CreateAOI(ctx context.Context, aoi *geocube.AOI) error {
 	return svc.unitOfWork(ctx, func(txn database.GeocubeTxBackend) error {
@@ -93,8 +108,8 @@ func (svc *Service) DeleteRecords(ctx context.Context, ids []string, noFail bool
 }
 
 // ListRecords implements GeocubeService
-func (svc *Service) ListRecords(ctx context.Context, name string, tags geocube.Metadata, fromTime, toTime time.Time, aoi *geocube.AOI, page, limit int, loadAOI bool) ([]*geocube.Record, error) {
-	return svc.db.FindRecords(ctx, name, tags, fromTime, toTime, "", aoi, page, limit, true, loadAOI)
+func (svc *Service) ListRecords(ctx context.Context, name string, tags geocube.TagsQuery, fromTime, toTime time.Time, aoi *geocube.AOI, page, limit int, after *geocube.Cursor, loadAOI bool) ([]*geocube.Record, error) {
+	return svc.db.FindRecords(ctx, name, tags, fromTime, toTime, "", aoi, page, limit, after, true, loadAOI)
 }
 
 // AddRecordsTags add tags on list of records
@@ -181,8 +196,8 @@ func (svc *Service) InstantiateVariable(ctx context.Context, variableID string,
 }
 
 // ListVariables implements GeocubeService
-func (svc *Service) ListVariables(ctx context.Context, namelike string, page, limit int) ([]*geocube.Variable, error) {
-	return svc.db.FindVariables(ctx, namelike, page, limit)
+func (svc *Service) ListVariables(ctx context.Context, namelike string, page, limit int, after *geocube.Cursor) ([]*geocube.Variable, error) {
+	return svc.db.FindVariables(ctx, namelike, page, limit, after)
 }
 
 // DeleteVariable implements GeocubeService
@@ -243,7 +258,17 @@ func (svc *Service) validateRemoteContainer(ctx context.Context, container *geoc
 	}
 
 	storageClass := geocube.StorageClassSTANDARD
-	if strings.EqualFold(containerURI.Protocol(), "gs") {
+	switch strings.ToLower(containerURI.Protocol()) {
+	case "http", "https":
+		if _, err := containerURI.GetAttrs(ctx); err != nil {
+			return geocube.NewValidationError("%s is not reachable: %v", container.URI, err)
+		}
+		// Http containers are read-only: they must never be deleted by the geocube
+		if container.Managed {
+			log.Logger(ctx).Sugar().Warnf("%s is read-only: it is indexed as an unmanaged container", container.URI)
+			container.Managed = false
+		}
+	case "gs", "s3", "az", "mem":
 		attrs, err := containerURI.GetAttrs(ctx)
 		if err != nil {
 			return geocube.NewValidationError("%s is not reachable: %v", container.URI, err)
@@ -266,34 +291,59 @@ func (svc *Service) validateRemoteContainer(ctx context.Context, container *geoc
 	return nil
 }
 
+// IndexationOptions defines user-options for the indexation of external datasets
+type IndexationOptions struct {
+	ValidShape bool             // Compute the shape of the datasets from their valid pixels (see image.ComputeValidShape)
+	Metadata   *MetadataOptions // Read the GDAL metadata of the files (nil to ignore the metadata)
+}
+
+// MetadataOptions defines how the GDAL metadata of the files are used during the indexation
+type MetadataOptions struct {
+	Domains     []string          // Metadata domains (default domain if empty)
+	Tags        map[string]string // Metadata key (see image.ReadMetadata) -> Record tag
+	DataMapping bool              // Deduce the real range of values of the datasets from the scale/offset of the bands, if not defined by the user
+}
+
 // validateAndSetRemoteDataset validates and completes Dataset
-func (svc *Service) validateAndSetRemoteDataset(_ context.Context, dataset *geocube.Dataset) error {
+// It returns the warnings and the record tags read from the metadata (see MetadataOptions)
+func (svc *Service) validateAndSetRemoteDataset(_ context.Context, dataset *geocube.Dataset, options IndexationOptions) ([]string, geocube.Metadata, error) {
 	datasetURI := dataset.GDALURI()
 	ds, err := godal.Open(datasetURI, image.ErrLogger)
 	if err != nil {
-		return geocube.NewValidationError("%s cannot be opened: %v", datasetURI, err)
+		return nil, nil, geocube.NewValidationError("%s cannot be opened: %v", datasetURI, err)
 	}
 	defer ds.Close()
+	var warnings []string
 
 	// Validate bands
 	nbbands := int64(ds.Structure().NBands)
 	for _, b := range dataset.Bands {
 		if b <= 0 || b > nbbands {
-			return geocube.NewValidationError("%s has no band: %d", datasetURI, b)
+			return nil, nil, geocube.NewValidationError("%s has no band: %d", datasetURI, b)
 		}
 	}
 
 	// Set shape
-	extent, err := ds.Bounds()
-	if err != nil {
-		return geocube.NewValidationError("failed to get dataset's bounds : %s", err.Error())
-	}
-	bounds := geom.NewBounds(geom.XY)
-	bounds.SetCoords([]float64{extent[0], extent[1]}, []float64{extent[2], extent[3]})
-	mp := geom.NewMultiPolygon(geom.XY)
-	mp.Push(bounds.Polygon())
-	if err := dataset.SetShape(mp, ds.Projection()); err != nil {
-		return err
+	if options.ValidShape {
+		mp, err := image.ComputeValidShape(ds, dataset.Bands, dataset.DataMapping.NoData)
+		if err != nil {
+			return nil, nil, geocube.NewValidationError("%s: failed to compute the valid shape: %v", datasetURI, err)
+		}
+		if err := dataset.SetShape(mp, ds.Projection()); err != nil {
+			return nil, nil, err
+		}
+	} else {
+		extent, err := ds.Bounds()
+		if err != nil {
+			return nil, nil, geocube.NewValidationError("failed to get dataset's bounds : %s", err.Error())
+		}
+		bounds := geom.NewBounds(geom.XY)
+		bounds.SetCoords([]float64{extent[0], extent[1]}, []float64{extent[2], extent[3]})
+		mp := geom.NewMultiPolygon(geom.XY)
+		mp.Push(bounds.Polygon())
+		if err := dataset.SetShape(mp, ds.Projection()); err != nil {
+			return nil, nil, err
+		}
 	}
 
 	// Set format
@@ -304,18 +354,18 @@ func (svc *Service) validateAndSetRemoteDataset(_ context.Context, dataset *geoc
 		if gdaldtype == godal.Unknown {
 			gdaldtype = bstruct.DataType
 		} else if gdaldtype != bstruct.DataType {
-			return geocube.NewValidationError("%s : all bands must have the same data type (found %s and %s)", datasetURI, gdaldtype.String(), bstruct.DataType.String())
+			return nil, nil, geocube.NewValidationError("%s : all bands must have the same data type (found %s and %s)", datasetURI, gdaldtype.String(), bstruct.DataType.String())
 		}
 	}
 	dtype := bitmap.DTypeFromGDal(gdaldtype)
 	if dtype == bitmap.DTypeUNDEFINED {
-		return geocube.NewValidationError("%s : datatype not found or not supported: %s", datasetURI, gdaldtype.String())
+		return nil, nil, geocube.NewValidationError("%s : datatype not found or not supported: %s", datasetURI, gdaldtype.String())
 	}
 	if dataset.DataMapping.DType != bitmap.DTypeUNDEFINED && dtype != dataset.DataMapping.DType {
-		fmt.Printf("Warning: overwrite dtype (%s->%s) of %s\n", dataset.DataMapping.DType, dtype, datasetURI)
+		warnings = append(warnings, fmt.Sprintf("%s: overwrite dtype (%s->%s)", datasetURI, dataset.DataMapping.DType, dtype))
 	}
 	if err := dataset.SetDataType(dtype); err != nil {
-		return err
+		return nil, nil, err
 	}
 
 	// Set overviews
@@ -328,7 +378,53 @@ func (svc *Service) validateAndSetRemoteDataset(_ context.Context, dataset *geoc
 	}
 	dataset.SetOverviews(hasOverviews)
 
-	return nil
+	// Check nodata
+	for _, b := range dataset.Bands {
+		nodata, ok := bands[int(b)-1].NoData()
+		if ok && nodata != dataset.DataMapping.NoData && !(math.IsNaN(nodata) && math.IsNaN(dataset.DataMapping.NoData)) {
+			warnings = append(warnings, fmt.Sprintf("%s: nodata of band %d (%v) is different from the nodata of the dataformat (%v)", datasetURI, b, nodata, dataset.DataMapping.NoData))
+		}
+	}
+
+	// Metadata
+	var tags geocube.Metadata
+	if options.Metadata != nil {
+		if options.Metadata.DataMapping {
+			w, err := setRangeExtFromScaleOffset(ds, dataset)
+			if err != nil {
+				return nil, nil, err
+			}
+			warnings = append(warnings, w...)
+		}
+		if len(options.Metadata.Tags) > 0 {
+			metadata := image.ReadMetadata(ds, options.Metadata.Domains)
+			tags = geocube.Metadata{}
+			for key, tag := range options.Metadata.Tags {
+				if v, ok := metadata[key]; ok {
+					tags[tag] = v
+				}
+			}
+		}
+	}
+
+	return warnings, tags, nil
+}
+
+// setRangeExtFromScaleOffset sets the real range of values of the dataset from the scale and the offset of its bands (see geocube.SetRangeExtFromScaleOffset)
+// The scale and the offset of a band are 1 and 0 if they are not defined
+func setRangeExtFromScaleOffset(ds *godal.Dataset, dataset *geocube.Dataset) ([]string, error) {
+	bands := ds.Bands()
+	structure := bands[dataset.Bands[0]-1].Structure()
+	scale, offset := structure.Scale, structure.Offset
+	for _, b := range dataset.Bands[1:] {
+		if structure := bands[b-1].Structure(); structure.Scale != scale || structure.Offset != offset {
+			return []string{fmt.Sprintf("%s: the bands have different scales or offsets: the real range of values is not deduced", dataset.GDALURI())}, nil
+		}
+	}
+	if _, err := dataset.SetRangeExtFromScaleOffset(scale, offset); err != nil {
+		return nil, geocube.NewValidationError("%s: %v", dataset.GDALURI(), err)
+	}
+	return nil, nil
 }
 
 // GetContainers implements GeocubeService
@@ -342,39 +438,64 @@ func (svc *Service) GetContainers(ctx context.Context, containerUris []string) (
 
 // IndexExternalDatasets implements GeocubeService
 // Index datasets that are not fully known. Checks that the container is reachable and get some missing informations.
-func (svc *Service) IndexExternalDatasets(ctx context.Context, newcontainer *geocube.Container, datasets []*geocube.Dataset) error {
+// Returns the warnings raised during the validation of the datasets (e.g. dtype or nodata mismatches).
+func (svc *Service) IndexExternalDatasets(ctx context.Context, newcontainer *geocube.Container, datasets []*geocube.Dataset, options IndexationOptions) ([]string, error) {
 	var err error
 	log.Logger(ctx).Sugar().Debugf("Index external container %s containing %d datasets", newcontainer.URI, len(datasets))
 
 	// Validate container
 	if err = svc.validateRemoteContainer(ctx, newcontainer); err != nil {
-		return fmt.Errorf("IndexExternalDatasets.%w", err)
+		return nil, fmt.Errorf("IndexExternalDatasets.%w", err)
 	}
 
 	// Validate datasets
+	var warnings []string
+	recordsTags := map[string]geocube.Metadata{}
 	variables := make(map[string]*geocube.Variable)
 	for _, dataset := range datasets {
+		// Validate using remote dataset
+		w, tags, err := svc.validateAndSetRemoteDataset(ctx, dataset, options)
+		if err != nil {
+			return nil, fmt.Errorf("IndexExternalDatasets.%w", err)
+		}
+		warnings = append(warnings, w...)
+		if len(tags) > 0 {
+			if recordsTags[dataset.RecordID] == nil {
+				recordsTags[dataset.RecordID] = geocube.Metadata{}
+			}
+			maps.Copy(recordsTags[dataset.RecordID], tags)
+		}
+
 		// Validate using variable
 		v, ok := variables[dataset.InstanceID]
 		if !ok {
 			if v, err = svc.db.ReadVariableFromInstanceID(ctx, dataset.InstanceID); err != nil {
-				return fmt.Errorf("IndexExternalDatasets.%w", err)
+				return nil, fmt.Errorf("IndexExternalDatasets.%w", err)
 			}
 			variables[dataset.InstanceID] = v
 		}
 		if err := dataset.ValidateWithVariable(v); err != nil {
-			return fmt.Errorf("IndexExternalDatasets.%w", err)
-		}
-		// Validate using remote dataset
-		if err := svc.validateAndSetRemoteDataset(ctx, dataset); err != nil {
-			return fmt.Errorf("IndexExternalDatasets.%w", err)
+			return nil, fmt.Errorf("IndexExternalDatasets.%w", err)
 		}
 	}
-	return svc.unitOfWork(ctx, func(txn database.GeocubeTxBackend) error {
+	for _, w := range warnings {
+		log.Logger(ctx).Sugar().Warn(w)
+	}
+
+	return warnings, svc.unitOfWork(ctx, func(txn database.GeocubeTxBackend) error {
 		if err := svc.prepareIndexation(ctx, txn, newcontainer, datasets); err != nil {
 			return err
 		}
-		return svc.saveContainer(ctx, txn, newcontainer)
+		if err := svc.saveContainer(ctx, txn, newcontainer); err != nil {
+			return err
+		}
+		// Tags read from the metadata
+		for recordID, tags := range recordsTags {
+			if _, err := txn.AddRecordsTags(ctx, []string{recordID}, tags); err != nil {
+				return fmt.Errorf("IndexExternalDatasets.%w", err)
+			}
+		}
+		return nil
 	})
 }
 
@@ -444,7 +565,7 @@ func (svc *Service) ConsolidateFromRecords(ctx context.Context, job *geocube.Job
 }
 
 // ConsolidateFromFilters implements GeocubeService
-func (svc *Service) ConsolidateFromFilters(ctx context.Context, job *geocube.Job, tags map[string]string, fromTime, toTime time.Time) error {
+func (svc *Service) ConsolidateFromFilters(ctx context.Context, job *geocube.Job, tags geocube.TagsQuery, fromTime, toTime time.Time) error {
 	// Get the list of datasets for the instanceID and the filters provided
 	// TODO check that ListActiveDatasetsID does not take too long
 	start := time.Now()
@@ -498,7 +619,7 @@ func (svc *Service) ListLayouts(ctx context.Context, nameLike string) ([]*geocub
 	return svc.db.FindLayouts(ctx, nameLike)
 }
 
-func (svc *Service) FindContainerLayouts(ctx context.Context, instanceId string, aoi *geocube.AOI, recordIds []string, tags map[string]string, fromTime, toTime time.Time) ([]string, [][]string, error) {
+func (svc *Service) FindContainerLayouts(ctx context.Context, instanceId string, aoi *geocube.AOI, recordIds []string, tags geocube.TagsQuery, fromTime, toTime time.Time) ([]string, [][]string, error) {
 	return svc.db.FindContainerLayouts(ctx, instanceId, aoi, recordIds, tags, fromTime, toTime)
 }
 
@@ -525,8 +646,8 @@ func (svc *Service) TileAOI(ctx context.Context, aoi *geocube.AOI, layoutName st
 
 // ListJobs implements GeocubeService
 // ListJobs retrieves only the Job but not the tasks
-func (svc *Service) ListJobs(ctx context.Context, nameLike string, page, limit int) ([]*geocube.Job, error) {
-	jobs, err := svc.db.FindJobs(ctx, nameLike, page, limit)
+func (svc *Service) ListJobs(ctx context.Context, nameLike string, page, limit int, after *geocube.Cursor) ([]*geocube.Job, error) {
+	jobs, err := svc.db.FindJobs(ctx, nameLike, page, limit, after)
 	if err != nil {
 		return nil, fmt.Errorf("ListJobs.%w", err)
 	}
@@ -559,11 +680,12 @@ func (svc *Service) RetryJob(ctx context.Context, jobID string, forceAnyState bo
 	if forceAnyState {
 		event = geocube.RetryForced
 	}
-	// Check that the event can be triggered (job is not persisted)
-	if err := job.Trigger(*geocube.NewJobEvent(jobID, event, "")); err != nil {
+	// Check that the event can be triggered (on a copy of the job, that is not persisted)
+	check := *job
+	if err := check.Trigger(*geocube.NewJobEvent(jobID, event, "")); err != nil {
 		return fmt.Errorf("RetryJob.%w", err)
 	}
-	return svc.publishEvent(ctx, event, job, "")
+	return svc.publishEvent(ctx, nil, event, job, "")
 }
 
 // CancelJob implements GeocubeService
@@ -576,11 +698,12 @@ func (svc *Service) CancelJob(ctx context.Context, jobID string, forceAnyState b
 	if forceAnyState {
 		event = geocube.CancelledByUserForced
 	}
-	// Check that the event can be triggered (job is not persisted)
-	if err := job.Trigger(*geocube.NewJobEvent(jobID, event, "")); err != nil {
+	// Check that the event can be triggered (on a copy of the job, that is not persisted)
+	check := *job
+	if err := check.Trigger(*geocube.NewJobEvent(jobID, event, "")); err != nil {
 		return fmt.Errorf("CancelJob.%w", err)
 	}
-	return svc.publishEvent(ctx, event, job, "")
+	return svc.publishEvent(ctx, nil, event, job, "")
 }
 
 // ContinueJob implements GeocubeService
@@ -589,12 +712,13 @@ func (svc *Service) ContinueJob(ctx context.Context, jobID string) error {
 	if err != nil {
 		return fmt.Errorf("ContinueJob.%w", err)
 	}
-	// Check that the event can be triggered (job is not persisted)
-	if err := job.Trigger(*geocube.NewJobEvent(jobID, geocube.Continue, "")); err != nil {
+	// Check that the event can be triggered (on a copy of the job, that is not persisted)
+	check := *job
+	if err := check.Trigger(*geocube.NewJobEvent(jobID, geocube.Continue, "")); err != nil {
 		return fmt.Errorf("ContinueJob.%w", err)
 	}
 
-	return svc.publishEvent(ctx, geocube.Continue, job, "")
+	return svc.publishEvent(ctx, nil, geocube.Continue, job, "")
 }
 
 // CleanJobs implements GeocubeService
@@ -648,6 +772,30 @@ func (svc *Service) CleanJobs(ctx context.Context, nameLike string, state *geocu
 	return count, err
 }
 
+// unitOfWorkTxn is the transaction of a unitOfWork with the functions to be called once it is committed
+type unitOfWorkTxn struct {
+	database.GeocubeTxBackend
+	onCommit []func() error
+}
+
+// committedError is returned by unitOfWork when the transaction has been committed,
+// but one of the functions called on commit failed (see onCommit)
+type committedError struct {
+	err error
+}
+
+func (e committedError) Error() string { return e.err.Error() }
+func (e committedError) Unwrap() error { return e.err }
+
+// onCommit calls f once the transaction of the unitOfWork is committed, or immediately if txn is not a unitOfWork.
+func onCommit(txn database.GeocubeTxBackend, f func() error) error {
+	if uow, ok := txn.(*unitOfWorkTxn); ok {
+		uow.onCommit = append(uow.onCommit, f)
+		return nil
+	}
+	return f()
+}
+
 func (svc *Service) unitOfWork(ctx context.Context, f func(txn database.GeocubeTxBackend) error) (err error) {
 	// Start transaction
 	txn, err := svc.db.StartTransaction(ctx)
@@ -663,10 +811,25 @@ func (svc *Service) unitOfWork(ctx context.Context, f func(txn database.GeocubeT
 	}()
 
 	// Execute function
-	if err = f(txn); err != nil {
+	uow := &unitOfWorkTxn{GeocubeTxBackend: txn}
+	if err = f(uow); err != nil {
 		return fmt.Errorf("uow.%w", err)
 	}
 
 	// Commit
-	return txn.Commit()
+	if err = txn.Commit(); err != nil {
+		return err
+	}
+
+	// Call the functions waiting for the commit
+	var errs error
+	for _, f := range uow.onCommit {
+		if e := f(); e != nil {
+			errs = utils.MergeErrors(true, errs, e)
+		}
+	}
+	if errs != nil {
+		return committedError{errs}
+	}
+	return nil
 }
@@ -0,0 +1,118 @@
+package svc
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/airbusgeo/geocube/internal/geocube"
+	"github.com/airbusgeo/geocube/internal/log"
+	"github.com/airbusgeo/geocube/internal/stac"
+)
+
+// STACImportResult is the result of the import of a STAC Item
+type STACImportResult struct {
+	ItemID     string
+	RecordID   string
+	AOIID      string
+	NewRecord  bool
+	NbDatasets int
+}
+
+// ImportSTACItems implements GeocubeService
+// The items are validated before being imported. Each item is imported independently: if an error occurs,
+// the previous items are imported and the import can be safely retried, as importing an item twice is idempotent.
+func (svc *Service) ImportSTACItems(ctx context.Context, items []*stac.Item, mapping *stac.Mapping) ([]STACImportResult, error) {
+	// Validate all the items
+	type importedItem struct {
+		item       *stac.Item
+		aoi        *geocube.AOI
+		record     *geocube.Record
+		containers []*geocube.Container
+		datasets   [][]*geocube.Dataset
+	}
+	imports := make([]importedItem, len(items))
+	for i, item := range items {
+		var err error
+		imp := importedItem{item: item}
+		if imp.aoi, err = item.AOI(); err != nil {
+			return nil, fmt.Errorf("ImportSTACItems.%w", err)
+		}
+		if imp.record, err = mapping.Record(ctx, item, imp.aoi.ID); err != nil {
+			return nil, fmt.Errorf("ImportSTACItems.%w", err)
+		}
+		if imp.containers, imp.datasets, err = mapping.Containers(item, imp.record.ID); err != nil {
+			return nil, fmt.Errorf("ImportSTACItems.%w", err)
+		}
+		imports[i] = imp
+	}
+
+	// Import the items
+	results := make([]STACImportResult, len(imports))
+	for i, imp := range imports {
+		res := &results[i]
+		res.ItemID = imp.item.ID
+
+		// Create the AOI or retrieve the identical one
+		if err := svc.CreateAOI(ctx, imp.aoi); err != nil {
+			gcerr, ok := geocube.AsError(err, geocube.EntityAlreadyExists)
+			if !ok {
+				return nil, fmt.Errorf("ImportSTACItems[%s].%w", imp.item.ID, err)
+			}
+			imp.aoi.ID = gcerr.Detail(geocube.DetailAlreadyExistsID)
+		}
+		imp.record.AOI.ID = imp.aoi.ID
+
+		// Create the record or retrieve the identical one
+		record, err := svc.findIdenticalRecord(ctx, imp.record)
+		if err != nil {
+			return nil, fmt.Errorf("ImportSTACItems[%s].%w", imp.item.ID, err)
+		}
+		if record == nil {
+			if err = svc.CreateRecords(ctx, []*geocube.Record{imp.record}); err == nil {
+				record, res.NewRecord = imp.record, true
+			} else if geocube.IsError(err, geocube.EntityAlreadyExists) {
+				// Created concurrently
+				record, err = svc.findIdenticalRecord(ctx, imp.record)
+			}
+			if err != nil || record == nil {
+				return nil, fmt.Errorf("ImportSTACItems[%s].createRecord: %w", imp.item.ID, err)
+			}
+		}
+		res.RecordID, res.AOIID = record.ID, record.AOI.ID
+
+		// Index the datasets
+		for j, container := range imp.containers {
+			for _, d := range imp.datasets[j] {
+				d.RecordID = record.ID
+			}
+			if _, err := svc.IndexExternalDatasets(ctx, container, imp.datasets[j], IndexationOptions{}); err != nil {
+				return nil, fmt.Errorf("ImportSTACItems[%s].%w", imp.item.ID, err)
+			}
+			res.NbDatasets += len(imp.datasets[j])
+		}
+		log.Logger(ctx).Sugar().Debugf("STAC Item %s imported in record %s (%d datasets)", res.ItemID, res.RecordID, res.NbDatasets)
+	}
+	return results, nil
+}
+
+// findIdenticalRecord returns the record with the same name, datetime and tags or nil if it does not exist
+func (svc *Service) findIdenticalRecord(ctx context.Context, record *geocube.Record) (*geocube.Record, error) {
+	// The name is a pattern for FindRecords: it is only used to filter the records if it has no wildcard.
+	// In any case, the name of the records is compared exactly below.
+	namelike := string(record.Name)
+	if strings.ContainsAny(namelike, "*?") || strings.HasSuffix(namelike, "(?i)") {
+		namelike = ""
+	}
+	records, err := svc.db.FindRecords(ctx, namelike, nil, record.Time, record.Time, "", nil, 0, 0, nil, false, false)
+	if err != nil {
+		return nil, fmt.Errorf("findIdenticalRecord.%w", err)
+	}
+	for _, r := range records {
+		if r.Name == record.Name && r.Time.Equal(record.Time) && maps.Equal(r.Tags, record.Tags) {
+			return r, nil
+		}
+	}
+	return nil, nil
+}
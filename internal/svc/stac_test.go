@@ -0,0 +1,64 @@
+package svc_test
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/airbusgeo/geocube/interface/database/memdb"
+	mocksMessaging "github.com/airbusgeo/geocube/interface/messaging/mocks"
+	"github.com/airbusgeo/geocube/internal/geocube"
+	"github.com/airbusgeo/geocube/internal/svc"
+	"github.com/google/uuid"
+	"github.com/twpayne/go-geom"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("findIdenticalRecord", func() {
+
+	var (
+		ctx = context.Background()
+
+		db      *memdb.BackendDB
+		service *svc.Service
+		aoi     *geocube.AOI
+		t       = time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	)
+
+	newRecord := func(name string, tags geocube.Metadata) *geocube.Record {
+		return &geocube.Record{ID: uuid.New().String(), Name: geocube.URN(name), Time: t, Tags: tags, AOI: geocube.AOI{ID: aoi.ID}}
+	}
+
+	BeforeEach(func() {
+		db = memdb.New()
+		var err error
+		service, err = svc.New(ctx, db, new(mocksMessaging.Publisher), new(mocksMessaging.Publisher), os.TempDir(), os.TempDir(), 1)
+		if err != nil {
+			panic(err)
+		}
+		mp := geom.NewMultiPolygon(geom.XY)
+		Expect(mp.Push(geom.NewPolygonFlat(geom.XY, []float64{0, 0, 1, 0, 1, 1, 0, 1, 0, 0}, []int{10}))).To(BeNil())
+		aoi, err = geocube.NewAOIFromMultiPolygon(*mp)
+		Expect(err).To(BeNil())
+		Expect(db.CreateAOI(ctx, aoi)).To(BeNil())
+	})
+
+	It("should compare the name exactly, even if it looks like a pattern", func() {
+		Expect(db.CreateRecords(ctx, []*geocube.Record{newRecord("s2a_t31tcj_2024", nil), newRecord("S2A_T31TCJ_2024", nil)})).To(BeNil())
+		for _, name := range []string{"S2A_T31TCJ_2024*", "S2A_T31TCJ_202?", "S2A_T31TCJ_2024(?i)"} {
+			record := newRecord(name, geocube.Metadata{"a": "b"})
+			Expect(db.CreateRecords(ctx, []*geocube.Record{record})).To(BeNil())
+
+			found, err := svc.FindIdenticalRecord(service, ctx, newRecord(name, geocube.Metadata{"a": "b"}))
+			Expect(err).To(BeNil())
+			Expect(found).NotTo(BeNil(), name)
+			Expect(found.ID).To(Equal(record.ID), name)
+
+			found, err = svc.FindIdenticalRecord(service, ctx, newRecord(name, nil))
+			Expect(err).To(BeNil())
+			Expect(found).To(BeNil(), name)
+		}
+	})
+})
@@ -0,0 +1,171 @@
+package svc
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/airbusgeo/geocube/internal/geocube"
+	internalImage "github.com/airbusgeo/geocube/internal/image"
+	"github.com/airbusgeo/geocube/internal/log"
+	"github.com/airbusgeo/geocube/internal/utils/affine"
+	"github.com/airbusgeo/geocube/internal/utils/proj"
+	"github.com/airbusgeo/godal"
+)
+
+// TimeSeriesLocation is a point or a polygon whose time series is extracted
+type TimeSeriesLocation struct {
+	ID string
+	internalImage.TimeSeriesLocation
+}
+
+// TimeSeries is the time series of a location, sorted by datetime
+type TimeSeries struct {
+	Index  int // Index of the location
+	ID     string
+	Values []TimeSeriesValue
+	Err    error // Error of the location (the other locations are not affected)
+}
+
+// TimeSeriesValue is the value of a location at the datetime of a record
+type TimeSeriesValue struct {
+	Record *geocube.Record
+	internalImage.TimeSeriesValue
+}
+
+// validate checks that the location is a point or a polygon
+func (l TimeSeriesLocation) validate() error {
+	if len(l.X) != len(l.Y) {
+		return geocube.NewValidationError("location %s: x and y must have the same length", l.ID)
+	}
+	if len(l.X) != 1 && len(l.X) < 3 {
+		return geocube.NewValidationError("location %s: must be a point or a polygon of at least 3 vertices", l.ID)
+	}
+	return nil
+}
+
+// geographicRing returns a ring in geographic coordinates covering the location defined in crs
+func (l TimeSeriesLocation) geographicRing(crs *godal.SpatialRef) (proj.GeographicRing, error) {
+	minX, maxX, minY, maxY := l.X[0], l.X[0], l.Y[0], l.Y[0]
+	for i := range l.X {
+		minX, maxX, minY, maxY = math.Min(minX, l.X[i]), math.Max(maxX, l.X[i]), math.Min(minY, l.Y[i]), math.Max(maxY, l.Y[i])
+	}
+	// A point is extended to a tiny square
+	w, h := math.Max(maxX-minX, 1e-6), math.Max(maxY-minY, 1e-6)
+	return proj.NewGeographicRingFromExtent(affine.NewAffine(minX, w, 0, maxY, 0, -h), 1, 1, crs)
+}
+
+// GetTimeSeries implements GeocubeService
+// The locations are defined in crs. The records are defined by recordsID or by the filters (recordTags, fromTime, toTime).
+// The time series are streamed in the order of the locations.
+// A location that fails (e.g. too many pixels) is streamed with its error and the next locations are processed.
+func (svc *Service) GetTimeSeries(ctx context.Context, instanceID string, locations []TimeSeriesLocation, crs *godal.SpatialRef,
+	recordsID []string, recordTags geocube.TagsQuery, fromTime, toTime time.Time) (<-chan TimeSeries, error) {
+	if len(locations) == 0 {
+		return nil, geocube.NewValidationError("at least one location must be provided")
+	}
+	for _, location := range locations {
+		if err := location.validate(); err != nil {
+			return nil, fmt.Errorf("GetTimeSeries: %w", err)
+		}
+	}
+	variable, err := svc.db.ReadVariableFromInstanceID(ctx, instanceID)
+	if err != nil {
+		return nil, fmt.Errorf("GetTimeSeries.%w", err)
+	}
+	if err := variable.CheckInstanceExists(instanceID); err != nil {
+		return nil, fmt.Errorf("GetTimeSeries.%w", err)
+	}
+
+	reader, err := internalImage.NewTimeSeriesReader(crs)
+	if err != nil {
+		return nil, fmt.Errorf("GetTimeSeries.%w", err)
+	}
+
+	out := make(chan TimeSeries)
+	go func() {
+		defer close(out)
+		defer reader.Close()
+		start := time.Now()
+		for i, location := range locations {
+			ts := TimeSeries{Index: i, ID: location.ID}
+			ts.Values, ts.Err = svc.getTimeSeries(ctx, reader, instanceID, location, crs, recordsID, recordTags, fromTime, toTime)
+			if ts.Err != nil {
+				log.Logger(ctx).Sugar().Warnf("GetTimeSeries: location %d (%s): %v", i, location.ID, ts.Err)
+			}
+			select {
+			case out <- ts:
+			case <-ctx.Done():
+				return
+			}
+		}
+		log.Logger(ctx).Sugar().Infof("GetTimeSeries: %d location(s) in %v", len(locations), time.Since(start))
+	}()
+	return out, nil
+}
+
+// getTimeSeries returns the time series of the location.
+// The value of a record is read from its topmost dataset covering the location (the last one having valid pixels).
+func (svc *Service) getTimeSeries(ctx context.Context, reader *internalImage.TimeSeriesReader, instanceID string, location TimeSeriesLocation, crs *godal.SpatialRef,
+	recordsID []string, recordTags geocube.TagsQuery, fromTime, toTime time.Time) ([]TimeSeriesValue, error) {
+	geogExtent, err := location.geographicRing(crs)
+	if err != nil {
+		return nil, fmt.Errorf("getTimeSeries.%w", err)
+	}
+
+	// Find the datasets that cover the location
+	datasets, err := svc.db.FindDatasets(ctx, geocube.DatasetStatusACTIVE, nil, "", []string{instanceID}, recordsID, recordTags, fromTime, toTime, &geogExtent, nil, 0, 0, nil, true)
+	if err != nil {
+		return nil, fmt.Errorf("getTimeSeries.%w", err)
+	}
+	if len(datasets) == 0 {
+		return nil, nil
+	}
+	datasetsByRecord, records, err := svc.groupDatasetsByRecord(ctx, datasets)
+	if err != nil {
+		return nil, fmt.Errorf("getTimeSeries.%w", err)
+	}
+
+	// Read the values of all the datasets at once
+	var flatDatasets []*internalImage.Dataset
+	for _, slice := range datasetsByRecord {
+		flatDatasets = append(flatDatasets, slice.Datasets...)
+	}
+	values, err := reader.Read(ctx, flatDatasets, location.TimeSeriesLocation)
+	if err != nil {
+		return nil, fmt.Errorf("getTimeSeries.%w", err)
+	}
+
+	var timeSeries []TimeSeriesValue
+	for i, slice := range datasetsByRecord {
+		recordValues := values[:len(slice.Datasets)]
+		values = values[len(slice.Datasets):]
+
+		var value *internalImage.TimeSeriesValue
+		for j := len(recordValues) - 1; j >= 0; j-- {
+			if v := recordValues[j]; v != nil {
+				if value == nil {
+					value = v
+				}
+				if hasValidPixels(v) {
+					value = v
+					break
+				}
+			}
+		}
+		if value != nil {
+			timeSeries = append(timeSeries, TimeSeriesValue{Record: records[i], TimeSeriesValue: *value})
+		}
+	}
+	return timeSeries, nil
+}
+
+func hasValidPixels(v *internalImage.TimeSeriesValue) bool {
+	for _, n := range v.NbPixels {
+		if n > 0 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,211 @@
+package svc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/airbusgeo/geocube/internal/geocube"
+	internalImage "github.com/airbusgeo/geocube/internal/image"
+	"github.com/airbusgeo/geocube/internal/log"
+	"github.com/airbusgeo/geocube/internal/utils/affine"
+	"github.com/airbusgeo/geocube/internal/utils/proj"
+	"github.com/twpayne/go-geom"
+	"github.com/twpayne/go-geom/encoding/geojson"
+)
+
+// Zone is a polygon or a multipolygon in geographic coordinates (lon/lat) whose statistics are computed
+type Zone struct {
+	ID       string
+	Geometry *geom.MultiPolygon
+}
+
+// ZonalStatistics are the statistics of a zone at the datetime of a record
+type ZonalStatistics struct {
+	ZoneIndex int // Index of the zone
+	ZoneID    string
+	Record    *geocube.Record
+	Bands     []internalImage.ZonalStatistics
+	Err       error // Error of the zone for the record, or of the whole zone if Record is nil (the other zones and records are not affected)
+}
+
+// ZonesFromGeoJSON returns the zones of a GeoJSON FeatureCollection (or Feature) of Polygons and MultiPolygons.
+// The id of a zone is the property idProperty of the feature (or the id of the feature if idProperty is empty).
+// Only returns ValidationError
+func ZonesFromGeoJSON(data []byte, idProperty string) ([]Zone, error) {
+	var header struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &header); err != nil {
+		return nil, geocube.NewValidationError("invalid zones: %v", err)
+	}
+	var features []*geojson.Feature
+	switch header.Type {
+	case "FeatureCollection":
+		var fc geojson.FeatureCollection
+		if err := json.Unmarshal(data, &fc); err != nil {
+			return nil, geocube.NewValidationError("invalid zones: %v", err)
+		}
+		features = fc.Features
+	case "Feature":
+		var f geojson.Feature
+		if err := json.Unmarshal(data, &f); err != nil {
+			return nil, geocube.NewValidationError("invalid zones: %v", err)
+		}
+		features = []*geojson.Feature{&f}
+	default:
+		return nil, geocube.NewValidationError("zones must be a GeoJSON FeatureCollection or Feature")
+	}
+
+	zones := make([]Zone, len(features))
+	for i, f := range features {
+		zones[i].ID = f.ID
+		if idProperty != "" {
+			switch v := f.Properties[idProperty].(type) {
+			case string:
+				zones[i].ID = v
+			case float64:
+				zones[i].ID = strconv.FormatFloat(v, 'f', -1, 64)
+			case nil:
+				return nil, geocube.NewValidationError("zone %d: property %s not found", i, idProperty)
+			default:
+				zones[i].ID = fmt.Sprint(v)
+			}
+		}
+		switch g := f.Geometry.(type) {
+		case *geom.Polygon:
+			zones[i].Geometry = geom.NewMultiPolygon(geom.XY)
+			if err := zones[i].Geometry.Push(geom.NewPolygonFlat(geom.XY, g.FlatCoords(), g.Ends())); err != nil {
+				return nil, geocube.NewValidationError("zone %d: invalid geometry: %v", i, err)
+			}
+		case *geom.MultiPolygon:
+			zones[i].Geometry = geom.NewMultiPolygonFlat(geom.XY, g.FlatCoords(), g.Endss())
+		default:
+			return nil, geocube.NewValidationError("zone %d: the geometry must be a Polygon or a MultiPolygon", i)
+		}
+		if zones[i].Geometry.Empty() {
+			return nil, geocube.NewValidationError("zone %d: empty geometry", i)
+		}
+	}
+	return zones, nil
+}
+
+// geographicRing returns the bounding box of the zone
+func (z Zone) geographicRing() (proj.GeographicRing, error) {
+	crs, err := proj.CRSFromEPSG(4326)
+	if err != nil {
+		return proj.GeographicRing{}, err
+	}
+	b := z.Geometry.Bounds()
+	return proj.NewGeographicRingFromExtent(affine.NewAffine(b.Min(0), b.Max(0)-b.Min(0), 0, b.Max(1), 0, b.Min(1)-b.Max(1)), 1, 1, crs)
+}
+
+// GetZonalStatistics implements GeocubeService
+// The records are defined by recordsID or by the filters (recordTags, fromTime, toTime).
+// The statistics are streamed zone by zone, sorted by datetime of record.
+// A zone or a record that fails (e.g. the zone is too large) is streamed with its error and the next ones are processed.
+func (svc *Service) GetZonalStatistics(ctx context.Context, instanceID string, zones []Zone, recordsID []string, recordTags geocube.TagsQuery,
+	fromTime, toTime time.Time, options internalImage.ZonalStatisticsOptions) (<-chan ZonalStatistics, error) {
+	if len(zones) == 0 {
+		return nil, geocube.NewValidationError("at least one zone must be provided")
+	}
+	if len(options.Statistics) == 0 {
+		options.Statistics = []internalImage.ZonalStatistic{internalImage.ZonalMean}
+	}
+	for _, p := range options.Percentiles {
+		if p < 0 || p > 100 {
+			return nil, geocube.NewValidationError("percentile must be in [0, 100]: %v", p)
+		}
+	}
+	if options.HistogramBins < 0 {
+		return nil, geocube.NewValidationError("the number of bins of the histogram must be positive")
+	}
+
+	variable, err := svc.db.ReadVariableFromInstanceID(ctx, instanceID)
+	if err != nil {
+		return nil, fmt.Errorf("GetZonalStatistics.%w", err)
+	}
+	if err := variable.CheckInstanceExists(instanceID); err != nil {
+		return nil, fmt.Errorf("GetZonalStatistics.%w", err)
+	}
+	if options.HistogramBins > 0 {
+		if options.HistogramMin == 0 && options.HistogramMax == 0 {
+			options.HistogramMin, options.HistogramMax = variable.DFormat.Range.Min, variable.DFormat.Range.Max
+		}
+		if options.HistogramMin >= options.HistogramMax {
+			return nil, geocube.NewValidationError("the range of the histogram is empty: [%v, %v]", options.HistogramMin, options.HistogramMax)
+		}
+	}
+	outDesc := internalImage.GdalDatasetDescriptor{
+		Bands:       len(variable.Bands),
+		Resampling:  variable.Resampling,
+		DataMapping: geocube.DataMapping{RangeExt: variable.DFormat.Range},
+	}
+
+	reader := internalImage.NewZonalStatisticsReader()
+	out := make(chan ZonalStatistics)
+	go func() {
+		defer close(out)
+		defer reader.Close()
+		start := time.Now()
+		send := func(stats ZonalStatistics) bool {
+			if stats.Err != nil {
+				log.Logger(ctx).Sugar().Warnf("GetZonalStatistics: zone %d (%s): %v", stats.ZoneIndex, stats.ZoneID, stats.Err)
+			}
+			select {
+			case out <- stats:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+		for i, zone := range zones {
+			if err := svc.getZonalStatistics(ctx, reader, i, zone, instanceID, recordsID, recordTags, fromTime, toTime, outDesc, &options, send); err != nil {
+				if !send(ZonalStatistics{ZoneIndex: i, ZoneID: zone.ID, Err: err}) {
+					return
+				}
+			}
+			if ctx.Err() != nil {
+				return
+			}
+		}
+		log.Logger(ctx).Sugar().Infof("GetZonalStatistics: %d zone(s) in %v", len(zones), time.Since(start))
+	}()
+	return out, nil
+}
+
+// getZonalStatistics sends the statistics of the zone for each record having datasets covering the zone
+// The datasets of a record are merged as in GetCube. The error of a record is sent with the record.
+func (svc *Service) getZonalStatistics(ctx context.Context, reader *internalImage.ZonalStatisticsReader, index int, zone Zone, instanceID string, recordsID []string, recordTags geocube.TagsQuery,
+	fromTime, toTime time.Time, outDesc internalImage.GdalDatasetDescriptor, options *internalImage.ZonalStatisticsOptions, send func(ZonalStatistics) bool) error {
+	geogExtent, err := zone.geographicRing()
+	if err != nil {
+		return fmt.Errorf("getZonalStatistics.%w", err)
+	}
+
+	// Find the datasets that cover the zone
+	datasets, err := svc.db.FindDatasets(ctx, geocube.DatasetStatusACTIVE, nil, "", []string{instanceID}, recordsID, recordTags, fromTime, toTime, &geogExtent, nil, 0, 0, nil, true)
+	if err != nil {
+		return fmt.Errorf("getZonalStatistics.%w", err)
+	}
+	if len(datasets) == 0 {
+		return nil
+	}
+	datasetsByRecord, records, err := svc.groupDatasetsByRecord(ctx, datasets)
+	if err != nil {
+		return fmt.Errorf("getZonalStatistics.%w", err)
+	}
+
+	for i, slice := range datasetsByRecord {
+		stats := ZonalStatistics{ZoneIndex: index, ZoneID: zone.ID, Record: records[i]}
+		if stats.Bands, err = reader.Compute(ctx, slice.Datasets, zone.Geometry, outDesc, options); err != nil {
+			stats.Err = fmt.Errorf("getZonalStatistics.%w", err)
+		}
+		if !send(stats) {
+			return nil
+		}
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package svc_test
+
+import (
+	"github.com/airbusgeo/geocube/internal/geocube"
+	"github.com/airbusgeo/geocube/internal/svc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ZonesFromGeoJSON", func() {
+
+	const featureCollection = `{"type": "FeatureCollection", "features": [
+		{"type": "Feature", "id": "a", "properties": {"parcel": 12},
+		 "geometry": {"type": "Polygon", "coordinates": [[[0, 0], [1, 0], [1, 1], [0, 0]]]}},
+		{"type": "Feature", "id": 2, "properties": {"parcel": "b"},
+		 "geometry": {"type": "MultiPolygon", "coordinates": [[[[0, 0], [1, 0], [1, 1], [0, 0]]], [[[2, 2], [3, 2], [3, 3], [2, 2]]]]}}
+	]}`
+
+	It("should return the zones with the id of the features", func() {
+		zones, err := svc.ZonesFromGeoJSON([]byte(featureCollection), "")
+		Expect(err).To(BeNil())
+		Expect(zones).To(HaveLen(2))
+		Expect(zones[0].ID).To(Equal("a"))
+		Expect(zones[0].Geometry.NumPolygons()).To(Equal(1))
+		Expect(zones[1].ID).To(Equal("2"))
+		Expect(zones[1].Geometry.NumPolygons()).To(Equal(2))
+	})
+
+	It("should return the zones with the id of a property", func() {
+		zones, err := svc.ZonesFromGeoJSON([]byte(featureCollection), "parcel")
+		Expect(err).To(BeNil())
+		Expect(zones[0].ID).To(Equal("12"))
+		Expect(zones[1].ID).To(Equal("b"))
+	})
+
+	It("should accept a single feature", func() {
+		zones, err := svc.ZonesFromGeoJSON([]byte(`{"type": "Feature", "id": "a", "properties": {},
+			"geometry": {"type": "Polygon", "coordinates": [[[0, 0], [1, 0], [1, 1], [0, 0]]]}}`), "")
+		Expect(err).To(BeNil())
+		Expect(zones).To(HaveLen(1))
+	})
+
+	It("should refuse invalid zones", func() {
+		for _, zones := range []string{
+			`{"type": "Polygon", "coordinates": [[[0, 0], [1, 0], [1, 1], [0, 0]]]}`,
+			`{"type": "Feature", "properties": {}, "geometry": {"type": "Point", "coordinates": [0, 0]}}`,
+			`not a json`,
+		} {
+			_, err := svc.ZonesFromGeoJSON([]byte(zones), "")
+			Expect(geocube.IsError(err, geocube.EntityValidationError)).To(BeTrue(), zones)
+		}
+		_, err := svc.ZonesFromGeoJSON([]byte(featureCollection), "unknown")
+		Expect(geocube.IsError(err, geocube.EntityValidationError)).To(BeTrue())
+	})
+})
@@ -335,6 +335,53 @@ func (ba *ByteArray) Restart() error {
 	return nil
 }
 
+// /////////////////////////////////////////////////////////////////////
+// MultiChunkReader implements ChunkReader as the concatenation of several ChunkReaders
+type MultiChunkReader struct {
+	Readers []ChunkReader
+	cur     int
+}
+
+func (mr *MultiChunkReader) Next(chunkSize int) ([]byte, error) {
+	var chunk []byte
+	for len(chunk) < chunkSize && mr.cur < len(mr.Readers) {
+		b, err := mr.Readers[mr.cur].Next(chunkSize - len(chunk))
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+		if err == io.EOF || len(b) == 0 {
+			mr.cur++
+			continue
+		}
+		if chunk == nil && len(b) == chunkSize {
+			return b, nil
+		}
+		chunk = append(chunk, b...)
+	}
+	if len(chunk) == 0 {
+		return nil, io.EOF
+	}
+	return chunk, nil
+}
+
+func (mr *MultiChunkReader) Len() int {
+	l := 0
+	for _, r := range mr.Readers {
+		l += r.Len()
+	}
+	return l
+}
+
+func (mr *MultiChunkReader) Restart() error {
+	for _, r := range mr.Readers {
+		if err := r.Restart(); err != nil {
+			return err
+		}
+	}
+	mr.cur = 0
+	return nil
+}
+
 func getPix(bytes []byte, dtype DType) interface{} {
 	// Convert up to a slice of the right type
 	var pix interface{}
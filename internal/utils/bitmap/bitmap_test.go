@@ -2,6 +2,7 @@ package bitmap
 
 import (
 	"bytes"
+	"io"
 	"reflect"
 	"testing"
 
@@ -71,6 +72,31 @@ func TestRotatingBuffer(t *testing.T) {
 	testInternalBuffer(t, &rb, []byte{16, 17, 18, 19}, 4)
 }
 
+func TestMultiChunkReader(t *testing.T) {
+	mr := MultiChunkReader{Readers: []ChunkReader{
+		&ByteArray{Bytes: []byte{1, 2, 3}},
+		&ByteArray{},
+		&ByteArray{Bytes: []byte{4, 5, 6, 7, 8}},
+	}}
+	if mr.Len() != 8 {
+		t.Errorf("Len() : want 8, got %d", mr.Len())
+	}
+	for _, exp := range [][]byte{{1, 2}, {3, 4}, {5, 6}, {7, 8}} {
+		if res, err := mr.Next(2); err != nil || !reflect.DeepEqual(res, exp) {
+			t.Errorf("Next(2) : want %v, got %v, %v", exp, res, err)
+		}
+	}
+	if _, err := mr.Next(2); err != io.EOF {
+		t.Errorf("Next(2) : want EOF, got %v", err)
+	}
+	if err := mr.Restart(); err != nil {
+		t.Fatal(err)
+	}
+	if res, err := mr.Next(10); err != nil || !reflect.DeepEqual(res, []byte{1, 2, 3, 4, 5, 6, 7, 8}) {
+		t.Errorf("Next(10) : got %v, %v", res, err)
+	}
+}
+
 var _ = Describe("Test Streamable Bitmap", func() {
 	var path string
 	var chunkSize int